@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type idempotencyKeyCleaner interface {
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// runIdempotencyKeyCleanup removes expired idempotency keys every interval until ctx is canceled.
+// Expired keys are ignored by the middleware anyway, the cleanup only keeps the table small.
+func runIdempotencyKeyCleanup(ctx context.Context, cleaner idempotencyKeyCleaner, interval time.Duration, logger *zap.Logger) {
+	logger.Info("starting idempotency key cleanup...")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		count, err := cleaner.DeleteExpired(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("idempotency key cleanup", zap.Error(err))
+		}
+		if count > 0 {
+			logger.Debug("idempotency key cleanup: expired keys removed", zap.Int64("count", count))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
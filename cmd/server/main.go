@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,31 +16,83 @@ import (
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
+	"github.com/inna-maikut/avito-pvz/internal/api/api_key_create"
+	"github.com/inna-maikut/avito-pvz/internal/api/api_key_list"
+	"github.com/inna-maikut/avito-pvz/internal/api/api_key_revoke"
+	"github.com/inna-maikut/avito-pvz/internal/api/audit_get"
 	"github.com/inna-maikut/avito-pvz/internal/api/dummy_login"
+	"github.com/inna-maikut/avito-pvz/internal/api/jwks_get"
 	"github.com/inna-maikut/avito-pvz/internal/api/login"
+	"github.com/inna-maikut/avito-pvz/internal/api/logout"
+	"github.com/inna-maikut/avito-pvz/internal/api/oidc_callback"
+	"github.com/inna-maikut/avito-pvz/internal/api/oidc_login"
 	"github.com/inna-maikut/avito-pvz/internal/api/product_add"
+	"github.com/inna-maikut/avito-pvz/internal/api/product_batch_add"
+	"github.com/inna-maikut/avito-pvz/internal/api/product_delete"
 	"github.com/inna-maikut/avito-pvz/internal/api/product_remove_last"
+	"github.com/inna-maikut/avito-pvz/internal/api/pvz_details_get"
+	"github.com/inna-maikut/avito-pvz/internal/api/pvz_employee_assign"
+	"github.com/inna-maikut/avito-pvz/internal/api/pvz_employee_unassign"
 	"github.com/inna-maikut/avito-pvz/internal/api/pvz_get"
+	"github.com/inna-maikut/avito-pvz/internal/api/pvz_receptions_get"
 	"github.com/inna-maikut/avito-pvz/internal/api/pvz_register"
 	"github.com/inna-maikut/avito-pvz/internal/api/reception_close"
 	"github.com/inna-maikut/avito-pvz/internal/api/reception_create"
+	"github.com/inna-maikut/avito-pvz/internal/api/reception_get"
+	"github.com/inna-maikut/avito-pvz/internal/api/reception_reopen"
 	"github.com/inna-maikut/avito-pvz/internal/api/register"
+	"github.com/inna-maikut/avito-pvz/internal/api/token_refresh"
+	"github.com/inna-maikut/avito-pvz/internal/api/user_list"
+	"github.com/inna-maikut/avito-pvz/internal/api/user_password_change"
+	"github.com/inna-maikut/avito-pvz/internal/api/user_password_reset"
+	"github.com/inna-maikut/avito-pvz/internal/api/user_sso_link_allow"
+	"github.com/inna-maikut/avito-pvz/internal/api/user_unlock"
+	"github.com/inna-maikut/avito-pvz/internal/api/user_update"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_create"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_delete"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_deliveries_get"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_get"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_list"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_update"
+	"github.com/inna-maikut/avito-pvz/internal/generated/pvz_v1"
+	"github.com/inna-maikut/avito-pvz/internal/grpc_api/pvz_service"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/metrics"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/middleware"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/outbox_sink"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/password_policy"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/pg"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/rate_limit"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/role_permissions"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/webhook_sender"
+	"github.com/inna-maikut/avito-pvz/internal/model"
 	"github.com/inna-maikut/avito-pvz/internal/repository"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/api_key_managing"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/audit_getting"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/authenticating"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/dummy_authenticating"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/logging_out"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/outbox_relaying"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/product_adding"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/product_removing"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/pvz_assigning"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/pvz_getting"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/pvz_list_getting"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/pvz_registering"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/reception_closing"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/reception_creating"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/reception_getting"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/reception_reopening"
 	"github.com/inna-maikut/avito-pvz/internal/usecases/registering"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/token_refreshing"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/user_managing"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/user_unlocking"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/webhook_delivering"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/webhook_dispatching"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/webhook_subscribing"
 )
 
 const (
@@ -47,13 +100,23 @@ const (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrateMain(os.Args[2:]))
+	}
+
 	cfg := config.Load()
 
+	authMode, err := model.ParseAuthMode(cfg.AppEnv)
+	if err != nil {
+		panic(fmt.Errorf("parse APP_ENV: %w", err))
+	}
+	dummyTokenAccess := authMode.DummyTokenAccess(cfg.DummyTokensReadOnly)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	logger := zap.Must(zap.NewProduction())
-	if os.Getenv("APP_ENV") == "development" {
+	if authMode == model.AuthModeDevelopment {
 		logger = zap.Must(zap.NewDevelopment())
 	}
 	defer func() {
@@ -87,6 +150,10 @@ func main() {
 		panic(fmt.Errorf("create metrics: %w", err))
 	}
 
+	logger.Info("auth mode", zap.Stringer("mode", authMode), zap.Bool("dummyLogin", authMode.DummyLoginEnabled()),
+		zap.Stringer("dummyTokens", dummyTokenAccess))
+	metric.SetAuthMode(authMode.String(), dummyTokenAccess.String())
+
 	// Postgres DB and repositories
 
 	db, cancelDB, err := pg.NewDB(ctx, cfg)
@@ -95,9 +162,21 @@ func main() {
 	}
 	defer cancelDB()
 
+	if cfg.MigrateOnStart {
+		err = runMigrateCommand(ctx, db, "up", os.Stdout, logger)
+		if err != nil {
+			panic(fmt.Errorf("apply migrations: %w", err))
+		}
+	}
+
 	trManager := manager.Must(trmsqlx.NewDefaultFactory(db))
 
-	tokenProvider, err := jwt.NewProviderFromEnv()
+	jwtKeys, err := jwt.LoadKeySet(cfg.JWTKeysDir)
+	if err != nil {
+		panic(fmt.Errorf("load jwt keys: %w", err))
+	}
+
+	tokenProvider, err := jwt.New(jwtKeys, cfg.JWTSigningKeyID, cfg.AccessTokenTTL)
 	if err != nil {
 		panic(fmt.Errorf("create jwt provider: %w", err))
 	}
@@ -127,55 +206,261 @@ func main() {
 		panic(fmt.Errorf("create user repository: %w", err))
 	}
 
+	auditRepo, err := repository.NewAuditRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create audit repository: %w", err))
+	}
+
+	outboxRepo, err := repository.NewOutboxRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create outbox repository: %w", err))
+	}
+
+	webhookSubscriptionRepo, err := repository.NewWebhookSubscriptionRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create webhook subscription repository: %w", err))
+	}
+
+	webhookDeliveryRepo, err := repository.NewWebhookDeliveryRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create webhook delivery repository: %w", err))
+	}
+
+	refreshTokenRepo, err := repository.NewRefreshTokenRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create refresh token repository: %w", err))
+	}
+
+	revokedTokenRepo, err := repository.NewRevokedTokenRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create revoked token repository: %w", err))
+	}
+
+	pvzAssignmentRepo, err := repository.NewPVZAssignmentRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create pvz assignment repository: %w", err))
+	}
+
+	loginFailureRepo, err := repository.NewLoginFailureRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create login failure repository: %w", err))
+	}
+
+	apiKeyRepo, err := repository.NewAPIKeyRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create api key repository: %w", err))
+	}
+
+	idempotencyKeyRepo, err := repository.NewIdempotencyKeyRepository(db, trmsqlx.DefaultCtxGetter)
+	if err != nil {
+		panic(fmt.Errorf("create idempotency key repository: %w", err))
+	}
+
+	// Infrastructure
+
+	revocationCache, err := jwt.NewRevocationCache(revokedTokenRepo, cfg.TokenRevocationCacheTTL)
+	if err != nil {
+		panic(fmt.Errorf("create token revocation cache: %w", err))
+	}
+
+	activeUserCache, err := jwt.NewActiveUserCache(userRepo, cfg.TokenRevocationCacheTTL)
+	if err != nil {
+		panic(fmt.Errorf("create active user cache: %w", err))
+	}
+
+	breachedPasswords, err := password_policy.LoadBreachedList(cfg.BreachedPasswordsFile)
+	if err != nil {
+		panic(fmt.Errorf("load breached passwords: %w", err))
+	}
+
+	passwordChecker, err := password_policy.NewChecker(model.PasswordPolicy{
+		MinLength:        cfg.PasswordMinLength,
+		MaxLength:        cfg.PasswordMaxLength,
+		RequireUppercase: cfg.PasswordRequireUppercase,
+		RequireLowercase: cfg.PasswordRequireLowercase,
+		RequireDigit:     cfg.PasswordRequireDigit,
+		RequireSpecial:   cfg.PasswordRequireSpecial,
+	}, breachedPasswords)
+	if err != nil {
+		panic(fmt.Errorf("create password checker: %w", err))
+	}
+
+	rolePermissions, err := role_permissions.Load(cfg.RolePermissionsFile)
+	if err != nil {
+		panic(fmt.Errorf("load role permissions: %w", err))
+	}
+
+	webhookSender, err := webhook_sender.New(cfg.WebhookTimeout)
+	if err != nil {
+		panic(fmt.Errorf("create webhook sender: %w", err))
+	}
+
+	rateLimitRouteRules, err := rate_limit.ParseRouteRules(cfg.RateLimitRoutes)
+	if err != nil {
+		panic(fmt.Errorf("parse rate limit route rules: %w", err))
+	}
+
+	rateLimiter, err := rate_limit.NewLimiter(rate_limit.Rule{Rate: cfg.RateLimitRate, Burst: cfg.RateLimitBurst},
+		rateLimitRouteRules)
+	if err != nil {
+		panic(fmt.Errorf("create rate limiter: %w", err))
+	}
+
+	ipRateLimiter, err := rate_limit.NewLimiter(rate_limit.Rule{Rate: cfg.RateLimitIPRate, Burst: cfg.RateLimitIPBurst}, nil)
+	if err != nil {
+		panic(fmt.Errorf("create ip rate limiter: %w", err))
+	}
+
 	// Use cases
 
+	auditGetting, err := audit_getting.New(auditRepo)
+	if err != nil {
+		panic(fmt.Errorf("create audit_getting use case: %w", err))
+	}
+
 	dummyAuthentication, err := dummy_authenticating.New(tokenProvider)
 	if err != nil {
 		panic(fmt.Errorf("create dummy_authenticating use case: %w", err))
 	}
 
-	authentication, err := authenticating.New(userRepo, tokenProvider)
+	authentication, err := authenticating.New(userRepo, tokenProvider, refreshTokenRepo, pvzAssignmentRepo, loginFailureRepo,
+		model.LoginPolicy{
+			MaxFailures:      cfg.LoginMaxFailures,
+			MaxFailuresPerIP: cfg.LoginMaxFailuresPerIP,
+			Delay:            cfg.LoginFailureDelay,
+			Lockout:          cfg.LoginLockoutDuration,
+		}, cfg.RefreshTokenTTL)
 	if err != nil {
 		panic(fmt.Errorf("create authenticating use case: %w", err))
 	}
 
-	registration, err := registering.New(userRepo)
+	tokenRefreshing, err := token_refreshing.New(trManager, refreshTokenRepo, userRepo, tokenProvider, pvzAssignmentRepo,
+		cfg.RefreshTokenTTL)
+	if err != nil {
+		panic(fmt.Errorf("create token_refreshing use case: %w", err))
+	}
+
+	loggingOut, err := logging_out.New(trManager, refreshTokenRepo, revocationCache)
+	if err != nil {
+		panic(fmt.Errorf("create logging_out use case: %w", err))
+	}
+
+	userUnlocking, err := user_unlocking.New(userRepo, loginFailureRepo)
+	if err != nil {
+		panic(fmt.Errorf("create user_unlocking use case: %w", err))
+	}
+
+	userManaging, err := user_managing.New(trManager, userRepo, refreshTokenRepo, passwordChecker, activeUserCache)
+	if err != nil {
+		panic(fmt.Errorf("create user_managing use case: %w", err))
+	}
+
+	apiKeyManaging, err := api_key_managing.New(apiKeyRepo)
+	if err != nil {
+		panic(fmt.Errorf("create api_key_managing use case: %w", err))
+	}
+
+	registration, err := registering.New(userRepo, passwordChecker)
 	if err != nil {
 		panic(fmt.Errorf("create registering use case: %w", err))
 	}
 
-	productAdding, err := product_adding.New(trManager, receptionRepo, pvzLocker, productRepo, metric)
+	productAdding, err := product_adding.New(trManager, receptionRepo, pvzLocker, productRepo, auditRepo, outboxRepo, metric)
 	if err != nil {
 		panic(fmt.Errorf("create product_adding use case: %w", err))
 	}
 
-	productRemoving, err := product_removing.New(trManager, receptionRepo, pvzLocker, productRepo)
+	productRemoving, err := product_removing.New(trManager, receptionRepo, pvzLocker, productRepo, auditRepo, outboxRepo)
 	if err != nil {
 		panic(fmt.Errorf("create product_removing use case: %w", err))
 	}
 
+	pvzAssigning, err := pvz_assigning.New(pvzRepo, userRepo, pvzAssignmentRepo)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_assigning use case: %w", err))
+	}
+
 	pvzListGetting, err := pvz_list_getting.New(pvzRepo, receptionRepo, productRepo)
 	if err != nil {
 		panic(fmt.Errorf("create pvz_list_getting use case: %w", err))
 	}
 
-	pvzRegistering, err := pvz_registering.New(pvzRepo, metric)
+	pvzGetting, err := pvz_getting.New(pvzRepo, receptionRepo, productRepo)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_getting use case: %w", err))
+	}
+
+	pvzRegistering, err := pvz_registering.New(trManager, pvzRepo, auditRepo, metric)
 	if err != nil {
 		panic(fmt.Errorf("create pvz_registering use case: %w", err))
 	}
 
-	receptionClosing, err := reception_closing.New(trManager, receptionRepo, pvzLocker)
+	receptionClosing, err := reception_closing.New(trManager, receptionRepo, pvzLocker, auditRepo, outboxRepo)
 	if err != nil {
 		panic(fmt.Errorf("create reception_closing use case: %w", err))
 	}
 
-	receptionCreating, err := reception_creating.New(trManager, receptionRepo, pvzLocker, metric)
+	receptionReopening, err := reception_reopening.New(trManager, receptionRepo, pvzLocker, auditRepo, outboxRepo)
+	if err != nil {
+		panic(fmt.Errorf("create reception_reopening use case: %w", err))
+	}
+
+	receptionGetting, err := reception_getting.New(receptionRepo, productRepo)
+	if err != nil {
+		panic(fmt.Errorf("create reception_getting use case: %w", err))
+	}
+
+	receptionCreating, err := reception_creating.New(trManager, receptionRepo, pvzLocker, auditRepo, outboxRepo, metric)
 	if err != nil {
 		panic(fmt.Errorf("create reception_creating use case: %w", err))
 	}
 
+	webhookSubscribing, err := webhook_subscribing.New(webhookSubscriptionRepo, webhookDeliveryRepo)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_subscribing use case: %w", err))
+	}
+
+	webhookDispatching, err := webhook_dispatching.New(webhookSubscriptionRepo, webhookDeliveryRepo)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_dispatching use case: %w", err))
+	}
+
+	webhookDelivering, err := webhook_delivering.New(webhookDeliveryRepo, webhookSender,
+		cfg.WebhookBatchSize, cfg.WebhookMaxAttempts, cfg.WebhookLease)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_delivering use case: %w", err))
+	}
+
+	// the relay always schedules webhook subscription deliveries, OUTBOX_SINK adds one more destination
+	sinks := []outbox_sink.Sink{webhookDispatching}
+	if cfg.OutboxSink != "" {
+		sink, closeSink, err := newOutboxSink(cfg)
+		if err != nil {
+			panic(fmt.Errorf("create outbox sink: %w", err))
+		}
+		defer closeSink()
+
+		sinks = append(sinks, sink)
+	}
+
+	outboxRelaying, err := outbox_relaying.New(outboxRepo, outbox_sink.NewMultiSink(sinks...), cfg.OutboxBatchSize,
+		cfg.OutboxMaxAttempts, cfg.OutboxLease)
+	if err != nil {
+		panic(fmt.Errorf("create outbox_relaying use case: %w", err))
+	}
+
 	// API Handlers
 
+	auditGetHandler, err := audit_get.New(auditGetting, logger)
+	if err != nil {
+		panic(fmt.Errorf("create audit_get handler: %w", err))
+	}
+
+	jwksGetHandler, err := jwks_get.New(tokenProvider)
+	if err != nil {
+		panic(fmt.Errorf("create jwks_get handler: %w", err))
+	}
+
 	dummyLoginHandler, err := dummy_login.New(dummyAuthentication, logger)
 	if err != nil {
 		panic(fmt.Errorf("create dummy_login handler: %w", err))
@@ -186,6 +471,27 @@ func main() {
 		panic(fmt.Errorf("create login handler: %w", err))
 	}
 
+	// the OpenID Connect login is served when OIDC_ISSUER is set
+	var oidcLoginHandler *oidc_login.Handler
+	var oidcCallbackHandler *oidc_callback.Handler
+	if cfg.OIDCIssuer != "" {
+		oidcLoginHandler, oidcCallbackHandler, err = newOIDCHandlers(ctx, cfg, userRepo, tokenProvider, refreshTokenRepo,
+			pvzAssignmentRepo, logger)
+		if err != nil {
+			panic(fmt.Errorf("create oidc handlers: %w", err))
+		}
+	}
+
+	tokenRefreshHandler, err := token_refresh.New(tokenRefreshing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create token_refresh handler: %w", err))
+	}
+
+	logoutHandler, err := logout.New(loggingOut, logger)
+	if err != nil {
+		panic(fmt.Errorf("create logout handler: %w", err))
+	}
+
 	registerHandler, err := register.New(registration, logger)
 	if err != nil {
 		panic(fmt.Errorf("create register handler: %w", err))
@@ -196,6 +502,16 @@ func main() {
 		panic(fmt.Errorf("create product_add handler: %w", err))
 	}
 
+	productBatchAddHandler, err := product_batch_add.New(productAdding, logger)
+	if err != nil {
+		panic(fmt.Errorf("create product_batch_add handler: %w", err))
+	}
+
+	productDeleteHandler, err := product_delete.New(productRemoving, logger)
+	if err != nil {
+		panic(fmt.Errorf("create product_delete handler: %w", err))
+	}
+
 	productRemoveLastHandler, err := product_remove_last.New(productRemoving, logger)
 	if err != nil {
 		panic(fmt.Errorf("create product_remove_last handler: %w", err))
@@ -206,11 +522,31 @@ func main() {
 		panic(fmt.Errorf("create pvz_get handler: %w", err))
 	}
 
+	pvzDetailsGetHandler, err := pvz_details_get.New(pvzGetting, logger)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_details_get handler: %w", err))
+	}
+
+	pvzReceptionsGetHandler, err := pvz_receptions_get.New(pvzGetting, logger)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_receptions_get handler: %w", err))
+	}
+
 	pvzRegisterHandler, err := pvz_register.New(pvzRegistering, logger)
 	if err != nil {
 		panic(fmt.Errorf("create pvz_register handler: %w", err))
 	}
 
+	pvzEmployeeAssignHandler, err := pvz_employee_assign.New(pvzAssigning, logger)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_employee_assign handler: %w", err))
+	}
+
+	pvzEmployeeUnassignHandler, err := pvz_employee_unassign.New(pvzAssigning, logger)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_employee_unassign handler: %w", err))
+	}
+
 	receptionCloseHandler, err := reception_close.New(receptionClosing, logger)
 	if err != nil {
 		panic(fmt.Errorf("create reception_close handler: %w", err))
@@ -221,31 +557,179 @@ func main() {
 		panic(fmt.Errorf("create reception_create handler: %w", err))
 	}
 
+	receptionReopenHandler, err := reception_reopen.New(receptionReopening, logger)
+	if err != nil {
+		panic(fmt.Errorf("create reception_reopen handler: %w", err))
+	}
+
+	receptionGetHandler, err := reception_get.New(receptionGetting, logger)
+	if err != nil {
+		panic(fmt.Errorf("create reception_get handler: %w", err))
+	}
+
+	webhookCreateHandler, err := webhook_create.New(webhookSubscribing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_create handler: %w", err))
+	}
+
+	webhookListHandler, err := webhook_list.New(webhookSubscribing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_list handler: %w", err))
+	}
+
+	webhookGetHandler, err := webhook_get.New(webhookSubscribing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_get handler: %w", err))
+	}
+
+	webhookUpdateHandler, err := webhook_update.New(webhookSubscribing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_update handler: %w", err))
+	}
+
+	webhookDeleteHandler, err := webhook_delete.New(webhookSubscribing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_delete handler: %w", err))
+	}
+
+	webhookDeliveriesGetHandler, err := webhook_deliveries_get.New(webhookSubscribing, logger)
+	if err != nil {
+		panic(fmt.Errorf("create webhook_deliveries_get handler: %w", err))
+	}
+
+	userUnlockHandler, err := user_unlock.New(userUnlocking, logger)
+	if err != nil {
+		panic(fmt.Errorf("create user_unlock handler: %w", err))
+	}
+
+	userListHandler, err := user_list.New(userManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create user_list handler: %w", err))
+	}
+
+	userUpdateHandler, err := user_update.New(userManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create user_update handler: %w", err))
+	}
+
+	userPasswordResetHandler, err := user_password_reset.New(userManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create user_password_reset handler: %w", err))
+	}
+
+	userSSOLinkAllowHandler, err := user_sso_link_allow.New(userManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create user_sso_link_allow handler: %w", err))
+	}
+
+	userPasswordChangeHandler, err := user_password_change.New(userManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create user_password_change handler: %w", err))
+	}
+
+	apiKeyCreateHandler, err := api_key_create.New(apiKeyManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create api_key_create handler: %w", err))
+	}
+
+	apiKeyListHandler, err := api_key_list.New(apiKeyManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create api_key_list handler: %w", err))
+	}
+
+	apiKeyRevokeHandler, err := api_key_revoke.New(apiKeyManaging, logger)
+	if err != nil {
+		panic(fmt.Errorf("create api_key_revoke handler: %w", err))
+	}
+
+	// gRPC services
+
+	pvzService, err := pvz_service.New(pvzListGetting, logger)
+	if err != nil {
+		panic(fmt.Errorf("create pvz_service grpc service: %w", err))
+	}
+
+	grpcAuthInterceptor := middleware.CreateGRPCAuthInterceptor(tokenProvider, revocationCache, activeUserCache,
+		apiKeyRepo, rolePermissions, dummyTokenAccess, map[string]model.Permission{
+			pvz_v1.PVZService_GetPVZList_FullMethodName: model.PermissionPVZRead,
+		})
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcAuthInterceptor))
+	pvz_v1.RegisterPVZServiceServer(grpcServer, pvzService)
+
 	// HTTP server set up
 
 	noAuthMW, err := middleware.CreateNoAuthMiddleware()
 	if err != nil {
 		panic(fmt.Errorf("create no auth middleware: %w", err))
 	}
-	authMW, err := middleware.CreateAuthMiddleware(tokenProvider)
+	authMW, err := middleware.CreateAuthMiddleware(tokenProvider, revocationCache, activeUserCache, apiKeyRepo,
+		rolePermissions, dummyTokenAccess)
 	if err != nil {
 		panic(fmt.Errorf("create auth middleware: %w", err))
 	}
+	rateLimitMW, err := middleware.CreateRateLimitMiddleware(rateLimiter, metric)
+	if err != nil {
+		panic(fmt.Errorf("create rate limit middleware: %w", err))
+	}
+	ipRateLimitMW, err := middleware.CreateIPRateLimitMiddleware(ipRateLimiter, metric)
+	if err != nil {
+		panic(fmt.Errorf("create ip rate limit middleware: %w", err))
+	}
+	idempotencyMW, err := middleware.CreateIdempotencyMiddleware(idempotencyKeyRepo, cfg.IdempotencyKeyTTL,
+		cfg.IdempotencyLockTTL, logger)
+	if err != nil {
+		panic(fmt.Errorf("create idempotency middleware: %w", err))
+	}
 
 	authMux := http.NewServeMux()
 
-	authMux.HandleFunc("POST /pvz", pvzRegisterHandler.Handle)
-	authMux.HandleFunc("GET /pvz", pvzGetHandler.Handle)
-	authMux.HandleFunc("POST /pvz/{pvzId}/close_last_reception", receptionCloseHandler.Handle)
-	authMux.HandleFunc("POST /pvz/{pvzId}/delete_last_product", productRemoveLastHandler.Handle)
-	authMux.HandleFunc("POST /receptions", receptionCreateHandler.Handle)
-	authMux.HandleFunc("POST /products", productAddHandler.Handle)
+	authMux.HandleFunc("GET /audit", rateLimitMW(auditGetHandler.Handle))
+	authMux.HandleFunc("POST /logout", rateLimitMW(logoutHandler.Handle))
+	authMux.HandleFunc("POST /pvz", rateLimitMW(idempotencyMW(pvzRegisterHandler.Handle)))
+	authMux.HandleFunc("GET /pvz", rateLimitMW(pvzGetHandler.Handle))
+	authMux.HandleFunc("GET /pvz/{pvzId}", rateLimitMW(pvzDetailsGetHandler.Handle))
+	authMux.HandleFunc("GET /pvz/{pvzId}/receptions", rateLimitMW(pvzReceptionsGetHandler.Handle))
+	authMux.HandleFunc("PUT /pvz/{pvzId}/employees/{userId}", rateLimitMW(pvzEmployeeAssignHandler.Handle))
+	authMux.HandleFunc("DELETE /pvz/{pvzId}/employees/{userId}", rateLimitMW(pvzEmployeeUnassignHandler.Handle))
+	authMux.HandleFunc("POST /pvz/{pvzId}/close_last_reception", rateLimitMW(idempotencyMW(receptionCloseHandler.Handle)))
+	authMux.HandleFunc("POST /pvz/{pvzId}/delete_last_product", rateLimitMW(idempotencyMW(productRemoveLastHandler.Handle)))
+	authMux.HandleFunc("POST /receptions", rateLimitMW(idempotencyMW(receptionCreateHandler.Handle)))
+	authMux.HandleFunc("GET /receptions/{receptionId}", rateLimitMW(receptionGetHandler.Handle))
+	authMux.HandleFunc("POST /receptions/{receptionId}/reopen", rateLimitMW(idempotencyMW(receptionReopenHandler.Handle)))
+	authMux.HandleFunc("POST /products", rateLimitMW(idempotencyMW(productAddHandler.Handle)))
+	authMux.HandleFunc("POST /products/batch", rateLimitMW(idempotencyMW(productBatchAddHandler.Handle)))
+	authMux.HandleFunc("DELETE /products/{productId}", rateLimitMW(idempotencyMW(productDeleteHandler.Handle)))
+	authMux.HandleFunc("POST /webhooks", rateLimitMW(webhookCreateHandler.Handle))
+	authMux.HandleFunc("GET /webhooks", rateLimitMW(webhookListHandler.Handle))
+	authMux.HandleFunc("GET /webhooks/{webhookId}", rateLimitMW(webhookGetHandler.Handle))
+	authMux.HandleFunc("PUT /webhooks/{webhookId}", rateLimitMW(webhookUpdateHandler.Handle))
+	authMux.HandleFunc("DELETE /webhooks/{webhookId}", rateLimitMW(webhookDeleteHandler.Handle))
+	authMux.HandleFunc("GET /webhooks/{webhookId}/deliveries", rateLimitMW(webhookDeliveriesGetHandler.Handle))
+	authMux.HandleFunc("POST /users/{userId}/unlock", rateLimitMW(userUnlockHandler.Handle))
+	authMux.HandleFunc("GET /users", rateLimitMW(userListHandler.Handle))
+	authMux.HandleFunc("PATCH /users/{userId}", rateLimitMW(userUpdateHandler.Handle))
+	authMux.HandleFunc("POST /users/{userId}/reset_password", rateLimitMW(userPasswordResetHandler.Handle))
+	authMux.HandleFunc("POST /users/{userId}/allow_sso_link", rateLimitMW(userSSOLinkAllowHandler.Handle))
+	authMux.HandleFunc("POST /users/me/password", rateLimitMW(userPasswordChangeHandler.Handle))
+	authMux.HandleFunc("POST /api_keys", rateLimitMW(apiKeyCreateHandler.Handle))
+	authMux.HandleFunc("GET /api_keys", rateLimitMW(apiKeyListHandler.Handle))
+	authMux.HandleFunc("POST /api_keys/{apiKeyId}/revoke", rateLimitMW(apiKeyRevokeHandler.Handle))
 
 	m := http.NewServeMux()
-	m.Handle("POST /dummyLogin", noAuthMW(http.HandlerFunc(dummyLoginHandler.Handle)))
-	m.Handle("POST /login", noAuthMW(http.HandlerFunc(loginHandler.Handle)))
-	m.Handle("POST /register", noAuthMW(http.HandlerFunc(registerHandler.Handle)))
-	m.Handle("/", authMW(authMux))
+	m.Handle("GET /.well-known/jwks.json", noAuthMW(rateLimitMW(jwksGetHandler.Handle)))
+	if authMode.DummyLoginEnabled() {
+		m.Handle("POST /dummyLogin", noAuthMW(rateLimitMW(dummyLoginHandler.Handle)))
+	}
+	m.Handle("POST /login", noAuthMW(rateLimitMW(loginHandler.Handle)))
+	if oidcLoginHandler != nil {
+		m.Handle("GET /auth/oidc/login", noAuthMW(rateLimitMW(oidcLoginHandler.Handle)))
+		m.Handle("GET /auth/oidc/callback", noAuthMW(rateLimitMW(oidcCallbackHandler.Handle)))
+	}
+	m.Handle("POST /token/refresh", noAuthMW(rateLimitMW(tokenRefreshHandler.Handle)))
+	m.Handle("POST /register", noAuthMW(rateLimitMW(registerHandler.Handle)))
+	// the IP limit runs first, so requests rejected by authMW are limited too
+	m.Handle("/", ipRateLimitMW(authMW(authMux)))
 	handler := metric.HTTPServerMW(m)
 
 	var wg sync.WaitGroup
@@ -264,6 +748,34 @@ func main() {
 		runHTTPServer(ctx, handler, cfg, logger)
 	}()
 
+	// grpc server
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runGRPCServer(ctx, grpcServer, cfg, logger)
+	}()
+
+	// outbox relay
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runOutboxRelay(ctx, outboxRelaying, cfg.OutboxRelayInterval, logger)
+	}()
+
+	// webhook subscription deliveries
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runWebhookDelivery(ctx, webhookDelivering, cfg.WebhookDeliveryInterval, logger)
+	}()
+
+	// idempotency key cleanup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runIdempotencyKeyCleanup(ctx, idempotencyKeyRepo, cfg.IdempotencyCleanupInterval, logger)
+	}()
+
 	wg.Wait()
 	logger.Info("successful stop")
 }
@@ -295,3 +807,27 @@ func runHTTPServer(ctx context.Context, handler http.Handler, cfg config.Config,
 		logger.Error("HTTP server ListenAndServe", zap.Error(err))
 	}
 }
+
+func runGRPCServer(ctx context.Context, s *grpc.Server, cfg config.Config, logger *zap.Logger) {
+	lc := net.ListenConfig{}
+	listener, err := lc.Listen(ctx, "tcp", cfg.GRPCServerHost+":"+strconv.Itoa(cfg.GRPCServerPort))
+	if err != nil {
+		err = fmt.Errorf("gRPC server listen: %w", err)
+		logger.Error("gRPC server listen", zap.Error(err))
+		return
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		s.GracefulStop()
+	}()
+
+	logger.Info("starting grpc server...")
+
+	err = s.Serve(listener)
+	if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		err = fmt.Errorf("gRPC server Serve: %w", err)
+		logger.Error("gRPC server Serve", zap.Error(err))
+	}
+}
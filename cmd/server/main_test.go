@@ -6,15 +6,24 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
+	"github.com/inna-maikut/avito-pvz/internal/generated/pvz_v1"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/outbox_relaying"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/webhook_delivering"
 )
 
 func TestMetrics_RunHTTPServer(t *testing.T) {
@@ -68,3 +77,178 @@ func TestMetrics_RunHTTPServer(t *testing.T) {
 		}
 	}, 100*time.Millisecond, 100*time.Nanosecond)
 }
+
+func TestRunGRPCServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.Config{
+		GRPCServerHost: "127.0.0.1",
+		GRPCServerPort: 9004,
+	}
+	done := make(chan struct{})
+	go func() {
+		s := grpc.NewServer()
+		pvz_v1.RegisterPVZServiceServer(s, pvz_v1.UnimplementedPVZServiceServer{})
+		runGRPCServer(ctx, s, cfg, zap.NewNop())
+		done <- struct{}{}
+	}()
+
+	conn, err := grpc.NewClient("localhost:9004", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pvz_v1.NewPVZServiceClient(conn)
+
+	require.Eventually(t, func() bool {
+		_, err = client.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{})
+
+		return status.Code(err) == codes.Unimplemented
+	}, time.Second, time.Millisecond)
+
+	cancel()
+
+	require.Eventually(t, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+}
+
+type fakeOutboxRelaying struct {
+	calls atomic.Int32
+}
+
+// RelayBatch returns a full batch on the first call and an empty one afterwards.
+func (f *fakeOutboxRelaying) RelayBatch(_ context.Context) (outbox_relaying.Result, error) {
+	if f.calls.Add(1) == 1 {
+		return outbox_relaying.Result{Delivered: 1, Failed: 1}, nil
+	}
+	return outbox_relaying.Result{}, nil
+}
+
+func (f *fakeOutboxRelaying) BatchSize() int {
+	return 2
+}
+
+func TestRunOutboxRelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	relaying := &fakeOutboxRelaying{}
+	done := make(chan struct{})
+	go func() {
+		runOutboxRelay(ctx, relaying, time.Hour, zap.NewNop())
+		done <- struct{}{}
+	}()
+
+	// the full batch is followed by the next one without waiting for the interval
+	require.Eventually(t, func() bool {
+		return relaying.calls.Load() == 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+
+	require.Eventually(t, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int32(2), relaying.calls.Load())
+}
+
+type fakeWebhookDelivering struct {
+	calls atomic.Int32
+}
+
+// DeliverBatch returns a full batch on the first call and an empty one afterwards.
+func (f *fakeWebhookDelivering) DeliverBatch(_ context.Context) (webhook_delivering.Result, error) {
+	if f.calls.Add(1) == 1 {
+		return webhook_delivering.Result{Delivered: 1, Failed: 1, Dead: 1}, nil
+	}
+	return webhook_delivering.Result{}, nil
+}
+
+func (f *fakeWebhookDelivering) BatchSize() int {
+	return 3
+}
+
+func TestRunWebhookDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	delivering := &fakeWebhookDelivering{}
+	done := make(chan struct{})
+	go func() {
+		runWebhookDelivery(ctx, delivering, time.Hour, zap.NewNop())
+		done <- struct{}{}
+	}()
+
+	require.Eventually(t, func() bool {
+		return delivering.calls.Load() == 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+
+	require.Eventually(t, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+	require.Equal(t, int32(2), delivering.calls.Load())
+}
+
+func TestNewOutboxSink(t *testing.T) {
+	for _, cfg := range []config.Config{
+		{OutboxSink: "webhook", OutboxWebhookURL: "http://localhost:9005/events", OutboxWebhookTimeout: time.Second},
+		{OutboxSink: "file", OutboxFilePath: filepath.Join(t.TempDir(), "outbox.jsonl")},
+		{OutboxSink: "memory"},
+	} {
+		t.Run("success."+cfg.OutboxSink, func(t *testing.T) {
+			sink, closeSink, err := newOutboxSink(cfg)
+			require.NoError(t, err)
+			require.NotNil(t, sink)
+			closeSink()
+		})
+	}
+
+	t.Run("error.unknown", func(t *testing.T) {
+		_, _, err := newOutboxSink(config.Config{OutboxSink: "kafka"})
+		require.Error(t, err)
+	})
+	t.Run("error.webhook_without_url", func(t *testing.T) {
+		_, _, err := newOutboxSink(config.Config{OutboxSink: "webhook", OutboxWebhookTimeout: time.Second})
+		require.Error(t, err)
+	})
+}
+
+func TestNewOIDCHandlers(t *testing.T) {
+	t.Run("error.group_roles", func(t *testing.T) {
+		_, _, err := newOIDCHandlers(context.Background(), config.Config{
+			OIDCIssuer:     "http://localhost:9006",
+			OIDCGroupRoles: "pvz-staff=boss",
+		}, nil, nil, nil, nil, zap.NewNop())
+		require.Error(t, err)
+	})
+	t.Run("error.issuer_unavailable", func(t *testing.T) {
+		_, _, err := newOIDCHandlers(context.Background(), config.Config{
+			OIDCIssuer:      "http://localhost:9006",
+			OIDCClientID:    "pvz-service",
+			OIDCRedirectURL: "http://localhost:8080/auth/oidc/callback",
+			OIDCScopes:      "openid email",
+			OIDCGroupsClaim: "groups",
+			OIDCGroupRoles:  "pvz-staff=employee",
+			OIDCTimeout:     time.Second,
+		}, nil, nil, nil, nil, zap.NewNop())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/migrator"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/pg"
+	"github.com/inna-maikut/avito-pvz/migrations"
+)
+
+const migrateUsage = "usage: server migrate up|down|status"
+
+// migrateMain runs "migrate" subcommand and returns process exit code.
+func migrateMain(args []string) int {
+	cfg := config.Load()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	logger := zap.Must(zap.NewDevelopment())
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	if len(args) != 1 {
+		logger.Error(migrateUsage)
+		return 2
+	}
+
+	db, cancelDB, err := pg.NewDB(ctx, cfg)
+	if err != nil {
+		logger.Error("unable to init database", zap.Error(err))
+		return 1
+	}
+	defer cancelDB()
+
+	err = runMigrateCommand(ctx, db, args[0], os.Stdout, logger)
+	if err != nil {
+		logger.Error("migrate "+args[0], zap.Error(err))
+		return 1
+	}
+
+	return 0
+}
+
+func runMigrateCommand(ctx context.Context, db *sqlx.DB, command string, out io.Writer, logger *zap.Logger) error {
+	m, err := migrator.New(db, migrations.FS, logger)
+	if err != nil {
+		return fmt.Errorf("migrator.New: %w", err)
+	}
+
+	switch command {
+	case "up":
+		err = m.Up(ctx)
+		if err != nil {
+			return fmt.Errorf("migrator.Up: %w", err)
+		}
+	case "down":
+		err = m.Down(ctx)
+		if err != nil {
+			return fmt.Errorf("migrator.Down: %w", err)
+		}
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("migrator.Status: %w", err)
+		}
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.Applied {
+				appliedAt = "applied at " + status.AppliedAt.Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(out, "%04d_%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/api/oidc_callback"
+	"github.com/inna-maikut/avito-pvz/internal/api/oidc_login"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/oidc"
+	"github.com/inna-maikut/avito-pvz/internal/repository"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/oidc_authenticating"
+)
+
+// newOIDCHandlers creates the handlers of the OpenID Connect login configured by OIDC_ISSUER,
+// the configuration of the issuer is loaded on start.
+func newOIDCHandlers(ctx context.Context, cfg config.Config, userRepo *repository.UserRepository,
+	tokenProvider *jwt.Provider, refreshTokenRepo *repository.RefreshTokenRepository,
+	assignmentRepo *repository.PVZAssignmentRepository, logger *zap.Logger,
+) (*oidc_login.Handler, *oidc_callback.Handler, error) {
+	groupRoles, err := oidc.ParseGroupRoles(cfg.OIDCGroupRoles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc.ParseGroupRoles: %w", err)
+	}
+
+	client, err := oidc.New(ctx, oidc.Config{
+		Issuer:       cfg.OIDCIssuer,
+		ClientID:     cfg.OIDCClientID,
+		ClientSecret: cfg.OIDCClientSecret,
+		RedirectURL:  cfg.OIDCRedirectURL,
+		Scopes:       strings.Fields(cfg.OIDCScopes),
+		GroupsClaim:  cfg.OIDCGroupsClaim,
+	}, cfg.OIDCTimeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc.New: %w", err)
+	}
+
+	authentication, err := oidc_authenticating.New(client, userRepo, tokenProvider, refreshTokenRepo, assignmentRepo,
+		groupRoles, cfg.RefreshTokenTTL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc_authenticating.New: %w", err)
+	}
+
+	loginHandler, err := oidc_login.New(authentication, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc_login.New: %w", err)
+	}
+
+	callbackHandler, err := oidc_callback.New(authentication, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc_callback.New: %w", err)
+	}
+
+	return loginHandler, callbackHandler, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/outbox_sink"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/outbox_relaying"
+)
+
+type outboxSink interface {
+	Send(ctx context.Context, message model.OutboxMessage) error
+}
+
+type outboxRelaying interface {
+	RelayBatch(ctx context.Context) (outbox_relaying.Result, error)
+	BatchSize() int
+}
+
+// newOutboxSink creates the sink configured by OUTBOX_SINK, the returned func closes it on shutdown.
+func newOutboxSink(cfg config.Config) (outboxSink, func(), error) {
+	switch cfg.OutboxSink {
+	case "webhook":
+		webhookSink, err := outbox_sink.NewWebhookSink(cfg.OutboxWebhookURL, cfg.OutboxWebhookTimeout)
+		if err != nil {
+			return nil, nil, fmt.Errorf("outbox_sink.NewWebhookSink: %w", err)
+		}
+		return webhookSink, func() {}, nil
+	case "file":
+		fileSink, err := outbox_sink.NewFileSink(cfg.OutboxFilePath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("outbox_sink.NewFileSink: %w", err)
+		}
+		return fileSink, func() { _ = fileSink.Close() }, nil
+	case "memory":
+		return outbox_sink.NewMemorySink(), func() {}, nil
+	}
+	return nil, nil, fmt.Errorf("unknown outbox sink %q, expected webhook, file or memory", cfg.OutboxSink)
+}
+
+// runOutboxRelay delivers pending outbox messages every interval until ctx is canceled.
+// A full batch is followed by the next one right away, so a backlog is drained without waiting.
+func runOutboxRelay(ctx context.Context, relaying outboxRelaying, interval time.Duration, logger *zap.Logger) {
+	logger.Info("starting outbox relay...")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		res, err := relaying.RelayBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("outbox relay", zap.Error(err))
+		}
+		if res.Failed > 0 || res.Dead > 0 {
+			logger.Warn("outbox relay: some messages were not delivered, failed ones will be retried",
+				zap.Int("delivered", res.Delivered), zap.Int("failed", res.Failed), zap.Int("dead", res.Dead))
+		}
+
+		if err == nil && res.Delivered+res.Failed+res.Dead >= relaying.BatchSize() {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/usecases/webhook_delivering"
+)
+
+type webhookDelivering interface {
+	DeliverBatch(ctx context.Context) (webhook_delivering.Result, error)
+	BatchSize() int
+}
+
+// runWebhookDelivery sends pending webhook deliveries every interval until ctx is canceled.
+// Like the outbox relay, a full batch is followed by the next one right away.
+func runWebhookDelivery(ctx context.Context, delivering webhookDelivering, interval time.Duration, logger *zap.Logger) {
+	logger.Info("starting webhook delivery...")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		res, err := delivering.DeliverBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("webhook delivery", zap.Error(err))
+		}
+		if res.Failed > 0 || res.Dead > 0 {
+			logger.Warn("webhook delivery: some deliveries failed",
+				zap.Int("delivered", res.Delivered), zap.Int("failed", res.Failed), zap.Int("dead", res.Dead))
+		}
+
+		if err == nil && res.Delivered+res.Failed+res.Dead >= delivering.BatchSize() {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package api_key_create
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type apiKeyManaging interface {
+	CreateKey(ctx context.Context, name string, role model.UserRole, pvzIDs []model.PVZID, createdBy model.UserID,
+		expiresAt time.Time) (string, model.APIKey, error)
+}
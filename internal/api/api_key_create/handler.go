@@ -0,0 +1,95 @@
+package api_key_create
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	apiKeyManaging apiKeyManaging
+	logger         internal.Logger
+}
+
+func New(apiKeyManaging apiKeyManaging, logger internal.Logger) (*Handler, error) {
+	if apiKeyManaging == nil {
+		return nil, errors.New("apiKeyManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		apiKeyManaging: apiKeyManaging,
+		logger:         logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionAPIKeyManage); !ok {
+		return
+	}
+
+	var request api.PostApiKeysJSONRequestBody
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	role, err := model.ParseUserRole(request.Role)
+	if err != nil {
+		api_handler.BadRequest(w, "invalid role")
+		return
+	}
+
+	var pvzIDs []model.PVZID
+	if request.PvzIds != nil {
+		pvzIDs = make([]model.PVZID, 0, len(*request.PvzIds))
+		for _, pvzID := range *request.PvzIds {
+			pvzIDs = append(pvzIDs, model.PVZID(pvzID))
+		}
+	}
+
+	key, apiKey, err := h.apiKeyManaging.CreateKey(ctx, request.Name, role, pvzIDs, tokenInfo.UserID, request.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, model.ErrAPIKeyInvalid) {
+			api_handler.BadRequest(w, err.Error())
+			return
+		}
+		err = fmt.Errorf("apiKeyManaging.CreateKey: %w", err)
+		h.logger.Error("POST /api_keys: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.String("name", request.Name))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.Created(w, convertAPIKey(key, apiKey))
+}
+
+func convertAPIKey(key string, apiKey model.APIKey) api.APIKeyCreated {
+	pvzIDs := make([]types.UUID, 0, len(apiKey.PVZIDs))
+	for _, pvzID := range apiKey.PVZIDs {
+		pvzIDs = append(pvzIDs, pvzID.UUID())
+	}
+
+	return api.APIKeyCreated{
+		Id:        apiKey.ID.UUID(),
+		Key:       key,
+		Name:      apiKey.Name,
+		Role:      apiKey.UserRole.String(),
+		PvzIds:    pvzIDs,
+		CreatedBy: apiKey.CreatedBy.UUID(),
+		CreatedAt: apiKey.CreatedAt,
+		ExpiresAt: apiKey.ExpiresAt,
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package api_key_create -typed -destination mock_deps_test.go
+//
+
+// Package api_key_create is a generated GoMock package.
+package api_key_create
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockapiKeyManaging is a mock of apiKeyManaging interface.
+type MockapiKeyManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyManagingMockRecorder is the mock recorder for MockapiKeyManaging.
+type MockapiKeyManagingMockRecorder struct {
+	mock *MockapiKeyManaging
+}
+
+// NewMockapiKeyManaging creates a new mock instance.
+func NewMockapiKeyManaging(ctrl *gomock.Controller) *MockapiKeyManaging {
+	mock := &MockapiKeyManaging{ctrl: ctrl}
+	mock.recorder = &MockapiKeyManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyManaging) EXPECT() *MockapiKeyManagingMockRecorder {
+	return m.recorder
+}
+
+// CreateKey mocks base method.
+func (m *MockapiKeyManaging) CreateKey(ctx context.Context, name string, role model.UserRole, pvzIDs []model.PVZID, createdBy model.UserID, expiresAt time.Time) (string, model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, name, role, pvzIDs, createdBy, expiresAt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(model.APIKey)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockapiKeyManagingMockRecorder) CreateKey(ctx, name, role, pvzIDs, createdBy, expiresAt any) *MockapiKeyManagingCreateKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockapiKeyManaging)(nil).CreateKey), ctx, name, role, pvzIDs, createdBy, expiresAt)
+	return &MockapiKeyManagingCreateKeyCall{Call: call}
+}
+
+// MockapiKeyManagingCreateKeyCall wrap *gomock.Call
+type MockapiKeyManagingCreateKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyManagingCreateKeyCall) Return(arg0 string, arg1 model.APIKey, arg2 error) *MockapiKeyManagingCreateKeyCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyManagingCreateKeyCall) Do(f func(context.Context, string, model.UserRole, []model.PVZID, model.UserID, time.Time) (string, model.APIKey, error)) *MockapiKeyManagingCreateKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyManagingCreateKeyCall) DoAndReturn(f func(context.Context, string, model.UserRole, []model.PVZID, model.UserID, time.Time) (string, model.APIKey, error)) *MockapiKeyManagingCreateKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package api_key_list
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type apiKeyManaging interface {
+	ListKeys(ctx context.Context) ([]model.APIKey, error)
+}
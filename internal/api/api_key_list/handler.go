@@ -0,0 +1,77 @@
+package api_key_list
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	apiKeyManaging apiKeyManaging
+	logger         internal.Logger
+}
+
+func New(apiKeyManaging apiKeyManaging, logger internal.Logger) (*Handler, error) {
+	if apiKeyManaging == nil {
+		return nil, errors.New("apiKeyManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		apiKeyManaging: apiKeyManaging,
+		logger:         logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionAPIKeyManage); !ok {
+		return
+	}
+
+	apiKeys, err := h.apiKeyManaging.ListKeys(ctx)
+	if err != nil {
+		err = fmt.Errorf("apiKeyManaging.ListKeys: %w", err)
+		h.logger.Error("GET /api_keys: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := make([]api.APIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		res = append(res, convertAPIKey(apiKey))
+	}
+
+	api_handler.OK(w, res)
+}
+
+func convertAPIKey(apiKey model.APIKey) api.APIKey {
+	pvzIDs := make([]types.UUID, 0, len(apiKey.PVZIDs))
+	for _, pvzID := range apiKey.PVZIDs {
+		pvzIDs = append(pvzIDs, pvzID.UUID())
+	}
+
+	return api.APIKey{
+		Id:         apiKey.ID.UUID(),
+		Name:       apiKey.Name,
+		Role:       apiKey.UserRole.String(),
+		PvzIds:     pvzIDs,
+		CreatedBy:  apiKey.CreatedBy.UUID(),
+		CreatedAt:  apiKey.CreatedAt,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
@@ -0,0 +1,132 @@
+package api_key_list
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockapiKeyManaging(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockapiKeyManaging(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	apiKeyID, err := model.ParseAPIKeyID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a03")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	expiresAt := date.Add(30 * 24 * time.Hour)
+
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockapiKeyManaging(ctrl)
+	useCaseMock.EXPECT().
+		ListKeys(gomock.Any()).
+		Return([]model.APIKey{{
+			ID:         apiKeyID,
+			Name:       "sorting centre",
+			KeyHash:    model.HashAPIKey("pvz_key"),
+			UserRole:   model.UserRoleEmployee,
+			PVZIDs:     []model.PVZID{pvzID},
+			CreatedBy:  userID,
+			CreatedAt:  date,
+			ExpiresAt:  expiresAt,
+			LastUsedAt: &date,
+		}}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/api_keys", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": "6451927e-846b-4c97-9924-cba818687a01",
+		"name": "sorting centre",
+		"role": "employee",
+		"pvzIds": ["6451927e-846b-4c97-9924-cba818687a02"],
+		"createdBy": "6451927e-846b-4c97-9924-cba818687a03",
+		"createdAt": "2025-04-09T20:55:59Z",
+		"expiresAt": "2025-05-09T20:55:59Z",
+		"lastUsedAt": "2025-04-09T20:55:59Z"
+	}]`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleAuditor,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockapiKeyManaging(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					ListKeys(gomock.Any()).
+					Return(nil, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/api_keys", nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
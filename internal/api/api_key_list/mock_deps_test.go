@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package api_key_list -typed -destination mock_deps_test.go
+//
+
+// Package api_key_list is a generated GoMock package.
+package api_key_list
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockapiKeyManaging is a mock of apiKeyManaging interface.
+type MockapiKeyManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyManagingMockRecorder is the mock recorder for MockapiKeyManaging.
+type MockapiKeyManagingMockRecorder struct {
+	mock *MockapiKeyManaging
+}
+
+// NewMockapiKeyManaging creates a new mock instance.
+func NewMockapiKeyManaging(ctrl *gomock.Controller) *MockapiKeyManaging {
+	mock := &MockapiKeyManaging{ctrl: ctrl}
+	mock.recorder = &MockapiKeyManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyManaging) EXPECT() *MockapiKeyManagingMockRecorder {
+	return m.recorder
+}
+
+// ListKeys mocks base method.
+func (m *MockapiKeyManaging) ListKeys(ctx context.Context) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeys", ctx)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeys indicates an expected call of ListKeys.
+func (mr *MockapiKeyManagingMockRecorder) ListKeys(ctx any) *MockapiKeyManagingListKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockapiKeyManaging)(nil).ListKeys), ctx)
+	return &MockapiKeyManagingListKeysCall{Call: call}
+}
+
+// MockapiKeyManagingListKeysCall wrap *gomock.Call
+type MockapiKeyManagingListKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyManagingListKeysCall) Return(arg0 []model.APIKey, arg1 error) *MockapiKeyManagingListKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyManagingListKeysCall) Do(f func(context.Context) ([]model.APIKey, error)) *MockapiKeyManagingListKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyManagingListKeysCall) DoAndReturn(f func(context.Context) ([]model.APIKey, error)) *MockapiKeyManagingListKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
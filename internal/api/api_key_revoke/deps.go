@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package api_key_revoke
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type apiKeyManaging interface {
+	RevokeKey(ctx context.Context, apiKeyID model.APIKeyID) error
+}
@@ -0,0 +1,62 @@
+package api_key_revoke
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	apiKeyManaging apiKeyManaging
+	logger         internal.Logger
+}
+
+func New(apiKeyManaging apiKeyManaging, logger internal.Logger) (*Handler, error) {
+	if apiKeyManaging == nil {
+		return nil, errors.New("apiKeyManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		apiKeyManaging: apiKeyManaging,
+		logger:         logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionAPIKeyManage); !ok {
+		return
+	}
+
+	apiKeyID, err := model.ParseAPIKeyID(r.PathValue("apiKeyId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid apiKeyId")
+		return
+	}
+
+	err = h.apiKeyManaging.RevokeKey(ctx, apiKeyID)
+	if err != nil {
+		if errors.Is(err, model.ErrAPIKeyNotFound) {
+			api_handler.NotFound(w, "api key not found")
+			return
+		}
+		err = fmt.Errorf("apiKeyManaging.RevokeKey: %w", err)
+		h.logger.Error("POST /api_keys/{apiKeyId}/revoke: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("apiKeyId", apiKeyID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package api_key_revoke -typed -destination mock_deps_test.go
+//
+
+// Package api_key_revoke is a generated GoMock package.
+package api_key_revoke
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockapiKeyManaging is a mock of apiKeyManaging interface.
+type MockapiKeyManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyManagingMockRecorder is the mock recorder for MockapiKeyManaging.
+type MockapiKeyManagingMockRecorder struct {
+	mock *MockapiKeyManaging
+}
+
+// NewMockapiKeyManaging creates a new mock instance.
+func NewMockapiKeyManaging(ctrl *gomock.Controller) *MockapiKeyManaging {
+	mock := &MockapiKeyManaging{ctrl: ctrl}
+	mock.recorder = &MockapiKeyManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyManaging) EXPECT() *MockapiKeyManagingMockRecorder {
+	return m.recorder
+}
+
+// RevokeKey mocks base method.
+func (m *MockapiKeyManaging) RevokeKey(ctx context.Context, apiKeyID model.APIKeyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKey", ctx, apiKeyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeKey indicates an expected call of RevokeKey.
+func (mr *MockapiKeyManagingMockRecorder) RevokeKey(ctx, apiKeyID any) *MockapiKeyManagingRevokeKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKey", reflect.TypeOf((*MockapiKeyManaging)(nil).RevokeKey), ctx, apiKeyID)
+	return &MockapiKeyManagingRevokeKeyCall{Call: call}
+}
+
+// MockapiKeyManagingRevokeKeyCall wrap *gomock.Call
+type MockapiKeyManagingRevokeKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyManagingRevokeKeyCall) Return(arg0 error) *MockapiKeyManagingRevokeKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyManagingRevokeKeyCall) Do(f func(context.Context, model.APIKeyID) error) *MockapiKeyManagingRevokeKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyManagingRevokeKeyCall) DoAndReturn(f func(context.Context, model.APIKeyID) error) *MockapiKeyManagingRevokeKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
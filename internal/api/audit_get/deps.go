@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package audit_get
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type auditGetting interface {
+	GetAuditEvents(ctx context.Context, filter model.AuditFilter, page, limit int64) ([]model.AuditEvent, error)
+}
@@ -0,0 +1,175 @@
+package audit_get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	defaultLimit = 30
+	maxLimit     = 100
+)
+
+type listQuery struct {
+	filter      model.AuditFilter
+	page, limit int64
+}
+
+type Handler struct {
+	auditGetting auditGetting
+	logger       internal.Logger
+}
+
+func New(auditGetting auditGetting, logger internal.Logger) (*Handler, error) {
+	if auditGetting == nil {
+		return nil, errors.New("auditGetting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		auditGetting: auditGetting,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionAuditRead); !ok {
+		return
+	}
+
+	query, err := parseQuery(r.URL.Query())
+	if err != nil {
+		api_handler.BadRequest(w, "validation query: "+err.Error())
+		return
+	}
+
+	events, err := h.auditGetting.GetAuditEvents(ctx, query.filter, query.page, query.limit)
+	if err != nil {
+		err = fmt.Errorf("auditGetting.GetAuditEvents: %w", err)
+		h.logger.Error("GET /audit: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("query", r.URL.Query()))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := make([]api.AuditEvent, 0, len(events))
+	for _, event := range events {
+		res = append(res, api.AuditEvent{
+			Id:          event.ID.UUID(),
+			UserId:      event.UserID.UUID(),
+			ApiKeyId:    (*types.UUID)(event.APIKeyID),
+			Action:      api.AuditEventAction(event.Action.String()),
+			PvzId:       event.PVZID.UUID(),
+			ReceptionId: (*types.UUID)(event.ReceptionID),
+			ProductId:   (*types.UUID)(event.ProductID),
+			DateTime:    event.CreatedAt,
+		})
+	}
+
+	api_handler.OK(w, res)
+}
+
+func ptrOf[T any](value T) *T {
+	return &value
+}
+
+func parseQuery(query url.Values) (res listQuery, err error) {
+	if userIDParam := query.Get("userId"); userIDParam != "" {
+		var userID model.UserID
+		userID, err = model.ParseUserID(userIDParam)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse user id: %w", err)
+		}
+		res.filter.UserID = &userID
+	}
+
+	if apiKeyIDParam := query.Get("apiKeyId"); apiKeyIDParam != "" {
+		var apiKeyID model.APIKeyID
+		apiKeyID, err = model.ParseAPIKeyID(apiKeyIDParam)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse api key id: %w", err)
+		}
+		res.filter.APIKeyID = &apiKeyID
+	}
+
+	if pvzIDParam := query.Get("pvzId"); pvzIDParam != "" {
+		var pvzID model.PVZID
+		pvzID, err = model.ParsePVZID(pvzIDParam)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse pvz id: %w", err)
+		}
+		res.filter.PVZID = &pvzID
+	}
+
+	if actionParam := query.Get("action"); actionParam != "" {
+		var action model.AuditAction
+		action, err = model.ParseAuditAction(actionParam)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse action: %w", err)
+		}
+		res.filter.Action = &action
+	}
+
+	if startDate := query.Get("startDate"); startDate != "" {
+		var fromValue strfmt.DateTime
+		fromValue, err = strfmt.ParseDateTime(startDate)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse start date: %w", err)
+		}
+		res.filter.From = ptrOf(time.Time(fromValue))
+	}
+
+	if endDate := query.Get("endDate"); endDate != "" {
+		var toValue strfmt.DateTime
+		toValue, err = strfmt.ParseDateTime(endDate)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse end date: %w", err)
+		}
+		res.filter.To = ptrOf(time.Time(toValue))
+	}
+
+	res.page = 1
+	if pageParam := query.Get("page"); pageParam != "" {
+		res.page, err = strconv.ParseInt(pageParam, 10, 64)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse page: %w", err)
+		}
+		if res.page < 1 {
+			return listQuery{}, errors.New("page must be greater than zero")
+		}
+	}
+
+	res.limit = defaultLimit
+	if limitParam := query.Get("limit"); limitParam != "" {
+		res.limit, err = strconv.ParseInt(limitParam, 10, 64)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse limit: %w", err)
+		}
+		if res.limit < 1 {
+			return listQuery{}, errors.New("limit must be greater than zero")
+		}
+		if res.limit > maxLimit {
+			return listQuery{}, errors.New("limit must be not greater than 100")
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,253 @@
+package audit_get
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockauditGetting(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockauditGetting(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockauditGetting(ctrl)
+
+	eventUUID, err := uuid.Parse("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a03")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a04")
+	require.NoError(t, err)
+	productID, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+
+	from := time.Date(2025, 4, 9, 20, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 9, 21, 0, 0, 0, time.UTC)
+	action := model.AuditActionProductRemoved
+
+	useCaseMock.EXPECT().
+		GetAuditEvents(gomock.Any(), model.AuditFilter{
+			UserID: &userID,
+			PVZID:  &pvzID,
+			Action: &action,
+			From:   &from,
+			To:     &to,
+		}, int64(2), int64(50)).
+		Return([]model.AuditEvent{
+			{
+				ID:          model.AuditEventID(eventUUID),
+				UserID:      userID,
+				Action:      model.AuditActionProductRemoved,
+				PVZID:       pvzID,
+				ReceptionID: &receptionID,
+				ProductID:   &productID,
+				CreatedAt:   time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC),
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit?userId="+userID.UUID().String()+
+		"&pvzId="+pvzID.UUID().String()+"&action=product_removed&startDate=2025-04-09T20:00:00Z"+
+		"&endDate=2025-04-09T21:00:00Z&page=2&limit=50", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": "6451927e-846b-4c97-9924-cba818687a01",
+		"userId": "6451927e-846b-4c97-9924-cba818687a02",
+		"action": "product_removed",
+		"pvzId": "6451927e-846b-4c97-9924-cba818687a03",
+		"receptionId": "6451927e-846b-4c97-9924-cba818687a04",
+		"productId": "6451927e-846b-4c97-9924-cba818687a05",
+		"dateTime": "2025-04-09T20:55:59Z"
+	}]`, w.Body.String())
+}
+
+func TestHandler_Handle_APIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockauditGetting(ctrl)
+
+	eventUUID, err := uuid.Parse("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	apiKeyID, err := model.ParseAPIKeyID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a03")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetAuditEvents(gomock.Any(), model.AuditFilter{APIKeyID: &apiKeyID}, int64(1), int64(30)).
+		Return([]model.AuditEvent{
+			{
+				ID:        model.AuditEventID(eventUUID),
+				UserID:    model.DefaultUserID,
+				APIKeyID:  &apiKeyID,
+				Action:    model.AuditActionPVZRegistered,
+				PVZID:     pvzID,
+				CreatedAt: time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC),
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit?apiKeyId="+apiKeyID.UUID().String(), nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": "6451927e-846b-4c97-9924-cba818687a01",
+		"userId": "00000000-0000-0000-0000-000000000000",
+		"apiKeyId": "6451927e-846b-4c97-9924-cba818687a02",
+		"action": "pvz_registered",
+		"pvzId": "6451927e-846b-4c97-9924-cba818687a03",
+		"dateTime": "2025-04-09T20:55:59Z"
+	}]`, w.Body.String())
+}
+
+func TestHandler_Handle_Empty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockauditGetting(ctrl)
+
+	useCaseMock.EXPECT().
+		GetAuditEvents(gomock.Any(), model.AuditFilter{}, int64(1), int64(30)).
+		Return(nil, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[]`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		query      string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleEmployee,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "invalid_user_id",
+			role:     model.UserRoleModerator,
+			query:    "?userId=123",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_pvz_id",
+			role:     model.UserRoleModerator,
+			query:    "?pvzId=123",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_action",
+			role:     model.UserRoleModerator,
+			query:    "?action=product_sold",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_start_date",
+			role:     model.UserRoleModerator,
+			query:    "?startDate=yesterday",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_page",
+			role:     model.UserRoleModerator,
+			query:    "?page=0",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too_big_limit",
+			role:     model.UserRoleModerator,
+			query:    "?limit=101",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockauditGetting(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					GetAuditEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/audit"+tc.query, nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
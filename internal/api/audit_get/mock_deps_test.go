@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package audit_get -typed -destination mock_deps_test.go
+//
+
+// Package audit_get is a generated GoMock package.
+package audit_get
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauditGetting is a mock of auditGetting interface.
+type MockauditGetting struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditGettingMockRecorder
+	isgomock struct{}
+}
+
+// MockauditGettingMockRecorder is the mock recorder for MockauditGetting.
+type MockauditGettingMockRecorder struct {
+	mock *MockauditGetting
+}
+
+// NewMockauditGetting creates a new mock instance.
+func NewMockauditGetting(ctrl *gomock.Controller) *MockauditGetting {
+	mock := &MockauditGetting{ctrl: ctrl}
+	mock.recorder = &MockauditGettingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditGetting) EXPECT() *MockauditGettingMockRecorder {
+	return m.recorder
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockauditGetting) GetAuditEvents(ctx context.Context, filter model.AuditFilter, page, limit int64) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, filter, page, limit)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockauditGettingMockRecorder) GetAuditEvents(ctx, filter, page, limit any) *MockauditGettingGetAuditEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockauditGetting)(nil).GetAuditEvents), ctx, filter, page, limit)
+	return &MockauditGettingGetAuditEventsCall{Call: call}
+}
+
+// MockauditGettingGetAuditEventsCall wrap *gomock.Call
+type MockauditGettingGetAuditEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditGettingGetAuditEventsCall) Return(arg0 []model.AuditEvent, arg1 error) *MockauditGettingGetAuditEventsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditGettingGetAuditEventsCall) Do(f func(context.Context, model.AuditFilter, int64, int64) ([]model.AuditEvent, error)) *MockauditGettingGetAuditEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditGettingGetAuditEventsCall) DoAndReturn(f func(context.Context, model.AuditFilter, int64, int64) ([]model.AuditEvent, error)) *MockauditGettingGetAuditEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,9 +18,20 @@ import (
 )
 
 const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditEventAction.
+const (
+	ProductAdded      AuditEventAction = "product_added"
+	ProductRemoved    AuditEventAction = "product_removed"
+	PvzRegistered     AuditEventAction = "pvz_registered"
+	ReceptionClosed   AuditEventAction = "reception_closed"
+	ReceptionCreated  AuditEventAction = "reception_created"
+	ReceptionReopened AuditEventAction = "reception_reopened"
+)
+
 // Defines values for PVZCity.
 const (
 	Казань         PVZCity = "Казань"
@@ -43,14 +54,18 @@ const (
 
 // Defines values for UserRole.
 const (
-	UserRoleEmployee  UserRole = "employee"
-	UserRoleModerator UserRole = "moderator"
+	UserRoleAuditor         UserRole = "auditor"
+	UserRoleEmployee        UserRole = "employee"
+	UserRoleModerator       UserRole = "moderator"
+	UserRoleRegionalManager UserRole = "regional_manager"
 )
 
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
-	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
-	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
+	PostDummyLoginJSONBodyRoleAuditor         PostDummyLoginJSONBodyRole = "auditor"
+	PostDummyLoginJSONBodyRoleEmployee        PostDummyLoginJSONBodyRole = "employee"
+	PostDummyLoginJSONBodyRoleModerator       PostDummyLoginJSONBodyRole = "moderator"
+	PostDummyLoginJSONBodyRoleRegionalManager PostDummyLoginJSONBodyRole = "regional_manager"
 )
 
 // Defines values for PostProductsJSONBodyType.
@@ -60,17 +75,126 @@ const (
 	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
 )
 
+// Defines values for PostProductsBatchJSONBodyProductsType.
+const (
+	Обувь       PostProductsBatchJSONBodyProductsType = "обувь"
+	Одежда      PostProductsBatchJSONBodyProductsType = "одежда"
+	Электроника PostProductsBatchJSONBodyProductsType = "электроника"
+)
+
+// Defines values for GetPvzParamsMode.
+const (
+	Pvz        GetPvzParamsMode = "pvz"
+	Receptions GetPvzParamsMode = "receptions"
+)
+
 // Defines values for PostRegisterJSONBodyRole.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	Auditor         PostRegisterJSONBodyRole = "auditor"
+	Employee        PostRegisterJSONBodyRole = "employee"
+	Moderator       PostRegisterJSONBodyRole = "moderator"
+	RegionalManager PostRegisterJSONBodyRole = "regional_manager"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	CreatedBy openapi_types.UUID `json:"createdBy"`
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastUsedAt Время последнего запроса с ключом с точностью до минуты, нет, если ключ не использовался
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+
+	// PvzIds Ключ работает только с этими ПВЗ, пустой список - с ПВЗ, доступными роли
+	PvzIds    []openapi_types.UUID `json:"pvzIds"`
+	RevokedAt *time.Time           `json:"revokedAt,omitempty"`
+
+	// Role Роль, с правами которой действует ключ - employee, moderator, auditor или regional_manager
+	Role string `json:"role"`
+}
+
+// APIKeyCreated defines model for APIKeyCreated.
+type APIKeyCreated struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	CreatedBy openapi_types.UUID `json:"createdBy"`
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key Ключ для заголовка X-API-Key. Хранится только его хеш, повторно получить ключ нельзя
+	Key string `json:"key"`
+
+	// LastUsedAt Время последнего запроса с ключом с точностью до минуты, нет, если ключ не использовался
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+
+	// PvzIds Ключ работает только с этими ПВЗ, пустой список - с ПВЗ, доступными роли
+	PvzIds    []openapi_types.UUID `json:"pvzIds"`
+	RevokedAt *time.Time           `json:"revokedAt,omitempty"`
+
+	// Role Роль, с правами которой действует ключ - employee, moderator, auditor или regional_manager
+	Role string `json:"role"`
+}
+
+// APIKeyInput defines model for APIKeyInput.
+type APIKeyInput struct {
+	// ExpiresAt Не позже чем через год
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Name Название клиента, например интеграции сортировочного центра
+	Name   string                `json:"name"`
+	PvzIds *[]openapi_types.UUID `json:"pvzIds,omitempty"`
+
+	// Role Роль, с правами которой действует ключ - employee или auditor, административные роли ключам не выдаются
+	Role string `json:"role"`
+}
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Action AuditEventAction `json:"action"`
+
+	// ApiKeyId API-ключ машинного клиента, выполнившего действие
+	ApiKeyId    *openapi_types.UUID `json:"apiKeyId,omitempty"`
+	DateTime    time.Time           `json:"dateTime"`
+	Id          openapi_types.UUID  `json:"id"`
+	ProductId   *openapi_types.UUID `json:"productId,omitempty"`
+	PvzId       openapi_types.UUID  `json:"pvzId"`
+	ReceptionId *openapi_types.UUID `json:"receptionId,omitempty"`
+
+	// UserId Для действий по API-ключу - нулевой UUID, ключ указан в apiKeyId
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// AuditEventAction defines model for AuditEvent.Action.
+type AuditEventAction string
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// JWK Публичный ключ для проверки подписи токенов (RFC 7517)
+type JWK struct {
+	// Alg ES256 или RS256
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+
+	// Kty EC или RSA
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+	Y   *string `json:"y,omitempty"`
+}
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
 	City             PVZCity             `json:"city"`
@@ -81,8 +205,27 @@ type PVZ struct {
 // PVZCity defines model for PVZ.City.
 type PVZCity string
 
+// PVZListItem defines model for PVZListItem.
+type PVZListItem struct {
+	Pvz        *PVZ `json:"pvz,omitempty"`
+	Receptions *[]struct {
+		Products  *[]Product `json:"products,omitempty"`
+		Reception *Reception `json:"reception,omitempty"`
+	} `json:"receptions,omitempty"`
+}
+
+// PasswordPolicyError defines model for PasswordPolicyError.
+type PasswordPolicyError struct {
+	Message string `json:"message"`
+
+	// Violations Нарушенные правила парольной политики - min_length, max_length, uppercase, lowercase, digit, special, not_email, not_breached. Нет, если запрос неверен по другой причине.
+	Violations *[]string `json:"violations,omitempty"`
+}
+
 // Product defines model for Product.
 type Product struct {
+	// Barcode Штрихкод товара, если товар был отсканирован
+	Barcode     *string             `json:"barcode,omitempty"`
 	DateTime    *time.Time          `json:"dateTime,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
 	ReceptionId openapi_types.UUID  `json:"receptionId"`
@@ -108,14 +251,93 @@ type Token = string
 
 // User defines model for User.
 type User struct {
-	Email openapi_types.Email `json:"email"`
-	Id    *openapi_types.UUID `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
+	// Disabled Отключенный пользователь не может войти, его токены отклоняются
+	Disabled *bool               `json:"disabled,omitempty"`
+	Email    openapi_types.Email `json:"email"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Role     UserRole            `json:"role"`
 }
 
 // UserRole defines model for User.Role.
 type UserRole string
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       int                `json:"attempts"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	DeliveredAt    *time.Time         `json:"deliveredAt,omitempty"`
+	EventId        openapi_types.UUID `json:"eventId"`
+	EventType      string             `json:"eventType"`
+	Id             openapi_types.UUID `json:"id"`
+	LastError      *string            `json:"lastError,omitempty"`
+	LastStatusCode *int               `json:"lastStatusCode,omitempty"`
+	NextAttemptAt  time.Time          `json:"nextAttemptAt"`
+
+	// Status pending, delivered или dead
+	Status string `json:"status"`
+}
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes Типы событий - reception.created, reception.closed, reception.reopened, product.added, product.removed
+	EventTypes []string           `json:"eventTypes"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// PvzIds События только этих ПВЗ, пустой список - события всех ПВЗ
+	PvzIds    []openapi_types.UUID `json:"pvzIds"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+	Url       string               `json:"url"`
+}
+
+// WebhookSubscriptionInput defines model for WebhookSubscriptionInput.
+type WebhookSubscriptionInput struct {
+	EventTypes []string              `json:"eventTypes"`
+	PvzIds     *[]openapi_types.UUID `json:"pvzIds,omitempty"`
+
+	// Secret Ключ HMAC-SHA256 подписи, не меньше 16 символов. При обновлении можно не передавать
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// UserId Фильтр по пользователю
+	UserId *openapi_types.UUID `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// ApiKeyId Фильтр по API-ключу
+	ApiKeyId *openapi_types.UUID `form:"apiKeyId,omitempty" json:"apiKeyId,omitempty"`
+
+	// PvzId Фильтр по ПВЗ
+	PvzId *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+
+	// Action Фильтр по действию, одно из значений AuditEvent.action
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество событий на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAuthOidcCallbackParams defines parameters for GetAuthOidcCallback.
+type GetAuthOidcCallbackParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
 	Role PostDummyLoginJSONBodyRole `json:"role"`
@@ -130,15 +352,53 @@ type PostLoginJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// PostLogoutJSONBody defines parameters for PostLogout.
+type PostLogoutJSONBody struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	// Barcode Штрихкод товара, в рамках одной приемки не может повторяться
+	Barcode *string                  `json:"barcode,omitempty"`
+	PvzId   openapi_types.UUID       `json:"pvzId"`
+	Type    PostProductsJSONBodyType `json:"type"`
+}
+
+// PostProductsParams defines parameters for PostProducts.
+type PostProductsParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // PostProductsJSONBodyType defines parameters for PostProducts.
 type PostProductsJSONBodyType string
 
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Products []struct {
+		// Barcode Штрихкод товара, в рамках одной приемки не может повторяться
+		Barcode *string                               `json:"barcode,omitempty"`
+		Type    PostProductsBatchJSONBodyProductsType `json:"type"`
+	} `json:"products"`
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+// PostProductsBatchParams defines parameters for PostProductsBatch.
+type PostProductsBatchParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostProductsBatchJSONBodyProductsType defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBodyProductsType string
+
+// DeleteProductsProductIdParams defines parameters for DeleteProductsProductId.
+type DeleteProductsProductIdParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
 	// StartDate Начальная дата диапазона
@@ -152,6 +412,45 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Mode Режим выдачи. receptions - постранично по приемкам (ПВЗ без приемок не попадают в выдачу), pvz - постранично по ПВЗ в порядке регистрации, к каждому ПВЗ прикладываются не больше 20 последних приемок за период. В режиме pvz лимит задает количество ПВЗ на странице.
+	Mode *GetPvzParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// City Фильтр по городу ПВЗ (только для mode=pvz), одно из значений PVZ.city
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// Cursor Курсор для постраничной выдачи по ключу (дата и ID приемки). Пустое значение - первая страница. При наличии параметра ответ возвращается в виде объекта с полями items и nextCursor. Не может использоваться вместе с page и mode=pvz.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPvzParamsMode defines parameters for GetPvz.
+type GetPvzParamsMode string
+
+// PostPvzParams defines parameters for PostPvz.
+type PostPvzParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostPvzPvzIdCloseLastReceptionParams defines parameters for PostPvzPvzIdCloseLastReception.
+type PostPvzPvzIdCloseLastReceptionParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostPvzPvzIdDeleteLastProductParams defines parameters for PostPvzPvzIdDeleteLastProduct.
+type PostPvzPvzIdDeleteLastProductParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество приемок на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -159,6 +458,18 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// PostReceptionsParams defines parameters for PostReceptions.
+type PostReceptionsParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostReceptionsReceptionIdReopenParams defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenParams struct {
+	// IdempotencyKey Ключ идемпотентности, например UUID, сгенерированный клиентом на одно действие. Повтор запроса с тем же ключом в течение суток (настраивается) не выполняет действие еще раз, а возвращает сохраненный ответ с заголовком `Idempotent-Replayed: true`. Ключ действует только для своего пользователя или API-ключа.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email"`
@@ -169,15 +480,72 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// PostTokenRefreshJSONBody defines parameters for PostTokenRefresh.
+type PostTokenRefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Role Фильтр по роли - employee, moderator, auditor или regional_manager
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество пользователей на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostUsersMePasswordJSONBody defines parameters for PostUsersMePassword.
+type PostUsersMePasswordJSONBody struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// PatchUsersUserIdJSONBody defines parameters for PatchUsersUserId.
+type PatchUsersUserIdJSONBody struct {
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Role Новая роль - employee, moderator, auditor или regional_manager
+	Role *string `json:"role,omitempty"`
+}
+
+// PostUsersUserIdResetPasswordJSONBody defines parameters for PostUsersUserIdResetPassword.
+type PostUsersUserIdResetPasswordJSONBody struct {
+	Password string `json:"password"`
+}
+
+// GetWebhooksWebhookIdDeliveriesParams defines parameters for GetWebhooksWebhookIdDeliveries.
+type GetWebhooksWebhookIdDeliveriesParams struct {
+	// Status Фильтр по статусу - pending, delivered или dead
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество доставок на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody = APIKeyInput
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
+type PostLogoutJSONRequestBody PostLogoutJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
@@ -187,37 +555,150 @@ type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostTokenRefreshJSONRequestBody defines body for PostTokenRefresh for application/json ContentType.
+type PostTokenRefreshJSONRequestBody PostTokenRefreshJSONBody
+
+// PostUsersMePasswordJSONRequestBody defines body for PostUsersMePassword for application/json ContentType.
+type PostUsersMePasswordJSONRequestBody PostUsersMePasswordJSONBody
+
+// PatchUsersUserIdJSONRequestBody defines body for PatchUsersUserId for application/json ContentType.
+type PatchUsersUserIdJSONRequestBody PatchUsersUserIdJSONBody
+
+// PostUsersUserIdResetPasswordJSONRequestBody defines body for PostUsersUserIdResetPassword for application/json ContentType.
+type PostUsersUserIdResetPasswordJSONRequestBody PostUsersUserIdResetPasswordJSONBody
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookSubscriptionInput
+
+// PutWebhooksWebhookIdJSONRequestBody defines body for PutWebhooksWebhookId for application/json ContentType.
+type PutWebhooksWebhookIdJSONRequestBody = WebhookSubscriptionInput
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ724TSRJ/lVHffeCkASfHffK3u+M4cUK6iONYCRShwe44A54/9LSza5Cl2N6FRWSX",
-	"1QoJCS1iWV5gcGJinHjyCtVvtKrqGXvGHsdOYmUNn2zPVHdXVf+q6lflx6zkOb7nclcGrPiYBaVN7lj0",
-	"9V9CeAK/+MLzuZA2p8cODwKrwvGrrPucFVkghe1WWKNhMsEf1mzBy6x4Zyi4biaC3r37vCRZw2Rrt25P",
-	"7lyyZR0/uVtzcAP4BSLVhD50IGQmg3cQwgD6qnUR3kJXtaCrtuGDaqtt2MX3ryGEfZRRO6lDE+1MZpdx",
-	"9w1POJZkRVar2WWWIyZ4xQ6ksKTtuVcsyTOLypbkF6Xt8MmVY+aTNbm2C69cK8lJ+3Hvm7j1nAeewKIS",
-	"99Gca/PJ6weji1A/wAF00fNqGyIYQA/6+koi2IMufIS95OcH1YZOrv/H3ENvs6rlOetG8v4c3eVvPZrT",
-	"UYG0ZC1Iu8p27/rCqwgeBMxkpaoX8Nm+GFqSnD3cOc8lN70H3M0JP5P9P+A5Acsdy65mzNFPzoAnr5rB",
-	"B3f8qlfnqL/jlbmwpCdmW51oQbtNGoru5aWasGX9f5iUtDH3uCW4+HtNbo5+XU30/c9XN9F1JM2K8duR",
-	"AZtS+qyBG9vuhkcQ4kFJ2DG+MMFgRulATzUN2IMD9cJQbThS2xBCh0JgAD31woC38DO8MqBn0MsedOEQ",
-	"+hDBJ0O1IMJ8RYHSwbNtWSVlrNID7paNgIstu4Su2uIi0AevXlq5tIKO9XzuWr7NiuwyPTKZb8lNMrxQ",
-	"rjlO/bpXsXUoeAFlELxoKwlttuYF8spITvubB/IfXpkya8lzJXdpoeX7VbtESwv3Ax1fOvlPImgx9z3t",
-	"njNiUtQ4PQh8zw308X9dWTmR8n8WfIMV2Z8Ko9JWiOtaQQcPHTp2+e9VE46gq76HAYR4ySF08Dbpgvch",
-	"VE/w7vGW/rZAfXSRzdPnDXShQ4AcqOfwyaDShnCLVFNHR81xLFFH2bcQwYFqq6caotA1qDo2YzRGsAuR",
-	"hmafJELaoFCdjabFAukEqci3guBrT5Rn84xki+GKLwNjq+eOsa6hIaRa8U8s6zDQP8Yh91Oe5gYcERJ3",
-	"YD9Ogy3oYh7VePM18wmOh9xaIrUo1M1fz8+R+GilTgfVxUEj4aJ54PgtqWSIgwg+jIrgciRBA3pwgDV4",
-	"gJDFWOqrFvSgAwMqxZna3NM6Xz4HnV+icqqFzGGkb1c9055L0RpWvJMlNHfWG+uZIHuZ9XuS2ROGERrQ",
-	"oUwPfdVWz1Rb/ZixWrWNCySOEdmHaEhqmhAhpFUb9mJQR9CJac1f4ljdeoQuqPCcKP03l2tbjyjlCsvh",
-	"kouAbJm4vFA9hZBOj/PdHqWEEL/00DPUsUVUkZCVsSJ7WOOizkzmWo4OIktI6sLM1MXM145NKPSajuqq",
-	"p6dWh7vlRSnzBiI4RGgbhJZtSrU99UQ9n3K2j810+uAy37BqVcmKqyZzbNd2MGmtDs+2XckrXEz1xAH0",
-	"KNsjS+ggP9DJ7hCRpkGGoRWOqQfdKepVbceWU/RbMZljfaMVvLwyQ9v1M5ZmW3InyK0CM7PhrduZdjk4",
-	"brtULRuKzJVqhyZbQlj1zIGz9hh1w41GTmeY3XcOicnk9Q6OsP1BphjngxOmrBwq2oz37GOk6dZJNQ31",
-	"LeZvtaPBhfwBupS1dZ4i6jCWw3XHBSHsQo9COF5EfHE6n6BUdVoqMRMv51ywkyPH7i1xK0Swr2nbsnQq",
-	"n2HZfTfyIiE49m5uLYVDzQUJxJoQR9AZFdHCY2J6jQLNgu5WrUDezcT7scBdw7X/xJXXrUCOwn+i9FJG",
-	"9i25maoX8SgpC87cypXPh8+ciedNZTlwToV9qK+zr7bVc6zWS8Y+jzKqqjZ8RMTkaPyZBcGrlAUUBEc0",
-	"jUeKgKSRcnWkWkOZSco9Ngwjsoo8gjylvkvXl0yklHmVyzhU/NSwfGagXKGFGClJsf1D42RqP0W8O1ym",
-	"Xsqcs4sa67nGL3g4Mx2aN5pnfGbwf5+2IQ/+u7oGZBu0QXrWNmzSerCfatNimpNx64Xr167+1zRO26xl",
-	"Gev0QLkxkjvv4crYFGQ5xh8nKUJpbrV0RYi6OLVDcZmtPTSUSxvyZTCyQTzYnlVzLpw+pCp2IOP/044J",
-	"qFhquSbki/6LbniUeZZ/cRYXt/RHZ34blDd+3lnKxig7T/+VSkovGbbMnqc3Gr8HAAD//82HIsO8IQAA",
+	"H4sIAAAAAAAC/+x9W3Mbx5X/V5nC//9gVQ1JSbaTiqrywEjKhrYcsUjLTmKr6BHQoiYEZpCZAWVKxSpS",
+	"XEXKShE3Ltc6lVrZlr1bu48QRJjgDfwK3d9o65y+TPdM40aCIMXgxRaBwXT36XN+59qnHxaKYaUaBiRI",
+	"4sKVh4WqF3kVkpAI/5opkUo1TEhQXPmQrMAnJRIXI7+a+GFQuFKg/6B77AV74tAW3aJNuk8PaZs9ok16",
+	"wB7RA9pm6+wRbbkOPaB1esjWaIvu0yZbc27dmrnmOmydvoGH4SPaYmu0TRu0Tg/oAXtGdxy6S/doS7yt",
+	"TffxNQ5t0y14t4ND7uAQDXhs0qHfwRvgYbbm0G0xZput07rD1h2c2b5Df6JNfDdMnb+3gd+xJziZFm06",
+	"bJ1t4KC7zjswKozC1midtnCGTfaIrbPNCzCjpkMb7BmsnO7RA7YJX+bm5tAm+wu8F96x7TqwjgZt023a",
+	"gI/YX/hLgSJt9hifOqDNlBJtfBF/gq/sDY4HBNvFNXyhNiuZmCPVsrdCSlecJKqRLyaddKO0ebEN/kJY",
+	"5h57Du+B7/fYJkwDZteEURy+NPacbvP9QTLCU7QF++NMz85MKHLWJz8PCm7BB/a4R7wSiQpuIfAqpHBF",
+	"Z6cJ4Ce3EBfvkYoHjFXxvrxBgsXkXuHK5fffdwsVP5B/X3ILyUoVXhAnkR8sFlZXV+VPkU2nZ2cEe1aj",
+	"sEqixCf4eTEiXkJK0wn8cTeMKl5SuFIoeQmZSPwKKeTe68qf/GrF+Emt5pdsT5Mvq35E4kEG8Et9vbns",
+	"xcmtWM49I3VfsTVgZNiBQ+TuPdpEmRD7lWN8g9m5ILTZEymg7Dl7ARvfdug+bdED4Hz2DIW2yR65wLrr",
+	"uNHyNYLrW2w9yxp0D8Si4PZJDM4XD/NfVJcfzJTiLoCDEvIaxaKe52JY4l8BeWBBgApf0W9chx6yDeT8",
+	"Nt1xcO6wAhDxCfiFemxLkGWDHqL4wTsQm/ZoC1g7IZW4r00UH3hR5K3A3xFZDpcG48coLBMLGb7ni3Vx",
+	"3odIDKD+Pt+kNkdAXKdF4NU2TjikUi2HK4S4TiUskchLwsh1vFrJT8JIindEFv0w8MoLFS/wFkmUnyYu",
+	"7U81PyKlwpXPCkgM3Foxf7Wfuny5mnjqknRbvT6880dSTIAKXMCv8ueBHF65fPNu4cpnDwv/PyJ3C1cK",
+	"/28q1WNTAhqm+M8Kq24WGJa6KzOOgTmUrTu/mwCs+5CsTDr0vwVIt7gqyMAol0T2mDbZU5cjqNBLXHVx",
+	"udlgT/D3z03R4hKFctSd0rCOPL1uK4rNBNVaksdFA7YyRHhJm3x226gmUSXu4/8QdLYdJMnWwCKeG6YO",
+	"uo9TUKhjperrFoMBgQkUzxvUl3+mLdri2nINBF3YDgLVOPH/zF8Hz9tmlmLMMSR6NOIpRVFIJpgPdIuD",
+	"NWAYXyPiXQMRq6nwykkVM93XrJUtWmcvOOf25DJTlHtIKszw+jIJLGznFTl9HhZIUKvAm6vLDxYAXuKE",
+	"wFgwbJEgFRcEOJiflcM481FEwioJ8MNqFJZqxWTBK5WMvyNSCZdJqXA7t0634FV9EJNSfg91owb0Yp09",
+	"RXJL7soxrGYDwj6wp1IbZyzBgtubzUCiPva54AzVrBA0menz6eUHfT6pNqTP52sxiWxUp19z6DVptoOI",
+	"ZNiZbMOZcMBUQdungbLEfQq1aWwDMBtR/AAsfLXXPTfAps/EjF3JxZI62mbZ5OF6FIVRXhQqJI69RZvt",
+	"kxlbPmh79weffmih4Hdsg74G3mRPpPOwm1FshwItAdJ3ASMO0afi9lCLa7Jd9D7atOG8M/frq87P37/0",
+	"8wsFN7MMr7yYn8H1+cvv/0wi1hz8YTe0l62Wn90eXPJL9s8TiyK/fjUdfdqqk6zvqsX2sb+0frrSe+9g",
+	"cnzq/OUu0qvDTs7nmWSJrJjqqZutA8yQU1B5cyG2jj/7yR/ywxf9ZEUHa/qf6FDsgioruAX6CpX3Lns0",
+	"Qb8DxYXs9JptsDX6Br7/hxQ+9tyKvX6/0AL6IfJgc695Sd+YmFk7rqbD2m/4cTKTkEqeBtXlB70ID7TT",
+	"EdDcsszrOPz2v6uz/Ad2Z0IM2Osdc+rB1VXL+nMck6eQF8f3w6g0G5b94srAiOYWlv2w7Cna5C1BtsY2",
+	"2FMV7mimNhOIcR3wqc4NGvYcQWlHGNBgPNMWYtiEU/GDhTKGDFyn4n2p/l2rVklU9GLiOuXwvvxnyV/0",
+	"E9eJq6Toe2XXCcJkgVQ8X/zzTkS84j1SmnToy6wXrLnX3FxvCOP4gOspugULom/ERDG09QSNh6YIjsit",
+	"72FfDqALJKPk9uWOFxXDks1I/V80G1vsMQaQtjjsN5DSdW256ccOfc2e0T0MRiEQcPdHRe1GaskManHw",
+	"D1I0Y39Fy2EXidCGhcCCCm4BVWGT/kS35J+v2QZtWEEss0H4rTk1217N6ZJr7tbJGX59m3Jx4iW1WCeV",
+	"HyxUo3AxIjF68GCE96aFWklqKIk320jycbhE7Fr5VkwsaFPyY+9OmdgsyG/ZI+XzaAFUewzzuXCJ9mmb",
+	"/sS9L7QlRdRaePHKHmLPkPnh/cAzbDPvQ90JwzLxApg6wolBc/7JMZheuJtyZ6R/WHALKn4DZgZ3Ewtu",
+	"IRe76blvcoo4lG2rPiV37oXh0jVS9pdJZIm6eklCKtVEBzg/SAiMvuoeJSZb4kMN9iMCPmifLI/PfiwA",
+	"4jgRW6Ubrd/OI/9fFWicJ01AvkymOfEGWWkqsKYkVElQ8oNF11H0k2ZxiXh9ej2SijqN1IhuutXZyev7",
+	"3IWJ5mt3tBkPIXyvZmmhB/2Btughe4ZRI1BlaDvsOBOOAuxJMaSrf4RBB/0TGXNwHWHPTWLIIf1TRhz6",
+	"1/SD4bhtca/SNWWDkTwUzh73GQg3XkQbbJ021W+PFf6uVUuD7mctKvf2s7iDFZULxv7ng87Il+kk+uTL",
+	"TjFUg9P63+dhRB1jUoxI0iWC/ZuPpq9OzP9mGh1xw7l3lcIDdfYcrG7n0s9g1yHQ2pDRbkilgnHoADPw",
+	"OACaSwcYdhXqEgPZBzxezA3gLR7ohIj2kXczt5H5feIkqEV+sjIPTo7QOxjfma4l97pQpt9I3laa5OXL",
+	"3cIl1lXo1oiJfzF7c/5jZ8qr+gvgY3/RKQGq8gcpdfisgTp3iBeRSM6f//VryRoffPqxTJeilYHfpm+5",
+	"lyRVnhb1g7uhFR5grg1gApXj3VBulthatinE3MGgEDAAJvV3OVCk/kGbNmBsPynjZLziEglKTkyiZb8I",
+	"crxMopgPfGny4uRFWB1Aplf1C1cK7+JHbqHqJfdw46Ym75NyeWIpCO8HU3+8vxRP/jHm+mCRcznInSdt",
+	"/cK/kORTUi5/CI9/cH8p/iDGcFxE4moYxJwXLl+8CP8rhkEiQtFetVr2i/iWKfn6NPvcI7Iyz2mbo2kK",
+	"nJrJuQMspP6uA1HlHy1Xi//LzOIGr0c4oE1DVtkzJPYLyYQohDy2r9mjXBhqlYoXrdiif83ug+uSrEcF",
+	"RVJKn87zTFwQR1Ys3223ppHF4+PuUV8BE5Xyy7rS/W8f/Pi9i+8OjX+4UWibwddpojmNKmCdCD0wUA4z",
+	"nTo+fHZ79bax750WYwAee8Yem3DXpo08t2JGCX3jpzw31+JeT1tl7DhnvWOrHLGCZeMCqr4wtjDIbBgb",
+	"HPKnGomTX4WllaFtgJ4LXTXVDZTHrOb48tKQh5aJaxsPqIKGdaQv6J0DzoEXR8CBL1X0SrjJWmzrLZUD",
+	"RUWVV+ac3Vv1D8jQOvxNPZTZpdUpXuWBFqNg+Mxyv0lpjN5IphbNGmCAgjQsHWMbWtEPf3ibq4pJB2Mf",
+	"ppTq2R8ksm7XNFLjf1fGJjsK57RY4BxfnmuUCn72kBs8oNNTc0fLuJkipxd+9crF3barjU72nQFUY0HS",
+	"BQlm8d4IZvEPo0rsgNbpDt3iUxhIlr+VvH3iUgzxsq4WDD6QY/nMuv8LwivsOUSURSLAHnR8Id2DP9Ug",
+	"gKbERaWY+xcOt/ckzKx5h6E1SR3q4GnUwDKoigsPdUSjdIC9cLV64RbdBrGAcl5Z6bvjpBUrkyq1byWR",
+	"/DKdbu/pvcSh6iJxVeelDXVkU7pFWyChmCWFBES9w8Bx4kUJZj6tpOqaArXgJAzVZE+OPB0SlIY1mZeg",
+	"8LC6S5VXgxf6Z/asE8d4i+bAJXLXq5UTrBGu+IFfqVX0emEVXO1ACe4nNQW3tLORQaBCZmq02WFqZb/i",
+	"J/a5vXvRhfJmMbmLF3tM9fZI/CTF9QP7SiaRxip2AFvVNaNUOY33H1g9ARpzz14DldcmIuDAMa0lpBrL",
+	"Di2V4UdRjcm9qdAvFaeKXrkMkR5NT5qkmZ+/mUYCZfkmRpfEmQi2zl4A3hgGbIM9xuHr4vSGLUen+UbK",
+	"duWZDD08yTbTb0ETiGy+LBxw6Gs+N/7M5wFXB28wV39IDzvQl9vJ8/M3beYxmgbJvZt+qXhVksduGGfQ",
+	"AlPyPVRJB12QHOmHBBm62w9vn2AIjad3beL0I3ogTfZU6qO6qpNu0W1e6Ms2XXlCh/PMLmdS9JMgfAXl",
+	"lhvsLyglXrFI4ngijVUVXBGGxVX9bmKO3I1IfG9CpZyz+WNuLXBvS8TcdpxI/Cp9rxQfEftN4HVT4rEv",
+	"ulJ6dZSQCSltDg4o3NxgVkLnOshR3FRHMWugCSBETYkZngRxkGtx8pdOfvIIJ5ovDFNRM3edmWvGXhzI",
+	"qqE2FhLtZYoc2YZcCqKCHm0VB6vWsOJD+Unvjmx30kptAce9EcmVq+Ah+gbblFWuu2bxER4waPKjeEpj",
+	"iipPpC+nifZYnW1qj7HnagtUPUU9G3L+BkG+ydZECVfLgHU9TTI/fzOrVcrhoh90VCn0O/FbmzqxWGds",
+	"Qx95fv4mHjHkcY8227RMTjtup+uPhlMMwyWfODDJBZSRyc8DXfkDSbNFYRh2cm7OXLu6MDM/f+v6XA+d",
+	"cQMXn8Hddy9e7pcQKbmBFEDUMPIf4FAOCUrV0A8SJLoBgTdCzscmC+dBytzlrzjN9O0UOHyI/9WPH+wg",
+	"c71zs0qCmWvO1TAISDERBkWpVqms3JC73iFClqFz5mxNA6VXJNthre9Mz84uXP/tJ78skWVSDqsVEiSS",
+	"txMSJxcmHfpDihWHwu1AfnaKZc+vODgtjHQ3+F42uJjQfT4V2vo8AFFwrt366KPfL3x888Prv51fmLs+",
+	"fW3h5m9v/N5JERMT5tqpD03AW2lBnVpM+hzn5yfskdzWTgG5aykJjx4wN7PZIygs6lBQ1E88/uwYImfJ",
+	"23nPfnrLPL6I8qLJSi5fqM6hqZPPj4RDjOepMgVwAv2nylkBznPpcBl0gFq6qihX7l1qIF+hfvG2s+d5",
+	"tJMvjVzYmppBhH+KfBL+MTrz8MdBjLIORSo428unPdsGP6/Oy3HlAfXXaNbvGm0fjNAAFp6t0Zb0PzZg",
+	"G9gTmcYGLX6IUSjMeSubzuTjOZJEKxPTdxNeRZyZ9P8oWwZTYPoBcsx/b9ADumUWSWgHebUiCT6RXYyv",
+	"5xg6De0hR1/+xQi24xXGNp+K3hB0P02WdKKby4/TaevcwmVvqXquM0xWU6n9uw0bO3pTSqOFtaSnSoNn",
+	"hmZ0cRDsVPu+alFGq30lQ3/I1qurhCh7xvHrbFgwo4D2H7IxAq1U8cDVil3SkKKZQR4oZfkVYBMPUOip",
+	"edmhApm/kwJ2+JED6VBmJmpRrOC8Cn+D7vDj7od4Ah7r9Thb6yfOOjP2rHwqF7y0ET19ZCrTIGj19rBk",
+	"48jHlhq8NQgULdZBTYjknzqJJQoaW/mDHxoGsU3AIHGwQ+tM87P3ejSmGeSgzQhPJPFJHc26HZ6QqsOM",
+	"HcRUHDIDtfM6DXCcDcDKREJpHfaJBzwszHU20lFyzhjkeoRhwS3OVoL5t3EZa4gbe/wX27Quk/a4hl+M",
+	"CKL53vPWRHUgIRRYPzVFnVddi3LZDJeA4Ot7wDZctWX6NmqdgTJlV107mMn2XflOXwgSaNZdHmVpTffZ",
+	"SiLZOjQph0yLFOe7Rh07lfm1uT8ytKCgWnmqqBHZBrSgMvbPnqzM8zK8UrDsBVPnTd3xkuK9LmFGxXjs",
+	"mcFQWtmdyJrs46R5yBdqjV4rzat/cMAzklvS2LZG76Su/RVO7swoXOvR9HOik0emaW1HiirelzOcnu+L",
+	"0g/x56UOh4P6KoQyxpaFVGoPR6Hpj9e/oJsayEgjd2RcjvCcE+gWmMA5yBobCufbUMjCjAkPevbO4Iym",
+	"kXkZWxr/BJYGSp8ee4Ljr+YBtt4GiNBTuIH6lNt039KgDv3vPSHjtE73YDrQSHMIhsxD1bdrlWvfMuGN",
+	"cEzz4hp+Lg2MWfmbYxsZrrW6v6q9/6TL+zUXAcPAZ9I11JG9bhq7UkwEkGJYs35+wP+9kXqJlnMFo9JA",
+	"34wBfvQA/2Mq8RY3Eqo4UxznZx4PaFOyrch05FyNo4Py8gOtXilX2zO7/KDnQZHxqYC361QAdxf30zOz",
+	"wzoZcEk/GfDuxYFn+z06qwhAsqnrE9qaTHuVxNAl85C2jak+UTm3jM6i+847sgHAa17ilX7fVgoBM3O8",
+	"3A3iJGgQq+HZxgXXqS4/6DWwGCbn06GieaN1tcVOv67DzzXX0Tdv0322oV7BpwhVonW6lT8134S1oKRj",
+	"s4vLF7Ol8S32WL5ErXObp6J5/rlNtyYd+hWf2k/c4MMlAqfAMXqgwbZeNbtr4SI5XRvnaD3zM8xTyRaK",
+	"K97ROw66KrRhfAhQdftop6jeiAqCrZTQVsSE+f2yuvzgQs9zVrOf/GESmzDaFyq+6lrY7pXL4f3rlWqy",
+	"8olXrhFpbGZFGA5R8M7QacdTGyfSHY1vRRtUR2sw+04Kgy1n5lpGg1zAHimyj04zu+CmMyE5qCHOZBh7",
+	"Xk9brOB5D9EjsCWbHdYRAx9xHZfe/mC7NEI5vQ2uy/FUBPs3HuESFwBw1b0J3Opg5AbWBD2brtaiOIx4",
+	"m0Mj9GZR+DIAByPtC9aGOg0HEBheKLmhMz8XcbgTPYgQBkT0hu8vRKX1/8z32snGQNUrh/DuQkp/24lm",
+	"jY0lWoGXKmycrG50naBWLmttG43vTQzksSnYlFq5DH30pCT16LOESzambWs+36vVhvAbjmkUWgoX07Ps",
+	"0jtBG/1fU3AT6qQpO0oLAW/m4tBcDEEP0YP0R92bWFgtv9GF83v2qB1xslcOmStk5/sy7nbR+ZD+2JM9",
+	"p55srjtJF8uq67HM6vKDqYeY9lnt4YzOytxQz24dMos09FjeETOSfjArus4aDXP7u/ol/cnq7RzDvMyE",
+	"B+qyKb7yhLLalDY7q02et+sPD3N5u4JrXac9gWeD0jF6nkaLE+XLDdjgZHCrRmvIp7oRDxzr6ie85dBW",
+	"35gzhU1RF6Cp7YLRh76rdYRQdBV+ecOLk5TZTyg9cepw1m9Tfgt7GZkEW9bgrCY9zneeY2yYnVPD7BuN",
+	"X1sixml0zxBXcHYBWUt22Sh6St1eA0l5LpdDaVW7xKEnkPJkLyCpLLJ5y3D0LUrxun2W82SKf7Isofru",
+	"avkstjmGxzE8vmUZWPu1s5lCqfQixp752oy5emPm1zdd/rOjJWgVuspz7PHUQ95dL1M/kz3ESH+SfKEd",
+	"+TQvADFbRvAE2JYuWLvKoMYrdcUBI2nSs83JXFmwqNsRwH5dzvmW7Ac4Ct/dDv6qJeFJo/+rPOoIfasD",
+	"DjRgGLu9p+f2vjqabjgm+nybZQQZ7s9MRmTKjhZVcwvimoduhE9F+xD7yWS6jjWMTgnssZuBEvZYQ04L",
+	"1ezoMFtLxtBgMl0ftsjZcZC7XTxls7a0RfzTBdT6odlQA27f5GWwI7akLHa8iP2UeVdkr+D9nFHSMRp5",
+	"P+slWfm6pNGXYw2tT+tbd0do94Bla2yincPMxN9FE7010UvDlL+02kLz9aDYqK/WtDtDylCYuNo5hNYN",
+	"UU/t/OVxjv6d9sn+QbIZeunHmctmoIYRhkaPFPE4bDcO271t5SYHoqlfr+TFkQ9HpAg89VC7BrlrkUqK",
+	"xnPpL/oydSPj+bNSt3IGDDij76aeZ+9+QLwHbueU+9jCOxULz9iWnKVH6ydRhZKBi3VLtF9eKYinY5qZ",
+	"c1r41bCtPBNjpvi9vP2afhrYzPEfnkz+9KyA1BDtN65G6uokfBvcbbYOR+3HkHBGIWFUhp5tKoYhrWxt",
+	"JfDSUhrA+B63XDhVw/I7rV3nAT/9pFfHtLKb3u/52x64v+jHouFoN4AXT52tztPuKFqs5/pZu8fpuj68",
+	"8ABkqzoEq3pe+DP0CqBZQZzZsOwXV46d3Kmn9wupO1TUcT0er2YbspmVelhVD4nY9iM0gprZ7uzf58/C",
+	"9mxkazTY7i4r2HlW9PkeYVdb0zvRnj677ddfqgbnubatbgckFmxja4hudpgdsO/6yy691s/OtUOn3/l3",
+	"zkL4rGHUb/9frRaBvuaNACzUl3d4iKvaeUchkHm2Yb07vRaLTe8UGbmFDwx87ai6cGTCkUrGdZSOcR2h",
+	"YuSCc3rGnjdDTTLo7ZdnLm3Y6Qq983zXI1e/g96I35FUYxdvOLf0d+HFPrtiHMGIRsyZqpAp3Va1t0fN",
+	"Ayh71mHWbJPD5rbZfsO1NTk3PBHjlktpJ9nLJvkN6PCaeqeLkRAvPyKzmvk7FJOmWIsiEiSz3cz7gNyf",
+	"7fvimewLzZ8fwwzK2deaeQp3IaDqOom6+rNtVY/wRj9jdZmW/+bCtJ6UQjRUsWAaN8kIvn5ZyoHsg9Pp",
+	"lvFBsWlfGDFsHZNETXlfiJzzpg4hej11VfZZzl4RlLmlpkWbBk7gBkaDwAz2KWnLjiqKlFpHItElJdsy",
+	"o6U1e1G2eTrkpEO/119mvXQxe92idgE9v2sQZiHubGialaHqTkcRCVe3UwpTVDRKTkVUXIIijx3zCbFN",
+	"K/IB9RH6BqgKHVb15jDgteTHcJpax807YVgmXqDHTWxukMkGRzZ3+7h4ZZRu6MDhkpOF9nEIvr+6q75L",
+	"VkcViu+FaAPglby5tX918nedJXlRrXRLO89HHXLPXXAGLziqtStV1RT2D1uI43Ch7AdLnS1f+sqmO3L3",
+	"n5r3m0L2QV7L22l9qQIRTbaaUn90u6nXpiwmHfo3nATD+/eQ45+mpjJvbd3WJ67fpZxudVdTmuuTaaDa",
+	"fBzeAJqNUrf0Nm81fbxL63la1MdYeMaxcCBM+T7L6mllgmQCbHyG5wqllyA6pr2h7S6CuctvLD4uwEQk",
+	"JsnCKFzryV5SOwdT0RzMt8sk7P8O1+rYa37bvebzDVo/IuHromhmV+UH0vs4lX/d0ZU/PjLVgnJYXOqe",
+	"DtTQ4xZ//Exp+79lLoyVxUhwMdBY058voXkltrXF2zdnN76l3VtrHKnteU/w4IJ0n9y5F4ZLXRN1n8pn",
+	"RpHOEYPN1+6kW3Gk7M5W+sFbkk3pXfDeeYkyw4fNkbFKiW0Ofka8I3gaLDD8Dq6WTZ8J4MT6iEt5rMzX",
+	"ARi2tH68Z/HUz7lh+swpj0OD9q0hML6Og1MPxb/6uoxIisWn8jd92RT3tadPPIiQ5VS9PdOYU0/NrND3",
+	"5CQOOdh6Gplik2lyL1LSbVHGuqPa/uAhiMGNC7enOXGGhOY0lcdYBs+rDNoOGg1deanOPrm2OqcuZ2fN",
+	"Tjx1UcerS0R8ZKyBz7n0f5vZ6hEbr9B61V8mkQg5962Mr6U/Gx1c9HFnEzdFgHMgBeJMOFUSlPxg0XXE",
+	"QklJxopLxCt1vlcuqcVvfaFv1jQ7x+W9gjEFW64MHgvK0GoMuOcVcL/WNnqXtgxYVVcimQDc7LeBz2CQ",
+	"vLr6fwMA8zH+v3nQAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,10 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package jwks_get
+
+import (
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+)
+
+type keyProvider interface {
+	JWKS() jwt.JWKS
+}
@@ -0,0 +1,62 @@
+package jwks_get
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+)
+
+// keys change only on restart, clients may cache them for a while
+const cacheControl = "public, max-age=300"
+
+type Handler struct {
+	keyProvider keyProvider
+}
+
+func New(keyProvider keyProvider) (*Handler, error) {
+	if keyProvider == nil {
+		return nil, errors.New("keyProvider is nil")
+	}
+	return &Handler{
+		keyProvider: keyProvider,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, _ *http.Request) {
+	jwks := h.keyProvider.JWKS()
+
+	res := api.JWKS{
+		Keys: make([]api.JWK, 0, len(jwks.Keys)),
+	}
+	for _, key := range jwks.Keys {
+		res.Keys = append(res.Keys, convertKey(key))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", cacheControl)
+	api_handler.OK(w, res)
+}
+
+func convertKey(key jwt.JWK) api.JWK {
+	return api.JWK{
+		Kty: key.Kty,
+		Kid: key.Kid,
+		Use: key.Use,
+		Alg: key.Alg,
+		Crv: emptyToNil(key.Crv),
+		X:   emptyToNil(key.X),
+		Y:   emptyToNil(key.Y),
+		N:   emptyToNil(key.N),
+		E:   emptyToNil(key.E),
+	}
+}
+
+func emptyToNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,53 @@
+package jwks_get
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockkeyProvider(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keyProviderMock := NewMockkeyProvider(ctrl)
+
+	keyProviderMock.EXPECT().
+		JWKS().
+		Return(jwt.JWKS{Keys: []jwt.JWK{
+			{Kty: "EC", Kid: "ec1", Use: "sig", Alg: "ES256", Crv: "P-256", X: "x1", Y: "y1"},
+			{Kty: "RSA", Kid: "rsa1", Use: "sig", Alg: "RS256", N: "n1", E: "AQAB"},
+		}})
+
+	handler, err := New(keyProviderMock)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+	require.JSONEq(t, `{"keys": [
+		{"kty": "EC", "kid": "ec1", "use": "sig", "alg": "ES256", "crv": "P-256", "x": "x1", "y": "y1"},
+		{"kty": "RSA", "kid": "rsa1", "use": "sig", "alg": "RS256", "n": "n1", "e": "AQAB"}
+	]}`, w.Body.String())
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package jwks_get -typed -destination mock_deps_test.go
+//
+
+// Package jwks_get is a generated GoMock package.
+package jwks_get
+
+import (
+	reflect "reflect"
+
+	jwt "github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockkeyProvider is a mock of keyProvider interface.
+type MockkeyProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeyProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockkeyProviderMockRecorder is the mock recorder for MockkeyProvider.
+type MockkeyProviderMockRecorder struct {
+	mock *MockkeyProvider
+}
+
+// NewMockkeyProvider creates a new mock instance.
+func NewMockkeyProvider(ctrl *gomock.Controller) *MockkeyProvider {
+	mock := &MockkeyProvider{ctrl: ctrl}
+	mock.recorder = &MockkeyProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeyProvider) EXPECT() *MockkeyProviderMockRecorder {
+	return m.recorder
+}
+
+// JWKS mocks base method.
+func (m *MockkeyProvider) JWKS() jwt.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(jwt.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockkeyProviderMockRecorder) JWKS() *MockkeyProviderJWKSCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockkeyProvider)(nil).JWKS))
+	return &MockkeyProviderJWKSCall{Call: call}
+}
+
+// MockkeyProviderJWKSCall wrap *gomock.Call
+type MockkeyProviderJWKSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockkeyProviderJWKSCall) Return(arg0 jwt.JWKS) *MockkeyProviderJWKSCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockkeyProviderJWKSCall) Do(f func() jwt.JWKS) *MockkeyProviderJWKSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockkeyProviderJWKSCall) DoAndReturn(f func() jwt.JWKS) *MockkeyProviderJWKSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -3,8 +3,10 @@ package login
 
 import (
 	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
 type authenticating interface {
-	Auth(ctx context.Context, email, password string) (string, error)
+	Auth(ctx context.Context, email, password, ip string) (model.TokenPair, error)
 }
@@ -42,8 +42,17 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := h.authenticating.Auth(r.Context(), string(authRequest.Email), authRequest.Password)
+	tokens, err := h.authenticating.Auth(r.Context(), string(authRequest.Email), authRequest.Password, api_handler.ClientIP(r))
 	if err != nil {
+		var blockedErr *model.LoginBlockedError
+		if errors.As(err, &blockedErr) {
+			if errors.Is(err, model.ErrAccountLocked) {
+				api_handler.Locked(w, "учетная запись временно заблокирована", blockedErr.RetryAfter)
+				return
+			}
+			api_handler.TooManyRequests(w, "слишком много попыток входа", blockedErr.RetryAfter)
+			return
+		}
 		if errors.Is(err, model.ErrUserNotFound) {
 			api_handler.Unauthorized(w, "неверные учетные данные")
 			return
@@ -52,11 +61,16 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			api_handler.Unauthorized(w, "неверные учетные данные")
 			return
 		}
+		if errors.Is(err, model.ErrUserDisabled) {
+			api_handler.Forbidden(w, "учетная запись отключена")
+			return
+		}
 		err = fmt.Errorf("authenticating.Auth: %w", err)
 		h.logger.Error("POST /login internal error", zap.Error(err), zap.Any("request", authRequest))
 		api_handler.InternalError(w, "internal server error")
 		return
 	}
 
-	api_handler.OK(w, token)
+	w.Header().Set(api_handler.RefreshTokenHeader, tokens.RefreshToken)
+	api_handler.OK(w, tokens.AccessToken)
 }
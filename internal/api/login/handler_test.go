@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/inna-maikut/avito-pvz/internal/model"
 	"github.com/stretchr/testify/assert"
@@ -38,8 +39,8 @@ func TestHandler_Handle_Success(t *testing.T) {
 	authenticatingMock := NewMockauthenticating(ctrl)
 
 	authenticatingMock.EXPECT().
-		Auth(gomock.Any(), "email1@gmail.com", "password1").
-		Return("token1", nil)
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{AccessToken: "token1", RefreshToken: "refresh1"}, nil)
 
 	handler, err := New(authenticatingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -52,6 +53,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, w.Code)
 	require.JSONEq(t, `"token1"`, w.Body.String())
+	require.Equal(t, "refresh1", w.Header().Get("X-Refresh-Token"))
 }
 
 func TestHandler_Handle_ErrUserNotFound(t *testing.T) {
@@ -59,8 +61,8 @@ func TestHandler_Handle_ErrUserNotFound(t *testing.T) {
 	authenticatingMock := NewMockauthenticating(ctrl)
 
 	authenticatingMock.EXPECT().
-		Auth(gomock.Any(), "email1@gmail.com", "password1").
-		Return("", model.ErrUserNotFound)
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{}, model.ErrUserNotFound)
 
 	handler, err := New(authenticatingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -80,8 +82,8 @@ func TestHandler_Handle_ErrWrongUserPassword(t *testing.T) {
 	authenticatingMock := NewMockauthenticating(ctrl)
 
 	authenticatingMock.EXPECT().
-		Auth(gomock.Any(), "email1@gmail.com", "password1").
-		Return("", model.ErrWrongUserPassword)
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{}, model.ErrWrongUserPassword)
 
 	handler, err := New(authenticatingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -96,13 +98,76 @@ func TestHandler_Handle_ErrWrongUserPassword(t *testing.T) {
 	require.JSONEq(t, `{"message": "неверные учетные данные"}`, w.Body.String())
 }
 
+func TestHandler_Handle_ErrUserDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticatingMock := NewMockauthenticating(ctrl)
+
+	authenticatingMock.EXPECT().
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{}, model.ErrUserDisabled)
+
+	handler, err := New(authenticatingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"email":"email1@gmail.com", "password":"password1"}`)
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(validData))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "учетная запись отключена"}`, w.Body.String())
+}
+
+func TestHandler_Handle_ErrAccountLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticatingMock := NewMockauthenticating(ctrl)
+	authenticatingMock.EXPECT().
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{}, &model.LoginBlockedError{Err: model.ErrAccountLocked, RetryAfter: 10 * time.Minute})
+
+	handler, err := New(authenticatingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"email":"email1@gmail.com", "password":"password1"}`)
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(validData))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusLocked, w.Code)
+	require.Equal(t, "600", w.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"message": "учетная запись временно заблокирована"}`, w.Body.String())
+}
+
+func TestHandler_Handle_ErrLoginThrottled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticatingMock := NewMockauthenticating(ctrl)
+	authenticatingMock.EXPECT().
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{}, &model.LoginBlockedError{Err: model.ErrLoginThrottled, RetryAfter: time.Second})
+
+	handler, err := New(authenticatingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"email":"email1@gmail.com", "password":"password1"}`)
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(validData))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "1", w.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"message": "слишком много попыток входа"}`, w.Body.String())
+}
+
 func TestHandler_Handle_InternalError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	authenticatingMock := NewMockauthenticating(ctrl)
 
 	authenticatingMock.EXPECT().
-		Auth(gomock.Any(), "email1@gmail.com", "password1").
-		Return("", assert.AnError)
+		Auth(gomock.Any(), "email1@gmail.com", "password1", "192.0.2.1").
+		Return(model.TokenPair{}, assert.AnError)
 
 	handler, err := New(authenticatingMock, zap.NewNop())
 	require.NoError(t, err)
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/inna-maikut/avito-pvz/internal/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,18 +42,18 @@ func (m *Mockauthenticating) EXPECT() *MockauthenticatingMockRecorder {
 }
 
 // Auth mocks base method.
-func (m *Mockauthenticating) Auth(ctx context.Context, email, password string) (string, error) {
+func (m *Mockauthenticating) Auth(ctx context.Context, email, password, ip string) (model.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Auth", ctx, email, password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Auth", ctx, email, password, ip)
+	ret0, _ := ret[0].(model.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Auth indicates an expected call of Auth.
-func (mr *MockauthenticatingMockRecorder) Auth(ctx, email, password any) *MockauthenticatingAuthCall {
+func (mr *MockauthenticatingMockRecorder) Auth(ctx, email, password, ip any) *MockauthenticatingAuthCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*Mockauthenticating)(nil).Auth), ctx, email, password)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*Mockauthenticating)(nil).Auth), ctx, email, password, ip)
 	return &MockauthenticatingAuthCall{Call: call}
 }
 
@@ -62,19 +63,19 @@ type MockauthenticatingAuthCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockauthenticatingAuthCall) Return(arg0 string, arg1 error) *MockauthenticatingAuthCall {
+func (c *MockauthenticatingAuthCall) Return(arg0 model.TokenPair, arg1 error) *MockauthenticatingAuthCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockauthenticatingAuthCall) Do(f func(context.Context, string, string) (string, error)) *MockauthenticatingAuthCall {
+func (c *MockauthenticatingAuthCall) Do(f func(context.Context, string, string, string) (model.TokenPair, error)) *MockauthenticatingAuthCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockauthenticatingAuthCall) DoAndReturn(f func(context.Context, string, string) (string, error)) *MockauthenticatingAuthCall {
+func (c *MockauthenticatingAuthCall) DoAndReturn(f func(context.Context, string, string, string) (model.TokenPair, error)) *MockauthenticatingAuthCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
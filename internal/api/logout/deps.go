@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package logout
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type loggingOut interface {
+	Logout(ctx context.Context, tokenInfo model.TokenInfo, refreshToken string) error
+}
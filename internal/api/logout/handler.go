@@ -0,0 +1,59 @@
+package logout
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+)
+
+type Handler struct {
+	loggingOut loggingOut
+	logger     internal.Logger
+}
+
+func New(loggingOut loggingOut, logger internal.Logger) (*Handler, error) {
+	if loggingOut == nil {
+		return nil, errors.New("loggingOut is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		loggingOut: loggingOut,
+		logger:     logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	// the body is optional, without it only the access token is revoked
+	var req api.PostLogoutJSONBody
+	if r.ContentLength != 0 {
+		if ok := api_handler.Parse(r, w, &req); !ok {
+			return
+		}
+	}
+
+	var refreshToken string
+	if req.RefreshToken != nil {
+		refreshToken = *req.RefreshToken
+	}
+
+	err := h.loggingOut.Logout(r.Context(), tokenInfo, refreshToken)
+	if err != nil {
+		err = fmt.Errorf("loggingOut.Logout: %w", err)
+		h.logger.Error("POST /logout: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,102 @@
+package logout
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockloggingOut(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockloggingOut(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	tokenInfo := model.TokenInfo{
+		UserID:   model.NewUserID(),
+		UserRole: model.UserRoleEmployee,
+		TokenID:  model.NewTokenID(),
+	}
+
+	testCases := []struct {
+		name             string
+		body             io.Reader
+		callUseCase      bool
+		wantRefreshToken string
+		useCaseErr       error
+		wantCode         int
+	}{
+		{
+			name:        "success.no_body",
+			body:        nil,
+			callUseCase: true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:             "success.refresh_token",
+			body:             bytes.NewBufferString(`{"refreshToken":"refresh1"}`),
+			callUseCase:      true,
+			wantRefreshToken: "refresh1",
+			wantCode:         http.StatusOK,
+		},
+		{
+			name:     "invalid_body",
+			body:     bytes.NewBufferString(`{"refreshToken":`),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:        "internal_error",
+			body:        nil,
+			callUseCase: true,
+			useCaseErr:  assert.AnError,
+			wantCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockloggingOut(ctrl)
+			if tc.callUseCase {
+				useCaseMock.EXPECT().
+					Logout(gomock.Any(), tokenInfo, tc.wantRefreshToken).
+					Return(tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/logout", tc.body)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), tokenInfo))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
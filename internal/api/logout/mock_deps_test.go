@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package logout -typed -destination mock_deps_test.go
+//
+
+// Package logout is a generated GoMock package.
+package logout
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockloggingOut is a mock of loggingOut interface.
+type MockloggingOut struct {
+	ctrl     *gomock.Controller
+	recorder *MockloggingOutMockRecorder
+	isgomock struct{}
+}
+
+// MockloggingOutMockRecorder is the mock recorder for MockloggingOut.
+type MockloggingOutMockRecorder struct {
+	mock *MockloggingOut
+}
+
+// NewMockloggingOut creates a new mock instance.
+func NewMockloggingOut(ctrl *gomock.Controller) *MockloggingOut {
+	mock := &MockloggingOut{ctrl: ctrl}
+	mock.recorder = &MockloggingOutMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloggingOut) EXPECT() *MockloggingOutMockRecorder {
+	return m.recorder
+}
+
+// Logout mocks base method.
+func (m *MockloggingOut) Logout(ctx context.Context, tokenInfo model.TokenInfo, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, tokenInfo, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockloggingOutMockRecorder) Logout(ctx, tokenInfo, refreshToken any) *MockloggingOutLogoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockloggingOut)(nil).Logout), ctx, tokenInfo, refreshToken)
+	return &MockloggingOutLogoutCall{Call: call}
+}
+
+// MockloggingOutLogoutCall wrap *gomock.Call
+type MockloggingOutLogoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloggingOutLogoutCall) Return(arg0 error) *MockloggingOutLogoutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloggingOutLogoutCall) Do(f func(context.Context, model.TokenInfo, string) error) *MockloggingOutLogoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloggingOutLogoutCall) DoAndReturn(f func(context.Context, model.TokenInfo, string) error) *MockloggingOutLogoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package oidc_callback
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type oidcAuthenticating interface {
+	Auth(ctx context.Context, code string, state model.OIDCState) (model.TokenPair, error)
+}
@@ -0,0 +1,89 @@
+package oidc_callback
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	oidcAuthenticating oidcAuthenticating
+	logger             internal.Logger
+}
+
+func New(oidcAuthenticating oidcAuthenticating, logger internal.Logger) (*Handler, error) {
+	if oidcAuthenticating == nil {
+		return nil, errors.New("oidcAuthenticating is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		oidcAuthenticating: oidcAuthenticating,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	state, err := api_handler.OIDCState(w, r)
+	if err != nil {
+		api_handler.BadRequest(w, "no login in progress")
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(state.State)) != 1 {
+		api_handler.BadRequest(w, "state mismatch")
+		return
+	}
+
+	// the user declined the login or the provider failed, e.g. error=access_denied
+	if providerErr := query.Get("error"); providerErr != "" {
+		api_handler.Unauthorized(w, "вход через SSO не выполнен: "+providerErr)
+		return
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		api_handler.BadRequest(w, "empty code")
+		return
+	}
+
+	tokens, err := h.oidcAuthenticating.Auth(r.Context(), code, state)
+	if err != nil {
+		if errors.Is(err, model.ErrOIDCAuthFailed) {
+			api_handler.Unauthorized(w, "вход через SSO не выполнен")
+			return
+		}
+		if errors.Is(err, model.ErrOIDCEmailNotVerified) {
+			api_handler.Unauthorized(w, "email не подтвержден в SSO")
+			return
+		}
+		if errors.Is(err, model.ErrOIDCNoRole) {
+			api_handler.Forbidden(w, "нет роли для групп пользователя")
+			return
+		}
+		if errors.Is(err, model.ErrOIDCUserConflict) {
+			api_handler.Forbidden(w, "пользователь с этим email не привязан к учетной записи SSO")
+			return
+		}
+		if errors.Is(err, model.ErrUserDisabled) {
+			api_handler.Forbidden(w, "учетная запись отключена")
+			return
+		}
+		err = fmt.Errorf("oidcAuthenticating.Auth: %w", err)
+		h.logger.Error("GET /auth/oidc/callback internal error", zap.Error(err))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.Header().Set(api_handler.RefreshTokenHeader, tokens.RefreshToken)
+	api_handler.OK(w, tokens.AccessToken)
+}
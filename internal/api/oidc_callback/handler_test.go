@@ -0,0 +1,178 @@
+package oidc_callback
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcAuthenticating(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcAuthenticating(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	state := model.OIDCState{State: "state", Nonce: "nonce", CodeVerifier: "verifier"}
+
+	testCases := []struct {
+		name        string
+		query       string
+		cookie      string
+		callUseCase bool
+		useCaseErr  error
+		wantCode    int
+		wantBody    string
+	}{
+		{
+			name:        "success",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			wantCode:    http.StatusOK,
+			wantBody:    `"token1"`,
+		},
+		{
+			name:     "no_cookie",
+			query:    "?code=code&state=state",
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"message":"no login in progress"}`,
+		},
+		{
+			name:     "invalid_cookie",
+			query:    "?code=code&state=state",
+			cookie:   "state",
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"message":"no login in progress"}`,
+		},
+		{
+			name:     "state_mismatch",
+			query:    "?code=code&state=other",
+			cookie:   state.String(),
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"message":"state mismatch"}`,
+		},
+		{
+			name:     "provider_error",
+			query:    "?error=access_denied&state=state",
+			cookie:   state.String(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: `{"message":"вход через SSO не выполнен: access_denied"}`,
+		},
+		{
+			name:     "empty_code",
+			query:    "?state=state",
+			cookie:   state.String(),
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"message":"empty code"}`,
+		},
+		{
+			name:        "auth_failed",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrOIDCAuthFailed,
+			wantCode:    http.StatusUnauthorized,
+			wantBody:    `{"message":"вход через SSO не выполнен"}`,
+		},
+		{
+			name:        "email_not_verified",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrOIDCEmailNotVerified,
+			wantCode:    http.StatusUnauthorized,
+			wantBody:    `{"message":"email не подтвержден в SSO"}`,
+		},
+		{
+			name:        "no_role",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrOIDCNoRole,
+			wantCode:    http.StatusForbidden,
+			wantBody:    `{"message":"нет роли для групп пользователя"}`,
+		},
+		{
+			name:        "user_conflict",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrOIDCUserConflict,
+			wantCode:    http.StatusForbidden,
+			wantBody:    `{"message":"пользователь с этим email не привязан к учетной записи SSO"}`,
+		},
+		{
+			name:        "user_disabled",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrUserDisabled,
+			wantCode:    http.StatusForbidden,
+			wantBody:    `{"message":"учетная запись отключена"}`,
+		},
+		{
+			name:        "internal_error",
+			query:       "?code=code&state=state",
+			cookie:      state.String(),
+			callUseCase: true,
+			useCaseErr:  assert.AnError,
+			wantCode:    http.StatusInternalServerError,
+			wantBody:    `{"message":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockoidcAuthenticating(ctrl)
+			if tc.callUseCase {
+				tokens := model.TokenPair{AccessToken: "token1", RefreshToken: "refresh1"}
+				if tc.useCaseErr != nil {
+					tokens = model.TokenPair{}
+				}
+				useCaseMock.EXPECT().
+					Auth(gomock.Any(), "code", state).
+					Return(tokens, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback"+tc.query, nil)
+			if tc.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: api_handler.OIDCStateCookie, Value: tc.cookie})
+			}
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+			require.JSONEq(t, tc.wantBody, w.Body.String())
+			if tc.wantCode == http.StatusOK {
+				require.Equal(t, "refresh1", w.Header().Get(api_handler.RefreshTokenHeader))
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package oidc_callback -typed -destination mock_deps_test.go
+//
+
+// Package oidc_callback is a generated GoMock package.
+package oidc_callback
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockoidcAuthenticating is a mock of oidcAuthenticating interface.
+type MockoidcAuthenticating struct {
+	ctrl     *gomock.Controller
+	recorder *MockoidcAuthenticatingMockRecorder
+	isgomock struct{}
+}
+
+// MockoidcAuthenticatingMockRecorder is the mock recorder for MockoidcAuthenticating.
+type MockoidcAuthenticatingMockRecorder struct {
+	mock *MockoidcAuthenticating
+}
+
+// NewMockoidcAuthenticating creates a new mock instance.
+func NewMockoidcAuthenticating(ctrl *gomock.Controller) *MockoidcAuthenticating {
+	mock := &MockoidcAuthenticating{ctrl: ctrl}
+	mock.recorder = &MockoidcAuthenticatingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoidcAuthenticating) EXPECT() *MockoidcAuthenticatingMockRecorder {
+	return m.recorder
+}
+
+// Auth mocks base method.
+func (m *MockoidcAuthenticating) Auth(ctx context.Context, code string, state model.OIDCState) (model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth", ctx, code, state)
+	ret0, _ := ret[0].(model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockoidcAuthenticatingMockRecorder) Auth(ctx, code, state any) *MockoidcAuthenticatingAuthCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockoidcAuthenticating)(nil).Auth), ctx, code, state)
+	return &MockoidcAuthenticatingAuthCall{Call: call}
+}
+
+// MockoidcAuthenticatingAuthCall wrap *gomock.Call
+type MockoidcAuthenticatingAuthCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoidcAuthenticatingAuthCall) Return(arg0 model.TokenPair, arg1 error) *MockoidcAuthenticatingAuthCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoidcAuthenticatingAuthCall) Do(f func(context.Context, string, model.OIDCState) (model.TokenPair, error)) *MockoidcAuthenticatingAuthCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoidcAuthenticatingAuthCall) DoAndReturn(f func(context.Context, string, model.OIDCState) (model.TokenPair, error)) *MockoidcAuthenticatingAuthCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
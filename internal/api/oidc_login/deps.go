@@ -0,0 +1,10 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package oidc_login
+
+import (
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type oidcAuthenticating interface {
+	LoginURL() (string, model.OIDCState, error)
+}
@@ -0,0 +1,44 @@
+package oidc_login
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+)
+
+type Handler struct {
+	oidcAuthenticating oidcAuthenticating
+	logger             internal.Logger
+}
+
+func New(oidcAuthenticating oidcAuthenticating, logger internal.Logger) (*Handler, error) {
+	if oidcAuthenticating == nil {
+		return nil, errors.New("oidcAuthenticating is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		oidcAuthenticating: oidcAuthenticating,
+		logger:             logger,
+	}, nil
+}
+
+// Handle redirects the user to the identity provider, the provider redirects back to GET /auth/oidc/callback.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	loginURL, state, err := h.oidcAuthenticating.LoginURL()
+	if err != nil {
+		err = fmt.Errorf("oidcAuthenticating.LoginURL: %w", err)
+		h.logger.Error("GET /auth/oidc/login internal error", zap.Error(err))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.SetOIDCState(w, state)
+	http.Redirect(w, r, loginURL, http.StatusFound)
+}
@@ -0,0 +1,77 @@
+package oidc_login
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcAuthenticating(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcAuthenticating(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCaseMock := NewMockoidcAuthenticating(ctrl)
+
+		state := model.OIDCState{State: "state", Nonce: "nonce", CodeVerifier: "verifier"}
+		useCaseMock.EXPECT().LoginURL().Return("https://sso.example.com/authorize?state=state", state, nil)
+
+		handler, err := New(useCaseMock, zap.NewNop())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil)
+		w := httptest.NewRecorder()
+		handler.Handle(w, req)
+
+		require.Equal(t, http.StatusFound, w.Code)
+		require.Equal(t, "https://sso.example.com/authorize?state=state", w.Header().Get("Location"))
+
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, api_handler.OIDCStateCookie, cookies[0].Name)
+		assert.Equal(t, "state.nonce.verifier", cookies[0].Value)
+	})
+
+	t.Run("internal_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCaseMock := NewMockoidcAuthenticating(ctrl)
+
+		useCaseMock.EXPECT().LoginURL().Return("", model.OIDCState{}, assert.AnError)
+
+		handler, err := New(useCaseMock, zap.NewNop())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil)
+		w := httptest.NewRecorder()
+		handler.Handle(w, req)
+
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Empty(t, w.Result().Cookies())
+	})
+}
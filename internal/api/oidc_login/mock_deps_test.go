@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package oidc_login -typed -destination mock_deps_test.go
+//
+
+// Package oidc_login is a generated GoMock package.
+package oidc_login
+
+import (
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockoidcAuthenticating is a mock of oidcAuthenticating interface.
+type MockoidcAuthenticating struct {
+	ctrl     *gomock.Controller
+	recorder *MockoidcAuthenticatingMockRecorder
+	isgomock struct{}
+}
+
+// MockoidcAuthenticatingMockRecorder is the mock recorder for MockoidcAuthenticating.
+type MockoidcAuthenticatingMockRecorder struct {
+	mock *MockoidcAuthenticating
+}
+
+// NewMockoidcAuthenticating creates a new mock instance.
+func NewMockoidcAuthenticating(ctrl *gomock.Controller) *MockoidcAuthenticating {
+	mock := &MockoidcAuthenticating{ctrl: ctrl}
+	mock.recorder = &MockoidcAuthenticatingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoidcAuthenticating) EXPECT() *MockoidcAuthenticatingMockRecorder {
+	return m.recorder
+}
+
+// LoginURL mocks base method.
+func (m *MockoidcAuthenticating) LoginURL() (string, model.OIDCState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginURL")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(model.OIDCState)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LoginURL indicates an expected call of LoginURL.
+func (mr *MockoidcAuthenticatingMockRecorder) LoginURL() *MockoidcAuthenticatingLoginURLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginURL", reflect.TypeOf((*MockoidcAuthenticating)(nil).LoginURL))
+	return &MockoidcAuthenticatingLoginURLCall{Call: call}
+}
+
+// MockoidcAuthenticatingLoginURLCall wrap *gomock.Call
+type MockoidcAuthenticatingLoginURLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoidcAuthenticatingLoginURLCall) Return(arg0 string, arg1 model.OIDCState, arg2 error) *MockoidcAuthenticatingLoginURLCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoidcAuthenticatingLoginURLCall) Do(f func() (string, model.OIDCState, error)) *MockoidcAuthenticatingLoginURLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoidcAuthenticatingLoginURLCall) DoAndReturn(f func() (string, model.OIDCState, error)) *MockoidcAuthenticatingLoginURLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
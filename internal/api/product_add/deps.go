@@ -8,5 +8,5 @@ import (
 )
 
 type productAdding interface {
-	AddProduct(ctx context.Context, pvzID model.PVZID, category model.ProductCategory) (model.Product, error)
+	AddProduct(ctx context.Context, pvzID model.PVZID, category model.ProductCategory, barcode *string, actor model.Actor) (model.Product, error)
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+const maxBarcodeLength = 64
+
 type Handler struct {
 	productAdding productAdding
 	logger        internal.Logger
@@ -36,8 +39,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	if tokenInfo.UserRole != model.UserRoleEmployee {
-		api_handler.Forbidden(w, "only a user with the employee role can add product")
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionProductAdd); !ok {
 		return
 	}
 
@@ -47,14 +49,32 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	pvzID := model.PVZID(request.PvzId)
+	if !tokenInfo.PVZAccess.Allows(pvzID) {
+		api_handler.Forbidden(w, "user is not assigned to the pvz")
+		return
+	}
 	category, err := model.ParseProductCategory(string(request.Type))
 	if err != nil {
 		api_handler.BadRequest(w, "invalid type")
 		return
 	}
 
-	product, err := h.productAdding.AddProduct(ctx, pvzID, category)
+	var barcode *string
+	if request.Barcode != nil {
+		value := strings.TrimSpace(*request.Barcode)
+		if value == "" || len(value) > maxBarcodeLength {
+			api_handler.BadRequest(w, "invalid barcode")
+			return
+		}
+		barcode = &value
+	}
+
+	product, err := h.productAdding.AddProduct(ctx, pvzID, category, barcode, model.NewActor(tokenInfo))
 	if err != nil {
+		if errors.Is(err, model.ErrProductAlreadyScanned) {
+			api_handler.Conflict(w, "product with this barcode is already added to the reception")
+			return
+		}
 		err = fmt.Errorf("productAdding.AddProduct: %w", err)
 		h.logger.Error("POST /products/ internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
 			zap.Any("request", request))
@@ -68,5 +88,6 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		ReceptionId: product.ReceptionID.UUID(),
 		Type:        api.ProductType(product.Category.String()),
 		DateTime:    &product.AddedAt,
+		Barcode:     product.Barcode,
 	})
 }
@@ -50,7 +50,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
 
 	useCaseMock.EXPECT().
-		AddProduct(gomock.Any(), pvzID, model.ProductCategoryElectronics).
+		AddProduct(gomock.Any(), pvzID, model.ProductCategoryElectronics, nil, model.Actor{UserID: model.DefaultUserID}).
 		Return(model.Product{
 			ID:          productID,
 			ReceptionID: receptionID,
@@ -65,7 +65,9 @@ func TestHandler_Handle_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -86,13 +88,36 @@ func TestHandler_Handle_InvalidRole(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
 
 	require.Equal(t, http.StatusForbidden, w.Code)
-	require.JSONEq(t, `{"message": "only a user with the employee role can add product"}`, w.Body.String())
+	require.JSONEq(t, `{"message": "permission product:add is required"}`, w.Body.String())
+}
+
+func TestHandler_Handle_PVZAccessDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockproductAdding(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "type": "электроника"}`)
+	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.PVZAccess{PVZIDs: []model.PVZID{model.NewPVZID()}},
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "user is not assigned to the pvz"}`, w.Body.String())
 }
 
 func TestHandler_Handle_InvalidRequest(t *testing.T) {
@@ -106,7 +131,9 @@ func TestHandler_Handle_InvalidRequest(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -126,7 +153,9 @@ func TestHandler_Handle_InvalidType(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -140,7 +169,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	useCaseMock := NewMockproductAdding(ctrl)
 
 	useCaseMock.EXPECT().
-		AddProduct(gomock.Any(), gomock.Any(), gomock.Any()).
+		AddProduct(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(model.Product{}, assert.AnError)
 
 	handler, err := New(useCaseMock, zap.NewNop())
@@ -150,7 +179,9 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -159,3 +190,94 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 
 	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
 }
+
+func TestHandler_Handle_SuccessBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockproductAdding(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a04")
+	require.NoError(t, err)
+	productID, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a03")
+	require.NoError(t, err)
+
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	barcode := "4600000000001"
+
+	useCaseMock.EXPECT().
+		AddProduct(gomock.Any(), pvzID, model.ProductCategoryShoes, &barcode, model.Actor{UserID: model.DefaultUserID}).
+		Return(model.Product{
+			ID:          productID,
+			ReceptionID: receptionID,
+			Category:    model.ProductCategoryShoes,
+			AddedAt:     date,
+			Barcode:     &barcode,
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "type": "обувь", "barcode": " 4600000000001 "}`)
+	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	require.JSONEq(t, `{"id": "6451927e-846b-4c97-9924-cba818687a03", "receptionId": "6451927e-846b-4c97-9924-cba818687a04", "dateTime": "2025-04-09T20:55:59Z", "type": "обувь", "barcode": "4600000000001"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockproductAdding(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	invalidData := []byte(`{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "type": "обувь", "barcode": "  "}`)
+	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(invalidData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "invalid barcode"}`, w.Body.String())
+}
+
+func TestHandler_Handle_AlreadyScanned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockproductAdding(ctrl)
+
+	useCaseMock.EXPECT().
+		AddProduct(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.Product{}, model.ErrProductAlreadyScanned)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "type": "обувь", "barcode": "4600000000001"}`)
+	req := httptest.NewRequest(http.MethodPost, "/products/", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.JSONEq(t, `{"message": "product with this barcode is already added to the reception"}`, w.Body.String())
+}
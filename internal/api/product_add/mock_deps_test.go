@@ -42,18 +42,18 @@ func (m *MockproductAdding) EXPECT() *MockproductAddingMockRecorder {
 }
 
 // AddProduct mocks base method.
-func (m *MockproductAdding) AddProduct(ctx context.Context, pvzID model.PVZID, category model.ProductCategory) (model.Product, error) {
+func (m *MockproductAdding) AddProduct(ctx context.Context, pvzID model.PVZID, category model.ProductCategory, barcode *string, actor model.Actor) (model.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, category)
+	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, category, barcode, actor)
 	ret0, _ := ret[0].(model.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockproductAddingMockRecorder) AddProduct(ctx, pvzID, category any) *MockproductAddingAddProductCall {
+func (mr *MockproductAddingMockRecorder) AddProduct(ctx, pvzID, category, barcode, actor any) *MockproductAddingAddProductCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockproductAdding)(nil).AddProduct), ctx, pvzID, category)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockproductAdding)(nil).AddProduct), ctx, pvzID, category, barcode, actor)
 	return &MockproductAddingAddProductCall{Call: call}
 }
 
@@ -69,13 +69,13 @@ func (c *MockproductAddingAddProductCall) Return(arg0 model.Product, arg1 error)
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockproductAddingAddProductCall) Do(f func(context.Context, model.PVZID, model.ProductCategory) (model.Product, error)) *MockproductAddingAddProductCall {
+func (c *MockproductAddingAddProductCall) Do(f func(context.Context, model.PVZID, model.ProductCategory, *string, model.Actor) (model.Product, error)) *MockproductAddingAddProductCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockproductAddingAddProductCall) DoAndReturn(f func(context.Context, model.PVZID, model.ProductCategory) (model.Product, error)) *MockproductAddingAddProductCall {
+func (c *MockproductAddingAddProductCall) DoAndReturn(f func(context.Context, model.PVZID, model.ProductCategory, *string, model.Actor) (model.Product, error)) *MockproductAddingAddProductCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
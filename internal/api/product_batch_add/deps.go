@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package product_batch_add
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type productAdding interface {
+	AddProducts(ctx context.Context, pvzID model.PVZID, inputs []model.ProductInput, actor model.Actor) ([]model.Product, error)
+}
@@ -0,0 +1,118 @@
+package product_batch_add
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	maxBarcodeLength = 64
+	maxProducts      = 500
+)
+
+type Handler struct {
+	productAdding productAdding
+	logger        internal.Logger
+}
+
+func New(productAdding productAdding, logger internal.Logger) (*Handler, error) {
+	if productAdding == nil {
+		return nil, errors.New("productAdding is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		productAdding: productAdding,
+		logger:        logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionProductAdd); !ok {
+		return
+	}
+
+	var request api.PostProductsBatchJSONBody
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	pvzID := model.PVZID(request.PvzId)
+	if !tokenInfo.PVZAccess.Allows(pvzID) {
+		api_handler.Forbidden(w, "user is not assigned to the pvz")
+		return
+	}
+	if len(request.Products) == 0 || len(request.Products) > maxProducts {
+		api_handler.BadRequest(w, fmt.Sprintf("products should contain from 1 to %d items", maxProducts))
+		return
+	}
+
+	inputs := make([]model.ProductInput, 0, len(request.Products))
+	for _, product := range request.Products {
+		category, err := model.ParseProductCategory(string(product.Type))
+		if err != nil {
+			api_handler.BadRequest(w, "invalid type")
+			return
+		}
+
+		var barcode *string
+		if product.Barcode != nil {
+			value := strings.TrimSpace(*product.Barcode)
+			if value == "" || len(value) > maxBarcodeLength {
+				api_handler.BadRequest(w, "invalid barcode")
+				return
+			}
+			barcode = &value
+		}
+
+		inputs = append(inputs, model.ProductInput{
+			Category: category,
+			Barcode:  barcode,
+		})
+	}
+
+	products, err := h.productAdding.AddProducts(ctx, pvzID, inputs, model.NewActor(tokenInfo))
+	if err != nil {
+		if errors.Is(err, model.ErrReceptionNotFound) {
+			api_handler.BadRequest(w, "no reception in progress")
+			return
+		}
+		if errors.Is(err, model.ErrProductAlreadyScanned) {
+			api_handler.Conflict(w, "barcode is repeated or already added to the reception")
+			return
+		}
+		err = fmt.Errorf("productAdding.AddProducts: %w", err)
+		h.logger.Error("POST /products/batch: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Stringer("pvzID", request.PvzId), zap.Int("count", len(inputs)))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := make([]api.Product, 0, len(products))
+	for _, product := range products {
+		ID := product.ID.UUID()
+		res = append(res, api.Product{
+			Id:          &ID,
+			ReceptionId: product.ReceptionID.UUID(),
+			Type:        api.ProductType(product.Category.String()),
+			DateTime:    &product.AddedAt,
+			Barcode:     product.Barcode,
+		})
+	}
+
+	api_handler.Created(w, res)
+}
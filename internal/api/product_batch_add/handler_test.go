@@ -0,0 +1,216 @@
+package product_batch_add
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockproductAdding(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockproductAdding(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockproductAdding(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a04")
+	require.NoError(t, err)
+	productID1, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a03")
+	require.NoError(t, err)
+	productID2, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	barcode := "4600000000001"
+
+	useCaseMock.EXPECT().
+		AddProducts(gomock.Any(), pvzID, []model.ProductInput{
+			{Category: model.ProductCategoryShoes, Barcode: &barcode},
+			{Category: model.ProductCategoryElectronics},
+		}, model.Actor{UserID: model.DefaultUserID}).
+		Return([]model.Product{
+			{
+				ID:          productID1,
+				ReceptionID: receptionID,
+				Category:    model.ProductCategoryShoes,
+				AddedAt:     date,
+				Barcode:     &barcode,
+			},
+			{
+				ID:          productID2,
+				ReceptionID: receptionID,
+				Category:    model.ProductCategoryElectronics,
+				AddedAt:     date,
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "products": [
+		{"type": "обувь", "barcode": " 4600000000001 "},
+		{"type": "электроника"}
+	]}`)
+	req := httptest.NewRequest(http.MethodPost, "/products/batch", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	require.JSONEq(t, `[
+		{"id": "6451927e-846b-4c97-9924-cba818687a03", "receptionId": "6451927e-846b-4c97-9924-cba818687a04", "dateTime": "2025-04-09T20:55:59Z", "type": "обувь", "barcode": "4600000000001"},
+		{"id": "6451927e-846b-4c97-9924-cba818687a02", "receptionId": "6451927e-846b-4c97-9924-cba818687a04", "dateTime": "2025-04-09T20:55:59Z", "type": "электроника"}
+	]`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	validBody := `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "products": [{"type": "обувь", "barcode": "4600000000001"}]}`
+	tooManyBody := `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "products": [` +
+		strings.TrimSuffix(strings.Repeat(`{"type": "обувь"},`, maxProducts+1), ",") + `]}`
+
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		pvzAccess  model.PVZAccess
+		body       string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:      "invalid_role",
+			role:      model.UserRoleModerator,
+			pvzAccess: model.AllPVZAccess(),
+			body:      validBody,
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "pvz_access_denied",
+			role:      model.UserRoleEmployee,
+			pvzAccess: model.PVZAccess{PVZIDs: []model.PVZID{model.NewPVZID()}},
+			body:      validBody,
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "invalid_request",
+			role:      model.UserRoleEmployee,
+			pvzAccess: model.AllPVZAccess(),
+			body:      `{"pvzId": "6451927e-846b-4c97-9924-cba818687a0", "products": [{"type": "обувь"}]}`,
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "empty_products",
+			role:      model.UserRoleEmployee,
+			pvzAccess: model.AllPVZAccess(),
+			body:      `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "products": []}`,
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "too_many_products",
+			role:      model.UserRoleEmployee,
+			pvzAccess: model.AllPVZAccess(),
+			body:      tooManyBody,
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "invalid_type",
+			role:      model.UserRoleEmployee,
+			pvzAccess: model.AllPVZAccess(),
+			body:      `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "products": [{"type": "обувь"}, {"type": ""}]}`,
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "invalid_barcode",
+			role:      model.UserRoleEmployee,
+			pvzAccess: model.AllPVZAccess(),
+			body:      `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05", "products": [{"type": "обувь", "barcode": "  "}]}`,
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:       "no_reception_in_progress",
+			role:       model.UserRoleEmployee,
+			pvzAccess:  model.AllPVZAccess(),
+			body:       validBody,
+			useCaseErr: model.ErrReceptionNotFound,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "already_scanned",
+			role:       model.UserRoleEmployee,
+			pvzAccess:  model.AllPVZAccess(),
+			body:       validBody,
+			useCaseErr: model.ErrProductAlreadyScanned,
+			wantCode:   http.StatusConflict,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleEmployee,
+			pvzAccess:  model.AllPVZAccess(),
+			body:       validBody,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockproductAdding(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					AddProducts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/products/batch", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+				PVZAccess:   tc.pvzAccess,
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package product_batch_add -typed -destination mock_deps_test.go
+//
+
+// Package product_batch_add is a generated GoMock package.
+package product_batch_add
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockproductAdding is a mock of productAdding interface.
+type MockproductAdding struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductAddingMockRecorder
+	isgomock struct{}
+}
+
+// MockproductAddingMockRecorder is the mock recorder for MockproductAdding.
+type MockproductAddingMockRecorder struct {
+	mock *MockproductAdding
+}
+
+// NewMockproductAdding creates a new mock instance.
+func NewMockproductAdding(ctrl *gomock.Controller) *MockproductAdding {
+	mock := &MockproductAdding{ctrl: ctrl}
+	mock.recorder = &MockproductAddingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductAdding) EXPECT() *MockproductAddingMockRecorder {
+	return m.recorder
+}
+
+// AddProducts mocks base method.
+func (m *MockproductAdding) AddProducts(ctx context.Context, pvzID model.PVZID, inputs []model.ProductInput, actor model.Actor) ([]model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProducts", ctx, pvzID, inputs, actor)
+	ret0, _ := ret[0].([]model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProducts indicates an expected call of AddProducts.
+func (mr *MockproductAddingMockRecorder) AddProducts(ctx, pvzID, inputs, actor any) *MockproductAddingAddProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProducts", reflect.TypeOf((*MockproductAdding)(nil).AddProducts), ctx, pvzID, inputs, actor)
+	return &MockproductAddingAddProductsCall{Call: call}
+}
+
+// MockproductAddingAddProductsCall wrap *gomock.Call
+type MockproductAddingAddProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductAddingAddProductsCall) Return(arg0 []model.Product, arg1 error) *MockproductAddingAddProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductAddingAddProductsCall) Do(f func(context.Context, model.PVZID, []model.ProductInput, model.Actor) ([]model.Product, error)) *MockproductAddingAddProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductAddingAddProductsCall) DoAndReturn(f func(context.Context, model.PVZID, []model.ProductInput, model.Actor) ([]model.Product, error)) *MockproductAddingAddProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
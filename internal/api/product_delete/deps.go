@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package product_delete
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type productRemoving interface {
+	RemoveProduct(ctx context.Context, productID model.ProductID, actor model.Actor, access model.PVZAccess) error
+}
@@ -0,0 +1,70 @@
+package product_delete
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	productRemoving productRemoving
+	logger          internal.Logger
+}
+
+func New(productRemoving productRemoving, logger internal.Logger) (*Handler, error) {
+	if productRemoving == nil {
+		return nil, errors.New("productRemoving is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		productRemoving: productRemoving,
+		logger:          logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionProductDelete); !ok {
+		return
+	}
+
+	productID, err := model.ParseProductID(r.PathValue("productId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid productId")
+		return
+	}
+
+	err = h.productRemoving.RemoveProduct(ctx, productID, model.NewActor(tokenInfo), tokenInfo.PVZAccess)
+	if err != nil {
+		if errors.Is(err, model.ErrPVZAccessDenied) {
+			api_handler.Forbidden(w, "user is not assigned to the pvz")
+			return
+		}
+		if errors.Is(err, model.ErrProductNotFound) {
+			api_handler.NotFound(w, "product not found")
+			return
+		}
+		if errors.Is(err, model.ErrReceptionClosed) {
+			api_handler.BadRequest(w, "reception is already closed")
+			return
+		}
+		err = fmt.Errorf("productRemoving.RemoveProduct: %w", err)
+		h.logger.Error("DELETE /products/{productId}: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("productId", productID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,139 @@
+package product_delete
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockproductRemoving(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockproductRemoving(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	productID, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a31")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		role      model.UserRole
+		productID string
+		prepare   func(m *MockproductRemoving)
+		wantCode  int
+	}{
+		{
+			name:      "success",
+			role:      model.UserRoleEmployee,
+			productID: productID.UUID().String(),
+			prepare: func(m *MockproductRemoving) {
+				m.EXPECT().RemoveProduct(gomock.Any(), productID, model.Actor{UserID: model.DefaultUserID}, model.AllPVZAccess()).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "invalid_role",
+			role:      model.UserRoleModerator,
+			productID: productID.UUID().String(),
+			prepare:   func(*MockproductRemoving) {},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "auditor_read_only",
+			role:      model.UserRoleAuditor,
+			productID: productID.UUID().String(),
+			prepare:   func(*MockproductRemoving) {},
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "invalid_product_id",
+			role:      model.UserRoleEmployee,
+			productID: "6451927e-846b-4c97-9924-cba818687a3",
+			prepare:   func(*MockproductRemoving) {},
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "product_not_found",
+			role:      model.UserRoleEmployee,
+			productID: productID.UUID().String(),
+			prepare: func(m *MockproductRemoving) {
+				m.EXPECT().RemoveProduct(gomock.Any(), productID, model.Actor{UserID: model.DefaultUserID}, model.AllPVZAccess()).Return(model.ErrProductNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:      "reception_closed",
+			role:      model.UserRoleEmployee,
+			productID: productID.UUID().String(),
+			prepare: func(m *MockproductRemoving) {
+				m.EXPECT().RemoveProduct(gomock.Any(), productID, model.Actor{UserID: model.DefaultUserID}, model.AllPVZAccess()).Return(model.ErrReceptionClosed)
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:      "pvz_access_denied",
+			role:      model.UserRoleEmployee,
+			productID: productID.UUID().String(),
+			prepare: func(m *MockproductRemoving) {
+				m.EXPECT().RemoveProduct(gomock.Any(), productID, model.Actor{UserID: model.DefaultUserID}, model.AllPVZAccess()).
+					Return(model.ErrPVZAccessDenied)
+			},
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:      "internal_error",
+			role:      model.UserRoleEmployee,
+			productID: productID.UUID().String(),
+			prepare: func(m *MockproductRemoving) {
+				m.EXPECT().RemoveProduct(gomock.Any(), productID, model.Actor{UserID: model.DefaultUserID}, model.AllPVZAccess()).Return(assert.AnError)
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockproductRemoving(ctrl)
+			tc.prepare(useCaseMock)
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodDelete, "/products/{productId}", nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+				PVZAccess:   model.AllPVZAccess(),
+			}))
+			req.SetPathValue("productId", tc.productID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
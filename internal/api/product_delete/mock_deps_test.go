@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package product_delete -typed -destination mock_deps_test.go
+//
+
+// Package product_delete is a generated GoMock package.
+package product_delete
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockproductRemoving is a mock of productRemoving interface.
+type MockproductRemoving struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductRemovingMockRecorder
+	isgomock struct{}
+}
+
+// MockproductRemovingMockRecorder is the mock recorder for MockproductRemoving.
+type MockproductRemovingMockRecorder struct {
+	mock *MockproductRemoving
+}
+
+// NewMockproductRemoving creates a new mock instance.
+func NewMockproductRemoving(ctrl *gomock.Controller) *MockproductRemoving {
+	mock := &MockproductRemoving{ctrl: ctrl}
+	mock.recorder = &MockproductRemovingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductRemoving) EXPECT() *MockproductRemovingMockRecorder {
+	return m.recorder
+}
+
+// RemoveProduct mocks base method.
+func (m *MockproductRemoving) RemoveProduct(ctx context.Context, productID model.ProductID, actor model.Actor, access model.PVZAccess) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProduct", ctx, productID, actor, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProduct indicates an expected call of RemoveProduct.
+func (mr *MockproductRemovingMockRecorder) RemoveProduct(ctx, productID, actor, access any) *MockproductRemovingRemoveProductCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProduct", reflect.TypeOf((*MockproductRemoving)(nil).RemoveProduct), ctx, productID, actor, access)
+	return &MockproductRemovingRemoveProductCall{Call: call}
+}
+
+// MockproductRemovingRemoveProductCall wrap *gomock.Call
+type MockproductRemovingRemoveProductCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRemovingRemoveProductCall) Return(arg0 error) *MockproductRemovingRemoveProductCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRemovingRemoveProductCall) Do(f func(context.Context, model.ProductID, model.Actor, model.PVZAccess) error) *MockproductRemovingRemoveProductCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRemovingRemoveProductCall) DoAndReturn(f func(context.Context, model.ProductID, model.Actor, model.PVZAccess) error) *MockproductRemovingRemoveProductCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
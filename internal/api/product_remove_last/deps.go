@@ -8,5 +8,5 @@ import (
 )
 
 type productRemoving interface {
-	RemoveLastProduct(ctx context.Context, pvzID model.PVZID) error
+	RemoveLastProduct(ctx context.Context, pvzID model.PVZID, actor model.Actor) error
 }
@@ -35,8 +35,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	if tokenInfo.UserRole != model.UserRoleEmployee {
-		api_handler.Forbidden(w, "only a user with the employee role can remove product")
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionProductDelete); !ok {
 		return
 	}
 
@@ -46,9 +45,14 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.productRemoving.RemoveLastProduct(ctx, pvzID)
+	if !tokenInfo.PVZAccess.Allows(pvzID) {
+		api_handler.Forbidden(w, "user is not assigned to the pvz")
+		return
+	}
+
+	err = h.productRemoving.RemoveLastProduct(ctx, pvzID, model.NewActor(tokenInfo))
 	if err != nil {
-		err = fmt.Errorf("productRemoving.RemoveLastProduct(: %w", err)
+		err = fmt.Errorf("productRemoving.RemoveLastProduct: %w", err)
 		h.logger.Error("POST /pvz/{pvzId}/delete_last_product: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
 			zap.Any("pvzId", pvzID))
 		api_handler.InternalError(w, "internal server error")
@@ -43,7 +43,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	useCaseMock.EXPECT().
-		RemoveLastProduct(gomock.Any(), pvzID).
+		RemoveLastProduct(gomock.Any(), pvzID, model.Actor{UserID: model.DefaultUserID}).
 		Return(nil)
 
 	handler, err := New(useCaseMock, zap.NewNop())
@@ -52,7 +52,9 @@ func TestHandler_Handle_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/delete_last_product", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	req.SetPathValue("pvzId", pvzID.UUID().String())
 	w := httptest.NewRecorder()
@@ -74,7 +76,8 @@ func TestHandler_Handle_InvalidRole(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/delete_last_product", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	req.SetPathValue("pvzId", pvzID.UUID().String())
 	w := httptest.NewRecorder()
@@ -83,6 +86,31 @@ func TestHandler_Handle_InvalidRole(t *testing.T) {
 	require.Equal(t, http.StatusForbidden, w.Code)
 }
 
+func TestHandler_Handle_PVZAccessDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockproductRemoving(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a04")
+	require.NoError(t, err)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/delete_last_product", bytes.NewReader(nil))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.PVZAccess{PVZIDs: []model.PVZID{model.NewPVZID()}},
+	}))
+	req.SetPathValue("pvzId", pvzID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "user is not assigned to the pvz"}`, w.Body.String())
+}
+
 func TestHandler_Handle_InternalError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	useCaseMock := NewMockproductRemoving(ctrl)
@@ -91,7 +119,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	require.NoError(t, err)
 
 	useCaseMock.EXPECT().
-		RemoveLastProduct(gomock.Any(), pvzID).
+		RemoveLastProduct(gomock.Any(), pvzID, model.Actor{UserID: model.DefaultUserID}).
 		Return(assert.AnError)
 
 	handler, err := New(useCaseMock, zap.NewNop())
@@ -100,7 +128,9 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/delete_last_product", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	req.SetPathValue("pvzId", pvzID.UUID().String())
 	w := httptest.NewRecorder()
@@ -121,7 +151,9 @@ func TestHandler_Handle_InvalidPvzId(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/delete_last_product", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	req.SetPathValue("pvzId", pvzID1)
 	w := httptest.NewRecorder()
@@ -42,17 +42,17 @@ func (m *MockproductRemoving) EXPECT() *MockproductRemovingMockRecorder {
 }
 
 // RemoveLastProduct mocks base method.
-func (m *MockproductRemoving) RemoveLastProduct(ctx context.Context, pvzID model.PVZID) error {
+func (m *MockproductRemoving) RemoveLastProduct(ctx context.Context, pvzID model.PVZID, actor model.Actor) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveLastProduct", ctx, pvzID)
+	ret := m.ctrl.Call(m, "RemoveLastProduct", ctx, pvzID, actor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveLastProduct indicates an expected call of RemoveLastProduct.
-func (mr *MockproductRemovingMockRecorder) RemoveLastProduct(ctx, pvzID any) *MockproductRemovingRemoveLastProductCall {
+func (mr *MockproductRemovingMockRecorder) RemoveLastProduct(ctx, pvzID, actor any) *MockproductRemovingRemoveLastProductCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLastProduct", reflect.TypeOf((*MockproductRemoving)(nil).RemoveLastProduct), ctx, pvzID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLastProduct", reflect.TypeOf((*MockproductRemoving)(nil).RemoveLastProduct), ctx, pvzID, actor)
 	return &MockproductRemovingRemoveLastProductCall{Call: call}
 }
 
@@ -68,13 +68,13 @@ func (c *MockproductRemovingRemoveLastProductCall) Return(arg0 error) *Mockprodu
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockproductRemovingRemoveLastProductCall) Do(f func(context.Context, model.PVZID) error) *MockproductRemovingRemoveLastProductCall {
+func (c *MockproductRemovingRemoveLastProductCall) Do(f func(context.Context, model.PVZID, model.Actor) error) *MockproductRemovingRemoveLastProductCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockproductRemovingRemoveLastProductCall) DoAndReturn(f func(context.Context, model.PVZID) error) *MockproductRemovingRemoveLastProductCall {
+func (c *MockproductRemovingRemoveLastProductCall) DoAndReturn(f func(context.Context, model.PVZID, model.Actor) error) *MockproductRemovingRemoveLastProductCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
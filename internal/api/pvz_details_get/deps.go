@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package pvz_details_get
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzGetting interface {
+	GetPVZ(ctx context.Context, pvzID model.PVZID) (model.PVZDetails, error)
+}
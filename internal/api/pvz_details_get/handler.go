@@ -0,0 +1,91 @@
+package pvz_details_get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	pvzGetting pvzGetting
+	logger     internal.Logger
+}
+
+type PVZDetailsResponse struct {
+	PVZ                 api.PVZ        `json:"pvz"`
+	InProgressReception *api.Reception `json:"inProgressReception"`
+}
+
+func New(pvzGetting pvzGetting, logger internal.Logger) (*Handler, error) {
+	if pvzGetting == nil {
+		return nil, errors.New("pvzGetting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+
+	return &Handler{
+		pvzGetting: pvzGetting,
+		logger:     logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionPVZRead); !ok {
+		return
+	}
+
+	pvzID, err := model.ParsePVZID(r.PathValue("pvzId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid pvzId")
+		return
+	}
+
+	details, err := h.pvzGetting.GetPVZ(ctx, pvzID)
+	if err != nil {
+		if errors.Is(err, model.ErrPVZNotFound) {
+			api_handler.NotFound(w, "pvz not found")
+			return
+		}
+		err = fmt.Errorf("pvzGetting.GetPVZ: %w", err)
+		h.logger.Error("GET /pvz/{pvzId}: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("pvzId", pvzID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertToDTO(details))
+}
+
+func convertToDTO(details model.PVZDetails) PVZDetailsResponse {
+	res := PVZDetailsResponse{
+		PVZ: api.PVZ{
+			Id:               (*types.UUID)(&details.PVZ.ID),
+			City:             api.PVZCity(details.PVZ.City),
+			RegistrationDate: &details.PVZ.RegisteredAt,
+		},
+	}
+
+	if reception := details.InProgressReception; reception != nil {
+		res.InProgressReception = &api.Reception{
+			Id:       (*types.UUID)(&reception.ID),
+			PvzId:    types.UUID(reception.PVZID),
+			Status:   api.ReceptionStatus(reception.ReceptionStatus.String()),
+			DateTime: reception.ReceptedAt,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,200 @@
+package pvz_details_get
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzGetting(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzGetting(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func newRequest(pvzID string, role model.UserRole) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/pvz/{pvzId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    role,
+		Permissions: model.DefaultRolePermissions().Of(role),
+	}))
+	req.SetPathValue("pvzId", pvzID)
+	return req
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	useCaseMock.EXPECT().
+		GetPVZ(gomock.Any(), pvzID).
+		Return(model.PVZDetails{
+			PVZ: model.PVZ{
+				ID:           pvzID,
+				City:         "Казань",
+				RegisteredAt: date,
+			},
+			InProgressReception: &model.Reception{
+				ID:              receptionID,
+				PVZID:           pvzID,
+				ReceptionStatus: model.ReceptionStatusInProgress,
+				ReceptedAt:      date.Add(time.Second),
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"pvz": {
+			"id": "6451927e-846b-4c97-9924-cba818687a05",
+			"city": "Казань",
+			"registrationDate": "2025-04-09T20:55:59Z"
+		},
+		"inProgressReception": {
+			"id": "6451927e-846b-4c97-9924-cba818687a06",
+			"pvzId": "6451927e-846b-4c97-9924-cba818687a05",
+			"dateTime": "2025-04-09T20:56:00Z",
+			"status": "in_progress"
+		}
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_NoReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	useCaseMock.EXPECT().
+		GetPVZ(gomock.Any(), pvzID).
+		Return(model.PVZDetails{
+			PVZ: model.PVZ{
+				ID:           pvzID,
+				City:         "Москва",
+				RegisteredAt: date,
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), model.UserRoleModerator))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"pvz": {
+			"id": "6451927e-846b-4c97-9924-cba818687a05",
+			"city": "Москва",
+			"registrationDate": "2025-04-09T20:55:59Z"
+		},
+		"inProgressReception": null
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("6451927e-846b-4c97-9924-cba818687a05", 0))
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "permission pvz:read is required"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidPvzId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("6451927e-846b-4c97-9924-cba818687a0", model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "invalid pvzId"}`, w.Body.String())
+}
+
+func TestHandler_Handle_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetPVZ(gomock.Any(), pvzID).
+		Return(model.PVZDetails{}, model.ErrPVZNotFound)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"message": "pvz not found"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetPVZ(gomock.Any(), pvzID).
+		Return(model.PVZDetails{}, assert.AnError)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
+}
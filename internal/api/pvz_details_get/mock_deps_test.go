@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package pvz_details_get -typed -destination mock_deps_test.go
+//
+
+// Package pvz_details_get is a generated GoMock package.
+package pvz_details_get
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpvzGetting is a mock of pvzGetting interface.
+type MockpvzGetting struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzGettingMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzGettingMockRecorder is the mock recorder for MockpvzGetting.
+type MockpvzGettingMockRecorder struct {
+	mock *MockpvzGetting
+}
+
+// NewMockpvzGetting creates a new mock instance.
+func NewMockpvzGetting(ctrl *gomock.Controller) *MockpvzGetting {
+	mock := &MockpvzGetting{ctrl: ctrl}
+	mock.recorder = &MockpvzGettingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzGetting) EXPECT() *MockpvzGettingMockRecorder {
+	return m.recorder
+}
+
+// GetPVZ mocks base method.
+func (m *MockpvzGetting) GetPVZ(ctx context.Context, pvzID model.PVZID) (model.PVZDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZ", ctx, pvzID)
+	ret0, _ := ret[0].(model.PVZDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZ indicates an expected call of GetPVZ.
+func (mr *MockpvzGettingMockRecorder) GetPVZ(ctx, pvzID any) *MockpvzGettingGetPVZCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZ", reflect.TypeOf((*MockpvzGetting)(nil).GetPVZ), ctx, pvzID)
+	return &MockpvzGettingGetPVZCall{Call: call}
+}
+
+// MockpvzGettingGetPVZCall wrap *gomock.Call
+type MockpvzGettingGetPVZCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzGettingGetPVZCall) Return(arg0 model.PVZDetails, arg1 error) *MockpvzGettingGetPVZCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzGettingGetPVZCall) Do(f func(context.Context, model.PVZID) (model.PVZDetails, error)) *MockpvzGettingGetPVZCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzGettingGetPVZCall) DoAndReturn(f func(context.Context, model.PVZID) (model.PVZDetails, error)) *MockpvzGettingGetPVZCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package pvz_employee_assign
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzAssigning interface {
+	Assign(ctx context.Context, pvzID model.PVZID, userID, assignedBy model.UserID) error
+}
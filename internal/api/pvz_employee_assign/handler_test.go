@@ -0,0 +1,154 @@
+package pvz_employee_assign
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzAssigning(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzAssigning(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		role        model.UserRole
+		pvzID       string
+		userID      string
+		callUseCase bool
+		useCaseErr  error
+		wantCode    int
+	}{
+		{
+			name:        "success",
+			role:        model.UserRoleModerator,
+			pvzID:       pvzID.UUID().String(),
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:        "success.regional_manager",
+			role:        model.UserRoleRegionalManager,
+			pvzID:       pvzID.UUID().String(),
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleEmployee,
+			pvzID:    pvzID.UUID().String(),
+			userID:   userID.UUID().String(),
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "invalid_pvz_id",
+			role:     model.UserRoleModerator,
+			pvzID:    "6451927e-846b-4c97-9924-cba818687a0",
+			userID:   userID.UUID().String(),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_user_id",
+			role:     model.UserRoleModerator,
+			pvzID:    pvzID.UUID().String(),
+			userID:   "6451927e-846b-4c97-9924-cba818687a0",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:        "pvz_not_found",
+			role:        model.UserRoleModerator,
+			pvzID:       pvzID.UUID().String(),
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrPVZNotFound,
+			wantCode:    http.StatusNotFound,
+		},
+		{
+			name:        "user_not_found",
+			role:        model.UserRoleModerator,
+			pvzID:       pvzID.UUID().String(),
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrUserNotFound,
+			wantCode:    http.StatusNotFound,
+		},
+		{
+			name:        "user_not_employee",
+			role:        model.UserRoleModerator,
+			pvzID:       pvzID.UUID().String(),
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrUserNotEmployee,
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "internal_error",
+			role:        model.UserRoleModerator,
+			pvzID:       pvzID.UUID().String(),
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			useCaseErr:  assert.AnError,
+			wantCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockpvzAssigning(ctrl)
+			if tc.callUseCase {
+				useCaseMock.EXPECT().
+					Assign(gomock.Any(), pvzID, userID, model.DefaultUserID).
+					Return(tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPut, "/pvz/{pvzId}/employees/{userId}", nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserID:      model.DefaultUserID,
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			req.SetPathValue("pvzId", tc.pvzID)
+			req.SetPathValue("userId", tc.userID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package pvz_employee_assign -typed -destination mock_deps_test.go
+//
+
+// Package pvz_employee_assign is a generated GoMock package.
+package pvz_employee_assign
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpvzAssigning is a mock of pvzAssigning interface.
+type MockpvzAssigning struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzAssigningMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzAssigningMockRecorder is the mock recorder for MockpvzAssigning.
+type MockpvzAssigningMockRecorder struct {
+	mock *MockpvzAssigning
+}
+
+// NewMockpvzAssigning creates a new mock instance.
+func NewMockpvzAssigning(ctrl *gomock.Controller) *MockpvzAssigning {
+	mock := &MockpvzAssigning{ctrl: ctrl}
+	mock.recorder = &MockpvzAssigningMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzAssigning) EXPECT() *MockpvzAssigningMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockpvzAssigning) Assign(ctx context.Context, pvzID model.PVZID, userID, assignedBy model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, pvzID, userID, assignedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockpvzAssigningMockRecorder) Assign(ctx, pvzID, userID, assignedBy any) *MockpvzAssigningAssignCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockpvzAssigning)(nil).Assign), ctx, pvzID, userID, assignedBy)
+	return &MockpvzAssigningAssignCall{Call: call}
+}
+
+// MockpvzAssigningAssignCall wrap *gomock.Call
+type MockpvzAssigningAssignCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzAssigningAssignCall) Return(arg0 error) *MockpvzAssigningAssignCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzAssigningAssignCall) Do(f func(context.Context, model.PVZID, model.UserID, model.UserID) error) *MockpvzAssigningAssignCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzAssigningAssignCall) DoAndReturn(f func(context.Context, model.PVZID, model.UserID, model.UserID) error) *MockpvzAssigningAssignCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package pvz_employee_unassign
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzAssigning interface {
+	Unassign(ctx context.Context, pvzID model.PVZID, userID model.UserID) error
+}
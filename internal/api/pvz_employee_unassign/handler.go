@@ -0,0 +1,68 @@
+package pvz_employee_unassign
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	pvzAssigning pvzAssigning
+	logger       internal.Logger
+}
+
+func New(pvzAssigning pvzAssigning, logger internal.Logger) (*Handler, error) {
+	if pvzAssigning == nil {
+		return nil, errors.New("pvzAssigning is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		pvzAssigning: pvzAssigning,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionPVZAssign); !ok {
+		return
+	}
+
+	pvzID, err := model.ParsePVZID(r.PathValue("pvzId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid pvzId")
+		return
+	}
+
+	userID, err := model.ParseUserID(r.PathValue("userId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid userId")
+		return
+	}
+
+	err = h.pvzAssigning.Unassign(ctx, pvzID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrPVZAssignmentNotFound) {
+			api_handler.NotFound(w, "employee is not assigned to the pvz")
+			return
+		}
+		err = fmt.Errorf("pvzAssigning.Unassign: %w", err)
+		h.logger.Error("DELETE /pvz/{pvzId}/employees/{userId}: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("pvzId", pvzID), zap.Any("userId", userID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package pvz_employee_unassign -typed -destination mock_deps_test.go
+//
+
+// Package pvz_employee_unassign is a generated GoMock package.
+package pvz_employee_unassign
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpvzAssigning is a mock of pvzAssigning interface.
+type MockpvzAssigning struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzAssigningMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzAssigningMockRecorder is the mock recorder for MockpvzAssigning.
+type MockpvzAssigningMockRecorder struct {
+	mock *MockpvzAssigning
+}
+
+// NewMockpvzAssigning creates a new mock instance.
+func NewMockpvzAssigning(ctrl *gomock.Controller) *MockpvzAssigning {
+	mock := &MockpvzAssigning{ctrl: ctrl}
+	mock.recorder = &MockpvzAssigningMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzAssigning) EXPECT() *MockpvzAssigningMockRecorder {
+	return m.recorder
+}
+
+// Unassign mocks base method.
+func (m *MockpvzAssigning) Unassign(ctx context.Context, pvzID model.PVZID, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, pvzID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockpvzAssigningMockRecorder) Unassign(ctx, pvzID, userID any) *MockpvzAssigningUnassignCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockpvzAssigning)(nil).Unassign), ctx, pvzID, userID)
+	return &MockpvzAssigningUnassignCall{Call: call}
+}
+
+// MockpvzAssigningUnassignCall wrap *gomock.Call
+type MockpvzAssigningUnassignCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzAssigningUnassignCall) Return(arg0 error) *MockpvzAssigningUnassignCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzAssigningUnassignCall) Do(f func(context.Context, model.PVZID, model.UserID) error) *MockpvzAssigningUnassignCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzAssigningUnassignCall) DoAndReturn(f func(context.Context, model.PVZID, model.UserID) error) *MockpvzAssigningUnassignCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -10,4 +10,6 @@ import (
 
 type pvzListGetting interface {
 	GetPVZList(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, page, limit int64) (model.PVZList, error)
+	GetPVZListByPVZ(ctx context.Context, city *string, receptedAtFrom, receptedAtTo *time.Time, page, limit int64) (model.PVZList, error)
+	GetPVZListAfter(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, after *model.ReceptionCursor, limit int64) (model.PVZList, error)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"time"
 
@@ -22,8 +23,13 @@ import (
 const (
 	defaultLimit = 10
 	maxLimit     = 30
+
+	modeReceptions = "receptions"
+	modePVZ        = "pvz"
 )
 
+var validCities = []string{"Москва", "Санкт-Петербург", "Казань"}
+
 type PVZGetResponsePVZItem struct {
 	PVZ        api.PVZ                       `json:"pvz"`
 	Receptions []PVZGetResponseReceptionItem `json:"receptions"`
@@ -34,6 +40,23 @@ type PVZGetResponseReceptionItem struct {
 	Products  []api.Product `json:"products"`
 }
 
+// PVZGetCursorResponse is returned instead of plain list when the cursor query parameter is passed.
+type PVZGetCursorResponse struct {
+	Items      []PVZGetResponsePVZItem `json:"items"`
+	NextCursor *string                 `json:"nextCursor"`
+}
+
+type listQuery struct {
+	from, to    *time.Time
+	page, limit int64
+	// useCursor is true when the cursor parameter is passed, an empty cursor means the first page
+	useCursor bool
+	cursor    *model.ReceptionCursor
+	// mode is modeReceptions (default, pages over receptions) or modePVZ (pages over pvz)
+	mode string
+	city *string
+}
+
 type Handler struct {
 	pvzListGetting pvzListGetting
 	logger         internal.Logger
@@ -56,18 +79,27 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	if tokenInfo.UserRole != model.UserRoleModerator && tokenInfo.UserRole != model.UserRoleEmployee {
-		api_handler.Forbidden(w, "only a user with the moderator or employee role can create a pickup point in the system")
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionPVZRead); !ok {
 		return
 	}
 
-	from, to, page, limit, err := parseQuery(r.URL.Query())
+	query, err := parseQuery(r.URL.Query())
 	if err != nil {
 		api_handler.BadRequest(w, "validation query: "+err.Error())
 		return
 	}
 
-	pvzList, err := h.pvzListGetting.GetPVZList(ctx, from, to, page, limit)
+	if query.mode == modePVZ {
+		h.handlePVZPage(w, r, tokenInfo, query)
+		return
+	}
+
+	if query.useCursor {
+		h.handleCursorPage(w, r, tokenInfo, query)
+		return
+	}
+
+	pvzList, err := h.pvzListGetting.GetPVZList(ctx, query.from, query.to, query.page, query.limit)
 	if err != nil {
 		err = fmt.Errorf("pvzListGetting.RegisterPVZ: %w", err)
 		h.logger.Error("GET /pvz internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
@@ -79,19 +111,53 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	api_handler.OK(w, convertToDTO(pvzList))
 }
 
+func (h *Handler) handleCursorPage(w http.ResponseWriter, r *http.Request, tokenInfo model.TokenInfo, query listQuery) {
+	pvzList, err := h.pvzListGetting.GetPVZListAfter(r.Context(), query.from, query.to, query.cursor, query.limit)
+	if err != nil {
+		err = fmt.Errorf("pvzListGetting.GetPVZListAfter: %w", err)
+		h.logger.Error("GET /pvz internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("query", r.URL.Query()))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	var nextCursor *string
+	if pvzList.NextCursor != nil {
+		nextCursor = ptrOf(pvzList.NextCursor.Encode())
+	}
+
+	api_handler.OK(w, PVZGetCursorResponse{
+		Items:      convertToDTO(pvzList),
+		NextCursor: nextCursor,
+	})
+}
+
+func (h *Handler) handlePVZPage(w http.ResponseWriter, r *http.Request, tokenInfo model.TokenInfo, query listQuery) {
+	pvzList, err := h.pvzListGetting.GetPVZListByPVZ(r.Context(), query.city, query.from, query.to, query.page, query.limit)
+	if err != nil {
+		err = fmt.Errorf("pvzListGetting.GetPVZListByPVZ: %w", err)
+		h.logger.Error("GET /pvz internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("query", r.URL.Query()))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertToDTO(pvzList))
+}
+
 func ptrOf[T any](value T) *T {
 	return &value
 }
 
-func parseQuery(query url.Values) (from, to *time.Time, page, limit int64, err error) {
+func parseQuery(query url.Values) (res listQuery, err error) {
 	startDate := query.Get("startDate")
 	if startDate != "" {
 		var fromValue strfmt.DateTime
 		fromValue, err = strfmt.ParseDateTime(startDate)
 		if err != nil {
-			return nil, nil, 0, 0, fmt.Errorf("parse start date: %w", err)
+			return listQuery{}, fmt.Errorf("parse start date: %w", err)
 		}
-		from = ptrOf(time.Time(fromValue))
+		res.from = ptrOf(time.Time(fromValue))
 	}
 
 	endDate := query.Get("endDate")
@@ -99,41 +165,81 @@ func parseQuery(query url.Values) (from, to *time.Time, page, limit int64, err e
 		var toValue strfmt.DateTime
 		toValue, err = strfmt.ParseDateTime(endDate)
 		if err != nil {
-			return nil, nil, 0, 0, fmt.Errorf("parse end date: %w", err)
+			return listQuery{}, fmt.Errorf("parse end date: %w", err)
 		}
-		to = ptrOf(time.Time(toValue))
+		res.to = ptrOf(time.Time(toValue))
 	}
 
 	pageParam := query.Get("page")
 	if pageParam != "" {
-		page, err = strconv.ParseInt(pageParam, 10, 64)
+		res.page, err = strconv.ParseInt(pageParam, 10, 64)
 		if err != nil {
-			return nil, nil, 0, 0, fmt.Errorf("parse page: %w", err)
+			return listQuery{}, fmt.Errorf("parse page: %w", err)
 		}
-		if page < 1 {
-			return nil, nil, 0, 0, fmt.Errorf("page must be greater than zero")
+		if res.page < 1 {
+			return listQuery{}, fmt.Errorf("page must be greater than zero")
 		}
 	} else {
-		page = 1
+		res.page = 1
 	}
 
 	limitParam := query.Get("limit")
 	if limitParam != "" {
-		limit, err = strconv.ParseInt(limitParam, 10, 64)
+		res.limit, err = strconv.ParseInt(limitParam, 10, 64)
 		if err != nil {
-			return nil, nil, 0, 0, fmt.Errorf("parse page: %w", err)
+			return listQuery{}, fmt.Errorf("parse page: %w", err)
 		}
-		if limit < 1 {
-			return nil, nil, 0, 0, fmt.Errorf("limit must be greater than zero")
+		if res.limit < 1 {
+			return listQuery{}, fmt.Errorf("limit must be greater than zero")
 		}
-		if limit > maxLimit {
-			return nil, nil, 0, 0, fmt.Errorf("limit must be not greater than 30")
+		if res.limit > maxLimit {
+			return listQuery{}, fmt.Errorf("limit must be not greater than 30")
 		}
 	} else {
-		limit = defaultLimit
+		res.limit = defaultLimit
+	}
+
+	res.mode = query.Get("mode")
+	switch res.mode {
+	case "":
+		res.mode = modeReceptions
+	case modeReceptions, modePVZ:
+	default:
+		return listQuery{}, errors.New("mode must be receptions or pvz")
 	}
 
-	return from, to, page, limit, nil
+	city := query.Get("city")
+	if city != "" {
+		if res.mode != modePVZ {
+			return listQuery{}, errors.New("city can be used only with mode=pvz")
+		}
+		if !slices.Contains(validCities, city) {
+			return listQuery{}, errors.New("invalid city")
+		}
+		res.city = &city
+	}
+
+	if query.Has("cursor") {
+		if res.mode == modePVZ {
+			return listQuery{}, errors.New("cursor can't be used with mode=pvz")
+		}
+		if pageParam != "" {
+			return listQuery{}, errors.New("page and cursor can't be used together")
+		}
+		res.useCursor = true
+
+		cursorParam := query.Get("cursor")
+		if cursorParam != "" {
+			var cursor model.ReceptionCursor
+			cursor, err = model.ParseReceptionCursor(cursorParam)
+			if err != nil {
+				return listQuery{}, fmt.Errorf("parse cursor: %w", model.ErrInvalidCursor)
+			}
+			res.cursor = &cursor
+		}
+	}
+
+	return res, nil
 }
 
 func convertToDTO(pvzList model.PVZList) []PVZGetResponsePVZItem {
@@ -146,6 +252,7 @@ func convertToDTO(pvzList model.PVZList) []PVZGetResponsePVZItem {
 			ReceptionId: types.UUID(product.ReceptionID),
 			Type:        api.ProductType(product.Category.String()),
 			DateTime:    ptrOf(product.AddedAt),
+			Barcode:     product.Barcode,
 		})
 	}
 
@@ -163,13 +270,18 @@ func convertToDTO(pvzList model.PVZList) []PVZGetResponsePVZItem {
 	}
 
 	for _, pvz := range pvzList.PVZs {
+		receptions := receptionsByPVZ[pvz.ID]
+		if receptions == nil {
+			// pvz-centric listing returns pvz without receptions in the date window
+			receptions = []PVZGetResponseReceptionItem{}
+		}
 		res = append(res, PVZGetResponsePVZItem{
 			PVZ: api.PVZ{
 				Id:               (*types.UUID)(&pvz.ID),
 				City:             api.PVZCity(pvz.City),
 				RegistrationDate: &pvz.RegisteredAt,
 			},
-			Receptions: receptionsByPVZ[pvz.ID],
+			Receptions: receptions,
 		})
 	}
 
@@ -2,6 +2,7 @@ package pvz_get
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -115,7 +116,8 @@ func TestHandler_Handle_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz?startDate=2025-04-09T20:55:59.000Z&endDate=2025-04-09T21:55:59.000Z&page=1&limit=30", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -188,7 +190,8 @@ func TestHandler_Handle_InvalidStartDate(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz?startDate=2025-04-091", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -207,7 +210,8 @@ func TestHandler_Handle_InvalidEndDate(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz?endDate=2025-04-091", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -226,7 +230,8 @@ func TestHandler_Handle_InvalidLimit(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz?limit=31", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -245,7 +250,8 @@ func TestHandler_Handle_InvalidPage(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz?page=0", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -268,7 +274,8 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -277,3 +284,339 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 
 	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
 }
+
+func TestHandler_Handle_CursorFirstPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	pvzID1, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a11")
+	require.NoError(t, err)
+	receptionID1, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a21")
+	require.NoError(t, err)
+
+	from := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	reception1 := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      from.Add(1 * time.Second),
+	}
+	nextCursor := model.NewReceptionCursor(reception1)
+
+	useCaseMock.EXPECT().
+		GetPVZListAfter(gomock.Any(), nil, nil, (*model.ReceptionCursor)(nil), int64(1)).
+		Return(model.PVZList{
+			PVZs: []model.PVZ{
+				{
+					ID:           pvzID1,
+					City:         "Москва",
+					RegisteredAt: from,
+				},
+			},
+			Receptions: []model.Reception{reception1},
+			NextCursor: &nextCursor,
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?cursor=&limit=1", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"items": [{
+			"pvz": {
+				"id": "6451927e-846b-4c97-9924-cba818687a11",
+				"city": "Москва",
+				"registrationDate": "2025-04-09T20:55:59Z"
+			},
+			"receptions": [
+				{
+					"reception": {
+						"id": "6451927e-846b-4c97-9924-cba818687a21",
+						"pvzId": "6451927e-846b-4c97-9924-cba818687a11",
+						"status": "close",
+						"dateTime": "2025-04-09T20:56:00Z"
+					},
+					"products": null
+				}
+			]
+		}],
+		"nextCursor": "`+nextCursor.Encode()+`"
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_CursorLastPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	receptionID1, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a21")
+	require.NoError(t, err)
+	from := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	cursor := model.ReceptionCursor{ReceptedAt: from, ID: receptionID1}
+
+	useCaseMock.EXPECT().
+		GetPVZListAfter(gomock.Any(), &from, nil, gomock.Any(), int64(10)).
+		DoAndReturn(func(_ context.Context, _, _ *time.Time, after *model.ReceptionCursor, _ int64) (model.PVZList, error) {
+			require.NotNil(t, after)
+			require.Equal(t, receptionID1, after.ID)
+			require.True(t, from.Equal(after.ReceptedAt))
+			return model.PVZList{}, nil
+		})
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?startDate=2025-04-09T20:55:59.000Z&cursor="+cursor.Encode(), bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"items": [], "nextCursor": null}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?cursor=abc", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "validation query: parse cursor: invalid cursor"}`, w.Body.String())
+}
+
+func TestHandler_Handle_PageWithCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?cursor=&page=2", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "validation query: page and cursor can't be used together"}`, w.Body.String())
+}
+
+func TestHandler_Handle_CursorInternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	useCaseMock.EXPECT().
+		GetPVZListAfter(gomock.Any(), nil, nil, (*model.ReceptionCursor)(nil), int64(10)).
+		Return(model.PVZList{}, assert.AnError)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?cursor=", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
+}
+
+func TestHandler_Handle_ModePVZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	pvzID1, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a11")
+	require.NoError(t, err)
+	pvzID2, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a12")
+	require.NoError(t, err)
+	receptionID1, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a21")
+	require.NoError(t, err)
+
+	productID1, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a31")
+	require.NoError(t, err)
+
+	from := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	city := "Казань"
+	barcode := "4600000000001"
+
+	useCaseMock.EXPECT().
+		GetPVZListByPVZ(gomock.Any(), &city, &from, nil, int64(2), int64(5)).
+		Return(model.PVZList{
+			PVZs: []model.PVZ{
+				{
+					ID:           pvzID1,
+					City:         city,
+					RegisteredAt: from,
+				},
+				{
+					ID:           pvzID2,
+					City:         city,
+					RegisteredAt: from.Add(time.Second),
+				},
+			},
+			Receptions: []model.Reception{
+				{
+					ID:              receptionID1,
+					PVZID:           pvzID1,
+					ReceptionStatus: model.ReceptionStatusInProgress,
+					ReceptedAt:      from.Add(time.Minute),
+				},
+			},
+			Products: []model.Product{
+				{
+					ID:          productID1,
+					ReceptionID: receptionID1,
+					Category:    model.ProductCategoryShoes,
+					AddedAt:     from.Add(2 * time.Minute),
+					Barcode:     &barcode,
+				},
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?mode=pvz&city=%D0%9A%D0%B0%D0%B7%D0%B0%D0%BD%D1%8C&startDate=2025-04-09T20:55:59.000Z&page=2&limit=5", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[
+		{
+			"pvz": {
+				"id": "6451927e-846b-4c97-9924-cba818687a11",
+				"city": "Казань",
+				"registrationDate": "2025-04-09T20:55:59Z"
+			},
+			"receptions": [
+				{
+					"reception": {
+						"id": "6451927e-846b-4c97-9924-cba818687a21",
+						"pvzId": "6451927e-846b-4c97-9924-cba818687a11",
+						"status": "in_progress",
+						"dateTime": "2025-04-09T20:56:59Z"
+					},
+					"products": [
+						{
+							"id": "6451927e-846b-4c97-9924-cba818687a31",
+							"receptionId": "6451927e-846b-4c97-9924-cba818687a21",
+							"type": "обувь",
+							"dateTime": "2025-04-09T20:57:59Z",
+							"barcode": "4600000000001"
+						}
+					]
+				}
+			]
+		},
+		{
+			"pvz": {
+				"id": "6451927e-846b-4c97-9924-cba818687a12",
+				"city": "Казань",
+				"registrationDate": "2025-04-09T20:56:00Z"
+			},
+			"receptions": []
+		}
+	]`, w.Body.String())
+}
+
+func TestHandler_Handle_ModePVZInternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	useCaseMock.EXPECT().
+		GetPVZListByPVZ(gomock.Any(), nil, nil, nil, int64(1), int64(10)).
+		Return(model.PVZList{}, assert.AnError)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?mode=pvz", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
+}
+
+func TestHandler_Handle_ModeValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{
+			name:    "unknown_mode",
+			query:   "mode=abc",
+			message: "validation query: mode must be receptions or pvz",
+		},
+		{
+			name:    "city_without_mode",
+			query:   "city=%D0%9A%D0%B0%D0%B7%D0%B0%D0%BD%D1%8C",
+			message: "validation query: city can be used only with mode=pvz",
+		},
+		{
+			name:    "invalid_city",
+			query:   "mode=pvz&city=abc",
+			message: "validation query: invalid city",
+		},
+		{
+			name:    "cursor_with_mode_pvz",
+			query:   "mode=pvz&cursor=",
+			message: "validation query: cursor can't be used with mode=pvz",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockpvzListGetting(ctrl)
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/pvz?"+tc.query, bytes.NewReader(nil))
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    model.UserRoleModerator,
+				Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.JSONEq(t, `{"message": "`+tc.message+`"}`, w.Body.String())
+		})
+	}
+}
@@ -80,3 +80,81 @@ func (c *MockpvzListGettingGetPVZListCall) DoAndReturn(f func(context.Context, *
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetPVZListAfter mocks base method.
+func (m *MockpvzListGetting) GetPVZListAfter(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, after *model.ReceptionCursor, limit int64) (model.PVZList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZListAfter", ctx, receptedAtFrom, receptedAtTo, after, limit)
+	ret0, _ := ret[0].(model.PVZList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZListAfter indicates an expected call of GetPVZListAfter.
+func (mr *MockpvzListGettingMockRecorder) GetPVZListAfter(ctx, receptedAtFrom, receptedAtTo, after, limit any) *MockpvzListGettingGetPVZListAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZListAfter", reflect.TypeOf((*MockpvzListGetting)(nil).GetPVZListAfter), ctx, receptedAtFrom, receptedAtTo, after, limit)
+	return &MockpvzListGettingGetPVZListAfterCall{Call: call}
+}
+
+// MockpvzListGettingGetPVZListAfterCall wrap *gomock.Call
+type MockpvzListGettingGetPVZListAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzListGettingGetPVZListAfterCall) Return(arg0 model.PVZList, arg1 error) *MockpvzListGettingGetPVZListAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzListGettingGetPVZListAfterCall) Do(f func(context.Context, *time.Time, *time.Time, *model.ReceptionCursor, int64) (model.PVZList, error)) *MockpvzListGettingGetPVZListAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzListGettingGetPVZListAfterCall) DoAndReturn(f func(context.Context, *time.Time, *time.Time, *model.ReceptionCursor, int64) (model.PVZList, error)) *MockpvzListGettingGetPVZListAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPVZListByPVZ mocks base method.
+func (m *MockpvzListGetting) GetPVZListByPVZ(ctx context.Context, city *string, receptedAtFrom, receptedAtTo *time.Time, page, limit int64) (model.PVZList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZListByPVZ", ctx, city, receptedAtFrom, receptedAtTo, page, limit)
+	ret0, _ := ret[0].(model.PVZList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZListByPVZ indicates an expected call of GetPVZListByPVZ.
+func (mr *MockpvzListGettingMockRecorder) GetPVZListByPVZ(ctx, city, receptedAtFrom, receptedAtTo, page, limit any) *MockpvzListGettingGetPVZListByPVZCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZListByPVZ", reflect.TypeOf((*MockpvzListGetting)(nil).GetPVZListByPVZ), ctx, city, receptedAtFrom, receptedAtTo, page, limit)
+	return &MockpvzListGettingGetPVZListByPVZCall{Call: call}
+}
+
+// MockpvzListGettingGetPVZListByPVZCall wrap *gomock.Call
+type MockpvzListGettingGetPVZListByPVZCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzListGettingGetPVZListByPVZCall) Return(arg0 model.PVZList, arg1 error) *MockpvzListGettingGetPVZListByPVZCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzListGettingGetPVZListByPVZCall) Do(f func(context.Context, *string, *time.Time, *time.Time, int64, int64) (model.PVZList, error)) *MockpvzListGettingGetPVZListByPVZCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzListGettingGetPVZListByPVZCall) DoAndReturn(f func(context.Context, *string, *time.Time, *time.Time, int64, int64) (model.PVZList, error)) *MockpvzListGettingGetPVZListByPVZCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package pvz_receptions_get
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzGetting interface {
+	GetReceptions(ctx context.Context, pvzID model.PVZID, page, limit int64) ([]model.ReceptionWithProducts, error)
+}
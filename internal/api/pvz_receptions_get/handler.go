@@ -0,0 +1,145 @@
+package pvz_receptions_get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 30
+)
+
+type Handler struct {
+	pvzGetting pvzGetting
+	logger     internal.Logger
+}
+
+type PVZReceptionItem struct {
+	Reception api.Reception `json:"reception"`
+	Products  []api.Product `json:"products"`
+}
+
+func New(pvzGetting pvzGetting, logger internal.Logger) (*Handler, error) {
+	if pvzGetting == nil {
+		return nil, errors.New("pvzGetting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+
+	return &Handler{
+		pvzGetting: pvzGetting,
+		logger:     logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionReceptionRead); !ok {
+		return
+	}
+
+	pvzID, err := model.ParsePVZID(r.PathValue("pvzId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid pvzId")
+		return
+	}
+
+	page, limit, err := parseQuery(r.URL.Query())
+	if err != nil {
+		api_handler.BadRequest(w, "validation query: "+err.Error())
+		return
+	}
+
+	receptions, err := h.pvzGetting.GetReceptions(ctx, pvzID, page, limit)
+	if err != nil {
+		if errors.Is(err, model.ErrPVZNotFound) {
+			api_handler.NotFound(w, "pvz not found")
+			return
+		}
+		err = fmt.Errorf("pvzGetting.GetReceptions: %w", err)
+		h.logger.Error("GET /pvz/{pvzId}/receptions: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("pvzId", pvzID), zap.Any("query", r.URL.Query()))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertToDTO(receptions))
+}
+
+func parseQuery(query url.Values) (page, limit int64, err error) {
+	pageParam := query.Get("page")
+	if pageParam != "" {
+		page, err = strconv.ParseInt(pageParam, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("parse page: %w", err)
+		}
+		if page < 1 {
+			return 0, 0, fmt.Errorf("page must be greater than zero")
+		}
+	} else {
+		page = 1
+	}
+
+	limitParam := query.Get("limit")
+	if limitParam != "" {
+		limit, err = strconv.ParseInt(limitParam, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("parse limit: %w", err)
+		}
+		if limit < 1 {
+			return 0, 0, fmt.Errorf("limit must be greater than zero")
+		}
+		if limit > maxLimit {
+			return 0, 0, fmt.Errorf("limit must be not greater than 30")
+		}
+	} else {
+		limit = defaultLimit
+	}
+
+	return page, limit, nil
+}
+
+func convertToDTO(receptions []model.ReceptionWithProducts) []PVZReceptionItem {
+	res := make([]PVZReceptionItem, 0, len(receptions))
+
+	for _, item := range receptions {
+		products := make([]api.Product, 0, len(item.Products))
+		for _, product := range item.Products {
+			products = append(products, api.Product{
+				Id:          (*types.UUID)(&product.ID),
+				ReceptionId: types.UUID(product.ReceptionID),
+				Type:        api.ProductType(product.Category.String()),
+				DateTime:    &product.AddedAt,
+				Barcode:     product.Barcode,
+			})
+		}
+
+		res = append(res, PVZReceptionItem{
+			Reception: api.Reception{
+				Id:       (*types.UUID)(&item.Reception.ID),
+				PvzId:    types.UUID(item.Reception.PVZID),
+				Status:   api.ReceptionStatus(item.Reception.ReceptionStatus.String()),
+				DateTime: item.Reception.ReceptedAt,
+			},
+			Products: products,
+		})
+	}
+
+	return res
+}
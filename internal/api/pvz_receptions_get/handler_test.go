@@ -0,0 +1,252 @@
+package pvz_receptions_get
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzGetting(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzGetting(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func newRequest(pvzID, query string, role model.UserRole) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/pvz/{pvzId}/receptions"+query, bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    role,
+		Permissions: model.DefaultRolePermissions().Of(role),
+	}))
+	req.SetPathValue("pvzId", pvzID)
+	return req
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID1, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+	receptionID2, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a07")
+	require.NoError(t, err)
+	productID, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a08")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	useCaseMock.EXPECT().
+		GetReceptions(gomock.Any(), pvzID, int64(2), int64(5)).
+		Return([]model.ReceptionWithProducts{
+			{
+				Reception: model.Reception{
+					ID:              receptionID1,
+					PVZID:           pvzID,
+					ReceptionStatus: model.ReceptionStatusInProgress,
+					ReceptedAt:      date,
+				},
+			},
+			{
+				Reception: model.Reception{
+					ID:              receptionID2,
+					PVZID:           pvzID,
+					ReceptionStatus: model.ReceptionStatusClose,
+					ReceptedAt:      date.Add(-time.Hour),
+				},
+				Products: []model.Product{
+					{
+						ID:          productID,
+						ReceptionID: receptionID2,
+						Category:    model.ProductCategoryElectronics,
+						AddedAt:     date.Add(-time.Minute),
+					},
+				},
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), "?page=2&limit=5", model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[
+		{
+			"reception": {
+				"id": "6451927e-846b-4c97-9924-cba818687a06",
+				"pvzId": "6451927e-846b-4c97-9924-cba818687a05",
+				"dateTime": "2025-04-09T20:55:59Z",
+				"status": "in_progress"
+			},
+			"products": []
+		},
+		{
+			"reception": {
+				"id": "6451927e-846b-4c97-9924-cba818687a07",
+				"pvzId": "6451927e-846b-4c97-9924-cba818687a05",
+				"dateTime": "2025-04-09T19:55:59Z",
+				"status": "close"
+			},
+			"products": [
+				{
+					"id": "6451927e-846b-4c97-9924-cba818687a08",
+					"receptionId": "6451927e-846b-4c97-9924-cba818687a07",
+					"dateTime": "2025-04-09T20:54:59Z",
+					"type": "электроника"
+				}
+			]
+		}
+	]`, w.Body.String())
+}
+
+func TestHandler_Handle_DefaultPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetReceptions(gomock.Any(), pvzID, int64(1), int64(10)).
+		Return([]model.ReceptionWithProducts{}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), "", model.UserRoleModerator))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[]`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidQuery(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{
+			name:    "page",
+			query:   "?page=0",
+			message: "validation query: page must be greater than zero",
+		},
+		{
+			name:    "limit",
+			query:   "?limit=31",
+			message: "validation query: limit must be not greater than 30",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockpvzGetting(ctrl)
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			handler.Handle(w, newRequest("6451927e-846b-4c97-9924-cba818687a05", tc.query, model.UserRoleEmployee))
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.JSONEq(t, `{"message": "`+tc.message+`"}`, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_Handle_InvalidRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("6451927e-846b-4c97-9924-cba818687a05", "", 0))
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "permission reception:read is required"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidPvzId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest("6451927e-846b-4c97-9924-cba818687a0", "", model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "invalid pvzId"}`, w.Body.String())
+}
+
+func TestHandler_Handle_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetReceptions(gomock.Any(), pvzID, int64(1), int64(10)).
+		Return(nil, model.ErrPVZNotFound)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), "", model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"message": "pvz not found"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetReceptions(gomock.Any(), pvzID, int64(1), int64(10)).
+		Return(nil, assert.AnError)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.Handle(w, newRequest(pvzID.UUID().String(), "", model.UserRoleEmployee))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package pvz_receptions_get -typed -destination mock_deps_test.go
+//
+
+// Package pvz_receptions_get is a generated GoMock package.
+package pvz_receptions_get
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpvzGetting is a mock of pvzGetting interface.
+type MockpvzGetting struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzGettingMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzGettingMockRecorder is the mock recorder for MockpvzGetting.
+type MockpvzGettingMockRecorder struct {
+	mock *MockpvzGetting
+}
+
+// NewMockpvzGetting creates a new mock instance.
+func NewMockpvzGetting(ctrl *gomock.Controller) *MockpvzGetting {
+	mock := &MockpvzGetting{ctrl: ctrl}
+	mock.recorder = &MockpvzGettingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzGetting) EXPECT() *MockpvzGettingMockRecorder {
+	return m.recorder
+}
+
+// GetReceptions mocks base method.
+func (m *MockpvzGetting) GetReceptions(ctx context.Context, pvzID model.PVZID, page, limit int64) ([]model.ReceptionWithProducts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptions", ctx, pvzID, page, limit)
+	ret0, _ := ret[0].([]model.ReceptionWithProducts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptions indicates an expected call of GetReceptions.
+func (mr *MockpvzGettingMockRecorder) GetReceptions(ctx, pvzID, page, limit any) *MockpvzGettingGetReceptionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptions", reflect.TypeOf((*MockpvzGetting)(nil).GetReceptions), ctx, pvzID, page, limit)
+	return &MockpvzGettingGetReceptionsCall{Call: call}
+}
+
+// MockpvzGettingGetReceptionsCall wrap *gomock.Call
+type MockpvzGettingGetReceptionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzGettingGetReceptionsCall) Return(arg0 []model.ReceptionWithProducts, arg1 error) *MockpvzGettingGetReceptionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzGettingGetReceptionsCall) Do(f func(context.Context, model.PVZID, int64, int64) ([]model.ReceptionWithProducts, error)) *MockpvzGettingGetReceptionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzGettingGetReceptionsCall) DoAndReturn(f func(context.Context, model.PVZID, int64, int64) ([]model.ReceptionWithProducts, error)) *MockpvzGettingGetReceptionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -8,5 +8,5 @@ import (
 )
 
 type pvzRegistering interface {
-	RegisterPVZ(ctx context.Context, city string) (model.PVZ, error)
+	RegisterPVZ(ctx context.Context, city string, actor model.Actor) (model.PVZ, error)
 }
@@ -39,8 +39,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	if tokenInfo.UserRole != model.UserRoleModerator {
-		api_handler.Forbidden(w, "only a user with the moderator role can create a pickup point in the system")
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionPVZCreate); !ok {
 		return
 	}
 
@@ -55,7 +54,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pvz, err := h.pvzRegistering.RegisterPVZ(ctx, city)
+	pvz, err := h.pvzRegistering.RegisterPVZ(ctx, city, model.NewActor(tokenInfo))
 	if err != nil {
 		err = fmt.Errorf("pvzRegistering.RegisterPVZ: %w", err)
 		h.logger.Error("POST /api/pvz internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
@@ -46,7 +46,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
 
 	useCaseMock.EXPECT().
-		RegisterPVZ(gomock.Any(), "Москва").
+		RegisterPVZ(gomock.Any(), "Москва", model.Actor{UserID: model.DefaultUserID}).
 		Return(model.PVZ{
 			ID:           ID1,
 			City:         "Москва",
@@ -60,7 +60,8 @@ func TestHandler_Handle_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/api/pvz", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -81,7 +82,8 @@ func TestHandler_Handle_InvalidCity(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/api/pvz", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -101,13 +103,14 @@ func TestHandler_Handle_NotModerator(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/api/pvz", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
 
 	require.Equal(t, http.StatusForbidden, w.Code)
-	require.JSONEq(t, `{"message": "only a user with the moderator role can create a pickup point in the system"}`, w.Body.String())
+	require.JSONEq(t, `{"message": "permission pvz:create is required"}`, w.Body.String())
 }
 
 func TestHandler_Handle_InvalidRequest(t *testing.T) {
@@ -121,7 +124,8 @@ func TestHandler_Handle_InvalidRequest(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/api/pvz", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -135,7 +139,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	useCaseMock := NewMockpvzRegistering(ctrl)
 
 	useCaseMock.EXPECT().
-		RegisterPVZ(gomock.Any(), "Москва").
+		RegisterPVZ(gomock.Any(), "Москва", model.Actor{UserID: model.DefaultUserID}).
 		Return(model.PVZ{}, assert.AnError)
 
 	handler, err := New(useCaseMock, zap.NewNop())
@@ -145,7 +149,8 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/api/pvz", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -42,18 +42,18 @@ func (m *MockpvzRegistering) EXPECT() *MockpvzRegisteringMockRecorder {
 }
 
 // RegisterPVZ mocks base method.
-func (m *MockpvzRegistering) RegisterPVZ(ctx context.Context, city string) (model.PVZ, error) {
+func (m *MockpvzRegistering) RegisterPVZ(ctx context.Context, city string, actor model.Actor) (model.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterPVZ", ctx, city)
+	ret := m.ctrl.Call(m, "RegisterPVZ", ctx, city, actor)
 	ret0, _ := ret[0].(model.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterPVZ indicates an expected call of RegisterPVZ.
-func (mr *MockpvzRegisteringMockRecorder) RegisterPVZ(ctx, city any) *MockpvzRegisteringRegisterPVZCall {
+func (mr *MockpvzRegisteringMockRecorder) RegisterPVZ(ctx, city, actor any) *MockpvzRegisteringRegisterPVZCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPVZ", reflect.TypeOf((*MockpvzRegistering)(nil).RegisterPVZ), ctx, city)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPVZ", reflect.TypeOf((*MockpvzRegistering)(nil).RegisterPVZ), ctx, city, actor)
 	return &MockpvzRegisteringRegisterPVZCall{Call: call}
 }
 
@@ -69,13 +69,13 @@ func (c *MockpvzRegisteringRegisterPVZCall) Return(arg0 model.PVZ, arg1 error) *
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockpvzRegisteringRegisterPVZCall) Do(f func(context.Context, string) (model.PVZ, error)) *MockpvzRegisteringRegisterPVZCall {
+func (c *MockpvzRegisteringRegisterPVZCall) Do(f func(context.Context, string, model.Actor) (model.PVZ, error)) *MockpvzRegisteringRegisterPVZCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockpvzRegisteringRegisterPVZCall) DoAndReturn(f func(context.Context, string) (model.PVZ, error)) *MockpvzRegisteringRegisterPVZCall {
+func (c *MockpvzRegisteringRegisterPVZCall) DoAndReturn(f func(context.Context, string, model.Actor) (model.PVZ, error)) *MockpvzRegisteringRegisterPVZCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -8,5 +8,5 @@ import (
 )
 
 type receptionClosing interface {
-	CloseReception(ctx context.Context, pvzID model.PVZID) (model.Reception, error)
+	CloseReception(ctx context.Context, pvzID model.PVZID, actor model.Actor) (model.Reception, error)
 }
@@ -37,8 +37,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	if tokenInfo.UserRole != model.UserRoleEmployee {
-		api_handler.Forbidden(w, "only a user with the employee role can close reception")
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionReceptionClose); !ok {
 		return
 	}
 
@@ -48,7 +47,12 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	reception, err := h.receptionClosing.CloseReception(ctx, pvzID)
+	if !tokenInfo.PVZAccess.Allows(pvzID) {
+		api_handler.Forbidden(w, "user is not assigned to the pvz")
+		return
+	}
+
+	reception, err := h.receptionClosing.CloseReception(ctx, pvzID, model.NewActor(tokenInfo))
 	if err != nil {
 		err = fmt.Errorf("receptionClosing.CloseReception: %w", err)
 		h.logger.Error("POST /pvz/{pvzId}/close_last_reception: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
@@ -49,7 +49,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
 
 	useCaseMock.EXPECT().
-		CloseReception(gomock.Any(), pvzID).
+		CloseReception(gomock.Any(), pvzID, model.Actor{UserID: model.DefaultUserID}).
 		Return(model.Reception{
 			ID:              receptionID,
 			PVZID:           pvzID,
@@ -63,7 +63,9 @@ func TestHandler_Handle_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/close_last_reception", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	req.SetPathValue("pvzId", pvzID.UUID().String())
 	w := httptest.NewRecorder()
@@ -73,6 +75,40 @@ func TestHandler_Handle_Success(t *testing.T) {
 	require.JSONEq(t, `{"id": "6451927e-846b-4c97-9924-cba818687a06", "pvzId": "6451927e-846b-4c97-9924-cba818687a05", "dateTime": "2025-04-09T20:55:59Z", "status": "close"}`, w.Body.String())
 }
 
+func TestHandler_Handle_APIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionClosing(ctrl)
+
+	pvzID := model.NewPVZID()
+	apiKeyID := model.NewAPIKeyID()
+
+	// the change is attributed to the key, API key requests belong to no user
+	useCaseMock.EXPECT().
+		CloseReception(gomock.Any(), pvzID, model.Actor{UserID: model.DefaultUserID, APIKeyID: &apiKeyID}).
+		Return(model.Reception{
+			ID:              model.NewReceptionID(),
+			PVZID:           pvzID,
+			ReceptionStatus: model.ReceptionStatusClose,
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/close_last_reception", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserID:      model.DefaultUserID,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
+		APIKeyID:    apiKeyID,
+	}))
+	req.SetPathValue("pvzId", pvzID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
 func TestHandler_Handle_InvalidRole(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	useCaseMock := NewMockreceptionClosing(ctrl)
@@ -86,14 +122,40 @@ func TestHandler_Handle_InvalidRole(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/close_last_reception", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("pvzId", pvzID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "permission reception:close is required"}`, w.Body.String())
+}
+
+func TestHandler_Handle_PVZAccessDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionClosing(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a04")
+	require.NoError(t, err)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/close_last_reception", bytes.NewReader(nil))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.PVZAccess{PVZIDs: []model.PVZID{model.NewPVZID()}},
 	}))
 	req.SetPathValue("pvzId", pvzID.UUID().String())
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
 
 	require.Equal(t, http.StatusForbidden, w.Code)
-	require.JSONEq(t, `{"message": "only a user with the employee role can close reception"}`, w.Body.String())
+	require.JSONEq(t, `{"message": "user is not assigned to the pvz"}`, w.Body.String())
 }
 
 func TestHandler_Handle_InternalError(t *testing.T) {
@@ -104,7 +166,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	require.NoError(t, err)
 
 	useCaseMock.EXPECT().
-		CloseReception(gomock.Any(), pvzID).
+		CloseReception(gomock.Any(), pvzID, model.Actor{UserID: model.DefaultUserID}).
 		Return(model.Reception{}, assert.AnError)
 
 	handler, err := New(useCaseMock, zap.NewNop())
@@ -113,7 +175,9 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/close_last_reception", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	req.SetPathValue("pvzId", pvzID.UUID().String())
 	w := httptest.NewRecorder()
@@ -135,7 +199,9 @@ func TestHandler_Handle_InvalidPvzId(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/pvz/{pvzId}/close_last_reception", bytes.NewReader(nil))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	req.SetPathValue("pvzId", pvzID1)
 	w := httptest.NewRecorder()
@@ -42,18 +42,18 @@ func (m *MockreceptionClosing) EXPECT() *MockreceptionClosingMockRecorder {
 }
 
 // CloseReception mocks base method.
-func (m *MockreceptionClosing) CloseReception(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
+func (m *MockreceptionClosing) CloseReception(ctx context.Context, pvzID model.PVZID, actor model.Actor) (model.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseReception", ctx, pvzID)
+	ret := m.ctrl.Call(m, "CloseReception", ctx, pvzID, actor)
 	ret0, _ := ret[0].(model.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CloseReception indicates an expected call of CloseReception.
-func (mr *MockreceptionClosingMockRecorder) CloseReception(ctx, pvzID any) *MockreceptionClosingCloseReceptionCall {
+func (mr *MockreceptionClosingMockRecorder) CloseReception(ctx, pvzID, actor any) *MockreceptionClosingCloseReceptionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseReception", reflect.TypeOf((*MockreceptionClosing)(nil).CloseReception), ctx, pvzID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseReception", reflect.TypeOf((*MockreceptionClosing)(nil).CloseReception), ctx, pvzID, actor)
 	return &MockreceptionClosingCloseReceptionCall{Call: call}
 }
 
@@ -69,13 +69,13 @@ func (c *MockreceptionClosingCloseReceptionCall) Return(arg0 model.Reception, ar
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockreceptionClosingCloseReceptionCall) Do(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionClosingCloseReceptionCall {
+func (c *MockreceptionClosingCloseReceptionCall) Do(f func(context.Context, model.PVZID, model.Actor) (model.Reception, error)) *MockreceptionClosingCloseReceptionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockreceptionClosingCloseReceptionCall) DoAndReturn(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionClosingCloseReceptionCall {
+func (c *MockreceptionClosingCloseReceptionCall) DoAndReturn(f func(context.Context, model.PVZID, model.Actor) (model.Reception, error)) *MockreceptionClosingCloseReceptionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
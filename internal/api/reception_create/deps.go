@@ -8,5 +8,5 @@ import (
 )
 
 type receptionCreating interface {
-	CreateReception(ctx context.Context, pvzID model.PVZID) (model.Reception, error)
+	CreateReception(ctx context.Context, pvzID model.PVZID, actor model.Actor) (model.Reception, error)
 }
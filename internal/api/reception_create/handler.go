@@ -37,8 +37,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	tokenInfo := jwt.TokenInfoFromContext(r.Context())
 
-	if tokenInfo.UserRole != model.UserRoleEmployee {
-		api_handler.Forbidden(w, "only a user with the employee role can create reception")
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionReceptionCreate); !ok {
 		return
 	}
 
@@ -48,8 +47,12 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	pvzID := model.PVZID(createReceptionRequest.PvzId)
+	if !tokenInfo.PVZAccess.Allows(pvzID) {
+		api_handler.Forbidden(w, "user is not assigned to the pvz")
+		return
+	}
 
-	reception, err := h.receptionCreating.CreateReception(ctx, pvzID)
+	reception, err := h.receptionCreating.CreateReception(ctx, pvzID, model.NewActor(tokenInfo))
 	if err != nil {
 		err = fmt.Errorf("receptionCreating.CreateReception: %w", err)
 		h.logger.Error("POST /receptions/ internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
@@ -49,7 +49,7 @@ func TestHandler_Handle_Success(t *testing.T) {
 	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
 
 	useCaseMock.EXPECT().
-		CreateReception(gomock.Any(), ID1).
+		CreateReception(gomock.Any(), ID1, model.Actor{UserID: model.DefaultUserID}).
 		Return(model.Reception{
 			ID:              ID2,
 			PVZID:           ID1,
@@ -64,7 +64,9 @@ func TestHandler_Handle_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/receptions/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -85,13 +87,36 @@ func TestHandler_Handle_InvalidRole(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/receptions/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleModerator,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
 
 	require.Equal(t, http.StatusForbidden, w.Code)
-	require.JSONEq(t, `{"message": "only a user with the employee role can create reception"}`, w.Body.String())
+	require.JSONEq(t, `{"message": "permission reception:create is required"}`, w.Body.String())
+}
+
+func TestHandler_Handle_PVZAccessDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionCreating(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"pvzId": "6451927e-846b-4c97-9924-cba818687a07"}`)
+	req := httptest.NewRequest(http.MethodPost, "/receptions/", bytes.NewReader(validData))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.PVZAccess{PVZIDs: []model.PVZID{model.NewPVZID()}},
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "user is not assigned to the pvz"}`, w.Body.String())
 }
 
 func TestHandler_Handle_InvalidRequest(t *testing.T) {
@@ -105,7 +130,9 @@ func TestHandler_Handle_InvalidRequest(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/receptions/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
@@ -122,7 +149,7 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	require.NoError(t, err)
 
 	useCaseMock.EXPECT().
-		CreateReception(gomock.Any(), ID1).
+		CreateReception(gomock.Any(), ID1, model.Actor{UserID: model.DefaultUserID}).
 		Return(model.Reception{}, assert.AnError)
 
 	handler, err := New(useCaseMock, zap.NewNop())
@@ -132,7 +159,9 @@ func TestHandler_Handle_InternalError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/receptions/", bytes.NewReader(validData))
 	req.Header.Set("Content-Type", "application/json")
 	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
-		UserRole: model.UserRoleEmployee,
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+		PVZAccess:   model.AllPVZAccess(),
 	}))
 	w := httptest.NewRecorder()
 	handler.Handle(w, req)
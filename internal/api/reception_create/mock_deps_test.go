@@ -42,18 +42,18 @@ func (m *MockreceptionCreating) EXPECT() *MockreceptionCreatingMockRecorder {
 }
 
 // CreateReception mocks base method.
-func (m *MockreceptionCreating) CreateReception(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
+func (m *MockreceptionCreating) CreateReception(ctx context.Context, pvzID model.PVZID, actor model.Actor) (model.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReception", ctx, pvzID)
+	ret := m.ctrl.Call(m, "CreateReception", ctx, pvzID, actor)
 	ret0, _ := ret[0].(model.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateReception indicates an expected call of CreateReception.
-func (mr *MockreceptionCreatingMockRecorder) CreateReception(ctx, pvzID any) *MockreceptionCreatingCreateReceptionCall {
+func (mr *MockreceptionCreatingMockRecorder) CreateReception(ctx, pvzID, actor any) *MockreceptionCreatingCreateReceptionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockreceptionCreating)(nil).CreateReception), ctx, pvzID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockreceptionCreating)(nil).CreateReception), ctx, pvzID, actor)
 	return &MockreceptionCreatingCreateReceptionCall{Call: call}
 }
 
@@ -69,13 +69,13 @@ func (c *MockreceptionCreatingCreateReceptionCall) Return(arg0 model.Reception,
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockreceptionCreatingCreateReceptionCall) Do(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionCreatingCreateReceptionCall {
+func (c *MockreceptionCreatingCreateReceptionCall) Do(f func(context.Context, model.PVZID, model.Actor) (model.Reception, error)) *MockreceptionCreatingCreateReceptionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockreceptionCreatingCreateReceptionCall) DoAndReturn(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionCreatingCreateReceptionCall {
+func (c *MockreceptionCreatingCreateReceptionCall) DoAndReturn(f func(context.Context, model.PVZID, model.Actor) (model.Reception, error)) *MockreceptionCreatingCreateReceptionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package reception_get
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type receptionGetting interface {
+	GetReception(ctx context.Context, receptionID model.ReceptionID) (model.ReceptionWithProducts, error)
+}
@@ -0,0 +1,93 @@
+package reception_get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	receptionGetting receptionGetting
+	logger           internal.Logger
+}
+
+type ReceptionGetResponse struct {
+	Reception api.Reception `json:"reception"`
+	Products  []api.Product `json:"products"`
+}
+
+func New(receptionGetting receptionGetting, logger internal.Logger) (*Handler, error) {
+	if receptionGetting == nil {
+		return nil, errors.New("receptionGetting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+
+	return &Handler{
+		receptionGetting: receptionGetting,
+		logger:           logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionReceptionRead); !ok {
+		return
+	}
+
+	receptionID, err := model.ParseReceptionID(r.PathValue("receptionId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid receptionId")
+		return
+	}
+
+	res, err := h.receptionGetting.GetReception(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, model.ErrReceptionNotFound) {
+			api_handler.NotFound(w, "reception not found")
+			return
+		}
+		err = fmt.Errorf("receptionGetting.GetReception: %w", err)
+		h.logger.Error("GET /receptions/{receptionId}: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("receptionId", receptionID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, convertToDTO(res))
+}
+
+func convertToDTO(res model.ReceptionWithProducts) ReceptionGetResponse {
+	products := make([]api.Product, 0, len(res.Products))
+	for _, product := range res.Products {
+		products = append(products, api.Product{
+			Id:          (*types.UUID)(&product.ID),
+			ReceptionId: types.UUID(product.ReceptionID),
+			Type:        api.ProductType(product.Category.String()),
+			DateTime:    &product.AddedAt,
+			Barcode:     product.Barcode,
+		})
+	}
+
+	return ReceptionGetResponse{
+		Reception: api.Reception{
+			Id:       (*types.UUID)(&res.Reception.ID),
+			PvzId:    types.UUID(res.Reception.PVZID),
+			Status:   api.ReceptionStatus(res.Reception.ReceptionStatus.String()),
+			DateTime: res.Reception.ReceptedAt,
+		},
+		Products: products,
+	}
+}
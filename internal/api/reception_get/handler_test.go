@@ -0,0 +1,235 @@
+package reception_get
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockreceptionGetting(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockreceptionGetting(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+	productID, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a07")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	useCaseMock.EXPECT().
+		GetReception(gomock.Any(), receptionID).
+		Return(model.ReceptionWithProducts{
+			Reception: model.Reception{
+				ID:              receptionID,
+				PVZID:           pvzID,
+				ReceptionStatus: model.ReceptionStatusInProgress,
+				ReceptedAt:      date,
+			},
+			Products: []model.Product{
+				{
+					ID:          productID,
+					ReceptionID: receptionID,
+					Category:    model.ProductCategoryClothes,
+					AddedAt:     date.Add(time.Second),
+				},
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/receptions/{receptionId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("receptionId", receptionID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"reception": {
+			"id": "6451927e-846b-4c97-9924-cba818687a06",
+			"pvzId": "6451927e-846b-4c97-9924-cba818687a05",
+			"dateTime": "2025-04-09T20:55:59Z",
+			"status": "in_progress"
+		},
+		"products": [
+			{
+				"id": "6451927e-846b-4c97-9924-cba818687a07",
+				"receptionId": "6451927e-846b-4c97-9924-cba818687a06",
+				"dateTime": "2025-04-09T20:56:00Z",
+				"type": "одежда"
+			}
+		]
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_NoProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionGetting(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	useCaseMock.EXPECT().
+		GetReception(gomock.Any(), receptionID).
+		Return(model.ReceptionWithProducts{
+			Reception: model.Reception{
+				ID:              receptionID,
+				PVZID:           pvzID,
+				ReceptionStatus: model.ReceptionStatusClose,
+				ReceptedAt:      date,
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/receptions/{receptionId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+	}))
+	req.SetPathValue("receptionId", receptionID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"reception": {
+			"id": "6451927e-846b-4c97-9924-cba818687a06",
+			"pvzId": "6451927e-846b-4c97-9924-cba818687a05",
+			"dateTime": "2025-04-09T20:55:59Z",
+			"status": "close"
+		},
+		"products": []
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/receptions/{receptionId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{}))
+	req.SetPathValue("receptionId", "6451927e-846b-4c97-9924-cba818687a06")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, `{"message": "permission reception:read is required"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InvalidReceptionId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionGetting(ctrl)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/receptions/{receptionId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+	}))
+	req.SetPathValue("receptionId", "6451927e-846b-4c97-9924-cba818687a0")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "invalid receptionId"}`, w.Body.String())
+}
+
+func TestHandler_Handle_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionGetting(ctrl)
+
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetReception(gomock.Any(), receptionID).
+		Return(model.ReceptionWithProducts{}, model.ErrReceptionNotFound)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/receptions/{receptionId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleEmployee,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+	}))
+	req.SetPathValue("receptionId", receptionID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"message": "reception not found"}`, w.Body.String())
+}
+
+func TestHandler_Handle_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionGetting(ctrl)
+
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+
+	useCaseMock.EXPECT().
+		GetReception(gomock.Any(), receptionID).
+		Return(model.ReceptionWithProducts{}, assert.AnError)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/receptions/{receptionId}", bytes.NewReader(nil))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("receptionId", receptionID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, `{"message": "internal server error"}`, w.Body.String())
+}
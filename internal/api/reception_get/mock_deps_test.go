@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package reception_get -typed -destination mock_deps_test.go
+//
+
+// Package reception_get is a generated GoMock package.
+package reception_get
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreceptionGetting is a mock of receptionGetting interface.
+type MockreceptionGetting struct {
+	ctrl     *gomock.Controller
+	recorder *MockreceptionGettingMockRecorder
+	isgomock struct{}
+}
+
+// MockreceptionGettingMockRecorder is the mock recorder for MockreceptionGetting.
+type MockreceptionGettingMockRecorder struct {
+	mock *MockreceptionGetting
+}
+
+// NewMockreceptionGetting creates a new mock instance.
+func NewMockreceptionGetting(ctrl *gomock.Controller) *MockreceptionGetting {
+	mock := &MockreceptionGetting{ctrl: ctrl}
+	mock.recorder = &MockreceptionGettingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreceptionGetting) EXPECT() *MockreceptionGettingMockRecorder {
+	return m.recorder
+}
+
+// GetReception mocks base method.
+func (m *MockreceptionGetting) GetReception(ctx context.Context, receptionID model.ReceptionID) (model.ReceptionWithProducts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReception", ctx, receptionID)
+	ret0, _ := ret[0].(model.ReceptionWithProducts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReception indicates an expected call of GetReception.
+func (mr *MockreceptionGettingMockRecorder) GetReception(ctx, receptionID any) *MockreceptionGettingGetReceptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReception", reflect.TypeOf((*MockreceptionGetting)(nil).GetReception), ctx, receptionID)
+	return &MockreceptionGettingGetReceptionCall{Call: call}
+}
+
+// MockreceptionGettingGetReceptionCall wrap *gomock.Call
+type MockreceptionGettingGetReceptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionGettingGetReceptionCall) Return(arg0 model.ReceptionWithProducts, arg1 error) *MockreceptionGettingGetReceptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionGettingGetReceptionCall) Do(f func(context.Context, model.ReceptionID) (model.ReceptionWithProducts, error)) *MockreceptionGettingGetReceptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionGettingGetReceptionCall) DoAndReturn(f func(context.Context, model.ReceptionID) (model.ReceptionWithProducts, error)) *MockreceptionGettingGetReceptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package reception_reopen
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type receptionReopening interface {
+	ReopenReception(ctx context.Context, receptionID model.ReceptionID, actor model.Actor) (model.Reception, error)
+}
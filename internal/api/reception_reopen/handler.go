@@ -0,0 +1,78 @@
+package reception_reopen
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	receptionReopening receptionReopening
+	logger             internal.Logger
+}
+
+func New(receptionReopening receptionReopening, logger internal.Logger) (*Handler, error) {
+	if receptionReopening == nil {
+		return nil, errors.New("receptionReopening is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		receptionReopening: receptionReopening,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionReceptionReopen); !ok {
+		return
+	}
+
+	receptionID, err := model.ParseReceptionID(r.PathValue("receptionId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid receptionId")
+		return
+	}
+
+	reception, err := h.receptionReopening.ReopenReception(ctx, receptionID, model.NewActor(tokenInfo))
+	if err != nil {
+		if errors.Is(err, model.ErrReceptionNotFound) {
+			api_handler.NotFound(w, "reception not found")
+			return
+		}
+		if errors.Is(err, model.ErrReceptionNotClosed) {
+			api_handler.Conflict(w, "reception is not closed")
+			return
+		}
+		if errors.Is(err, model.ErrReceptionAlreadyExists) {
+			api_handler.Conflict(w, "another reception is already in progress at the pvz")
+			return
+		}
+		err = fmt.Errorf("receptionReopening.ReopenReception: %w", err)
+		h.logger.Error("POST /receptions/{receptionId}/reopen: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("receptionId", receptionID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	ID := reception.ID.UUID()
+	api_handler.OK(w, api.Reception{
+		PvzId:    types.UUID(reception.PVZID),
+		Id:       &ID,
+		Status:   api.ReceptionStatus(reception.ReceptionStatus.String()),
+		DateTime: reception.ReceptedAt,
+	})
+}
@@ -0,0 +1,155 @@
+package reception_reopen
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockreceptionReopening(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockreceptionReopening(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockreceptionReopening(ctrl)
+
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a05")
+	require.NoError(t, err)
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a07")
+	require.NoError(t, err)
+
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	useCaseMock.EXPECT().
+		ReopenReception(gomock.Any(), receptionID, model.Actor{UserID: userID}).
+		Return(model.Reception{
+			ID:              receptionID,
+			PVZID:           pvzID,
+			ReceptionStatus: model.ReceptionStatusInProgress,
+			ReceptedAt:      date,
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/receptions/{receptionId}/reopen", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserID:      userID,
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("receptionId", receptionID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"id": "6451927e-846b-4c97-9924-cba818687a06", "pvzId": "6451927e-846b-4c97-9924-cba818687a05", "dateTime": "2025-04-09T20:55:59Z", "status": "in_progress"}`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	receptionID, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a06")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		role        model.UserRole
+		receptionID string
+		useCaseErr  error
+		wantCode    int
+	}{
+		{
+			name:        "invalid_role",
+			role:        model.UserRoleEmployee,
+			receptionID: receptionID.UUID().String(),
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "invalid_reception_id",
+			role:        model.UserRoleModerator,
+			receptionID: "6451927e-846b-4c97-9924-cba818687a0",
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "reception_not_found",
+			role:        model.UserRoleModerator,
+			receptionID: receptionID.UUID().String(),
+			useCaseErr:  model.ErrReceptionNotFound,
+			wantCode:    http.StatusNotFound,
+		},
+		{
+			name:        "reception_not_closed",
+			role:        model.UserRoleModerator,
+			receptionID: receptionID.UUID().String(),
+			useCaseErr:  model.ErrReceptionNotClosed,
+			wantCode:    http.StatusConflict,
+		},
+		{
+			name:        "another_reception_in_progress",
+			role:        model.UserRoleModerator,
+			receptionID: receptionID.UUID().String(),
+			useCaseErr:  model.ErrReceptionAlreadyExists,
+			wantCode:    http.StatusConflict,
+		},
+		{
+			name:        "internal_error",
+			role:        model.UserRoleModerator,
+			receptionID: receptionID.UUID().String(),
+			useCaseErr:  assert.AnError,
+			wantCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockreceptionReopening(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					ReopenReception(gomock.Any(), receptionID, gomock.Any()).
+					Return(model.Reception{}, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions/{receptionId}/reopen", nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			req.SetPathValue("receptionId", tc.receptionID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package reception_reopen -typed -destination mock_deps_test.go
+//
+
+// Package reception_reopen is a generated GoMock package.
+package reception_reopen
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreceptionReopening is a mock of receptionReopening interface.
+type MockreceptionReopening struct {
+	ctrl     *gomock.Controller
+	recorder *MockreceptionReopeningMockRecorder
+	isgomock struct{}
+}
+
+// MockreceptionReopeningMockRecorder is the mock recorder for MockreceptionReopening.
+type MockreceptionReopeningMockRecorder struct {
+	mock *MockreceptionReopening
+}
+
+// NewMockreceptionReopening creates a new mock instance.
+func NewMockreceptionReopening(ctrl *gomock.Controller) *MockreceptionReopening {
+	mock := &MockreceptionReopening{ctrl: ctrl}
+	mock.recorder = &MockreceptionReopeningMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreceptionReopening) EXPECT() *MockreceptionReopeningMockRecorder {
+	return m.recorder
+}
+
+// ReopenReception mocks base method.
+func (m *MockreceptionReopening) ReopenReception(ctx context.Context, receptionID model.ReceptionID, actor model.Actor) (model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", ctx, receptionID, actor)
+	ret0, _ := ret[0].(model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockreceptionReopeningMockRecorder) ReopenReception(ctx, receptionID, actor any) *MockreceptionReopeningReopenReceptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockreceptionReopening)(nil).ReopenReception), ctx, receptionID, actor)
+	return &MockreceptionReopeningReopenReceptionCall{Call: call}
+}
+
+// MockreceptionReopeningReopenReceptionCall wrap *gomock.Call
+type MockreceptionReopeningReopenReceptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionReopeningReopenReceptionCall) Return(arg0 model.Reception, arg1 error) *MockreceptionReopeningReopenReceptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionReopeningReopenReceptionCall) Do(f func(context.Context, model.ReceptionID, model.Actor) (model.Reception, error)) *MockreceptionReopeningReopenReceptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionReopeningReopenReceptionCall) DoAndReturn(f func(context.Context, model.ReceptionID, model.Actor) (model.Reception, error)) *MockreceptionReopeningReopenReceptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
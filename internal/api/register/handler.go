@@ -55,6 +55,11 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			api_handler.BadRequest(w, "user already exists")
 			return
 		}
+		var policyErr *model.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			api_handler.PasswordPolicyViolated(w, policyErr)
+			return
+		}
 		err = fmt.Errorf("registering.Register: %w", err)
 		h.logger.Error("POST /register internal error", zap.Error(err), zap.Any("request", authRequest))
 		api_handler.InternalError(w, "internal server error")
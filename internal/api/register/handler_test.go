@@ -2,6 +2,7 @@ package register
 
 import (
 	"bytes"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -82,6 +83,30 @@ func TestHandler_Handle_UserAlreadyExists(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, w.Code)
 	require.JSONEq(t, `{"message": "user already exists"}`, w.Body.String())
 }
+func TestHandler_Handle_PasswordPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registeringMock := NewMockregistering(ctrl)
+
+	registeringMock.EXPECT().
+		Register(gomock.Any(), "email1@gmail.com", "password1", model.UserRoleModerator).
+		Return(nil, fmt.Errorf("passwordChecker.Check: %w", &model.PasswordPolicyError{
+			Violations: []model.PasswordRule{model.PasswordRuleUppercase, model.PasswordRuleNotBreached},
+		}))
+
+	handler, err := New(registeringMock, zap.NewNop())
+	require.NoError(t, err)
+
+	validData := []byte(`{"email": "email1@gmail.com", "password" : "password1", "role":"moderator"}`)
+	req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewBuffer(validData))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "password does not meet the policy", "violations": ["uppercase", "not_breached"]}`,
+		w.Body.String())
+}
+
 func TestHandler_Handle_InvalidRole(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	registeringMock := NewMockregistering(ctrl)
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package token_refresh
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type tokenRefreshing interface {
+	Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error)
+}
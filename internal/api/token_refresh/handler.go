@@ -0,0 +1,60 @@
+package token_refresh
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	tokenRefreshing tokenRefreshing
+	logger          internal.Logger
+}
+
+func New(tokenRefreshing tokenRefreshing, logger internal.Logger) (*Handler, error) {
+	if tokenRefreshing == nil {
+		return nil, errors.New("tokenRefreshing is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		tokenRefreshing: tokenRefreshing,
+		logger:          logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req api.PostTokenRefreshJSONBody
+	if ok := api_handler.Parse(r, w, &req); !ok {
+		return
+	}
+
+	if req.RefreshToken == "" {
+		api_handler.BadRequest(w, "empty refreshToken")
+		return
+	}
+
+	tokens, err := h.tokenRefreshing.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, model.ErrRefreshTokenInvalid) {
+			api_handler.Unauthorized(w, "refresh token is expired or revoked")
+			return
+		}
+		err = fmt.Errorf("tokenRefreshing.Refresh: %w", err)
+		// the request holds a secret, so it is not logged
+		h.logger.Error("POST /token/refresh: internal error", zap.Error(err))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.Header().Set(api_handler.RefreshTokenHeader, tokens.RefreshToken)
+	api_handler.OK(w, tokens.AccessToken)
+}
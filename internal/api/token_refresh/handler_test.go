@@ -0,0 +1,112 @@
+package token_refresh
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktokenRefreshing(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktokenRefreshing(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tokenRefreshingMock := NewMocktokenRefreshing(ctrl)
+
+	tokenRefreshingMock.EXPECT().
+		Refresh(gomock.Any(), "refresh1").
+		Return(model.TokenPair{AccessToken: "token2", RefreshToken: "refresh2"}, nil)
+
+	handler, err := New(tokenRefreshingMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/token/refresh", bytes.NewBufferString(`{"refreshToken":"refresh1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `"token2"`, w.Body.String())
+	require.Equal(t, "refresh2", w.Header().Get("X-Refresh-Token"))
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		body       string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:     "bad_request.invalid_body",
+			body:     `{"refreshToken":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "bad_request.empty_token",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "unauthorized.invalid_token",
+			body:       `{"refreshToken":"refresh1"}`,
+			useCaseErr: model.ErrRefreshTokenInvalid,
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "internal_error",
+			body:       `{"refreshToken":"refresh1"}`,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokenRefreshingMock := NewMocktokenRefreshing(ctrl)
+
+			if tc.useCaseErr != nil {
+				tokenRefreshingMock.EXPECT().
+					Refresh(gomock.Any(), "refresh1").
+					Return(model.TokenPair{}, tc.useCaseErr)
+			}
+
+			handler, err := New(tokenRefreshingMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/token/refresh", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+			require.Empty(t, w.Header().Get("X-Refresh-Token"))
+		})
+	}
+}
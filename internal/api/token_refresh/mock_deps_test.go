@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package token_refresh -typed -destination mock_deps_test.go
+//
+
+// Package token_refresh is a generated GoMock package.
+package token_refresh
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktokenRefreshing is a mock of tokenRefreshing interface.
+type MocktokenRefreshing struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenRefreshingMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenRefreshingMockRecorder is the mock recorder for MocktokenRefreshing.
+type MocktokenRefreshingMockRecorder struct {
+	mock *MocktokenRefreshing
+}
+
+// NewMocktokenRefreshing creates a new mock instance.
+func NewMocktokenRefreshing(ctrl *gomock.Controller) *MocktokenRefreshing {
+	mock := &MocktokenRefreshing{ctrl: ctrl}
+	mock.recorder = &MocktokenRefreshingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenRefreshing) EXPECT() *MocktokenRefreshingMockRecorder {
+	return m.recorder
+}
+
+// Refresh mocks base method.
+func (m *MocktokenRefreshing) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MocktokenRefreshingMockRecorder) Refresh(ctx, refreshToken any) *MocktokenRefreshingRefreshCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MocktokenRefreshing)(nil).Refresh), ctx, refreshToken)
+	return &MocktokenRefreshingRefreshCall{Call: call}
+}
+
+// MocktokenRefreshingRefreshCall wrap *gomock.Call
+type MocktokenRefreshingRefreshCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenRefreshingRefreshCall) Return(arg0 model.TokenPair, arg1 error) *MocktokenRefreshingRefreshCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenRefreshingRefreshCall) Do(f func(context.Context, string) (model.TokenPair, error)) *MocktokenRefreshingRefreshCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenRefreshingRefreshCall) DoAndReturn(f func(context.Context, string) (model.TokenPair, error)) *MocktokenRefreshingRefreshCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package user_list
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type userManaging interface {
+	List(ctx context.Context, role *model.UserRole, page, limit int64) ([]model.User, error)
+}
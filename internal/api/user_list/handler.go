@@ -0,0 +1,122 @@
+package user_list
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	defaultLimit = 30
+	maxLimit     = 100
+)
+
+type listQuery struct {
+	role        *model.UserRole
+	page, limit int64
+}
+
+type Handler struct {
+	userManaging userManaging
+	logger       internal.Logger
+}
+
+func New(userManaging userManaging, logger internal.Logger) (*Handler, error) {
+	if userManaging == nil {
+		return nil, errors.New("userManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		userManaging: userManaging,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionUserManage); !ok {
+		return
+	}
+
+	query, err := parseQuery(r.URL.Query())
+	if err != nil {
+		api_handler.BadRequest(w, "validation query: "+err.Error())
+		return
+	}
+
+	users, err := h.userManaging.List(ctx, query.role, query.page, query.limit)
+	if err != nil {
+		err = fmt.Errorf("userManaging.List: %w", err)
+		h.logger.Error("GET /users: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("query", r.URL.Query()))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := make([]api.User, 0, len(users))
+	for _, user := range users {
+		ID := user.UserID.UUID()
+		disabled := user.Disabled
+		res = append(res, api.User{
+			Id:       &ID,
+			Email:    types.Email(user.Email),
+			Role:     api.UserRole(user.UserRole.String()),
+			Disabled: &disabled,
+		})
+	}
+
+	api_handler.OK(w, res)
+}
+
+func parseQuery(query url.Values) (res listQuery, err error) {
+	if roleParam := query.Get("role"); roleParam != "" {
+		var role model.UserRole
+		role, err = model.ParseUserRole(roleParam)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse role: %w", err)
+		}
+		res.role = &role
+	}
+
+	res.page = 1
+	if pageParam := query.Get("page"); pageParam != "" {
+		res.page, err = strconv.ParseInt(pageParam, 10, 64)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse page: %w", err)
+		}
+		if res.page < 1 {
+			return listQuery{}, errors.New("page must be greater than zero")
+		}
+	}
+
+	res.limit = defaultLimit
+	if limitParam := query.Get("limit"); limitParam != "" {
+		res.limit, err = strconv.ParseInt(limitParam, 10, 64)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse limit: %w", err)
+		}
+		if res.limit < 1 {
+			return listQuery{}, errors.New("limit must be greater than zero")
+		}
+		if res.limit > maxLimit {
+			return listQuery{}, errors.New("limit must be not greater than 100")
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,139 @@
+package user_list
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockuserManaging(ctrl)
+
+	role := model.UserRoleEmployee
+
+	useCaseMock.EXPECT().
+		List(gomock.Any(), &role, int64(2), int64(10)).
+		Return([]model.User{
+			{
+				UserID:   model.UserID(uuid.MustParse("6451927e-846b-4c97-9924-cba818687a01")),
+				Email:    "test1@gmail.com",
+				Password: "hash",
+				UserRole: model.UserRoleEmployee,
+				Disabled: true,
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?role=employee&page=2&limit=10", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": "6451927e-846b-4c97-9924-cba818687a01",
+		"email": "test1@gmail.com",
+		"role": "employee",
+		"disabled": true
+	}]`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		query      string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleEmployee,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "invalid_role_filter",
+			role:     model.UserRoleModerator,
+			query:    "?role=admin",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_page",
+			role:     model.UserRoleModerator,
+			query:    "?page=0",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_limit",
+			role:     model.UserRoleModerator,
+			query:    "?limit=101",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockuserManaging(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					List(gomock.Any(), nil, int64(1), int64(defaultLimit)).
+					Return(nil, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/users"+tc.query, nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_list -typed -destination mock_deps_test.go
+//
+
+// Package user_list is a generated GoMock package.
+package user_list
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserManaging is a mock of userManaging interface.
+type MockuserManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockuserManagingMockRecorder is the mock recorder for MockuserManaging.
+type MockuserManagingMockRecorder struct {
+	mock *MockuserManaging
+}
+
+// NewMockuserManaging creates a new mock instance.
+func NewMockuserManaging(ctrl *gomock.Controller) *MockuserManaging {
+	mock := &MockuserManaging{ctrl: ctrl}
+	mock.recorder = &MockuserManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserManaging) EXPECT() *MockuserManagingMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockuserManaging) List(ctx context.Context, role *model.UserRole, page, limit int64) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, role, page, limit)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockuserManagingMockRecorder) List(ctx, role, page, limit any) *MockuserManagingListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockuserManaging)(nil).List), ctx, role, page, limit)
+	return &MockuserManagingListCall{Call: call}
+}
+
+// MockuserManagingListCall wrap *gomock.Call
+type MockuserManagingListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserManagingListCall) Return(arg0 []model.User, arg1 error) *MockuserManagingListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserManagingListCall) Do(f func(context.Context, *model.UserRole, int64, int64) ([]model.User, error)) *MockuserManagingListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserManagingListCall) DoAndReturn(f func(context.Context, *model.UserRole, int64, int64) ([]model.User, error)) *MockuserManagingListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
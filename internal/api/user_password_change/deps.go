@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package user_password_change
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type userManaging interface {
+	ChangePassword(ctx context.Context, userID model.UserID, currentPassword, newPassword string) error
+}
@@ -0,0 +1,77 @@
+package user_password_change
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	userManaging userManaging
+	logger       internal.Logger
+}
+
+func New(userManaging userManaging, logger internal.Logger) (*Handler, error) {
+	if userManaging == nil {
+		return nil, errors.New("userManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		userManaging: userManaging,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	// tokens of /dummyLogin belong to no registered user
+	if tokenInfo.UserID == model.DefaultUserID {
+		api_handler.Forbidden(w, "user is not registered")
+		return
+	}
+
+	var request api.PostUsersMePasswordJSONRequestBody
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	if request.NewPassword == "" {
+		api_handler.BadRequest(w, "empty password")
+		return
+	}
+
+	err := h.userManaging.ChangePassword(ctx, tokenInfo.UserID, request.CurrentPassword, request.NewPassword)
+	if err != nil {
+		if errors.Is(err, model.ErrWrongUserPassword) {
+			api_handler.Forbidden(w, "wrong current password")
+			return
+		}
+		if errors.Is(err, model.ErrUserNotFound) {
+			api_handler.Forbidden(w, "user is not registered")
+			return
+		}
+		var policyErr *model.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			api_handler.PasswordPolicyViolated(w, policyErr)
+			return
+		}
+		err = fmt.Errorf("userManaging.ChangePassword: %w", err)
+		h.logger.Error("POST /users/me/password: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
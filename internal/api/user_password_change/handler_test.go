@@ -0,0 +1,128 @@
+package user_password_change
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	userID := model.NewUserID()
+	validBody := `{"currentPassword": "old_password", "newPassword": "new_password"}`
+
+	testCases := []struct {
+		name        string
+		userID      model.UserID
+		body        string
+		callUseCase bool
+		useCaseErr  error
+		wantCode    int
+	}{
+		{
+			name:        "success",
+			userID:      userID,
+			body:        validBody,
+			callUseCase: true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:     "dummy_user",
+			userID:   model.DefaultUserID,
+			body:     validBody,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "empty_password",
+			userID:   userID,
+			body:     `{"currentPassword": "old_password", "newPassword": ""}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:        "wrong_password",
+			userID:      userID,
+			body:        validBody,
+			callUseCase: true,
+			useCaseErr:  model.ErrWrongUserPassword,
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "user_not_found",
+			userID:      userID,
+			body:        validBody,
+			callUseCase: true,
+			useCaseErr:  model.ErrUserNotFound,
+			wantCode:    http.StatusForbidden,
+		},
+		{
+			name:        "password_policy",
+			userID:      userID,
+			body:        validBody,
+			callUseCase: true,
+			useCaseErr:  &model.PasswordPolicyError{Violations: []model.PasswordRule{model.PasswordRuleNotEmail}},
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "internal_error",
+			userID:      userID,
+			body:        validBody,
+			callUseCase: true,
+			useCaseErr:  assert.AnError,
+			wantCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockuserManaging(ctrl)
+			if tc.callUseCase {
+				useCaseMock.EXPECT().
+					ChangePassword(gomock.Any(), userID, "old_password", "new_password").
+					Return(tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/password", strings.NewReader(tc.body))
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserID:      tc.userID,
+				UserRole:    model.UserRoleEmployee,
+				Permissions: model.DefaultRolePermissions().Of(model.UserRoleEmployee),
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
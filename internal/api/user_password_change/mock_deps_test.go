@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_password_change -typed -destination mock_deps_test.go
+//
+
+// Package user_password_change is a generated GoMock package.
+package user_password_change
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserManaging is a mock of userManaging interface.
+type MockuserManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockuserManagingMockRecorder is the mock recorder for MockuserManaging.
+type MockuserManagingMockRecorder struct {
+	mock *MockuserManaging
+}
+
+// NewMockuserManaging creates a new mock instance.
+func NewMockuserManaging(ctrl *gomock.Controller) *MockuserManaging {
+	mock := &MockuserManaging{ctrl: ctrl}
+	mock.recorder = &MockuserManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserManaging) EXPECT() *MockuserManagingMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockuserManaging) ChangePassword(ctx context.Context, userID model.UserID, currentPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, currentPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockuserManagingMockRecorder) ChangePassword(ctx, userID, currentPassword, newPassword any) *MockuserManagingChangePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockuserManaging)(nil).ChangePassword), ctx, userID, currentPassword, newPassword)
+	return &MockuserManagingChangePasswordCall{Call: call}
+}
+
+// MockuserManagingChangePasswordCall wrap *gomock.Call
+type MockuserManagingChangePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserManagingChangePasswordCall) Return(arg0 error) *MockuserManagingChangePasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserManagingChangePasswordCall) Do(f func(context.Context, model.UserID, string, string) error) *MockuserManagingChangePasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserManagingChangePasswordCall) DoAndReturn(f func(context.Context, model.UserID, string, string) error) *MockuserManagingChangePasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
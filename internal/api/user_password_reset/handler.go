@@ -0,0 +1,78 @@
+package user_password_reset
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	userManaging userManaging
+	logger       internal.Logger
+}
+
+func New(userManaging userManaging, logger internal.Logger) (*Handler, error) {
+	if userManaging == nil {
+		return nil, errors.New("userManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		userManaging: userManaging,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionUserManage); !ok {
+		return
+	}
+
+	userID, err := model.ParseUserID(r.PathValue("userId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid userId")
+		return
+	}
+
+	var request api.PostUsersUserIdResetPasswordJSONRequestBody
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	if request.Password == "" {
+		api_handler.BadRequest(w, "empty password")
+		return
+	}
+
+	err = h.userManaging.ResetPassword(ctx, userID, request.Password)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			api_handler.NotFound(w, "user not found")
+			return
+		}
+		var policyErr *model.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			api_handler.PasswordPolicyViolated(w, policyErr)
+			return
+		}
+		err = fmt.Errorf("userManaging.ResetPassword: %w", err)
+		h.logger.Error("POST /users/{userId}/reset_password: internal error", zap.Error(err),
+			zap.Any("tokenInfo", tokenInfo), zap.Any("userId", userID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
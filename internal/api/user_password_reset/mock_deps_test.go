@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_password_reset -typed -destination mock_deps_test.go
+//
+
+// Package user_password_reset is a generated GoMock package.
+package user_password_reset
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserManaging is a mock of userManaging interface.
+type MockuserManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockuserManagingMockRecorder is the mock recorder for MockuserManaging.
+type MockuserManagingMockRecorder struct {
+	mock *MockuserManaging
+}
+
+// NewMockuserManaging creates a new mock instance.
+func NewMockuserManaging(ctrl *gomock.Controller) *MockuserManaging {
+	mock := &MockuserManaging{ctrl: ctrl}
+	mock.recorder = &MockuserManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserManaging) EXPECT() *MockuserManagingMockRecorder {
+	return m.recorder
+}
+
+// ResetPassword mocks base method.
+func (m *MockuserManaging) ResetPassword(ctx context.Context, userID model.UserID, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockuserManagingMockRecorder) ResetPassword(ctx, userID, password any) *MockuserManagingResetPasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockuserManaging)(nil).ResetPassword), ctx, userID, password)
+	return &MockuserManagingResetPasswordCall{Call: call}
+}
+
+// MockuserManagingResetPasswordCall wrap *gomock.Call
+type MockuserManagingResetPasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserManagingResetPasswordCall) Return(arg0 error) *MockuserManagingResetPasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserManagingResetPasswordCall) Do(f func(context.Context, model.UserID, string) error) *MockuserManagingResetPasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserManagingResetPasswordCall) DoAndReturn(f func(context.Context, model.UserID, string) error) *MockuserManagingResetPasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package user_sso_link_allow
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type userManaging interface {
+	AllowSSOLink(ctx context.Context, userID model.UserID) error
+}
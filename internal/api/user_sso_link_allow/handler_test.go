@@ -0,0 +1,112 @@
+package user_sso_link_allow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle(t *testing.T) {
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		role        model.UserRole
+		userID      string
+		callUseCase bool
+		useCaseErr  error
+		wantCode    int
+	}{
+		{
+			name:        "success",
+			role:        model.UserRoleModerator,
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleEmployee,
+			userID:   userID.UUID().String(),
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "invalid_user_id",
+			role:     model.UserRoleModerator,
+			userID:   "6451927e-846b-4c97-9924-cba818687a0",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:        "user_not_found",
+			role:        model.UserRoleModerator,
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			useCaseErr:  model.ErrUserNotFound,
+			wantCode:    http.StatusNotFound,
+		},
+		{
+			name:        "internal_error",
+			role:        model.UserRoleModerator,
+			userID:      userID.UUID().String(),
+			callUseCase: true,
+			useCaseErr:  assert.AnError,
+			wantCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockuserManaging(ctrl)
+			if tc.callUseCase {
+				useCaseMock.EXPECT().
+					AllowSSOLink(gomock.Any(), userID).
+					Return(tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/{userId}/allow_sso_link", nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserID:      model.DefaultUserID,
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			req.SetPathValue("userId", tc.userID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_sso_link_allow -typed -destination mock_deps_test.go
+//
+
+// Package user_sso_link_allow is a generated GoMock package.
+package user_sso_link_allow
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserManaging is a mock of userManaging interface.
+type MockuserManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockuserManagingMockRecorder is the mock recorder for MockuserManaging.
+type MockuserManagingMockRecorder struct {
+	mock *MockuserManaging
+}
+
+// NewMockuserManaging creates a new mock instance.
+func NewMockuserManaging(ctrl *gomock.Controller) *MockuserManaging {
+	mock := &MockuserManaging{ctrl: ctrl}
+	mock.recorder = &MockuserManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserManaging) EXPECT() *MockuserManagingMockRecorder {
+	return m.recorder
+}
+
+// AllowSSOLink mocks base method.
+func (m *MockuserManaging) AllowSSOLink(ctx context.Context, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowSSOLink", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllowSSOLink indicates an expected call of AllowSSOLink.
+func (mr *MockuserManagingMockRecorder) AllowSSOLink(ctx, userID any) *MockuserManagingAllowSSOLinkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowSSOLink", reflect.TypeOf((*MockuserManaging)(nil).AllowSSOLink), ctx, userID)
+	return &MockuserManagingAllowSSOLinkCall{Call: call}
+}
+
+// MockuserManagingAllowSSOLinkCall wrap *gomock.Call
+type MockuserManagingAllowSSOLinkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserManagingAllowSSOLinkCall) Return(arg0 error) *MockuserManagingAllowSSOLinkCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserManagingAllowSSOLinkCall) Do(f func(context.Context, model.UserID) error) *MockuserManagingAllowSSOLinkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserManagingAllowSSOLinkCall) DoAndReturn(f func(context.Context, model.UserID) error) *MockuserManagingAllowSSOLinkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,62 @@
+package user_unlock
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	userUnlocking userUnlocking
+	logger        internal.Logger
+}
+
+func New(userUnlocking userUnlocking, logger internal.Logger) (*Handler, error) {
+	if userUnlocking == nil {
+		return nil, errors.New("userUnlocking is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		userUnlocking: userUnlocking,
+		logger:        logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionUserUnlock); !ok {
+		return
+	}
+
+	userID, err := model.ParseUserID(r.PathValue("userId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid userId")
+		return
+	}
+
+	err = h.userUnlocking.Unlock(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			api_handler.NotFound(w, "user not found")
+			return
+		}
+		err = fmt.Errorf("userUnlocking.Unlock: %w", err)
+		h.logger.Error("POST /users/{userId}/unlock: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("userId", userID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
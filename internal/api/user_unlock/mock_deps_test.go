@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_unlock -typed -destination mock_deps_test.go
+//
+
+// Package user_unlock is a generated GoMock package.
+package user_unlock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserUnlocking is a mock of userUnlocking interface.
+type MockuserUnlocking struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserUnlockingMockRecorder
+	isgomock struct{}
+}
+
+// MockuserUnlockingMockRecorder is the mock recorder for MockuserUnlocking.
+type MockuserUnlockingMockRecorder struct {
+	mock *MockuserUnlocking
+}
+
+// NewMockuserUnlocking creates a new mock instance.
+func NewMockuserUnlocking(ctrl *gomock.Controller) *MockuserUnlocking {
+	mock := &MockuserUnlocking{ctrl: ctrl}
+	mock.recorder = &MockuserUnlockingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserUnlocking) EXPECT() *MockuserUnlockingMockRecorder {
+	return m.recorder
+}
+
+// Unlock mocks base method.
+func (m *MockuserUnlocking) Unlock(ctx context.Context, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockuserUnlockingMockRecorder) Unlock(ctx, userID any) *MockuserUnlockingUnlockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockuserUnlocking)(nil).Unlock), ctx, userID)
+	return &MockuserUnlockingUnlockCall{Call: call}
+}
+
+// MockuserUnlockingUnlockCall wrap *gomock.Call
+type MockuserUnlockingUnlockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserUnlockingUnlockCall) Return(arg0 error) *MockuserUnlockingUnlockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserUnlockingUnlockCall) Do(f func(context.Context, model.UserID) error) *MockuserUnlockingUnlockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserUnlockingUnlockCall) DoAndReturn(f func(context.Context, model.UserID) error) *MockuserUnlockingUnlockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package user_update
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type userManaging interface {
+	Update(ctx context.Context, userID model.UserID, update model.UserUpdate) (*model.User, error)
+}
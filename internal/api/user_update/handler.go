@@ -0,0 +1,99 @@
+package user_update
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	userManaging userManaging
+	logger       internal.Logger
+}
+
+func New(userManaging userManaging, logger internal.Logger) (*Handler, error) {
+	if userManaging == nil {
+		return nil, errors.New("userManaging is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		userManaging: userManaging,
+		logger:       logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionUserManage); !ok {
+		return
+	}
+
+	userID, err := model.ParseUserID(r.PathValue("userId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid userId")
+		return
+	}
+
+	// a moderator can't take away their own access, so the last moderator can't lock everyone out
+	if userID == tokenInfo.UserID {
+		api_handler.BadRequest(w, "can't update own user")
+		return
+	}
+
+	var request api.PatchUsersUserIdJSONRequestBody
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	update := model.UserUpdate{Disabled: request.Disabled}
+	if request.Role != nil {
+		role, err := model.ParseUserRole(*request.Role)
+		if err != nil {
+			api_handler.BadRequest(w, "invalid role")
+			return
+		}
+		update.UserRole = &role
+	}
+	if update.UserRole == nil && update.Disabled == nil {
+		api_handler.BadRequest(w, "nothing to update")
+		return
+	}
+
+	user, err := h.userManaging.Update(ctx, userID, update)
+	if err != nil {
+		if errors.Is(err, model.ErrUserNotFound) {
+			api_handler.NotFound(w, "user not found")
+			return
+		}
+		if errors.Is(err, model.ErrUserRoleManagedBySSO) {
+			api_handler.Conflict(w, "role of the user is managed by sso groups")
+			return
+		}
+		err = fmt.Errorf("userManaging.Update: %w", err)
+		h.logger.Error("PATCH /users/{userId}: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("userId", userID), zap.Any("request", request))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	ID := user.UserID.UUID()
+	api_handler.OK(w, api.User{
+		Id:       &ID,
+		Email:    types.Email(user.Email),
+		Role:     api.UserRole(user.UserRole.String()),
+		Disabled: &user.Disabled,
+	})
+}
@@ -0,0 +1,173 @@
+package user_update
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserManaging(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockuserManaging(ctrl)
+
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	role := model.UserRoleAuditor
+	disabled := true
+
+	useCaseMock.EXPECT().
+		Update(gomock.Any(), userID, model.UserUpdate{UserRole: &role, Disabled: &disabled}).
+		Return(&model.User{UserID: userID, Email: "test1@gmail.com", UserRole: role, Disabled: true}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/{userId}", strings.NewReader(`{"role": "auditor", "disabled": true}`))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserID:      model.NewUserID(),
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("userId", userID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"id": "6451927e-846b-4c97-9924-cba818687a01",
+		"email": "test1@gmail.com",
+		"role": "auditor",
+		"disabled": true
+	}`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	validBody := `{"disabled": true}`
+	validID := "6451927e-846b-4c97-9924-cba818687a01"
+	moderatorID := model.NewUserID()
+
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		userID     string
+		body       string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleEmployee,
+			userID:   validID,
+			body:     validBody,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "invalid_user_id",
+			role:     model.UserRoleModerator,
+			userID:   "6451927e-846b-4c97-9924-cba818687a0",
+			body:     validBody,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "own_user",
+			role:     model.UserRoleModerator,
+			userID:   moderatorID.UUID().String(),
+			body:     validBody,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_new_role",
+			role:     model.UserRoleModerator,
+			userID:   validID,
+			body:     `{"role": "admin"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "nothing_to_update",
+			role:     model.UserRoleModerator,
+			userID:   validID,
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "user_not_found",
+			role:       model.UserRoleModerator,
+			userID:     validID,
+			body:       validBody,
+			useCaseErr: model.ErrUserNotFound,
+			wantCode:   http.StatusNotFound,
+		},
+		{
+			name:       "role_managed_by_sso",
+			role:       model.UserRoleModerator,
+			userID:     validID,
+			body:       validBody,
+			useCaseErr: model.ErrUserRoleManagedBySSO,
+			wantCode:   http.StatusConflict,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			userID:     validID,
+			body:       validBody,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockuserManaging(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/{userId}", strings.NewReader(tc.body))
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserID:      moderatorID,
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			req.SetPathValue("userId", tc.userID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
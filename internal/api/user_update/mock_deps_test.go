@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_update -typed -destination mock_deps_test.go
+//
+
+// Package user_update is a generated GoMock package.
+package user_update
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserManaging is a mock of userManaging interface.
+type MockuserManaging struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserManagingMockRecorder
+	isgomock struct{}
+}
+
+// MockuserManagingMockRecorder is the mock recorder for MockuserManaging.
+type MockuserManagingMockRecorder struct {
+	mock *MockuserManaging
+}
+
+// NewMockuserManaging creates a new mock instance.
+func NewMockuserManaging(ctrl *gomock.Controller) *MockuserManaging {
+	mock := &MockuserManaging{ctrl: ctrl}
+	mock.recorder = &MockuserManagingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserManaging) EXPECT() *MockuserManagingMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockuserManaging) Update(ctx context.Context, userID model.UserID, update model.UserUpdate) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, update)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockuserManagingMockRecorder) Update(ctx, userID, update any) *MockuserManagingUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockuserManaging)(nil).Update), ctx, userID, update)
+	return &MockuserManagingUpdateCall{Call: call}
+}
+
+// MockuserManagingUpdateCall wrap *gomock.Call
+type MockuserManagingUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserManagingUpdateCall) Return(arg0 *model.User, arg1 error) *MockuserManagingUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserManagingUpdateCall) Do(f func(context.Context, model.UserID, model.UserUpdate) (*model.User, error)) *MockuserManagingUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserManagingUpdateCall) DoAndReturn(f func(context.Context, model.UserID, model.UserUpdate) (*model.User, error)) *MockuserManagingUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook_create
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type webhookSubscribing interface {
+	CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error)
+}
@@ -0,0 +1,69 @@
+package webhook_create
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_subscription"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	webhookSubscribing webhookSubscribing
+	logger             internal.Logger
+}
+
+func New(webhookSubscribing webhookSubscribing, logger internal.Logger) (*Handler, error) {
+	if webhookSubscribing == nil {
+		return nil, errors.New("webhookSubscribing is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		webhookSubscribing: webhookSubscribing,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionWebhookManage); !ok {
+		return
+	}
+
+	var request api.PostWebhooksJSONRequestBody
+	if ok := api_handler.Parse(r, w, &request); !ok {
+		return
+	}
+
+	subscription, err := webhook_subscription.ParseInput(request)
+	if err != nil {
+		api_handler.BadRequest(w, err.Error())
+		return
+	}
+
+	subscription, err = h.webhookSubscribing.CreateSubscription(ctx, subscription)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookSubscriptionInvalid) {
+			api_handler.BadRequest(w, err.Error())
+			return
+		}
+		err = fmt.Errorf("webhookSubscribing.CreateSubscription: %w", err)
+		h.logger.Error("POST /webhooks: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.String("url", request.Url))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.Created(w, webhook_subscription.Convert(subscription))
+}
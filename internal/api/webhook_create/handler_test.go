@@ -0,0 +1,165 @@
+package webhook_create
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockwebhookSubscribing(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockwebhookSubscribing(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func testSubscription(t *testing.T) model.WebhookSubscription {
+	subscriptionID, err := model.ParseWebhookSubscriptionID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	pvzID, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	return model.WebhookSubscription{
+		ID:         subscriptionID,
+		URL:        "https://partner.local/events",
+		EventTypes: []model.OutboxEventType{model.OutboxEventReceptionClosed},
+		PVZIDs:     []model.PVZID{pvzID},
+		Secret:     "0123456789abcdef",
+		CreatedAt:  date,
+		UpdatedAt:  date,
+	}
+}
+
+const testSubscriptionJSON = `{
+	"id": "6451927e-846b-4c97-9924-cba818687a01",
+	"url": "https://partner.local/events",
+	"eventTypes": ["reception.closed"],
+	"pvzIds": ["6451927e-846b-4c97-9924-cba818687a02"],
+	"createdAt": "2025-04-09T20:55:59Z",
+	"updatedAt": "2025-04-09T20:55:59Z"
+}`
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockwebhookSubscribing(ctrl)
+
+	subscription := testSubscription(t)
+	input := subscription
+	input.ID = model.WebhookSubscriptionID{}
+	input.CreatedAt = time.Time{}
+	input.UpdatedAt = time.Time{}
+
+	useCaseMock.EXPECT().
+		CreateSubscription(gomock.Any(), input).
+		Return(subscription, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	body := `{"url": " https://partner.local/events ", "eventTypes": ["reception.closed"],
+		"pvzIds": ["6451927e-846b-4c97-9924-cba818687a02"], "secret": "0123456789abcdef"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.JSONEq(t, testSubscriptionJSON, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	validBody := `{"url": "https://partner.local/events", "eventTypes": ["reception.closed"], "secret": "0123456789abcdef"}`
+
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		body       string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:     "invalid_role",
+			role:     model.UserRoleEmployee,
+			body:     validBody,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "invalid_json",
+			role:     model.UserRoleModerator,
+			body:     `{"url":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid_event_type",
+			role:     model.UserRoleModerator,
+			body:     `{"url": "https://partner.local/events", "eventTypes": ["pvz_registered"], "secret": "0123456789abcdef"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid_subscription",
+			role:       model.UserRoleModerator,
+			body:       validBody,
+			useCaseErr: model.ErrWebhookSubscriptionInvalid,
+			wantCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			body:       validBody,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockwebhookSubscribing(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					CreateSubscription(gomock.Any(), gomock.Any()).
+					Return(model.WebhookSubscription{}, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tc.body))
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_create -typed -destination mock_deps_test.go
+//
+
+// Package webhook_create is a generated GoMock package.
+package webhook_create
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookSubscribing is a mock of webhookSubscribing interface.
+type MockwebhookSubscribing struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookSubscribingMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookSubscribingMockRecorder is the mock recorder for MockwebhookSubscribing.
+type MockwebhookSubscribingMockRecorder struct {
+	mock *MockwebhookSubscribing
+}
+
+// NewMockwebhookSubscribing creates a new mock instance.
+func NewMockwebhookSubscribing(ctrl *gomock.Controller) *MockwebhookSubscribing {
+	mock := &MockwebhookSubscribing{ctrl: ctrl}
+	mock.recorder = &MockwebhookSubscribingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookSubscribing) EXPECT() *MockwebhookSubscribingMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockwebhookSubscribing) CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockwebhookSubscribingMockRecorder) CreateSubscription(ctx, subscription any) *MockwebhookSubscribingCreateSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockwebhookSubscribing)(nil).CreateSubscription), ctx, subscription)
+	return &MockwebhookSubscribingCreateSubscriptionCall{Call: call}
+}
+
+// MockwebhookSubscribingCreateSubscriptionCall wrap *gomock.Call
+type MockwebhookSubscribingCreateSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookSubscribingCreateSubscriptionCall) Return(arg0 model.WebhookSubscription, arg1 error) *MockwebhookSubscribingCreateSubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookSubscribingCreateSubscriptionCall) Do(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MockwebhookSubscribingCreateSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookSubscribingCreateSubscriptionCall) DoAndReturn(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MockwebhookSubscribingCreateSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
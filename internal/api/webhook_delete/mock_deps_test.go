@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_delete -typed -destination mock_deps_test.go
+//
+
+// Package webhook_delete is a generated GoMock package.
+package webhook_delete
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookSubscribing is a mock of webhookSubscribing interface.
+type MockwebhookSubscribing struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookSubscribingMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookSubscribingMockRecorder is the mock recorder for MockwebhookSubscribing.
+type MockwebhookSubscribingMockRecorder struct {
+	mock *MockwebhookSubscribing
+}
+
+// NewMockwebhookSubscribing creates a new mock instance.
+func NewMockwebhookSubscribing(ctrl *gomock.Controller) *MockwebhookSubscribing {
+	mock := &MockwebhookSubscribing{ctrl: ctrl}
+	mock.recorder = &MockwebhookSubscribingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookSubscribing) EXPECT() *MockwebhookSubscribingMockRecorder {
+	return m.recorder
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockwebhookSubscribing) DeleteSubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockwebhookSubscribingMockRecorder) DeleteSubscription(ctx, subscriptionID any) *MockwebhookSubscribingDeleteSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockwebhookSubscribing)(nil).DeleteSubscription), ctx, subscriptionID)
+	return &MockwebhookSubscribingDeleteSubscriptionCall{Call: call}
+}
+
+// MockwebhookSubscribingDeleteSubscriptionCall wrap *gomock.Call
+type MockwebhookSubscribingDeleteSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookSubscribingDeleteSubscriptionCall) Return(arg0 error) *MockwebhookSubscribingDeleteSubscriptionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookSubscribingDeleteSubscriptionCall) Do(f func(context.Context, model.WebhookSubscriptionID) error) *MockwebhookSubscribingDeleteSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookSubscribingDeleteSubscriptionCall) DoAndReturn(f func(context.Context, model.WebhookSubscriptionID) error) *MockwebhookSubscribingDeleteSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook_deliveries_get
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type webhookSubscribing interface {
+	GetDeliveries(ctx context.Context, subscriptionID model.WebhookSubscriptionID, status *model.WebhookDeliveryStatus,
+		page, limit int64) ([]model.WebhookDelivery, error)
+}
@@ -0,0 +1,135 @@
+package webhook_deliveries_get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	defaultLimit = 30
+	maxLimit     = 100
+)
+
+type listQuery struct {
+	status      *model.WebhookDeliveryStatus
+	page, limit int64
+}
+
+type Handler struct {
+	webhookSubscribing webhookSubscribing
+	logger             internal.Logger
+}
+
+func New(webhookSubscribing webhookSubscribing, logger internal.Logger) (*Handler, error) {
+	if webhookSubscribing == nil {
+		return nil, errors.New("webhookSubscribing is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		webhookSubscribing: webhookSubscribing,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionWebhookManage); !ok {
+		return
+	}
+
+	subscriptionID, err := model.ParseWebhookSubscriptionID(r.PathValue("webhookId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid webhookId")
+		return
+	}
+
+	query, err := parseQuery(r.URL.Query())
+	if err != nil {
+		api_handler.BadRequest(w, "validation query: "+err.Error())
+		return
+	}
+
+	deliveries, err := h.webhookSubscribing.GetDeliveries(ctx, subscriptionID, query.status, query.page, query.limit)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookSubscriptionNotFound) {
+			api_handler.NotFound(w, "webhook subscription not found")
+			return
+		}
+		err = fmt.Errorf("webhookSubscribing.GetDeliveries: %w", err)
+		h.logger.Error("GET /webhooks/{webhookId}/deliveries: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("webhookId", subscriptionID), zap.Any("query", r.URL.Query()))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := make([]api.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		res = append(res, api.WebhookDelivery{
+			Id:             delivery.ID.UUID(),
+			EventId:        delivery.Message.ID.UUID(),
+			EventType:      delivery.Message.Type.String(),
+			Status:         delivery.Status.String(),
+			Attempts:       delivery.Attempts,
+			LastError:      delivery.LastError,
+			LastStatusCode: delivery.LastStatusCode,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			CreatedAt:      delivery.CreatedAt,
+			DeliveredAt:    delivery.DeliveredAt,
+		})
+	}
+
+	api_handler.OK(w, res)
+}
+
+func parseQuery(query url.Values) (res listQuery, err error) {
+	if statusParam := query.Get("status"); statusParam != "" {
+		var status model.WebhookDeliveryStatus
+		status, err = model.ParseWebhookDeliveryStatus(statusParam)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse status: %w", err)
+		}
+		res.status = &status
+	}
+
+	res.page = 1
+	if pageParam := query.Get("page"); pageParam != "" {
+		res.page, err = strconv.ParseInt(pageParam, 10, 64)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse page: %w", err)
+		}
+		if res.page < 1 {
+			return listQuery{}, errors.New("page must be greater than zero")
+		}
+	}
+
+	res.limit = defaultLimit
+	if limitParam := query.Get("limit"); limitParam != "" {
+		res.limit, err = strconv.ParseInt(limitParam, 10, 64)
+		if err != nil {
+			return listQuery{}, fmt.Errorf("parse limit: %w", err)
+		}
+		if res.limit < 1 {
+			return listQuery{}, errors.New("limit must be greater than zero")
+		}
+		if res.limit > maxLimit {
+			return listQuery{}, errors.New("limit must be not greater than 100")
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,182 @@
+package webhook_deliveries_get
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockwebhookSubscribing(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockwebhookSubscribing(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func ptrOf[T any](value T) *T {
+	return &value
+}
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockwebhookSubscribing(ctrl)
+
+	subscriptionID, err := model.ParseWebhookSubscriptionID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	status := model.WebhookDeliveryStatusDead
+
+	useCaseMock.EXPECT().
+		GetDeliveries(gomock.Any(), subscriptionID, &status, int64(2), int64(10)).
+		Return([]model.WebhookDelivery{
+			{
+				ID:             model.WebhookDeliveryID(uuid.MustParse("6451927e-846b-4c97-9924-cba818687a03")),
+				SubscriptionID: subscriptionID,
+				Message: model.OutboxMessage{
+					ID:   model.OutboxMessageID(uuid.MustParse("6451927e-846b-4c97-9924-cba818687a04")),
+					Type: model.OutboxEventReceptionClosed,
+				},
+				Status:         model.WebhookDeliveryStatusDead,
+				Attempts:       10,
+				LastError:      ptrOf("unexpected status code: 503"),
+				LastStatusCode: ptrOf(503),
+				NextAttemptAt:  date,
+				CreatedAt:      date,
+			},
+		}, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/{webhookId}/deliveries?status=dead&page=2&limit=10", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("webhookId", subscriptionID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": "6451927e-846b-4c97-9924-cba818687a03",
+		"eventId": "6451927e-846b-4c97-9924-cba818687a04",
+		"eventType": "reception.closed",
+		"status": "dead",
+		"attempts": 10,
+		"lastError": "unexpected status code: 503",
+		"lastStatusCode": 503,
+		"nextAttemptAt": "2025-04-09T20:55:59Z",
+		"createdAt": "2025-04-09T20:55:59Z"
+	}]`, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	validID := "6451927e-846b-4c97-9924-cba818687a01"
+
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		webhookID  string
+		query      string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:      "invalid_role",
+			role:      model.UserRoleEmployee,
+			webhookID: validID,
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "invalid_webhook_id",
+			role:      model.UserRoleModerator,
+			webhookID: "6451927e-846b-4c97-9924-cba818687a0",
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "invalid_status",
+			role:      model.UserRoleModerator,
+			webhookID: validID,
+			query:     "?status=failed",
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "invalid_page",
+			role:      model.UserRoleModerator,
+			webhookID: validID,
+			query:     "?page=0",
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:      "invalid_limit",
+			role:      model.UserRoleModerator,
+			webhookID: validID,
+			query:     "?limit=101",
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:       "subscription_not_found",
+			role:       model.UserRoleModerator,
+			webhookID:  validID,
+			useCaseErr: model.ErrWebhookSubscriptionNotFound,
+			wantCode:   http.StatusNotFound,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			webhookID:  validID,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockwebhookSubscribing(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					GetDeliveries(gomock.Any(), gomock.Any(), nil, int64(1), int64(defaultLimit)).
+					Return(nil, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/webhooks/{webhookId}/deliveries"+tc.query, nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			req.SetPathValue("webhookId", tc.webhookID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
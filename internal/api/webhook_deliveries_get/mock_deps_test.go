@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_deliveries_get -typed -destination mock_deps_test.go
+//
+
+// Package webhook_deliveries_get is a generated GoMock package.
+package webhook_deliveries_get
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookSubscribing is a mock of webhookSubscribing interface.
+type MockwebhookSubscribing struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookSubscribingMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookSubscribingMockRecorder is the mock recorder for MockwebhookSubscribing.
+type MockwebhookSubscribingMockRecorder struct {
+	mock *MockwebhookSubscribing
+}
+
+// NewMockwebhookSubscribing creates a new mock instance.
+func NewMockwebhookSubscribing(ctrl *gomock.Controller) *MockwebhookSubscribing {
+	mock := &MockwebhookSubscribing{ctrl: ctrl}
+	mock.recorder = &MockwebhookSubscribingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookSubscribing) EXPECT() *MockwebhookSubscribingMockRecorder {
+	return m.recorder
+}
+
+// GetDeliveries mocks base method.
+func (m *MockwebhookSubscribing) GetDeliveries(ctx context.Context, subscriptionID model.WebhookSubscriptionID, status *model.WebhookDeliveryStatus, page, limit int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, subscriptionID, status, page, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockwebhookSubscribingMockRecorder) GetDeliveries(ctx, subscriptionID, status, page, limit any) *MockwebhookSubscribingGetDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockwebhookSubscribing)(nil).GetDeliveries), ctx, subscriptionID, status, page, limit)
+	return &MockwebhookSubscribingGetDeliveriesCall{Call: call}
+}
+
+// MockwebhookSubscribingGetDeliveriesCall wrap *gomock.Call
+type MockwebhookSubscribingGetDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookSubscribingGetDeliveriesCall) Return(arg0 []model.WebhookDelivery, arg1 error) *MockwebhookSubscribingGetDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookSubscribingGetDeliveriesCall) Do(f func(context.Context, model.WebhookSubscriptionID, *model.WebhookDeliveryStatus, int64, int64) ([]model.WebhookDelivery, error)) *MockwebhookSubscribingGetDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookSubscribingGetDeliveriesCall) DoAndReturn(f func(context.Context, model.WebhookSubscriptionID, *model.WebhookDeliveryStatus, int64, int64) ([]model.WebhookDelivery, error)) *MockwebhookSubscribingGetDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
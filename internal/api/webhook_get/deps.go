@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook_get
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type webhookSubscribing interface {
+	GetSubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID) (model.WebhookSubscription, error)
+}
@@ -0,0 +1,63 @@
+package webhook_get
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_subscription"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	webhookSubscribing webhookSubscribing
+	logger             internal.Logger
+}
+
+func New(webhookSubscribing webhookSubscribing, logger internal.Logger) (*Handler, error) {
+	if webhookSubscribing == nil {
+		return nil, errors.New("webhookSubscribing is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		webhookSubscribing: webhookSubscribing,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionWebhookManage); !ok {
+		return
+	}
+
+	subscriptionID, err := model.ParseWebhookSubscriptionID(r.PathValue("webhookId"))
+	if err != nil {
+		api_handler.BadRequest(w, "invalid webhookId")
+		return
+	}
+
+	subscription, err := h.webhookSubscribing.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookSubscriptionNotFound) {
+			api_handler.NotFound(w, "webhook subscription not found")
+			return
+		}
+		err = fmt.Errorf("webhookSubscribing.GetSubscription: %w", err)
+		h.logger.Error("GET /webhooks/{webhookId}: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo),
+			zap.Any("webhookId", subscriptionID))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	api_handler.OK(w, webhook_subscription.Convert(subscription))
+}
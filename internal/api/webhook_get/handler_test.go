@@ -0,0 +1,152 @@
+package webhook_get
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockwebhookSubscribing(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockwebhookSubscribing(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func testSubscription(t *testing.T) model.WebhookSubscription {
+	subscriptionID, err := model.ParseWebhookSubscriptionID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	return model.WebhookSubscription{
+		ID:         subscriptionID,
+		URL:        "https://partner.local/events",
+		EventTypes: []model.OutboxEventType{model.OutboxEventReceptionClosed, model.OutboxEventReceptionCreated},
+		Secret:     "0123456789abcdef",
+		CreatedAt:  date,
+		UpdatedAt:  date,
+	}
+}
+
+const testSubscriptionJSON = `{
+	"id": "6451927e-846b-4c97-9924-cba818687a01",
+	"url": "https://partner.local/events",
+	"eventTypes": ["reception.closed", "reception.created"],
+	"pvzIds": [],
+	"createdAt": "2025-04-09T20:55:59Z",
+	"updatedAt": "2025-04-09T20:55:59Z"
+}`
+
+func TestHandler_Handle_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockwebhookSubscribing(ctrl)
+
+	subscription := testSubscription(t)
+
+	useCaseMock.EXPECT().
+		GetSubscription(gomock.Any(), subscription.ID).
+		Return(subscription, nil)
+
+	handler, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/{webhookId}", nil)
+	req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+		UserRole:    model.UserRoleModerator,
+		Permissions: model.DefaultRolePermissions().Of(model.UserRoleModerator),
+	}))
+	req.SetPathValue("webhookId", subscription.ID.UUID().String())
+	w := httptest.NewRecorder()
+	handler.Handle(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	// the secret is never returned
+	require.JSONEq(t, testSubscriptionJSON, w.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	validID := "6451927e-846b-4c97-9924-cba818687a01"
+
+	testCases := []struct {
+		name       string
+		role       model.UserRole
+		webhookID  string
+		useCaseErr error
+		wantCode   int
+	}{
+		{
+			name:      "invalid_role",
+			role:      model.UserRoleEmployee,
+			webhookID: validID,
+			wantCode:  http.StatusForbidden,
+		},
+		{
+			name:      "invalid_webhook_id",
+			role:      model.UserRoleModerator,
+			webhookID: "6451927e-846b-4c97-9924-cba818687a0",
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:       "subscription_not_found",
+			role:       model.UserRoleModerator,
+			webhookID:  validID,
+			useCaseErr: model.ErrWebhookSubscriptionNotFound,
+			wantCode:   http.StatusNotFound,
+		},
+		{
+			name:       "internal_error",
+			role:       model.UserRoleModerator,
+			webhookID:  validID,
+			useCaseErr: assert.AnError,
+			wantCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCaseMock := NewMockwebhookSubscribing(ctrl)
+			if tc.useCaseErr != nil {
+				useCaseMock.EXPECT().
+					GetSubscription(gomock.Any(), gomock.Any()).
+					Return(model.WebhookSubscription{}, tc.useCaseErr)
+			}
+
+			handler, err := New(useCaseMock, zap.NewNop())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/webhooks/{webhookId}", nil)
+			req = req.WithContext(jwt.ContextWithTokenInfo(req.Context(), model.TokenInfo{
+				UserRole:    tc.role,
+				Permissions: model.DefaultRolePermissions().Of(tc.role),
+			}))
+			req.SetPathValue("webhookId", tc.webhookID)
+			w := httptest.NewRecorder()
+			handler.Handle(w, req)
+
+			require.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package webhook_list
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/api/webhook_subscription"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Handler struct {
+	webhookSubscribing webhookSubscribing
+	logger             internal.Logger
+}
+
+func New(webhookSubscribing webhookSubscribing, logger internal.Logger) (*Handler, error) {
+	if webhookSubscribing == nil {
+		return nil, errors.New("webhookSubscribing is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Handler{
+		webhookSubscribing: webhookSubscribing,
+		logger:             logger,
+	}, nil
+}
+
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+
+	if ok := api_handler.RequirePermission(w, tokenInfo, model.PermissionWebhookManage); !ok {
+		return
+	}
+
+	subscriptions, err := h.webhookSubscribing.ListSubscriptions(ctx)
+	if err != nil {
+		err = fmt.Errorf("webhookSubscribing.ListSubscriptions: %w", err)
+		h.logger.Error("GET /webhooks: internal error", zap.Error(err), zap.Any("tokenInfo", tokenInfo))
+		api_handler.InternalError(w, "internal server error")
+		return
+	}
+
+	res := make([]api.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		res = append(res, webhook_subscription.Convert(subscription))
+	}
+
+	api_handler.OK(w, res)
+}
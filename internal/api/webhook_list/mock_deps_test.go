@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_list -typed -destination mock_deps_test.go
+//
+
+// Package webhook_list is a generated GoMock package.
+package webhook_list
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookSubscribing is a mock of webhookSubscribing interface.
+type MockwebhookSubscribing struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookSubscribingMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookSubscribingMockRecorder is the mock recorder for MockwebhookSubscribing.
+type MockwebhookSubscribingMockRecorder struct {
+	mock *MockwebhookSubscribing
+}
+
+// NewMockwebhookSubscribing creates a new mock instance.
+func NewMockwebhookSubscribing(ctrl *gomock.Controller) *MockwebhookSubscribing {
+	mock := &MockwebhookSubscribing{ctrl: ctrl}
+	mock.recorder = &MockwebhookSubscribingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookSubscribing) EXPECT() *MockwebhookSubscribingMockRecorder {
+	return m.recorder
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockwebhookSubscribing) ListSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockwebhookSubscribingMockRecorder) ListSubscriptions(ctx any) *MockwebhookSubscribingListSubscriptionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockwebhookSubscribing)(nil).ListSubscriptions), ctx)
+	return &MockwebhookSubscribingListSubscriptionsCall{Call: call}
+}
+
+// MockwebhookSubscribingListSubscriptionsCall wrap *gomock.Call
+type MockwebhookSubscribingListSubscriptionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookSubscribingListSubscriptionsCall) Return(arg0 []model.WebhookSubscription, arg1 error) *MockwebhookSubscribingListSubscriptionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookSubscribingListSubscriptionsCall) Do(f func(context.Context) ([]model.WebhookSubscription, error)) *MockwebhookSubscribingListSubscriptionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookSubscribingListSubscriptionsCall) DoAndReturn(f func(context.Context) ([]model.WebhookSubscription, error)) *MockwebhookSubscribingListSubscriptionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,66 @@
+// Package webhook_subscription converts webhook subscriptions between the API and the model for the webhook handlers.
+package webhook_subscription
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// ParseInput converts the subscription of a create or update request, the URL and the secret are validated by
+// the use case.
+func ParseInput(request api.WebhookSubscriptionInput) (model.WebhookSubscription, error) {
+	eventTypes := make([]model.OutboxEventType, 0, len(request.EventTypes))
+	for _, eventTypeParam := range request.EventTypes {
+		eventType, err := model.ParseOutboxEventType(eventTypeParam)
+		if err != nil {
+			return model.WebhookSubscription{}, fmt.Errorf("invalid event type %q", eventTypeParam)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	var pvzIDs []model.PVZID
+	if request.PvzIds != nil {
+		pvzIDs = make([]model.PVZID, 0, len(*request.PvzIds))
+		for _, pvzID := range *request.PvzIds {
+			pvzIDs = append(pvzIDs, model.PVZID(pvzID))
+		}
+	}
+
+	var secret string
+	if request.Secret != nil {
+		secret = *request.Secret
+	}
+
+	return model.WebhookSubscription{
+		URL:        strings.TrimSpace(request.Url),
+		EventTypes: eventTypes,
+		PVZIDs:     pvzIDs,
+		Secret:     secret,
+	}, nil
+}
+
+// Convert converts the subscription to its API representation, the secret is never returned.
+func Convert(subscription model.WebhookSubscription) api.WebhookSubscription {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, eventType.String())
+	}
+	pvzIDs := make([]types.UUID, 0, len(subscription.PVZIDs))
+	for _, pvzID := range subscription.PVZIDs {
+		pvzIDs = append(pvzIDs, pvzID.UUID())
+	}
+
+	return api.WebhookSubscription{
+		Id:         subscription.ID.UUID(),
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		PvzIds:     pvzIDs,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package webhook_subscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestParseInput(t *testing.T) {
+	pvzID := model.NewPVZID()
+	secret := "0123456789abcdef"
+
+	t.Run("success", func(t *testing.T) {
+		res, err := ParseInput(api.WebhookSubscriptionInput{
+			Url:        " https://partner.local/events ",
+			EventTypes: []string{"reception.closed", "product.added"},
+			PvzIds:     &[]types.UUID{pvzID.UUID()},
+			Secret:     &secret,
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.WebhookSubscription{
+			URL:        "https://partner.local/events",
+			EventTypes: []model.OutboxEventType{model.OutboxEventReceptionClosed, model.OutboxEventProductAdded},
+			PVZIDs:     []model.PVZID{pvzID},
+			Secret:     secret,
+		}, res)
+	})
+	t.Run("success.without_optional", func(t *testing.T) {
+		res, err := ParseInput(api.WebhookSubscriptionInput{
+			Url:        "https://partner.local/events",
+			EventTypes: []string{"reception.closed"},
+		})
+		require.NoError(t, err)
+		require.Nil(t, res.PVZIDs)
+		require.Empty(t, res.Secret)
+	})
+	t.Run("error.event_type", func(t *testing.T) {
+		_, err := ParseInput(api.WebhookSubscriptionInput{
+			Url:        "https://partner.local/events",
+			EventTypes: []string{"reception_closed"},
+		})
+		require.EqualError(t, err, `invalid event type "reception_closed"`)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	id := model.WebhookSubscriptionID(model.NewPVZID().UUID())
+	pvzID := model.NewPVZID()
+	date := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+
+	res := Convert(model.WebhookSubscription{
+		ID:         id,
+		URL:        "https://partner.local/events",
+		EventTypes: []model.OutboxEventType{model.OutboxEventReceptionClosed},
+		PVZIDs:     []model.PVZID{pvzID},
+		Secret:     "0123456789abcdef",
+		CreatedAt:  date,
+		UpdatedAt:  date,
+	})
+
+	require.Equal(t, api.WebhookSubscription{
+		Id:         id.UUID(),
+		Url:        "https://partner.local/events",
+		EventTypes: []string{"reception.closed"},
+		PvzIds:     []types.UUID{pvzID.UUID()},
+		CreatedAt:  date,
+		UpdatedAt:  date,
+	}, res)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_update -typed -destination mock_deps_test.go
+//
+
+// Package webhook_update is a generated GoMock package.
+package webhook_update
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookSubscribing is a mock of webhookSubscribing interface.
+type MockwebhookSubscribing struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookSubscribingMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookSubscribingMockRecorder is the mock recorder for MockwebhookSubscribing.
+type MockwebhookSubscribingMockRecorder struct {
+	mock *MockwebhookSubscribing
+}
+
+// NewMockwebhookSubscribing creates a new mock instance.
+func NewMockwebhookSubscribing(ctrl *gomock.Controller) *MockwebhookSubscribing {
+	mock := &MockwebhookSubscribing{ctrl: ctrl}
+	mock.recorder = &MockwebhookSubscribingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookSubscribing) EXPECT() *MockwebhookSubscribingMockRecorder {
+	return m.recorder
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockwebhookSubscribing) UpdateSubscription(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockwebhookSubscribingMockRecorder) UpdateSubscription(ctx, subscription any) *MockwebhookSubscribingUpdateSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockwebhookSubscribing)(nil).UpdateSubscription), ctx, subscription)
+	return &MockwebhookSubscribingUpdateSubscriptionCall{Call: call}
+}
+
+// MockwebhookSubscribingUpdateSubscriptionCall wrap *gomock.Call
+type MockwebhookSubscribingUpdateSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookSubscribingUpdateSubscriptionCall) Return(arg0 model.WebhookSubscription, arg1 error) *MockwebhookSubscribingUpdateSubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookSubscribingUpdateSubscriptionCall) Do(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MockwebhookSubscribingUpdateSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookSubscribingUpdateSubscriptionCall) DoAndReturn(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MockwebhookSubscribingUpdateSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,16 @@
+// Package backoff computes delays between retries of background deliveries.
+package backoff
+
+import "time"
+
+// Exponential doubles the delay after every failed attempt, starting from minDelay up to maxDelay.
+func Exponential(attempts int, minDelay, maxDelay time.Duration) time.Duration {
+	delay := minDelay
+	for range attempts {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,16 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponential(t *testing.T) {
+	require.Equal(t, time.Second, Exponential(0, time.Second, time.Minute))
+	require.Equal(t, 2*time.Second, Exponential(1, time.Second, time.Minute))
+	require.Equal(t, 8*time.Second, Exponential(3, time.Second, time.Minute))
+	require.Equal(t, time.Minute, Exponential(6, time.Second, time.Minute))
+	require.Equal(t, time.Minute, Exponential(1000, time.Second, time.Minute))
+}
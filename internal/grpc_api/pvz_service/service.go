@@ -0,0 +1,161 @@
+package pvz_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/generated/pvz_v1"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	defaultPage  = 1
+	defaultLimit = 10
+	maxLimit     = 30
+)
+
+type Service struct {
+	pvz_v1.UnimplementedPVZServiceServer
+
+	pvzListGetting pvzListGetting
+	logger         internal.Logger
+}
+
+func New(pvzListGetting pvzListGetting, logger internal.Logger) (*Service, error) {
+	if pvzListGetting == nil {
+		return nil, errors.New("pvzListGetting is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+	return &Service{
+		pvzListGetting: pvzListGetting,
+		logger:         logger,
+	}, nil
+}
+
+func (s *Service) GetPVZList(ctx context.Context, req *pvz_v1.GetPVZListRequest) (*pvz_v1.GetPVZListResponse, error) {
+	from, to, page, limit, err := parseRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "validation request: "+err.Error())
+	}
+
+	pvzList, err := s.pvzListGetting.GetPVZList(ctx, from, to, page, limit)
+	if err != nil {
+		err = fmt.Errorf("pvzListGetting.GetPVZList: %w", err)
+		s.logger.Error("grpc PVZService.GetPVZList internal error", zap.Error(err), zap.Any("request", req))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return convertToDTO(pvzList), nil
+}
+
+func parseRequest(req *pvz_v1.GetPVZListRequest) (from, to *time.Time, page, limit int64, err error) {
+	if req.GetStartDate() != nil {
+		if err = req.GetStartDate().CheckValid(); err != nil {
+			return nil, nil, 0, 0, fmt.Errorf("parse start date: %w", err)
+		}
+		from = ptrOf(req.GetStartDate().AsTime())
+	}
+
+	if req.GetEndDate() != nil {
+		if err = req.GetEndDate().CheckValid(); err != nil {
+			return nil, nil, 0, 0, fmt.Errorf("parse end date: %w", err)
+		}
+		to = ptrOf(req.GetEndDate().AsTime())
+	}
+
+	page = req.GetPage()
+	if page == 0 {
+		page = defaultPage
+	}
+	if page < 1 {
+		return nil, nil, 0, 0, errors.New("page must be greater than zero")
+	}
+
+	limit = req.GetLimit()
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit < 1 {
+		return nil, nil, 0, 0, errors.New("limit must be greater than zero")
+	}
+	if limit > maxLimit {
+		return nil, nil, 0, 0, errors.New("limit must be not greater than 30")
+	}
+
+	return from, to, page, limit, nil
+}
+
+func ptrOf[T any](value T) *T {
+	return &value
+}
+
+func convertToDTO(pvzList model.PVZList) *pvz_v1.GetPVZListResponse {
+	res := &pvz_v1.GetPVZListResponse{
+		Pvzs: make([]*pvz_v1.PVZItem, 0, len(pvzList.PVZs)),
+	}
+
+	productsByReception := make(map[model.ReceptionID][]*pvz_v1.Product, len(pvzList.Receptions))
+	for _, product := range pvzList.Products {
+		productsByReception[product.ReceptionID] = append(productsByReception[product.ReceptionID], &pvz_v1.Product{
+			Id:          product.ID.UUID().String(),
+			DateTime:    timestamppb.New(product.AddedAt),
+			Type:        product.Category.String(),
+			ReceptionId: product.ReceptionID.UUID().String(),
+			Barcode:     valueOf(product.Barcode),
+		})
+	}
+
+	receptionsByPVZ := make(map[model.PVZID][]*pvz_v1.ReceptionItem, len(pvzList.PVZs))
+	for _, reception := range pvzList.Receptions {
+		receptionsByPVZ[reception.PVZID] = append(receptionsByPVZ[reception.PVZID], &pvz_v1.ReceptionItem{
+			Reception: &pvz_v1.Reception{
+				Id:       reception.ID.UUID().String(),
+				DateTime: timestamppb.New(reception.ReceptedAt),
+				PvzId:    reception.PVZID.UUID().String(),
+				Status:   convertReceptionStatus(reception.ReceptionStatus),
+			},
+			Products: productsByReception[reception.ID],
+		})
+	}
+
+	for _, pvz := range pvzList.PVZs {
+		res.Pvzs = append(res.Pvzs, &pvz_v1.PVZItem{
+			Pvz: &pvz_v1.PVZ{
+				Id:               pvz.ID.UUID().String(),
+				RegistrationDate: timestamppb.New(pvz.RegisteredAt),
+				City:             pvz.City,
+			},
+			Receptions: receptionsByPVZ[pvz.ID],
+		})
+	}
+
+	return res
+}
+
+func convertReceptionStatus(receptionStatus model.ReceptionStatus) pvz_v1.ReceptionStatus {
+	switch receptionStatus {
+	case model.ReceptionStatusInProgress:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+	case model.ReceptionStatusClose:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSE
+	}
+	return pvz_v1.ReceptionStatus_RECEPTION_STATUS_UNSPECIFIED
+}
+
+func valueOf[T any](ptr *T) T {
+	if ptr == nil {
+		var zero T
+		return zero
+	}
+	return *ptr
+}
@@ -0,0 +1,214 @@
+package pvz_service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/inna-maikut/avito-pvz/internal/generated/pvz_v1"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzListGetting(ctrl), zap.NewNop())
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzListGetting(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestService_GetPVZList_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	pvzID1, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a11")
+	require.NoError(t, err)
+	pvzID2, err := model.ParsePVZID("6451927e-846b-4c97-9924-cba818687a12")
+	require.NoError(t, err)
+	receptionID1, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a21")
+	require.NoError(t, err)
+	receptionID2, err := model.ParseReceptionID("6451927e-846b-4c97-9924-cba818687a22")
+	require.NoError(t, err)
+	productID1, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a31")
+	require.NoError(t, err)
+	productID2, err := model.ParseProductID("6451927e-846b-4c97-9924-cba818687a32")
+	require.NoError(t, err)
+
+	from := time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC)
+	to := from.Add(1 * time.Hour)
+
+	useCaseMock.EXPECT().
+		GetPVZList(gomock.Any(), &from, &to, int64(2), int64(30)).
+		Return(model.PVZList{
+			PVZs: []model.PVZ{
+				{
+					ID:           pvzID1,
+					City:         "Москва",
+					RegisteredAt: from,
+				},
+				{
+					ID:           pvzID2,
+					City:         "Казань",
+					RegisteredAt: from.Add(10 * time.Second),
+				},
+			},
+			Receptions: []model.Reception{
+				{
+					ID:              receptionID1,
+					PVZID:           pvzID1,
+					ReceptionStatus: model.ReceptionStatusClose,
+					ReceptedAt:      from.Add(1 * time.Second),
+				},
+				{
+					ID:              receptionID2,
+					PVZID:           pvzID2,
+					ReceptionStatus: model.ReceptionStatusInProgress,
+					ReceptedAt:      from.Add(12 * time.Second),
+				},
+			},
+			Products: []model.Product{
+				{
+					ID:          productID1,
+					ReceptionID: receptionID1,
+					Category:    model.ProductCategoryElectronics,
+					AddedAt:     from.Add(1 * time.Minute),
+				},
+				{
+					ID:          productID2,
+					ReceptionID: receptionID1,
+					Category:    model.ProductCategoryShoes,
+					AddedAt:     from.Add(2 * time.Minute),
+					Barcode:     ptrOf("4600000000001"),
+				},
+			},
+		}, nil)
+
+	service, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	res, err := service.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{
+		StartDate: timestamppb.New(from),
+		EndDate:   timestamppb.New(to),
+		Page:      2,
+		Limit:     30,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res.GetPvzs(), 2)
+
+	pvz1 := res.GetPvzs()[0]
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a11", pvz1.GetPvz().GetId())
+	assert.Equal(t, "Москва", pvz1.GetPvz().GetCity())
+	assert.Equal(t, from, pvz1.GetPvz().GetRegistrationDate().AsTime())
+	require.Len(t, pvz1.GetReceptions(), 1)
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a21", pvz1.GetReceptions()[0].GetReception().GetId())
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a11", pvz1.GetReceptions()[0].GetReception().GetPvzId())
+	assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSE, pvz1.GetReceptions()[0].GetReception().GetStatus())
+	require.Len(t, pvz1.GetReceptions()[0].GetProducts(), 2)
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a31", pvz1.GetReceptions()[0].GetProducts()[0].GetId())
+	assert.Equal(t, "электроника", pvz1.GetReceptions()[0].GetProducts()[0].GetType())
+	assert.Empty(t, pvz1.GetReceptions()[0].GetProducts()[0].GetBarcode())
+	assert.Equal(t, "4600000000001", pvz1.GetReceptions()[0].GetProducts()[1].GetBarcode())
+	assert.Equal(t, "обувь", pvz1.GetReceptions()[0].GetProducts()[1].GetType())
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a21", pvz1.GetReceptions()[0].GetProducts()[1].GetReceptionId())
+
+	pvz2 := res.GetPvzs()[1]
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a12", pvz2.GetPvz().GetId())
+	require.Len(t, pvz2.GetReceptions(), 1)
+	assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, pvz2.GetReceptions()[0].GetReception().GetStatus())
+	assert.Empty(t, pvz2.GetReceptions()[0].GetProducts())
+}
+
+func TestService_GetPVZList_Defaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	useCaseMock.EXPECT().
+		GetPVZList(gomock.Any(), nil, nil, int64(1), int64(10)).
+		Return(model.PVZList{}, nil)
+
+	service, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	res, err := service.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.GetPvzs())
+}
+
+func TestService_GetPVZList_InvalidArgument(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     *pvz_v1.GetPVZListRequest
+		wantMsg string
+	}{
+		{
+			name:    "negative_page",
+			req:     &pvz_v1.GetPVZListRequest{Page: -1},
+			wantMsg: "validation request: page must be greater than zero",
+		},
+		{
+			name:    "negative_limit",
+			req:     &pvz_v1.GetPVZListRequest{Limit: -1},
+			wantMsg: "validation request: limit must be greater than zero",
+		},
+		{
+			name:    "too_big_limit",
+			req:     &pvz_v1.GetPVZListRequest{Limit: 31},
+			wantMsg: "validation request: limit must be not greater than 30",
+		},
+		{
+			name:    "invalid_start_date",
+			req:     &pvz_v1.GetPVZListRequest{StartDate: &timestamppb.Timestamp{Nanos: -1}},
+			wantMsg: "validation request: parse start date: ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			service, err := New(NewMockpvzListGetting(ctrl), zap.NewNop())
+			require.NoError(t, err)
+
+			_, err = service.GetPVZList(context.Background(), tc.req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.Contains(t, status.Convert(err).Message(), tc.wantMsg)
+		})
+	}
+}
+
+func TestService_GetPVZList_InternalError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCaseMock := NewMockpvzListGetting(ctrl)
+
+	useCaseMock.EXPECT().
+		GetPVZList(gomock.Any(), nil, nil, int64(1), int64(10)).
+		Return(model.PVZList{}, assert.AnError)
+
+	service, err := New(useCaseMock, zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = service.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Equal(t, "internal server error", status.Convert(err).Message())
+}
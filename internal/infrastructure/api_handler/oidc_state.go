@@ -0,0 +1,50 @@
+package api_handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	// OIDCStateCookie keeps the state of the OpenID Connect login until the callback
+	OIDCStateCookie = "oidc_state"
+
+	oidcStateCookiePath   = "/auth/oidc"
+	oidcStateCookieMaxAge = 10 * time.Minute
+)
+
+// SetOIDCState stores the state in a cookie that is sent back only to the callback of the same browser.
+// SameSite=Lax lets the cookie through the top-level redirect from the provider.
+func SetOIDCState(w http.ResponseWriter, state model.OIDCState) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     OIDCStateCookie,
+		Value:    state.String(),
+		Path:     oidcStateCookiePath,
+		MaxAge:   int(oidcStateCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// OIDCState reads the state of the cookie, the cookie is deleted, so the state can be used once.
+func OIDCState(w http.ResponseWriter, r *http.Request) (model.OIDCState, error) {
+	cookie, err := r.Cookie(OIDCStateCookie)
+	if err != nil {
+		return model.OIDCState{}, fmt.Errorf("r.Cookie: %w", err)
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     OIDCStateCookie,
+		Path:     oidcStateCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return model.ParseOIDCState(cookie.Value)
+}
@@ -0,0 +1,58 @@
+package api_handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestOIDCState(t *testing.T) {
+	state := model.OIDCState{State: "state", Nonce: "nonce", CodeVerifier: "verifier"}
+
+	t.Run("success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		SetOIDCState(w, state)
+
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, OIDCStateCookie, cookies[0].Name)
+		assert.Equal(t, "/auth/oidc", cookies[0].Path)
+		assert.Equal(t, 600, cookies[0].MaxAge)
+		assert.True(t, cookies[0].HttpOnly)
+		assert.True(t, cookies[0].Secure)
+		assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+
+		r := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback", nil)
+		r.AddCookie(cookies[0])
+		w = httptest.NewRecorder()
+
+		res, err := OIDCState(w, r)
+		require.NoError(t, err)
+		assert.Equal(t, state, res)
+
+		deleted := w.Result().Cookies()
+		require.Len(t, deleted, 1)
+		assert.Equal(t, OIDCStateCookie, deleted[0].Name)
+		assert.Equal(t, -1, deleted[0].MaxAge)
+	})
+
+	t.Run("error.no_cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback", nil)
+
+		_, err := OIDCState(httptest.NewRecorder(), r)
+		require.Error(t, err)
+	})
+
+	t.Run("error.invalid_cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback", nil)
+		r.AddCookie(&http.Cookie{Name: OIDCStateCookie, Value: "state"})
+
+		_, err := OIDCState(httptest.NewRecorder(), r)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,17 @@
+package api_handler
+
+import (
+	"net/http"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// RequirePermission responds with 403 if the permissions of the token do not include the permission.
+func RequirePermission(w http.ResponseWriter, tokenInfo model.TokenInfo, permission model.Permission) (ok bool) {
+	if tokenInfo.Permissions.Has(permission) {
+		return true
+	}
+
+	Forbidden(w, "permission "+string(permission)+" is required")
+	return false
+}
@@ -0,0 +1,34 @@
+package api_handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestRequirePermission(t *testing.T) {
+	tokenInfo := model.TokenInfo{
+		Permissions: model.NewPermissionSet(model.PermissionPVZRead),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok := RequirePermission(w, tokenInfo, model.PermissionPVZRead)
+
+		require.True(t, ok)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Empty(t, w.Body.String())
+	})
+	t.Run("forbidden", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ok := RequirePermission(w, tokenInfo, model.PermissionPVZCreate)
+
+		require.False(t, ok)
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.JSONEq(t, `{"message": "permission pvz:create is required"}`, w.Body.String())
+	})
+}
@@ -3,6 +3,7 @@ package api_handler
 import (
 	"encoding/json"
 	"io"
+	"net"
 	"net/http"
 )
 
@@ -22,3 +23,12 @@ func Parse[T any](r *http.Request, w http.ResponseWriter, t *T) (ok bool) {
 
 	return true
 }
+
+// ClientIP returns the host part of the remote address, or the whole address if it has no port.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
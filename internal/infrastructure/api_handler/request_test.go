@@ -58,3 +58,16 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	r.RemoteAddr = "10.0.0.1:1234"
+	assert.Equal(t, "10.0.0.1", ClientIP(r))
+
+	r.RemoteAddr = "[::1]:1234"
+	assert.Equal(t, "::1", ClientIP(r))
+
+	r.RemoteAddr = "10.0.0.1"
+	assert.Equal(t, "10.0.0.1", ClientIP(r))
+}
@@ -3,10 +3,16 @@ package api_handler
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+// RefreshTokenHeader carries the refresh token in login and refresh responses, the body stays the access token.
+const RefreshTokenHeader = "X-Refresh-Token"
+
 func InternalError(w http.ResponseWriter, description string) {
 	w.WriteHeader(http.StatusInternalServerError)
 	_ = json.NewEncoder(w).Encode(api.Error{
@@ -21,6 +27,20 @@ func BadRequest(w http.ResponseWriter, description string) {
 	})
 }
 
+// PasswordPolicyViolated responds with 400 listing the violated rules, so the client can show all of them at once.
+func PasswordPolicyViolated(w http.ResponseWriter, err *model.PasswordPolicyError) {
+	violations := make([]string, 0, len(err.Violations))
+	for _, rule := range err.Violations {
+		violations = append(violations, string(rule))
+	}
+
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(api.PasswordPolicyError{
+		Message:    "password does not meet the policy",
+		Violations: &violations,
+	})
+}
+
 func Unauthorized(w http.ResponseWriter, description string) {
 	w.WriteHeader(http.StatusUnauthorized)
 	_ = json.NewEncoder(w).Encode(api.Error{
@@ -35,6 +55,52 @@ func Forbidden(w http.ResponseWriter, description string) {
 	})
 }
 
+func NotFound(w http.ResponseWriter, description string) {
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(api.Error{
+		Message: description,
+	})
+}
+
+func Conflict(w http.ResponseWriter, description string) {
+	w.WriteHeader(http.StatusConflict)
+	_ = json.NewEncoder(w).Encode(api.Error{
+		Message: description,
+	})
+}
+
+func UnprocessableEntity(w http.ResponseWriter, description string) {
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	_ = json.NewEncoder(w).Encode(api.Error{
+		Message: description,
+	})
+}
+
+func TooManyRequests(w http.ResponseWriter, description string, retryAfter time.Duration) {
+	setRetryAfter(w, retryAfter)
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(api.Error{
+		Message: description,
+	})
+}
+
+func Locked(w http.ResponseWriter, description string, retryAfter time.Duration) {
+	setRetryAfter(w, retryAfter)
+	w.WriteHeader(http.StatusLocked)
+	_ = json.NewEncoder(w).Encode(api.Error{
+		Message: description,
+	})
+}
+
+// setRetryAfter sets the Retry-After header in whole seconds, rounded up so the client does not retry too early.
+func setRetryAfter(w http.ResponseWriter, retryAfter time.Duration) {
+	if retryAfter <= 0 {
+		return
+	}
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+}
+
 func OK[T any](w http.ResponseWriter, t T) {
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(t)
@@ -4,8 +4,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
 func TestInternalError(t *testing.T) {
@@ -24,6 +27,17 @@ func TestBadRequest(t *testing.T) {
 	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
 }
 
+func TestPasswordPolicyViolated(t *testing.T) {
+	w := httptest.NewRecorder()
+	PasswordPolicyViolated(w, &model.PasswordPolicyError{
+		Violations: []model.PasswordRule{model.PasswordRuleMinLength, model.PasswordRuleNotBreached},
+	})
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"message": "password does not meet the policy", "violations": ["min_length", "not_breached"]}`,
+		w.Body.String())
+}
+
 func TestUnauthorized(t *testing.T) {
 	w := httptest.NewRecorder()
 	Unauthorized(w, "my description")
@@ -40,6 +54,48 @@ func TestForbidden(t *testing.T) {
 	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
 }
 
+func TestNotFound(t *testing.T) {
+	w := httptest.NewRecorder()
+	NotFound(w, "my description")
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
+}
+
+func TestConflict(t *testing.T) {
+	w := httptest.NewRecorder()
+	Conflict(w, "my description")
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
+}
+
+func TestUnprocessableEntity(t *testing.T) {
+	w := httptest.NewRecorder()
+	UnprocessableEntity(w, "my description")
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
+}
+
+func TestTooManyRequests(t *testing.T) {
+	w := httptest.NewRecorder()
+	TooManyRequests(w, "my description", 1500*time.Millisecond)
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
+}
+
+func TestLocked(t *testing.T) {
+	w := httptest.NewRecorder()
+	Locked(w, "my description", 0)
+
+	require.Equal(t, http.StatusLocked, w.Code)
+	require.Empty(t, w.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"message": "my description"}`, w.Body.String())
+}
+
 func TestOK(t *testing.T) {
 	w := httptest.NewRecorder()
 	OK(w, "my description")
@@ -3,24 +3,118 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 )
 
 type Config struct {
+	// environment: development, test or production. /dummyLogin is served outside production only,
+	// with DummyTokensReadOnly its tokens keep only the read permissions of the role.
+	AppEnv              string `split_words:"true" default:"production"`
+	DummyTokensReadOnly bool   `split_words:"true"`
+
 	// database
 	DatabaseName     string `required:"true" split_words:"true"`
 	DatabaseHost     string `required:"true" split_words:"true"`
 	DatabasePort     int    `required:"true" split_words:"true"`
 	DatabaseUser     string `required:"true" split_words:"true"`
 	DatabasePassword string `required:"true" split_words:"true"`
+	// apply migrations from ./migrations on start
+	MigrateOnStart bool `split_words:"true"`
 
 	// http server
 	ServerHost        string `required:"true" split_words:"true"`
 	ServerPort        int    `required:"true" split_words:"true"`
 	MetricsServerHost string `required:"true" split_words:"true"`
 	MetricsServerPort int    `required:"true" split_words:"true"`
+
+	// jwt: *.pem keys of JWTKeysDir verify tokens, the key named JWTSigningKeyID.pem signs new ones
+	JWTKeysDir      string `split_words:"true" default:"jwt_keys"`
+	JWTSigningKeyID string `required:"true" split_words:"true"`
+
+	// auth: access tokens are short-lived, refresh tokens are rotated on every refresh.
+	// A revoked access token, or a token of a disabled user, may still be accepted for TokenRevocationCacheTTL
+	// on other instances.
+	AccessTokenTTL          time.Duration `split_words:"true" default:"15m"`
+	RefreshTokenTTL         time.Duration `split_words:"true" default:"720h"`
+	TokenRevocationCacheTTL time.Duration `split_words:"true" default:"30s"`
+
+	// login attempts: every failed attempt for an email doubles the delay before the next one, starting from
+	// LoginFailureDelay. After LoginMaxFailures the account is locked for LoginLockoutDuration,
+	// after LoginMaxFailuresPerIP failed attempts from an IP logins from it are blocked for the same duration.
+	LoginMaxFailures      int           `split_words:"true" default:"5"`
+	LoginMaxFailuresPerIP int           `split_words:"true" default:"20"`
+	LoginFailureDelay     time.Duration `split_words:"true" default:"1s"`
+	LoginLockoutDuration  time.Duration `split_words:"true" default:"15m"`
+
+	// password policy: new passwords are checked on registration and password changes. BreachedPasswordsFile is
+	// a newline-separated list of common and breached passwords, matched case-insensitively, none when empty.
+	PasswordMinLength        int    `split_words:"true" default:"8"`
+	PasswordMaxLength        int    `split_words:"true" default:"72"`
+	PasswordRequireUppercase bool   `split_words:"true"`
+	PasswordRequireLowercase bool   `split_words:"true" default:"true"`
+	PasswordRequireDigit     bool   `split_words:"true" default:"true"`
+	PasswordRequireSpecial   bool   `split_words:"true"`
+	BreachedPasswordsFile    string `split_words:"true" default:"breached_passwords.txt"`
+
+	// oidc login: staff log in with the SSO when OIDCIssuer is set. OIDCGroupRoles maps groups of the OIDCGroupsClaim
+	// claim to roles, e.g. "pvz-moderators=moderator;pvz-staff=employee", the first mapping of a user group wins.
+	OIDCIssuer       string        `split_words:"true"`
+	OIDCClientID     string        `split_words:"true"`
+	OIDCClientSecret string        `split_words:"true"`
+	OIDCRedirectURL  string        `split_words:"true"`
+	OIDCScopes       string        `split_words:"true" default:"openid email profile groups"`
+	OIDCGroupsClaim  string        `split_words:"true" default:"groups"`
+	OIDCGroupRoles   string        `split_words:"true"`
+	OIDCTimeout      time.Duration `split_words:"true" default:"5s"`
+
+	// rate limit: a token bucket per client (user, or IP for requests without a user) and route allows
+	// RateLimitRate requests per second with bursts up to RateLimitBurst. RateLimitRoutes overrides them for routes,
+	// e.g. "POST /products=5:10;GET /pvz=0:0", a zero rate disables the limit.
+	RateLimitRate   float64 `split_words:"true" default:"50"`
+	RateLimitBurst  int     `split_words:"true" default:"100"`
+	RateLimitRoutes string  `split_words:"true"`
+	// RateLimitIPRate and RateLimitIPBurst limit requests of an IP to all authenticated routes before
+	// authentication, so invalid tokens and API keys are limited too; clients behind one NAT share the bucket
+	RateLimitIPRate  float64 `split_words:"true" default:"100"`
+	RateLimitIPBurst int     `split_words:"true" default:"200"`
+
+	// idempotency keys: responses of requests with an Idempotency-Key header are replayed for IdempotencyKeyTTL,
+	// a request being handled holds its key for IdempotencyLockTTL and extends it while it runs, expired keys are
+	// removed every IdempotencyCleanupInterval
+	IdempotencyKeyTTL          time.Duration `split_words:"true" default:"24h"`
+	IdempotencyLockTTL         time.Duration `split_words:"true" default:"1m"`
+	IdempotencyCleanupInterval time.Duration `split_words:"true" default:"1h"`
+
+	// permissions: JSON file mapping roles to permissions, roles missing in the file keep the default permissions
+	RolePermissionsFile string `split_words:"true"`
+
+	// grpc server
+	GRPCServerHost string `required:"true" split_words:"true"`
+	GRPCServerPort int    `required:"true" split_words:"true"`
+
+	// outbox relay: the relay always schedules webhook subscription deliveries, OutboxSink (webhook, file or memory)
+	// adds an extra sink when set
+	OutboxSink           string        `split_words:"true"`
+	OutboxWebhookURL     string        `split_words:"true"`
+	OutboxWebhookTimeout time.Duration `split_words:"true" default:"5s"`
+	OutboxFilePath       string        `split_words:"true"`
+	OutboxRelayInterval  time.Duration `split_words:"true" default:"1s"`
+	OutboxBatchSize      int           `split_words:"true" default:"100"`
+	// a message is marked dead after OutboxMaxAttempts failed attempts; a claimed batch is sent again after
+	// OutboxLease if the relay dies, so the lease should cover sending a whole batch
+	OutboxMaxAttempts int           `split_words:"true" default:"20"`
+	OutboxLease       time.Duration `split_words:"true" default:"10m"`
+
+	// webhook subscriptions: a delivery is marked dead after WebhookMaxAttempts failed attempts; a claimed batch
+	// is sent again after WebhookLease if the worker dies, so the lease should cover sending a whole batch
+	WebhookDeliveryInterval time.Duration `split_words:"true" default:"1s"`
+	WebhookBatchSize        int           `split_words:"true" default:"100"`
+	WebhookMaxAttempts      int           `split_words:"true" default:"10"`
+	WebhookTimeout          time.Duration `split_words:"true" default:"5s"`
+	WebhookLease            time.Duration `split_words:"true" default:"10m"`
 }
 
 func Load() Config {
@@ -0,0 +1,55 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// ActiveUserCache keeps the results of user status checks for ttl, so a request goes to the store only
+// once per user in ttl. Forget drops the cached status: the instance that disabled a user rejects its tokens at once,
+// other instances reject them after their cached status expires.
+type ActiveUserCache struct {
+	store    activeUserStore
+	now      func() time.Time
+	statuses *ttlCache[model.UserID, bool]
+}
+
+func NewActiveUserCache(store activeUserStore, ttl time.Duration) (*ActiveUserCache, error) {
+	if store == nil {
+		return nil, errors.New("store is nil")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("ttl should be positive")
+	}
+	return &ActiveUserCache{
+		store:    store,
+		now:      time.Now,
+		statuses: newTTLCache[model.UserID, bool](ttl),
+	}, nil
+}
+
+func (c *ActiveUserCache) IsActive(ctx context.Context, userID model.UserID) (bool, error) {
+	now := c.now()
+
+	if active, ok := c.statuses.get(userID, now); ok {
+		return active, nil
+	}
+
+	active, err := c.store.IsActive(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("store.IsActive: %w", err)
+	}
+
+	c.statuses.set(userID, active, now)
+
+	return active, nil
+}
+
+// Forget drops the cached status of the user, the next check goes to the store.
+func (c *ActiveUserCache) Forget(userID model.UserID) {
+	c.statuses.delete(userID)
+}
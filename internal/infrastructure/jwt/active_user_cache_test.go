@@ -0,0 +1,92 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewActiveUserCache(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := NewActiveUserCache(NewMockactiveUserStore(ctrl), time.Minute)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewActiveUserCache(nil, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := NewActiveUserCache(NewMockactiveUserStore(ctrl), 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestActiveUserCache_IsActive(t *testing.T) {
+	userID := model.NewUserID()
+	now := time.Now()
+
+	t.Run("success.cached_until_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockactiveUserStore(ctrl)
+		store.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil).Times(2)
+
+		cache, err := NewActiveUserCache(store, time.Minute)
+		require.NoError(t, err)
+		cache.now = func() time.Time { return now }
+
+		for range 3 {
+			active, err := cache.IsActive(context.Background(), userID)
+			require.NoError(t, err)
+			require.True(t, active)
+		}
+
+		cache.now = func() time.Time { return now.Add(time.Minute) }
+		active, err := cache.IsActive(context.Background(), userID)
+		require.NoError(t, err)
+		require.True(t, active)
+	})
+	t.Run("success.forget", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockactiveUserStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil),
+			store.EXPECT().IsActive(gomock.Any(), userID).Return(false, nil),
+		)
+
+		cache, err := NewActiveUserCache(store, time.Minute)
+		require.NoError(t, err)
+		cache.now = func() time.Time { return now }
+
+		active, err := cache.IsActive(context.Background(), userID)
+		require.NoError(t, err)
+		require.True(t, active)
+
+		cache.Forget(userID)
+
+		active, err = cache.IsActive(context.Background(), userID)
+		require.NoError(t, err)
+		require.False(t, active)
+	})
+	t.Run("error.IsActive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockactiveUserStore(ctrl)
+		store.EXPECT().IsActive(gomock.Any(), userID).Return(false, assert.AnError)
+
+		cache, err := NewActiveUserCache(store, time.Minute)
+		require.NoError(t, err)
+
+		_, err = cache.IsActive(context.Background(), userID)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
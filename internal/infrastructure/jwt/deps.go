@@ -2,9 +2,34 @@
 package jwt
 
 import (
+	"context"
+	"time"
+
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
 type tokenProvider interface {
 	ParseToken(tokenStr string) (model.TokenInfo, error)
 }
+
+type revocationChecker interface {
+	IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error)
+}
+
+type revocationStore interface {
+	IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error)
+	Revoke(ctx context.Context, tokenID model.TokenID, expiresAt time.Time) error
+}
+
+type userChecker interface {
+	IsActive(ctx context.Context, userID model.UserID) (bool, error)
+}
+
+type activeUserStore interface {
+	IsActive(ctx context.Context, userID model.UserID) (bool, error)
+}
+
+type apiKeyStore interface {
+	GetByHash(ctx context.Context, keyHash []byte) (model.APIKey, error)
+	TouchLastUsed(ctx context.Context, apiKeyID model.APIKeyID) error
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
 
@@ -13,7 +14,22 @@ import (
 
 type tokenContextKey struct{}
 
-var ErrNoAuthHeader = errors.New("authorization header is missing")
+const (
+	// APIKeyHeader carries the key of the apiKeyAuth security scheme
+	APIKeyHeader = "X-API-Key"
+
+	// apiKeyTouchInterval limits writes of the last-used time of a key, busy clients would write it on every request
+	apiKeyTouchInterval = time.Minute
+)
+
+var (
+	ErrNoAuthHeader   = errors.New("authorization header is missing")
+	ErrNoAPIKeyHeader = errors.New("x-api-key header is missing")
+	ErrTokenRevoked   = errors.New("token is revoked")
+	ErrUserInactive   = errors.New("user is disabled")
+	ErrDummyToken     = errors.New("dummy tokens are not accepted")
+	ErrAPIKeyInvalid  = errors.New("api key is unknown, expired or revoked")
+)
 
 // GetJWSFromRequest extracts a JWS string from an Authorization: <jws> header
 func GetJWSFromRequest(req *http.Request) (string, error) {
@@ -24,28 +40,55 @@ func GetJWSFromRequest(req *http.Request) (string, error) {
 	return authHdr, nil
 }
 
-func NewAuthenticator(provider tokenProvider) openapi3filter.AuthenticationFunc {
-	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
-		return Authenticate(ctx, provider, input)
+// GetAPIKeyFromRequest extracts an API key from an X-API-Key: <key> header
+func GetAPIKeyFromRequest(req *http.Request) (string, error) {
+	key := req.Header.Get(APIKeyHeader)
+	if key == "" {
+		return "", ErrNoAPIKeyHeader
 	}
+	return key, nil
 }
 
-// Authenticate uses the specified validator to ensure a JWT is valid, then makes
-// sure that the claims provided by the JWT match the scopes as required in the API.
-func Authenticate(ctx context.Context, provider tokenProvider, input *openapi3filter.AuthenticationInput) error {
-	// Our security scheme is named BearerAuth, ensure this is the case
-	if input.SecuritySchemeName != "bearerAuth" {
-		return fmt.Errorf("security scheme %s != 'bearerAuth'", input.SecuritySchemeName)
+func NewAuthenticator(provider tokenProvider, checker revocationChecker, users userChecker, apiKeys apiKeyStore,
+	rolePermissions model.RolePermissions, dummyTokens model.DummyTokenAccess,
+) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		return Authenticate(ctx, provider, checker, users, apiKeys, rolePermissions, dummyTokens, input)
 	}
+}
 
-	jws, err := GetJWSFromRequest(input.RequestValidationInput.Request)
+// Authenticate checks the credentials of the security scheme: a JWT of bearerAuth or a key of apiKeyAuth.
+// The API is called either with a token or with a key, so operations list the schemes as alternatives and
+// the validator calls Authenticate for each of them until one succeeds.
+// The permissions of the role are put into the token info, handlers check them.
+func Authenticate(ctx context.Context, provider tokenProvider, checker revocationChecker, users userChecker,
+	apiKeys apiKeyStore, rolePermissions model.RolePermissions, dummyTokens model.DummyTokenAccess,
+	input *openapi3filter.AuthenticationInput,
+) error {
+	var (
+		tokenInfo model.TokenInfo
+		err       error
+	)
+	switch input.SecuritySchemeName {
+	case "bearerAuth":
+		tokenInfo, err = authenticateToken(ctx, provider, checker, users, dummyTokens, input.RequestValidationInput.Request)
+	case "apiKeyAuth":
+		tokenInfo, err = authenticateAPIKey(ctx, apiKeys, input.RequestValidationInput.Request)
+	default:
+		return fmt.Errorf("security scheme %s is not 'bearerAuth' or 'apiKeyAuth'", input.SecuritySchemeName)
+	}
 	if err != nil {
-		return fmt.Errorf("getting jws: %w", err)
+		return err
 	}
 
-	tokenInfo, err := provider.ParseToken(jws)
-	if err != nil {
-		return fmt.Errorf("validating JWS: %w", err)
+	tokenInfo.Permissions = rolePermissions.Of(tokenInfo.UserRole)
+	if tokenInfo.Dummy && dummyTokens == model.DummyTokenAccessReadOnly {
+		tokenInfo.Permissions = tokenInfo.Permissions.ReadOnly()
+	}
+	// keys scoped to PVZs stay scoped even if the role may change any PVZ
+	apiKeyScoped := tokenInfo.APIKeyID != model.APIKeyID{} && len(tokenInfo.PVZAccess.PVZIDs) > 0
+	if tokenInfo.Permissions.Has(model.PermissionPVZAny) && !apiKeyScoped {
+		tokenInfo.PVZAccess = model.AllPVZAccess()
 	}
 
 	ctx = ContextWithTokenInfo(ctx, tokenInfo)
@@ -54,6 +97,84 @@ func Authenticate(ctx context.Context, provider tokenProvider, input *openapi3fi
 	return nil
 }
 
+// authenticateToken ensures a JWT is valid and not revoked, and that its user is not disabled.
+// Dummy tokens are rejected or limited to read permissions depending on dummyTokens.
+func authenticateToken(ctx context.Context, provider tokenProvider, checker revocationChecker, users userChecker,
+	dummyTokens model.DummyTokenAccess, req *http.Request,
+) (model.TokenInfo, error) {
+	jws, err := GetJWSFromRequest(req)
+	if err != nil {
+		return model.TokenInfo{}, fmt.Errorf("getting jws: %w", err)
+	}
+
+	tokenInfo, err := provider.ParseToken(jws)
+	if err != nil {
+		return model.TokenInfo{}, fmt.Errorf("validating JWS: %w", err)
+	}
+
+	if tokenInfo.Dummy && dummyTokens == model.DummyTokenAccessDenied {
+		return model.TokenInfo{}, ErrDummyToken
+	}
+
+	revoked, err := checker.IsRevoked(ctx, tokenInfo.TokenID)
+	if err != nil {
+		return model.TokenInfo{}, fmt.Errorf("checker.IsRevoked: %w", err)
+	}
+	if revoked {
+		return model.TokenInfo{}, ErrTokenRevoked
+	}
+
+	// dummy tokens have no user
+	if tokenInfo.UserID != model.DefaultUserID {
+		active, err := users.IsActive(ctx, tokenInfo.UserID)
+		if err != nil {
+			return model.TokenInfo{}, fmt.Errorf("users.IsActive: %w", err)
+		}
+		if !active {
+			return model.TokenInfo{}, ErrUserInactive
+		}
+	}
+
+	return tokenInfo, nil
+}
+
+// authenticateAPIKey ensures the key is known, not expired and not revoked, and records its use.
+// Keys belong to no user, the token info gets the role and the PVZs of the key.
+func authenticateAPIKey(ctx context.Context, apiKeys apiKeyStore, req *http.Request) (model.TokenInfo, error) {
+	key, err := GetAPIKeyFromRequest(req)
+	if err != nil {
+		return model.TokenInfo{}, fmt.Errorf("getting api key: %w", err)
+	}
+
+	apiKey, err := apiKeys.GetByHash(ctx, model.HashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, model.ErrAPIKeyNotFound) {
+			return model.TokenInfo{}, ErrAPIKeyInvalid
+		}
+		return model.TokenInfo{}, fmt.Errorf("apiKeys.GetByHash: %w", err)
+	}
+
+	now := time.Now()
+	if !apiKey.IsActive(now) {
+		return model.TokenInfo{}, ErrAPIKeyInvalid
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyTouchInterval {
+		err = apiKeys.TouchLastUsed(ctx, apiKey.ID)
+		if err != nil {
+			return model.TokenInfo{}, fmt.Errorf("apiKeys.TouchLastUsed: %w", err)
+		}
+	}
+
+	return model.TokenInfo{
+		UserID:    model.DefaultUserID,
+		UserRole:  apiKey.UserRole,
+		PVZAccess: apiKey.PVZAccess(),
+		APIKeyID:  apiKey.ID,
+		ExpiresAt: apiKey.ExpiresAt,
+	}, nil
+}
+
 func TokenInfoFromContext(ctx context.Context) model.TokenInfo {
 	v := ctx.Value(tokenContextKey{})
 	res, ok := v.(model.TokenInfo)
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/stretchr/testify/assert"
@@ -60,15 +61,72 @@ func TestGetJWSFromRequest(t *testing.T) {
 	})
 }
 
+func TestGetAPIKeyFromRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("X-API-Key", "pvz_key")
+
+		res, err := GetAPIKeyFromRequest(r)
+		require.NoError(t, err)
+		require.Equal(t, "pvz_key", res)
+	})
+
+	t.Run("error.no_header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "asdf")
+
+		_, err := GetAPIKeyFromRequest(r)
+		require.ErrorIs(t, err, ErrNoAPIKeyHeader)
+	})
+}
+
 func TestAuthenticate(t *testing.T) {
 	type mocks struct {
-		tokenProvider *MocktokenProvider
+		tokenProvider     *MocktokenProvider
+		revocationChecker *MockrevocationChecker
+		userChecker       *MockuserChecker
+		apiKeyStore       *MockapiKeyStore
 	}
 	type args struct {
-		ctx   context.Context
-		input *openapi3filter.AuthenticationInput
+		ctx         context.Context
+		dummyTokens model.DummyTokenAccess
+		input       *openapi3filter.AuthenticationInput
 	}
 	userID := model.NewUserID()
+	tokenID := model.NewTokenID()
+	pvzID := model.NewPVZID()
+	apiKeyID := model.NewAPIKeyID()
+	expiresAt := time.Now().Add(time.Hour)
+	recentlyUsedAt := time.Now().Add(-time.Second)
+
+	newAPIKeyArgs := func(_ *testing.T) args {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-API-Key", "pvz_key")
+		return args{
+			ctx: context.Background(),
+			input: &openapi3filter.AuthenticationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request: req,
+				},
+				SecuritySchemeName: "apiKeyAuth",
+			},
+		}
+	}
+
+	newArgs := func(_ *testing.T) args {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "asdf")
+		return args{
+			ctx:         context.Background(),
+			dummyTokens: model.DummyTokenAccessFull,
+			input: &openapi3filter.AuthenticationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request: req,
+				},
+				SecuritySchemeName: "bearerAuth",
+			},
+		}
+	}
 
 	tests := []struct {
 		name    string
@@ -93,14 +151,117 @@ func TestAuthenticate(t *testing.T) {
 				}
 			},
 			prepare: func(_ *testing.T, m *mocks) {
-				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID}, nil)
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID, TokenID: tokenID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil)
 			},
 			check: func(t *testing.T, args args) {
 				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
-				assert.Equal(t, model.TokenInfo{UserID: userID}, tokenInfo)
+				assert.Equal(t, model.TokenInfo{UserID: userID, TokenID: tokenID}, tokenInfo)
 			},
 			wantErr: false,
 		},
+		{
+			name: "success.permissions",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{
+					UserID:   userID,
+					UserRole: model.UserRoleEmployee,
+					TokenID:  tokenID,
+				}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.True(t, tokenInfo.Permissions.Has(model.PermissionReceptionCreate))
+				assert.False(t, tokenInfo.Permissions.Has(model.PermissionPVZCreate))
+				assert.False(t, tokenInfo.PVZAccess.All)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.pvz_any",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{
+					UserID:   userID,
+					UserRole: model.UserRoleRegionalManager,
+					TokenID:  tokenID,
+				}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.True(t, tokenInfo.Permissions.Has(model.PermissionReceptionClose))
+				assert.True(t, tokenInfo.PVZAccess.All)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.dummy_token",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{
+					UserID:   model.DefaultUserID,
+					UserRole: model.UserRoleModerator,
+					TokenID:  tokenID,
+				}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, model.UserRoleModerator, tokenInfo.UserRole)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.dummy_read_only",
+			args: func(t *testing.T) args {
+				a := newArgs(t)
+				a.dummyTokens = model.DummyTokenAccessReadOnly
+				return a
+			},
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{
+					UserID:   model.DefaultUserID,
+					UserRole: model.UserRoleModerator,
+					TokenID:  tokenID,
+					Dummy:    true,
+				}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.True(t, tokenInfo.Dummy)
+				assert.True(t, tokenInfo.Permissions.Has(model.PermissionPVZRead))
+				assert.False(t, tokenInfo.Permissions.Has(model.PermissionPVZCreate))
+			},
+			wantErr: false,
+		},
+		{
+			name: "error.dummy_denied",
+			args: func(t *testing.T) args {
+				a := newArgs(t)
+				a.dummyTokens = model.DummyTokenAccessDenied
+				return a
+			},
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{
+					UserID:   model.DefaultUserID,
+					UserRole: model.UserRoleModerator,
+					TokenID:  tokenID,
+					Dummy:    true,
+				}, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, model.TokenInfo{}, tokenInfo)
+			},
+			wantErr: true,
+		},
 		{
 			name: "error.invalid_security_scheme",
 			args: func(_ *testing.T) args {
@@ -139,19 +300,223 @@ func TestAuthenticate(t *testing.T) {
 			check:   func(_ *testing.T, _ args) {},
 			wantErr: true,
 		},
+		{
+			name: "error.revoked",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID, TokenID: tokenID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(true, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, model.TokenInfo{}, tokenInfo)
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.user_inactive",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID, TokenID: tokenID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(false, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, model.TokenInfo{}, tokenInfo)
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.IsActive",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID, TokenID: tokenID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(false, assert.AnError)
+			},
+			check:   func(_ *testing.T, _ args) {},
+			wantErr: true,
+		},
+		{
+			name: "error.IsRevoked",
+			args: newArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID, TokenID: tokenID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, assert.AnError)
+			},
+			check:   func(_ *testing.T, _ args) {},
+			wantErr: true,
+		},
+		{
+			name: "success.api_key",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        apiKeyID,
+					UserRole:  model.UserRoleEmployee,
+					PVZIDs:    []model.PVZID{pvzID},
+					ExpiresAt: expiresAt,
+				}, nil)
+				m.apiKeyStore.EXPECT().TouchLastUsed(gomock.Any(), apiKeyID).Return(nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, apiKeyID, tokenInfo.APIKeyID)
+				assert.Equal(t, model.DefaultUserID, tokenInfo.UserID)
+				assert.Equal(t, model.UserRoleEmployee, tokenInfo.UserRole)
+				assert.Equal(t, model.PVZAccess{PVZIDs: []model.PVZID{pvzID}}, tokenInfo.PVZAccess)
+				assert.True(t, tokenInfo.Permissions.Has(model.PermissionProductAdd))
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.api_key_recently_used",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:         apiKeyID,
+					UserRole:   model.UserRoleEmployee,
+					ExpiresAt:  expiresAt,
+					LastUsedAt: &recentlyUsedAt,
+				}, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, apiKeyID, tokenInfo.APIKeyID)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.api_key_pvz_any",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:         apiKeyID,
+					UserRole:   model.UserRoleRegionalManager,
+					ExpiresAt:  expiresAt,
+					LastUsedAt: &recentlyUsedAt,
+				}, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.True(t, tokenInfo.PVZAccess.All)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.api_key_pvz_any_scoped",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:         apiKeyID,
+					UserRole:   model.UserRoleRegionalManager,
+					PVZIDs:     []model.PVZID{pvzID},
+					ExpiresAt:  expiresAt,
+					LastUsedAt: &recentlyUsedAt,
+				}, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.False(t, tokenInfo.PVZAccess.All)
+				assert.True(t, tokenInfo.PVZAccess.Allows(pvzID))
+				assert.False(t, tokenInfo.PVZAccess.Allows(model.NewPVZID()))
+			},
+			wantErr: false,
+		},
+		{
+			name: "error.api_key_missing",
+			args: func(t *testing.T) args {
+				a := newArgs(t)
+				a.input.SecuritySchemeName = "apiKeyAuth"
+				return a
+			},
+			prepare: func(_ *testing.T, _ *mocks) {},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, model.TokenInfo{}, tokenInfo)
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.api_key_unknown",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{}, model.ErrAPIKeyNotFound)
+			},
+			check:   func(_ *testing.T, _ args) {},
+			wantErr: true,
+		},
+		{
+			name: "error.api_key_expired",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        apiKeyID,
+					UserRole:  model.UserRoleEmployee,
+					ExpiresAt: time.Now().Add(-time.Minute),
+				}, nil)
+			},
+			check: func(t *testing.T, args args) {
+				tokenInfo := TokenInfoFromContext(args.input.RequestValidationInput.Request.Context())
+				assert.Equal(t, model.TokenInfo{}, tokenInfo)
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.api_key_revoked",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        apiKeyID,
+					UserRole:  model.UserRoleEmployee,
+					ExpiresAt: expiresAt,
+					RevokedAt: &recentlyUsedAt,
+				}, nil)
+			},
+			check:   func(_ *testing.T, _ args) {},
+			wantErr: true,
+		},
+		{
+			name: "error.GetByHash",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{}, assert.AnError)
+			},
+			check:   func(_ *testing.T, _ args) {},
+			wantErr: true,
+		},
+		{
+			name: "error.TouchLastUsed",
+			args: newAPIKeyArgs,
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        apiKeyID,
+					UserRole:  model.UserRoleEmployee,
+					ExpiresAt: expiresAt,
+				}, nil)
+				m.apiKeyStore.EXPECT().TouchLastUsed(gomock.Any(), apiKeyID).Return(assert.AnError)
+			},
+			check:   func(_ *testing.T, _ args) {},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
-				tokenProvider: NewMocktokenProvider(ctrl),
+				tokenProvider:     NewMocktokenProvider(ctrl),
+				revocationChecker: NewMockrevocationChecker(ctrl),
+				userChecker:       NewMockuserChecker(ctrl),
+				apiKeyStore:       NewMockapiKeyStore(ctrl),
 			}
 
 			tt.prepare(t, m)
 
 			a := tt.args(t)
-			err := Authenticate(a.ctx, m.tokenProvider, a.input)
+			err := Authenticate(a.ctx, m.tokenProvider, m.revocationChecker, m.userChecker, m.apiKeyStore,
+				model.DefaultRolePermissions(), a.dummyTokens, a.input)
 			require.Equal(t, err != nil, tt.wantErr)
 			tt.check(t, a)
 		})
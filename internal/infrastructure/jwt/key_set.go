@@ -0,0 +1,263 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const keyFileExt = ".pem"
+
+// Key is a signing key of the key set. Private is nil for keys that are only used to verify tokens,
+// e.g. a retired key whose tokens have not expired yet.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Public  crypto.PublicKey
+	Private crypto.Signer
+}
+
+// KeySet holds the keys tokens are signed and verified with, by kid.
+type KeySet struct {
+	keys map[string]Key
+}
+
+// JWKS is the JSON Web Key Set document with the public keys of the key set (RFC 7517).
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// EC keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+}
+
+// LoadKeySet reads all *.pem files of the directory, the file name without extension is the kid.
+// A file holds a P-256 EC key (ES256) or an RSA key (RS256), private or public.
+func LoadKeySet(dir string) (*KeySet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadDir: %w", err)
+	}
+
+	keys := make([]Key, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != keyFileExt {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile: %w", err)
+		}
+
+		key, err := ParseKey(strings.TrimSuffix(entry.Name(), keyFileExt), data)
+		if err != nil {
+			return nil, fmt.Errorf("parse key %s: %w", entry.Name(), err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(keys...)
+}
+
+func NewKeySet(keys ...Key) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys")
+	}
+
+	set := &KeySet{keys: make(map[string]Key, len(keys))}
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("empty key id")
+		}
+		if _, ok := set.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %s", key.ID)
+		}
+		set.keys[key.ID] = key
+	}
+
+	return set, nil
+}
+
+// ParseKey parses a PEM encoded private or public key.
+func ParseKey(id string, data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, errors.New("no PEM block")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return Key{}, fmt.Errorf("unsupported PEM block type %s", block.Type)
+	}
+	if err != nil {
+		return Key{}, fmt.Errorf("parse %s: %w", block.Type, err)
+	}
+
+	return newKey(id, parsed)
+}
+
+func newKey(id string, parsed any) (Key, error) {
+	switch k := parsed.(type) {
+	case *ecdsa.PrivateKey:
+		key, err := newKey(id, &k.PublicKey)
+		if err != nil {
+			return Key{}, err
+		}
+		key.Private = k
+		return key, nil
+	case *rsa.PrivateKey:
+		key, err := newKey(id, &k.PublicKey)
+		if err != nil {
+			return Key{}, err
+		}
+		key.Private = k
+		return key, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return Key{}, errors.New("only P-256 EC keys are supported")
+		}
+		return Key{ID: id, Method: jwt.SigningMethodES256, Public: k}, nil
+	case *rsa.PublicKey:
+		return Key{ID: id, Method: jwt.SigningMethodRS256, Public: k}, nil
+	}
+	return Key{}, fmt.Errorf("unsupported key type %T", parsed)
+}
+
+func (s *KeySet) Get(id string) (Key, bool) {
+	key, ok := s.keys[id]
+	return key, ok
+}
+
+// JWKS returns the public keys sorted by kid.
+func (s *KeySet) JWKS() JWKS {
+	ids := make([]string, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(ids))}
+	for _, id := range ids {
+		jwks.Keys = append(jwks.Keys, s.keys[id].JWK())
+	}
+
+	return jwks
+}
+
+func (k Key) JWK() JWK {
+	jwk := JWK{
+		Kid: k.ID,
+		Use: "sig",
+		Alg: k.Method.Alg(),
+	}
+
+	switch pub := k.Public.(type) {
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	}
+
+	return jwk
+}
+
+// ParseJWK parses a public key of a JSON Web Key Set, e.g. of an OpenID Connect provider.
+func ParseJWK(jwk JWK) (Key, error) {
+	var parsed any
+	switch jwk.Kty {
+	case "EC":
+		if jwk.Crv != elliptic.P256().Params().Name {
+			return Key{}, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := decodeJWKInt(jwk.X)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode x: %w", err)
+		}
+		y, err := decodeJWKInt(jwk.Y)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode y: %w", err)
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return Key{}, errors.New("point is not on the curve")
+		}
+		parsed = &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	case "RSA":
+		n, err := decodeJWKInt(jwk.N)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode n: %w", err)
+		}
+		e, err := decodeJWKInt(jwk.E)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode e: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() > math.MaxInt32 {
+			return Key{}, errors.New("exponent is too large")
+		}
+		parsed = &rsa.PublicKey{N: n, E: int(e.Int64())}
+	default:
+		return Key{}, fmt.Errorf("unsupported key type %s", jwk.Kty)
+	}
+
+	key, err := newKey(jwk.Kid, parsed)
+	if err != nil {
+		return Key{}, err
+	}
+	if jwk.Alg != "" && jwk.Alg != key.Method.Alg() {
+		return Key{}, fmt.Errorf("unsupported alg %s", jwk.Alg)
+	}
+
+	return key, nil
+}
+
+func decodeJWKInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("empty value")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("base64.RawURLEncoding.DecodeString: %w", err)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,193 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	require.NoError(t, err)
+}
+
+func TestLoadKeySet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		dir := t.TempDir()
+
+		ecKey := generateECKey(t)
+		der, err := x509.MarshalECPrivateKey(ecKey)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, "ec1.pem"), "EC PRIVATE KEY", der)
+
+		rsaKey := generateRSAKey(t)
+		der, err = x509.MarshalPKCS8PrivateKey(rsaKey)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, "rsa1.pem"), "PRIVATE KEY", der)
+
+		der, err = x509.MarshalPKIXPublicKey(&generateECKey(t).PublicKey)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, "old.pem"), "PUBLIC KEY", der)
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("not a key"), 0o600))
+
+		set, err := LoadKeySet(dir)
+		require.NoError(t, err)
+
+		key, ok := set.Get("ec1")
+		require.True(t, ok)
+		assert.Equal(t, "ES256", key.Method.Alg())
+		assert.NotNil(t, key.Private)
+
+		key, ok = set.Get("rsa1")
+		require.True(t, ok)
+		assert.Equal(t, "RS256", key.Method.Alg())
+		assert.NotNil(t, key.Private)
+
+		key, ok = set.Get("old")
+		require.True(t, ok)
+		assert.Equal(t, "ES256", key.Method.Alg())
+		assert.Nil(t, key.Private)
+
+		_, ok = set.Get("README")
+		assert.False(t, ok)
+	})
+	t.Run("error.no_dir", func(t *testing.T) {
+		_, err := LoadKeySet(filepath.Join(t.TempDir(), "missing"))
+		require.Error(t, err)
+	})
+	t.Run("error.no_keys", func(t *testing.T) {
+		_, err := LoadKeySet(t.TempDir())
+		require.Error(t, err)
+	})
+	t.Run("error.not_pem", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ec1.pem"), []byte("secret"), 0o600))
+		_, err := LoadKeySet(dir)
+		require.Error(t, err)
+	})
+	t.Run("error.unsupported_curve", func(t *testing.T) {
+		dir := t.TempDir()
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, "ec1.pem"), "EC PRIVATE KEY", der)
+
+		_, err = LoadKeySet(dir)
+		require.Error(t, err)
+	})
+}
+
+func TestNewKeySet(t *testing.T) {
+	key, err := newKey("ec1", generateECKey(t))
+	require.NoError(t, err)
+
+	t.Run("error.no_keys", func(t *testing.T) {
+		_, err := NewKeySet()
+		require.Error(t, err)
+	})
+	t.Run("error.empty_id", func(t *testing.T) {
+		noID := key
+		noID.ID = ""
+		_, err := NewKeySet(noID)
+		require.Error(t, err)
+	})
+	t.Run("error.duplicate_id", func(t *testing.T) {
+		_, err := NewKeySet(key, key)
+		require.Error(t, err)
+	})
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	keys := newTestKeys(t)
+
+	jwks := keys.set.JWKS()
+	require.Len(t, jwks.Keys, 3)
+
+	// sorted by kid
+	ec, old, rsaJWK := jwks.Keys[0], jwks.Keys[1], jwks.Keys[2]
+	assert.Equal(t, "ec1", ec.Kid)
+	assert.Equal(t, "old", old.Kid)
+	assert.Equal(t, "rsa1", rsaJWK.Kid)
+
+	assert.Equal(t, "EC", ec.Kty)
+	assert.Equal(t, "sig", ec.Use)
+	assert.Equal(t, "ES256", ec.Alg)
+	assert.Equal(t, "P-256", ec.Crv)
+	x, err := base64.RawURLEncoding.DecodeString(ec.X)
+	require.NoError(t, err)
+	require.Len(t, x, 32)
+	assert.Equal(t, 0, keys.ec.X.Cmp(new(big.Int).SetBytes(x)))
+	y, err := base64.RawURLEncoding.DecodeString(ec.Y)
+	require.NoError(t, err)
+	assert.Equal(t, 0, keys.ec.Y.Cmp(new(big.Int).SetBytes(y)))
+	assert.Empty(t, ec.N)
+
+	assert.Equal(t, "RSA", rsaJWK.Kty)
+	assert.Equal(t, "RS256", rsaJWK.Alg)
+	assert.Equal(t, "AQAB", rsaJWK.E)
+	n, err := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	require.NoError(t, err)
+	assert.Equal(t, 0, keys.rsa.N.Cmp(new(big.Int).SetBytes(n)))
+	assert.Empty(t, rsaJWK.Crv)
+}
+
+func TestParseJWK(t *testing.T) {
+	keys := newTestKeys(t)
+
+	t.Run("success", func(t *testing.T) {
+		for _, jwk := range keys.set.JWKS().Keys {
+			key, err := ParseJWK(jwk)
+			require.NoError(t, err)
+
+			want, ok := keys.set.Get(jwk.Kid)
+			require.True(t, ok)
+			assert.Equal(t, want.ID, key.ID)
+			assert.Equal(t, want.Method, key.Method)
+			assert.Equal(t, want.Public, key.Public)
+			assert.Nil(t, key.Private)
+		}
+	})
+
+	t.Run("success.no_alg", func(t *testing.T) {
+		jwk := keys.set.JWKS().Keys[0]
+		jwk.Alg = ""
+
+		key, err := ParseJWK(jwk)
+		require.NoError(t, err)
+		assert.Equal(t, "ES256", key.Method.Alg())
+	})
+
+	tests := []struct {
+		name   string
+		modify func(jwk *JWK)
+	}{
+		{name: "error.kty", modify: func(jwk *JWK) { jwk.Kty = "oct" }},
+		{name: "error.crv", modify: func(jwk *JWK) { jwk.Crv = "P-384" }},
+		{name: "error.x", modify: func(jwk *JWK) { jwk.X = "!" }},
+		{name: "error.y_empty", modify: func(jwk *JWK) { jwk.Y = "" }},
+		{name: "error.not_on_curve", modify: func(jwk *JWK) { jwk.X, jwk.Y = jwk.Y, jwk.X }},
+		{name: "error.alg", modify: func(jwk *JWK) { jwk.Alg = "ES384" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwk := keys.set.JWKS().Keys[0]
+			tt.modify(&jwk)
+
+			_, err := ParseJWK(jwk)
+			require.Error(t, err)
+		})
+	}
+}
@@ -10,7 +10,9 @@
 package jwt
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-pvz/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -78,3 +80,394 @@ func (c *MocktokenProviderParseTokenCall) DoAndReturn(f func(string) (model.Toke
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockrevocationChecker is a mock of revocationChecker interface.
+type MockrevocationChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevocationCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockrevocationCheckerMockRecorder is the mock recorder for MockrevocationChecker.
+type MockrevocationCheckerMockRecorder struct {
+	mock *MockrevocationChecker
+}
+
+// NewMockrevocationChecker creates a new mock instance.
+func NewMockrevocationChecker(ctrl *gomock.Controller) *MockrevocationChecker {
+	mock := &MockrevocationChecker{ctrl: ctrl}
+	mock.recorder = &MockrevocationCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevocationChecker) EXPECT() *MockrevocationCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsRevoked mocks base method.
+func (m *MockrevocationChecker) IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockrevocationCheckerMockRecorder) IsRevoked(ctx, tokenID any) *MockrevocationCheckerIsRevokedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockrevocationChecker)(nil).IsRevoked), ctx, tokenID)
+	return &MockrevocationCheckerIsRevokedCall{Call: call}
+}
+
+// MockrevocationCheckerIsRevokedCall wrap *gomock.Call
+type MockrevocationCheckerIsRevokedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrevocationCheckerIsRevokedCall) Return(arg0 bool, arg1 error) *MockrevocationCheckerIsRevokedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrevocationCheckerIsRevokedCall) Do(f func(context.Context, model.TokenID) (bool, error)) *MockrevocationCheckerIsRevokedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrevocationCheckerIsRevokedCall) DoAndReturn(f func(context.Context, model.TokenID) (bool, error)) *MockrevocationCheckerIsRevokedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrevocationStore is a mock of revocationStore interface.
+type MockrevocationStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevocationStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockrevocationStoreMockRecorder is the mock recorder for MockrevocationStore.
+type MockrevocationStoreMockRecorder struct {
+	mock *MockrevocationStore
+}
+
+// NewMockrevocationStore creates a new mock instance.
+func NewMockrevocationStore(ctrl *gomock.Controller) *MockrevocationStore {
+	mock := &MockrevocationStore{ctrl: ctrl}
+	mock.recorder = &MockrevocationStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevocationStore) EXPECT() *MockrevocationStoreMockRecorder {
+	return m.recorder
+}
+
+// IsRevoked mocks base method.
+func (m *MockrevocationStore) IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockrevocationStoreMockRecorder) IsRevoked(ctx, tokenID any) *MockrevocationStoreIsRevokedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockrevocationStore)(nil).IsRevoked), ctx, tokenID)
+	return &MockrevocationStoreIsRevokedCall{Call: call}
+}
+
+// MockrevocationStoreIsRevokedCall wrap *gomock.Call
+type MockrevocationStoreIsRevokedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrevocationStoreIsRevokedCall) Return(arg0 bool, arg1 error) *MockrevocationStoreIsRevokedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrevocationStoreIsRevokedCall) Do(f func(context.Context, model.TokenID) (bool, error)) *MockrevocationStoreIsRevokedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrevocationStoreIsRevokedCall) DoAndReturn(f func(context.Context, model.TokenID) (bool, error)) *MockrevocationStoreIsRevokedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Revoke mocks base method.
+func (m *MockrevocationStore) Revoke(ctx context.Context, tokenID model.TokenID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, tokenID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockrevocationStoreMockRecorder) Revoke(ctx, tokenID, expiresAt any) *MockrevocationStoreRevokeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockrevocationStore)(nil).Revoke), ctx, tokenID, expiresAt)
+	return &MockrevocationStoreRevokeCall{Call: call}
+}
+
+// MockrevocationStoreRevokeCall wrap *gomock.Call
+type MockrevocationStoreRevokeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrevocationStoreRevokeCall) Return(arg0 error) *MockrevocationStoreRevokeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrevocationStoreRevokeCall) Do(f func(context.Context, model.TokenID, time.Time) error) *MockrevocationStoreRevokeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrevocationStoreRevokeCall) DoAndReturn(f func(context.Context, model.TokenID, time.Time) error) *MockrevocationStoreRevokeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserChecker is a mock of userChecker interface.
+type MockuserChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockuserCheckerMockRecorder is the mock recorder for MockuserChecker.
+type MockuserCheckerMockRecorder struct {
+	mock *MockuserChecker
+}
+
+// NewMockuserChecker creates a new mock instance.
+func NewMockuserChecker(ctrl *gomock.Controller) *MockuserChecker {
+	mock := &MockuserChecker{ctrl: ctrl}
+	mock.recorder = &MockuserCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserChecker) EXPECT() *MockuserCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsActive mocks base method.
+func (m *MockuserChecker) IsActive(ctx context.Context, userID model.UserID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockuserCheckerMockRecorder) IsActive(ctx, userID any) *MockuserCheckerIsActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockuserChecker)(nil).IsActive), ctx, userID)
+	return &MockuserCheckerIsActiveCall{Call: call}
+}
+
+// MockuserCheckerIsActiveCall wrap *gomock.Call
+type MockuserCheckerIsActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserCheckerIsActiveCall) Return(arg0 bool, arg1 error) *MockuserCheckerIsActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserCheckerIsActiveCall) Do(f func(context.Context, model.UserID) (bool, error)) *MockuserCheckerIsActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserCheckerIsActiveCall) DoAndReturn(f func(context.Context, model.UserID) (bool, error)) *MockuserCheckerIsActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockactiveUserStore is a mock of activeUserStore interface.
+type MockactiveUserStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockactiveUserStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockactiveUserStoreMockRecorder is the mock recorder for MockactiveUserStore.
+type MockactiveUserStoreMockRecorder struct {
+	mock *MockactiveUserStore
+}
+
+// NewMockactiveUserStore creates a new mock instance.
+func NewMockactiveUserStore(ctrl *gomock.Controller) *MockactiveUserStore {
+	mock := &MockactiveUserStore{ctrl: ctrl}
+	mock.recorder = &MockactiveUserStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockactiveUserStore) EXPECT() *MockactiveUserStoreMockRecorder {
+	return m.recorder
+}
+
+// IsActive mocks base method.
+func (m *MockactiveUserStore) IsActive(ctx context.Context, userID model.UserID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockactiveUserStoreMockRecorder) IsActive(ctx, userID any) *MockactiveUserStoreIsActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockactiveUserStore)(nil).IsActive), ctx, userID)
+	return &MockactiveUserStoreIsActiveCall{Call: call}
+}
+
+// MockactiveUserStoreIsActiveCall wrap *gomock.Call
+type MockactiveUserStoreIsActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockactiveUserStoreIsActiveCall) Return(arg0 bool, arg1 error) *MockactiveUserStoreIsActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockactiveUserStoreIsActiveCall) Do(f func(context.Context, model.UserID) (bool, error)) *MockactiveUserStoreIsActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockactiveUserStoreIsActiveCall) DoAndReturn(f func(context.Context, model.UserID) (bool, error)) *MockactiveUserStoreIsActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockapiKeyStore is a mock of apiKeyStore interface.
+type MockapiKeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyStoreMockRecorder is the mock recorder for MockapiKeyStore.
+type MockapiKeyStoreMockRecorder struct {
+	mock *MockapiKeyStore
+}
+
+// NewMockapiKeyStore creates a new mock instance.
+func NewMockapiKeyStore(ctrl *gomock.Controller) *MockapiKeyStore {
+	mock := &MockapiKeyStore{ctrl: ctrl}
+	mock.recorder = &MockapiKeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyStore) EXPECT() *MockapiKeyStoreMockRecorder {
+	return m.recorder
+}
+
+// GetByHash mocks base method.
+func (m *MockapiKeyStore) GetByHash(ctx context.Context, keyHash []byte) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, keyHash)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockapiKeyStoreMockRecorder) GetByHash(ctx, keyHash any) *MockapiKeyStoreGetByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockapiKeyStore)(nil).GetByHash), ctx, keyHash)
+	return &MockapiKeyStoreGetByHashCall{Call: call}
+}
+
+// MockapiKeyStoreGetByHashCall wrap *gomock.Call
+type MockapiKeyStoreGetByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStoreGetByHashCall) Return(arg0 model.APIKey, arg1 error) *MockapiKeyStoreGetByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStoreGetByHashCall) Do(f func(context.Context, []byte) (model.APIKey, error)) *MockapiKeyStoreGetByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStoreGetByHashCall) DoAndReturn(f func(context.Context, []byte) (model.APIKey, error)) *MockapiKeyStoreGetByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TouchLastUsed mocks base method.
+func (m *MockapiKeyStore) TouchLastUsed(ctx context.Context, apiKeyID model.APIKeyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchLastUsed", ctx, apiKeyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchLastUsed indicates an expected call of TouchLastUsed.
+func (mr *MockapiKeyStoreMockRecorder) TouchLastUsed(ctx, apiKeyID any) *MockapiKeyStoreTouchLastUsedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchLastUsed", reflect.TypeOf((*MockapiKeyStore)(nil).TouchLastUsed), ctx, apiKeyID)
+	return &MockapiKeyStoreTouchLastUsedCall{Call: call}
+}
+
+// MockapiKeyStoreTouchLastUsedCall wrap *gomock.Call
+type MockapiKeyStoreTouchLastUsedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStoreTouchLastUsedCall) Return(arg0 error) *MockapiKeyStoreTouchLastUsedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStoreTouchLastUsedCall) Do(f func(context.Context, model.APIKeyID) error) *MockapiKeyStoreTouchLastUsedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStoreTouchLastUsedCall) DoAndReturn(f func(context.Context, model.APIKeyID) error) *MockapiKeyStoreTouchLastUsedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
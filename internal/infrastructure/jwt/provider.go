@@ -3,7 +3,6 @@ package jwt
 import (
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -11,46 +10,87 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
-const (
-	tokenLifetime = time.Hour * 72
-)
-
 var (
 	ErrInvalidJWTToken           = errors.New("invalid JWT token")
 	ErrInvalidUserIDInJWTToken   = errors.New("invalid userID in JWT token")
 	ErrInvalidUsernameInJWTToken = errors.New("invalid username in JWT token")
 	ErrInvalidRoleInJWTToken     = errors.New("invalid role in JWT token")
+	ErrInvalidIDInJWTToken       = errors.New("invalid jti in JWT token")
+	ErrUnknownKeyID              = errors.New("unknown kid in JWT token")
+	ErrInvalidPVZIDsInJWTToken   = errors.New("invalid pvzIds in JWT token")
+	ErrInvalidDummyInJWTToken    = errors.New("invalid dummy in JWT token")
 )
 
 type Provider struct {
-	secret []byte
+	keys          *KeySet
+	signingKey    Key
+	tokenLifetime time.Duration
 }
 
-func NewProviderFromEnv() (*Provider, error) {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		return nil, errors.New("env JWT_SECRET is empty")
+// New creates a provider signing tokens with the key signingKeyID. Tokens signed with any key of the set are accepted,
+// so a new key can be added to all instances before it is used for signing, and an old one is kept until its
+// tokens expire.
+func New(keys *KeySet, signingKeyID string, tokenLifetime time.Duration) (*Provider, error) {
+	if keys == nil {
+		return nil, errors.New("keys is nil")
+	}
+	signingKey, ok := keys.Get(signingKeyID)
+	if !ok {
+		return nil, fmt.Errorf("signing key %q not found", signingKeyID)
+	}
+	if signingKey.Private == nil {
+		return nil, fmt.Errorf("signing key %q has no private key", signingKeyID)
+	}
+	if tokenLifetime <= 0 {
+		return nil, errors.New("tokenLifetime must be positive")
 	}
 
-	return New(secret), nil
+	return &Provider{
+		keys:          keys,
+		signingKey:    signingKey,
+		tokenLifetime: tokenLifetime,
+	}, nil
 }
 
-func New(secret string) *Provider {
-	return &Provider{
-		secret: []byte(secret),
-	}
+func (p *Provider) CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess,
+) (string, error) {
+	return p.sign(p.claims(email, userID, role, access))
+}
+
+// CreateDummyToken creates a test token of /dummyLogin. It belongs to no user, gives access to all PVZs
+// and is marked with the dummy claim, so the mode of the environment decides what the token may do.
+func (p *Provider) CreateDummyToken(role model.UserRole) (string, error) {
+	claims := p.claims("", model.DefaultUserID, role, model.AllPVZAccess())
+	claims["dummy"] = true
+	return p.sign(claims)
 }
 
-func (p *Provider) CreateToken(email string, userID model.UserID, role model.UserRole) (string, error) {
+func (p *Provider) claims(email string, userID model.UserID, role model.UserRole, access model.PVZAccess,
+) jwt.MapClaims {
+	pvzIDs := make([]string, 0, len(access.PVZIDs))
+	for _, pvzID := range access.PVZIDs {
+		pvzIDs = append(pvzIDs, pvzID.UUID().String())
+	}
+
 	claims := jwt.MapClaims{
 		"email":  email,
 		"userID": userID.UUID().String(),
 		"role":   role.String(),
-		"exp":    time.Now().Add(tokenLifetime).Unix(),
+		"pvzIds": pvzIDs,
+		"jti":    model.NewTokenID().UUID().String(),
+		"exp":    time.Now().Add(p.tokenLifetime).Unix(),
+	}
+	if access.All {
+		claims["allPvz"] = true
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return claims
+}
+
+func (p *Provider) sign(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(p.signingKey.Method, claims)
+	token.Header["kid"] = p.signingKey.ID
 
-	tokenStr, err := token.SignedString(p.secret)
+	tokenStr, err := token.SignedString(p.signingKey.Private)
 	if err != nil {
 		return "", fmt.Errorf("token.SignedString: %w", err)
 	}
@@ -58,10 +98,13 @@ func (p *Provider) CreateToken(email string, userID model.UserID, role model.Use
 	return tokenStr, nil
 }
 
+// JWKS returns the public keys tokens can be verified with.
+func (p *Provider) JWKS() JWKS {
+	return p.keys.JWKS()
+}
+
 func (p *Provider) ParseToken(tokenStr string) (model.TokenInfo, error) {
-	token, err := jwt.Parse(tokenStr, func(_ *jwt.Token) (any, error) {
-		return p.secret, nil
-	})
+	token, err := jwt.Parse(tokenStr, p.verificationKey)
 	if err != nil {
 		return model.TokenInfo{}, fmt.Errorf("jwt.Parse: %w", err)
 	}
@@ -95,9 +138,90 @@ func (p *Provider) ParseToken(tokenStr string) (model.TokenInfo, error) {
 		return model.TokenInfo{}, ErrInvalidRoleInJWTToken
 	}
 
+	access, err := parsePVZAccess(claims)
+	if err != nil {
+		return model.TokenInfo{}, err
+	}
+
+	// tokens of the default user were issued by /dummyLogin before the dummy claim was added
+	dummy := userID == model.DefaultUserID
+	if rawDummy, ok := claims["dummy"]; ok {
+		isDummy, ok := rawDummy.(bool)
+		if !ok {
+			return model.TokenInfo{}, ErrInvalidDummyInJWTToken
+		}
+		dummy = dummy || isDummy
+	}
+
+	rawTokenID, ok := claims["jti"].(string)
+	if !ok {
+		return model.TokenInfo{}, ErrInvalidIDInJWTToken
+	}
+	tokenID, err := model.ParseTokenID(rawTokenID)
+	if err != nil {
+		return model.TokenInfo{}, ErrInvalidIDInJWTToken
+	}
+
+	// exp is checked by jwt.Parse, a token without exp never expires
+	var expiresAt time.Time
+	if exp, ok := claims["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(exp), 0)
+	}
+
 	return model.TokenInfo{
-		UserID:   userID,
-		Email:    email,
-		UserRole: role,
+		UserID:    userID,
+		Email:     email,
+		UserRole:  role,
+		PVZAccess: access,
+		Dummy:     dummy,
+		TokenID:   tokenID,
+		ExpiresAt: expiresAt,
 	}, nil
 }
+
+func (p *Provider) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := p.keys.Get(kid)
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	// the algorithm is taken from the key, not from the token, otherwise a token could pick a weaker one
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s for kid %s", token.Method.Alg(), kid)
+	}
+	return key.Public, nil
+}
+
+// parsePVZAccess reads pvzIds and allPvz claims, both are optional: a token without them gives no access to PVZs.
+func parsePVZAccess(claims jwt.MapClaims) (model.PVZAccess, error) {
+	var access model.PVZAccess
+
+	if rawAll, ok := claims["allPvz"]; ok {
+		access.All, ok = rawAll.(bool)
+		if !ok {
+			return model.PVZAccess{}, ErrInvalidPVZIDsInJWTToken
+		}
+	}
+
+	rawPVZIDs, ok := claims["pvzIds"]
+	if !ok {
+		return access, nil
+	}
+	list, ok := rawPVZIDs.([]any)
+	if !ok {
+		return model.PVZAccess{}, ErrInvalidPVZIDsInJWTToken
+	}
+	for _, rawPVZID := range list {
+		s, ok := rawPVZID.(string)
+		if !ok {
+			return model.PVZAccess{}, ErrInvalidPVZIDsInJWTToken
+		}
+		pvzID, err := model.ParsePVZID(s)
+		if err != nil {
+			return model.PVZAccess{}, ErrInvalidPVZIDsInJWTToken
+		}
+		access.PVZIDs = append(access.PVZIDs, pvzID)
+	}
+
+	return access, nil
+}
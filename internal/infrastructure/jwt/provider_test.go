@@ -1,6 +1,11 @@
 package jwt
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"testing"
 	"time"
 
@@ -11,14 +16,107 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+type testKeys struct {
+	set   *KeySet
+	ec    *ecdsa.PrivateKey
+	rsa   *rsa.PrivateKey
+	old   *ecdsa.PrivateKey
+	other *ecdsa.PrivateKey
+}
+
+// newTestKeys creates a set with an EC signing key "ec1", an RSA key "rsa1" and a verification only key "old".
+// other is not in the set.
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	keys := testKeys{
+		ec:    generateECKey(t),
+		rsa:   generateRSAKey(t),
+		old:   generateECKey(t),
+		other: generateECKey(t),
+	}
+
+	ecKey, err := newKey("ec1", keys.ec)
+	require.NoError(t, err)
+	rsaKey, err := newKey("rsa1", keys.rsa)
+	require.NoError(t, err)
+	oldKey, err := newKey("old", &keys.old.PublicKey)
+	require.NoError(t, err)
+
+	keys.set, err = NewKeySet(ecKey, rsaKey, oldKey)
+	require.NoError(t, err)
+
+	return keys
+}
+
+func generateECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key crypto.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	tokenStr, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return tokenStr
+}
+
+func TestNew(t *testing.T) {
+	keys := newTestKeys(t)
+
+	t.Run("success", func(t *testing.T) {
+		res, err := New(keys.set, "ec1", time.Hour)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, "ec1", time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.unknown_signing_key", func(t *testing.T) {
+		res, err := New(keys.set, "ec2", time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.signing_key_without_private_key", func(t *testing.T) {
+		res, err := New(keys.set, "old", time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_tokenLifetime", func(t *testing.T) {
+		res, err := New(keys.set, "ec1", 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
 func TestProvider_CreateToken(t *testing.T) {
-	secret := "secret"
+	keys := newTestKeys(t)
 	userID := model.NewUserID()
+	pvzID := model.NewPVZID()
 
 	type args struct {
 		email  string
 		userID model.UserID
 		role   model.UserRole
+		access model.PVZAccess
 	}
 	tests := []struct {
 		name    string
@@ -32,21 +130,29 @@ func TestProvider_CreateToken(t *testing.T) {
 				email:  "test@test.com",
 				userID: userID,
 				role:   model.UserRoleModerator,
+				access: model.AllPVZAccess(),
 			},
 			check: func(t *testing.T, got string) {
 				require.NotEmpty(t, got)
 				token, err := jwt.Parse(got, func(_ *jwt.Token) (any, error) {
-					return []byte(secret), nil
+					return &keys.ec.PublicKey, nil
 				})
 				require.NoError(t, err)
+				assert.Equal(t, "ES256", token.Method.Alg())
+				assert.Equal(t, "ec1", token.Header["kid"])
 
 				claims, _ := token.Claims.(jwt.MapClaims)
 				assert.InDelta(t, claims["exp"], time.Now().Add(time.Hour*72).Unix(), 10)
 				delete(claims, "exp")
+				_, err = model.ParseTokenID(claims["jti"].(string))
+				require.NoError(t, err)
+				delete(claims, "jti")
 				assert.Equal(t, jwt.MapClaims{
 					"email":  "test@test.com",
 					"userID": userID.UUID().String(),
 					"role":   "moderator",
+					"pvzIds": []any{},
+					"allPvz": true,
 				}, claims)
 			},
 			wantErr: false,
@@ -57,21 +163,28 @@ func TestProvider_CreateToken(t *testing.T) {
 				email:  "test@test.com",
 				userID: userID,
 				role:   model.UserRoleEmployee,
+				access: model.PVZAccess{PVZIDs: []model.PVZID{pvzID}},
 			},
 			check: func(t *testing.T, got string) {
 				require.NotEmpty(t, got)
 				token, err := jwt.Parse(got, func(_ *jwt.Token) (any, error) {
-					return []byte(secret), nil
+					return &keys.ec.PublicKey, nil
 				})
 				require.NoError(t, err)
+				assert.Equal(t, "ES256", token.Method.Alg())
+				assert.Equal(t, "ec1", token.Header["kid"])
 
 				claims, _ := token.Claims.(jwt.MapClaims)
 				assert.InDelta(t, claims["exp"], time.Now().Add(time.Hour*72).Unix(), 10)
 				delete(claims, "exp")
+				_, err = model.ParseTokenID(claims["jti"].(string))
+				require.NoError(t, err)
+				delete(claims, "jti")
 				assert.Equal(t, jwt.MapClaims{
 					"email":  "test@test.com",
 					"userID": userID.UUID().String(),
 					"role":   "employee",
+					"pvzIds": []any{pvzID.UUID().String()},
 				}, claims)
 			},
 			wantErr: false,
@@ -79,18 +192,37 @@ func TestProvider_CreateToken(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := New(secret)
-			got, err := p.CreateToken(tt.args.email, tt.args.userID, tt.args.role)
+			p, err := New(keys.set, "ec1", time.Hour*72)
+			require.NoError(t, err)
+			got, err := p.CreateToken(tt.args.email, tt.args.userID, tt.args.role, tt.args.access)
 			require.Equal(t, err != nil, tt.wantErr)
 			tt.check(t, got)
 		})
 	}
 }
 
+func TestProvider_CreateDummyToken(t *testing.T) {
+	keys := newTestKeys(t)
+	p, err := New(keys.set, "ec1", time.Hour)
+	require.NoError(t, err)
+
+	got, err := p.CreateDummyToken(model.UserRoleEmployee)
+	require.NoError(t, err)
+
+	info, err := p.ParseToken(got)
+	require.NoError(t, err)
+	assert.True(t, info.Dummy)
+	assert.Equal(t, model.DefaultUserID, info.UserID)
+	assert.Equal(t, model.UserRoleEmployee, info.UserRole)
+	assert.Equal(t, model.AllPVZAccess(), info.PVZAccess)
+}
+
 func TestProvider_ParseToken(t *testing.T) {
-	secret := "secret"
+	keys := newTestKeys(t)
 	exp := time.Now().Add(time.Hour * 72).Unix()
 	userID := model.NewUserID()
+	tokenID := model.NewTokenID()
+	pvzID := model.NewPVZID()
 
 	tests := []struct {
 		name     string
@@ -105,19 +237,17 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": userID.UUID().String(),
 					"role":   "moderator",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want: model.TokenInfo{
-				Email:    "email",
-				UserID:   userID,
-				UserRole: model.UserRoleModerator,
+				Email:     "email",
+				UserID:    userID,
+				UserRole:  model.UserRoleModerator,
+				TokenID:   tokenID,
+				ExpiresAt: time.Unix(exp, 0),
 			},
 			wantErr: false,
 		},
@@ -128,22 +258,176 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": userID.UUID().String(),
 					"role":   "employee",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want: model.TokenInfo{
+				Email:     "email",
+				UserID:    userID,
+				UserRole:  model.UserRoleEmployee,
+				TokenID:   tokenID,
+				ExpiresAt: time.Unix(exp, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.pvz_ids",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"pvzIds": []string{pvzID.UUID().String()},
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want: model.TokenInfo{
+				Email:     "email",
+				UserID:    userID,
+				UserRole:  model.UserRoleEmployee,
+				PVZAccess: model.PVZAccess{PVZIDs: []model.PVZID{pvzID}},
+				TokenID:   tokenID,
+				ExpiresAt: time.Unix(exp, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.all_pvz",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"pvzIds": []string{},
+					"allPvz": true,
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want: model.TokenInfo{
+				Email:     "email",
+				UserID:    userID,
+				UserRole:  model.UserRoleEmployee,
+				PVZAccess: model.AllPVZAccess(),
+				TokenID:   tokenID,
+				ExpiresAt: time.Unix(exp, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success.dummy",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "",
+					"userID": model.DefaultUserID.UUID().String(),
+					"role":   "moderator",
+					"allPvz": true,
+					"dummy":  true,
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want: model.TokenInfo{
-				Email:    "email",
-				UserID:   userID,
-				UserRole: model.UserRoleEmployee,
+				UserID:    model.DefaultUserID,
+				UserRole:  model.UserRoleModerator,
+				PVZAccess: model.AllPVZAccess(),
+				Dummy:     true,
+				TokenID:   tokenID,
+				ExpiresAt: time.Unix(exp, 0),
 			},
 			wantErr: false,
 		},
+		{
+			name: "success.dummy_without_claim",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "",
+					"userID": model.DefaultUserID.UUID().String(),
+					"role":   "moderator",
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want: model.TokenInfo{
+				UserID:    model.DefaultUserID,
+				UserRole:  model.UserRoleModerator,
+				Dummy:     true,
+				TokenID:   tokenID,
+				ExpiresAt: time.Unix(exp, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "err.wrong_claims.dummy_wrong_type",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"dummy":  "true",
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want:    model.TokenInfo{},
+			wantErr: true,
+		},
+		{
+			name: "err.wrong_claims.invalid_pvz_ids",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"pvzIds": []string{"123"},
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want:    model.TokenInfo{},
+			wantErr: true,
+		},
+		{
+			name: "err.wrong_claims.pvz_ids_wrong_type",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"pvzIds": pvzID.UUID().String(),
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want:    model.TokenInfo{},
+			wantErr: true,
+		},
+		{
+			name: "err.wrong_claims.all_pvz_wrong_type",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"allPvz": "true",
+					"jti":    tokenID.UUID().String(),
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want:    model.TokenInfo{},
+			wantErr: true,
+		},
 		{
 			name: "err.wrong_sign",
 			getToken: func(t *testing.T) string {
@@ -151,14 +435,10 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": userID.UUID().String(),
 					"role":   "employee",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret + "1"))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.other, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -170,14 +450,10 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": userID.UUID().String(),
 					"role":   "employee",
+					"jti":    tokenID.UUID().String(),
 					"exp":    time.Now().Add(-time.Hour).Unix(),
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -189,14 +465,10 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": "123",
 					"role":   "employee",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -208,14 +480,10 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": 1,
 					"role":   "employee",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -227,14 +495,10 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  123,
 					"userID": userID.UUID().String(),
 					"role":   "employee",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -245,14 +509,39 @@ func TestProvider_ParseToken(t *testing.T) {
 				claims := jwt.MapClaims{
 					"email":  "email",
 					"userID": userID.UUID().String(),
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want:    model.TokenInfo{},
+			wantErr: true,
+		},
+		{
+			name: "err.wrong_claims.no_jti",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
+			},
+			want:    model.TokenInfo{},
+			wantErr: true,
+		},
+		{
+			name: "err.wrong_claims.invalid_jti",
+			getToken: func(t *testing.T) string {
+				claims := jwt.MapClaims{
+					"email":  "email",
+					"userID": userID.UUID().String(),
+					"role":   "employee",
+					"jti":    "123",
+					"exp":    exp,
+				}
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -264,14 +553,10 @@ func TestProvider_ParseToken(t *testing.T) {
 					"email":  "email",
 					"userID": userID.UUID().String(),
 					"role":   "invalid",
+					"jti":    tokenID.UUID().String(),
 					"exp":    exp,
 				}
-				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-				tokenStr, err := token.SignedString([]byte(secret))
-				require.NoError(t, err)
-
-				return tokenStr
+				return signToken(t, jwt.SigningMethodES256, "ec1", keys.ec, claims)
 			},
 			want:    model.TokenInfo{},
 			wantErr: true,
@@ -279,7 +564,8 @@ func TestProvider_ParseToken(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := New(secret)
+			p, err := New(keys.set, "ec1", time.Hour*72)
+			require.NoError(t, err)
 			token := tt.getToken(t)
 			got, err := p.ParseToken(token)
 			require.Equal(t, err != nil, tt.wantErr)
@@ -287,3 +573,73 @@ func TestProvider_ParseToken(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_ParseToken_Keys(t *testing.T) {
+	keys := newTestKeys(t)
+	userID := model.NewUserID()
+	tokenID := model.NewTokenID()
+	exp := time.Now().Add(time.Hour).Unix()
+
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"email":  "email",
+			"userID": userID.UUID().String(),
+			"role":   "employee",
+			"jti":    tokenID.UUID().String(),
+			"exp":    exp,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name:  "success.rsa",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa1", keys.rsa, claims()),
+		},
+		{
+			name:  "success.verification_only_key",
+			token: signToken(t, jwt.SigningMethodES256, "old", keys.old, claims()),
+		},
+		{
+			name:      "err.no_kid",
+			token:     signToken(t, jwt.SigningMethodES256, "", keys.ec, claims()),
+			wantErr:   true,
+			wantErrIs: ErrUnknownKeyID,
+		},
+		{
+			name:      "err.unknown_kid",
+			token:     signToken(t, jwt.SigningMethodES256, "ec2", keys.other, claims()),
+			wantErr:   true,
+			wantErrIs: ErrUnknownKeyID,
+		},
+		{
+			name:    "err.wrong_key_for_kid",
+			token:   signToken(t, jwt.SigningMethodRS256, "old", keys.rsa, claims()),
+			wantErr: true,
+		},
+		{
+			name:    "err.hmac_with_public_key",
+			token:   signToken(t, jwt.SigningMethodHS256, "ec1", []byte("secret"), claims()),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(keys.set, "ec1", time.Hour)
+			require.NoError(t, err)
+
+			got, err := p.ParseToken(tt.token)
+			require.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErrIs != nil {
+				require.ErrorIs(t, err, tt.wantErrIs)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, userID, got.UserID)
+			}
+		})
+	}
+}
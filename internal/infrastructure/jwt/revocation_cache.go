@@ -0,0 +1,62 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// RevocationCache keeps the results of token revocation checks for ttl, so only the first request
+// with a token goes to the store. Revoke writes through, the instance that revoked a token rejects it at once,
+// other instances reject it after their cached result expires.
+type RevocationCache struct {
+	store   revocationStore
+	now     func() time.Time
+	results *ttlCache[model.TokenID, bool]
+}
+
+func NewRevocationCache(store revocationStore, ttl time.Duration) (*RevocationCache, error) {
+	if store == nil {
+		return nil, errors.New("store is nil")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("ttl should be positive")
+	}
+	return &RevocationCache{
+		store:   store,
+		now:     time.Now,
+		results: newTTLCache[model.TokenID, bool](ttl),
+	}, nil
+}
+
+func (c *RevocationCache) IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error) {
+	now := c.now()
+
+	if revoked, ok := c.results.get(tokenID, now); ok {
+		return revoked, nil
+	}
+
+	revoked, err := c.store.IsRevoked(ctx, tokenID)
+	if err != nil {
+		return false, fmt.Errorf("store.IsRevoked: %w", err)
+	}
+
+	c.results.set(tokenID, revoked, now)
+
+	return revoked, nil
+}
+
+// Revoke stores the token id until the token expires.
+func (c *RevocationCache) Revoke(ctx context.Context, tokenID model.TokenID, expiresAt time.Time) error {
+	err := c.store.Revoke(ctx, tokenID, expiresAt)
+	if err != nil {
+		return fmt.Errorf("store.Revoke: %w", err)
+	}
+
+	c.results.set(tokenID, true, c.now())
+
+	return nil
+}
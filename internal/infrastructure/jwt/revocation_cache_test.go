@@ -0,0 +1,106 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewRevocationCache(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := NewRevocationCache(NewMockrevocationStore(ctrl), time.Minute)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewRevocationCache(nil, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := NewRevocationCache(NewMockrevocationStore(ctrl), 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestRevocationCache_IsRevoked(t *testing.T) {
+	tokenID := model.NewTokenID()
+	now := time.Now()
+
+	t.Run("success.cached_until_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockrevocationStore(ctrl)
+		store.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil).Times(2)
+
+		cache, err := NewRevocationCache(store, time.Minute)
+		require.NoError(t, err)
+		cache.now = func() time.Time { return now }
+
+		for range 3 {
+			revoked, err := cache.IsRevoked(context.Background(), tokenID)
+			require.NoError(t, err)
+			require.False(t, revoked)
+		}
+
+		cache.now = func() time.Time { return now.Add(time.Minute) }
+		revoked, err := cache.IsRevoked(context.Background(), tokenID)
+		require.NoError(t, err)
+		require.False(t, revoked)
+	})
+	t.Run("success.revoke_writes_through", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockrevocationStore(ctrl)
+		store.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+		store.EXPECT().Revoke(gomock.Any(), tokenID, now.Add(time.Hour)).Return(nil)
+
+		cache, err := NewRevocationCache(store, time.Minute)
+		require.NoError(t, err)
+		cache.now = func() time.Time { return now }
+
+		revoked, err := cache.IsRevoked(context.Background(), tokenID)
+		require.NoError(t, err)
+		require.False(t, revoked)
+
+		require.NoError(t, cache.Revoke(context.Background(), tokenID, now.Add(time.Hour)))
+
+		revoked, err = cache.IsRevoked(context.Background(), tokenID)
+		require.NoError(t, err)
+		require.True(t, revoked)
+	})
+	t.Run("error.IsRevoked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockrevocationStore(ctrl)
+		store.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, assert.AnError)
+
+		cache, err := NewRevocationCache(store, time.Minute)
+		require.NoError(t, err)
+
+		_, err = cache.IsRevoked(context.Background(), tokenID)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("error.Revoke", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockrevocationStore(ctrl)
+		store.EXPECT().Revoke(gomock.Any(), tokenID, now).Return(assert.AnError)
+		store.EXPECT().IsRevoked(gomock.Any(), tokenID).Return(false, nil)
+
+		cache, err := NewRevocationCache(store, time.Minute)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, cache.Revoke(context.Background(), tokenID, now), assert.AnError)
+
+		// nothing is cached on error
+		revoked, err := cache.IsRevoked(context.Background(), tokenID)
+		require.NoError(t, err)
+		require.False(t, revoked)
+	})
+}
@@ -0,0 +1,69 @@
+package jwt
+
+import (
+	"sync"
+	"time"
+)
+
+const minSweepSize = 1024
+
+// ttlCache keeps values for ttl. Callers pass the current time, so they control the clock in tests.
+type ttlCache[K comparable, V any] struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	entries   map[K]ttlEntry[V]
+	sweepSize int
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		ttl:       ttl,
+		entries:   make(map[K]ttlEntry[V]),
+		sweepSize: minSweepSize,
+	}
+}
+
+// get returns the value of the key if it has not expired by now.
+func (c *ttlCache[K, V]) get(key K, now time.Time) (V, bool) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok || !now.Before(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache[K, V]) set(key K, value V, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = ttlEntry[V]{
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	}
+
+	// expired entries are swept when the map doubles, so the sweep cost is amortized over insertions
+	if len(c.entries) >= c.sweepSize {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		c.sweepSize = max(minSweepSize, 2*len(c.entries))
+	}
+}
+
+func (c *ttlCache[K, V]) delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
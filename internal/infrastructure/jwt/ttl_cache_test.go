@@ -0,0 +1,50 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestTTLCache(t *testing.T) {
+	userID := model.NewUserID()
+	now := time.Now()
+
+	t.Run("success.expires_after_ttl", func(t *testing.T) {
+		cache := newTTLCache[model.UserID, bool](time.Minute)
+
+		_, ok := cache.get(userID, now)
+		require.False(t, ok)
+
+		cache.set(userID, true, now)
+
+		value, ok := cache.get(userID, now.Add(time.Minute-time.Nanosecond))
+		require.True(t, ok)
+		require.True(t, value)
+
+		_, ok = cache.get(userID, now.Add(time.Minute))
+		require.False(t, ok)
+	})
+	t.Run("success.delete", func(t *testing.T) {
+		cache := newTTLCache[model.UserID, bool](time.Minute)
+		cache.set(userID, true, now)
+
+		cache.delete(userID)
+
+		_, ok := cache.get(userID, now)
+		require.False(t, ok)
+	})
+	t.Run("success.sweep", func(t *testing.T) {
+		cache := newTTLCache[model.UserID, bool](time.Minute)
+
+		for range minSweepSize - 1 {
+			cache.set(model.NewUserID(), true, now)
+		}
+
+		cache.set(userID, true, now.Add(time.Minute))
+		require.Len(t, cache.entries, 1)
+	})
+}
@@ -22,5 +22,9 @@ func (m *Metrics) HTTPServerMW(next http.Handler) http.Handler {
 }
 
 func httpEndpoint(r *http.Request) string {
-	return strings.ReplaceAll(r.Pattern, " ", "__")
+	return endpointLabel(r.Pattern)
+}
+
+func endpointLabel(pattern string) string {
+	return strings.ReplaceAll(pattern, " ", "__")
 }
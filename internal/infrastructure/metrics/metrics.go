@@ -11,6 +11,8 @@ type Metrics struct {
 
 	httpRequestsTotal     *prometheus.CounterVec
 	httpResponseTime      *prometheus.GaugeVec
+	httpRateLimitedTotal  *prometheus.CounterVec
+	authModeInfo          *prometheus.GaugeVec
 	pvzCount              prometheus.Counter
 	receptionCreatedCount prometheus.Counter
 	productAddedCount     prometheus.Counter
@@ -26,6 +28,14 @@ func collectors(m *Metrics) []prometheus.Collector {
 			Name: "http_response_time",
 			Help: "Время ответа на HTTP запросы в мс",
 		}, []string{"endpoint", "status_code"})),
+		register(&m.httpRateLimitedTotal, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_rate_limited_total",
+			Help: "Количество HTTP запросов, отклоненных ограничением частоты",
+		}, []string{"endpoint", "client_type"})),
+		register(&m.authModeInfo, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "auth_mode_info",
+			Help: "Режим аутентификации и доступ тестовых токенов /dummyLogin",
+		}, []string{"mode", "dummy_tokens"})),
 		register(&m.pvzCount, prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "pvz_registered_count",
 			Help: "Количество созданных ПВЗ",
@@ -71,3 +81,19 @@ func (m *Metrics) ReceptionCreatedCountInc() {
 func (m *Metrics) ProductAddedCountInc() {
 	m.productAddedCount.Inc()
 }
+
+// ProductAddedCountAdd counts products added by one batch import.
+func (m *Metrics) ProductAddedCountAdd(count int) {
+	m.productAddedCount.Add(float64(count))
+}
+
+// RateLimitedInc counts a request rejected by the rate limiter, clientType is user, api_key or ip.
+func (m *Metrics) RateLimitedInc(route, clientType string) {
+	m.httpRateLimitedTotal.WithLabelValues(endpointLabel(route), clientType).Inc()
+}
+
+// SetAuthMode exposes the active auth mode, dummyTokens is full, read_only or denied.
+func (m *Metrics) SetAuthMode(mode, dummyTokens string) {
+	m.authModeInfo.Reset()
+	m.authModeInfo.WithLabelValues(mode, dummyTokens).Set(1)
+}
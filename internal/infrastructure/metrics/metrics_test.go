@@ -77,6 +77,9 @@ func TestMetrics_RunHTTPServer(t *testing.T) {
 	m.ProductAddedCountInc()
 	m.ProductAddedCountInc()
 	m.ProductAddedCountInc()
+	m.ProductAddedCountAdd(300)
+	m.RateLimitedInc("POST /products", "user")
+	m.SetAuthMode("production", "denied")
 
 	resp, err = http.Get("http://localhost:9001/metrics")
 	require.NoError(t, err)
@@ -90,9 +93,11 @@ func TestMetrics_RunHTTPServer(t *testing.T) {
 
 	assert.Contains(t, respString, "pvz_registered_count 1")
 	assert.Contains(t, respString, "reception_created_count 2")
-	assert.Contains(t, respString, "product_added_count 3")
+	assert.Contains(t, respString, "product_added_count 303")
 	assert.Contains(t, respString, "http_requests_total{endpoint=\"POST__/dummyLogin\",status_code=\"200\"} 2")
 	assert.Contains(t, respString, "http_response_time{endpoint=\"POST__/dummyLogin\",status_code=\"200\"} ")
+	assert.Contains(t, respString, "http_rate_limited_total{client_type=\"user\",endpoint=\"POST__/products\"} 1")
+	assert.Contains(t, respString, "auth_mode_info{dummy_tokens=\"denied\",mode=\"production\"} 1")
 
 	cancel()
 
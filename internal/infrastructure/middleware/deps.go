@@ -2,9 +2,42 @@
 package middleware
 
 import (
+	"context"
+	"time"
+
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
 type tokenProvider interface {
 	ParseToken(tokenStr string) (model.TokenInfo, error)
 }
+
+type revocationChecker interface {
+	IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error)
+}
+
+type userChecker interface {
+	IsActive(ctx context.Context, userID model.UserID) (bool, error)
+}
+
+type apiKeyStore interface {
+	GetByHash(ctx context.Context, keyHash []byte) (model.APIKey, error)
+	TouchLastUsed(ctx context.Context, apiKeyID model.APIKeyID) error
+}
+
+type rateLimiter interface {
+	Allow(route, client string) (ok bool, retryAfter time.Duration)
+}
+
+type rateLimitMetrics interface {
+	RateLimitedInc(route, clientType string)
+}
+
+type idempotencyStore interface {
+	Begin(ctx context.Context, client, key string, requestHash []byte, lockedUntil, expiresAt time.Time,
+	) (model.IdempotencyRecord, bool, error)
+	Extend(ctx context.Context, client, key string, lockedUntil time.Time) error
+	Complete(ctx context.Context, client, key string, statusCode int, responseBody []byte) error
+	MarkResponseLost(ctx context.Context, client, key string) error
+	Release(ctx context.Context, client, key string) error
+}
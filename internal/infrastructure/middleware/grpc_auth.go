@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// CreateGRPCAuthInterceptor authenticates gRPC calls like the HTTP API does: the authorization metadata carries
+// a JWT and the x-api-key metadata carries a key of a machine client, both are checked by jwt.Authenticate.
+// methodPermissions maps full method names to the permission the caller needs, methods missing there are denied.
+func CreateGRPCAuthInterceptor(provider tokenProvider, checker revocationChecker, users userChecker,
+	apiKeys apiKeyStore, rolePermissions model.RolePermissions, dummyTokens model.DummyTokenAccess,
+	methodPermissions map[string]model.Permission,
+) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		permission, ok := methodPermissions[info.FullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "method is not allowed")
+		}
+
+		// jwt.Authenticate reads the credentials from an HTTP request, the metadata is copied into its headers
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, info.FullMethod, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get("authorization"); len(values) > 0 {
+			httpReq.Header.Set("Authorization", values[0])
+		}
+		schemeName := "bearerAuth"
+		if values := md.Get(jwt.APIKeyHeader); len(values) > 0 {
+			httpReq.Header.Set(jwt.APIKeyHeader, values[0])
+			schemeName = "apiKeyAuth"
+		}
+
+		err = jwt.Authenticate(ctx, provider, checker, users, apiKeys, rolePermissions, dummyTokens,
+			&openapi3filter.AuthenticationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{Request: httpReq},
+				SecuritySchemeName:     schemeName,
+			})
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "security requirements failed: "+err.Error())
+		}
+
+		tokenInfo := jwt.TokenInfoFromContext(httpReq.Context())
+		if !tokenInfo.Permissions.Has(permission) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		return handler(jwt.ContextWithTokenInfo(ctx, tokenInfo), req)
+	}
+}
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestCreateGRPCAuthInterceptor(t *testing.T) {
+	type mocks struct {
+		tokenProvider     *MocktokenProvider
+		revocationChecker *MockrevocationChecker
+		userChecker       *MockuserChecker
+		apiKeyStore       *MockapiKeyStore
+	}
+
+	const (
+		method       = "/pvz.v1.PVZService/GetPVZList"
+		manageMethod = "/pvz.v1.PVZService/ManageUsers"
+	)
+	userID := model.NewUserID()
+
+	tests := []struct {
+		name       string
+		method     string
+		md         metadata.MD
+		prepare    func(m *mocks)
+		wantCalled bool
+		wantCode   codes.Code
+		wantRole   model.UserRole
+	}{
+		{
+			name:   "success.token",
+			method: method,
+			md:     metadata.Pairs("authorization", "asdf"),
+			prepare: func(m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").
+					Return(model.TokenInfo{UserID: userID, UserRole: model.UserRoleEmployee}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), model.TokenID{}).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil)
+			},
+			wantCalled: true,
+			wantCode:   codes.OK,
+			wantRole:   model.UserRoleEmployee,
+		},
+		{
+			name:   "success.api_key",
+			method: method,
+			md:     metadata.Pairs("x-api-key", "pvz_key"),
+			prepare: func(m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        model.NewAPIKeyID(),
+					UserRole:  model.UserRoleAuditor,
+					ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				m.apiKeyStore.EXPECT().TouchLastUsed(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantCalled: true,
+			wantCode:   codes.OK,
+			wantRole:   model.UserRoleAuditor,
+		},
+		{
+			name:     "unauthenticated.no_metadata",
+			method:   method,
+			prepare:  func(m *mocks) {},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "unauthenticated.revoked",
+			method: method,
+			md:     metadata.Pairs("authorization", "asdf"),
+			prepare: func(m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), model.TokenID{}).Return(true, nil)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "unauthenticated.unknown_api_key",
+			method: method,
+			md:     metadata.Pairs("x-api-key", "pvz_key"),
+			prepare: func(m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).
+					Return(model.APIKey{}, model.ErrAPIKeyNotFound)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "permission_denied.role",
+			method: manageMethod,
+			md:     metadata.Pairs("authorization", "asdf"),
+			prepare: func(m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").
+					Return(model.TokenInfo{UserID: userID, UserRole: model.UserRoleEmployee}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), model.TokenID{}).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(true, nil)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "permission_denied.unknown_method",
+			method:   "/pvz.v1.PVZService/DeletePVZ",
+			md:       metadata.Pairs("authorization", "asdf"),
+			prepare:  func(m *mocks) {},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := &mocks{
+				tokenProvider:     NewMocktokenProvider(ctrl),
+				revocationChecker: NewMockrevocationChecker(ctrl),
+				userChecker:       NewMockuserChecker(ctrl),
+				apiKeyStore:       NewMockapiKeyStore(ctrl),
+			}
+			tt.prepare(m)
+
+			interceptor := CreateGRPCAuthInterceptor(m.tokenProvider, m.revocationChecker, m.userChecker,
+				m.apiKeyStore, model.DefaultRolePermissions(), model.DummyTokenAccessDenied,
+				map[string]model.Permission{method: model.PermissionPVZRead, manageMethod: model.PermissionUserManage})
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			called := false
+			res, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req any) (any, error) {
+					called = true
+					assert.Equal(t, tt.wantRole, jwt.TokenInfoFromContext(ctx).UserRole)
+					return "response", nil
+				})
+
+			assert.Equal(t, tt.wantCalled, called)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCalled {
+				assert.Equal(t, "response", res)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed from a previous request with the same key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+
+	// idempotencyStoreAttempts bounds retries of storing the response, and then of marking it lost
+	idempotencyStoreAttempts = 3
+	idempotencyStoreBackoff  = 100 * time.Millisecond
+)
+
+// CreateIdempotencyMiddleware makes retries of a request with the same Idempotency-Key header safe: the response
+// of the first request is stored for ttl and replayed to retries without calling the handler again. A key is
+// scoped to the client of the request, like in the rate limiter, and is bound to the method, URI and body of the
+// request, reusing it for a different request gets 422. A retry coming while the first request is still being
+// handled gets 409. The request holds the key for lockTTL and the lease is extended while it is handled, so only
+// if the lease passes, e.g. the instance died, the first request is considered lost and a retry takes the key over.
+// Responses with 5xx are not stored, so such a request may be retried with the same key. If the response of a done
+// request can't be stored, the key is marked as done with the response lost, and retries get 409 until it expires.
+// Requests without the header are passed as is.
+func CreateIdempotencyMiddleware(store idempotencyStore, ttl, lockTTL time.Duration, logger internal.Logger,
+) (func(next http.HandlerFunc) http.HandlerFunc, error) {
+	if store == nil {
+		return nil, errors.New("store is nil")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("ttl should be positive")
+	}
+	if lockTTL <= 0 || lockTTL > ttl {
+		return nil, errors.New("lockTTL should be positive and not greater than ttl")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				api_handler.BadRequest(w, "idempotency key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			_ = r.Body.Close()
+			if err != nil {
+				api_handler.BadRequest(w, "could not read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			clientType, client := requestClient(r)
+			client = clientType + ":" + client
+			requestHash := idempotencyRequestHash(r, body)
+
+			now := time.Now()
+			record, started, err := store.Begin(r.Context(), client, key, requestHash, now.Add(lockTTL), now.Add(ttl))
+			if err != nil {
+				logger.Error("idempotency: internal error", zap.Error(err), zap.String("route", r.Pattern),
+					zap.String("client", client))
+				api_handler.InternalError(w, "internal server error")
+				return
+			}
+			if !started {
+				switch {
+				case !record.Matches(requestHash):
+					api_handler.UnprocessableEntity(w, "idempotency key is already used for a different request")
+				case record.ResponseLost:
+					api_handler.Conflict(w, "request with this idempotency key is done, but its response is not stored")
+				case !record.Completed():
+					api_handler.Conflict(w, "request with this idempotency key is in progress")
+				default:
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(record.StatusCode)
+					_, _ = w.Write(record.ResponseBody)
+				}
+				return
+			}
+
+			// the response is stored even if the client has gone, a retry is expected exactly then
+			ctx := context.WithoutCancel(r.Context())
+			handled := false
+			defer func() {
+				if handled {
+					return
+				}
+				// the handler panicked or failed, its transaction is rolled back, so the request may be done again
+				if err := store.Release(ctx, client, key); err != nil {
+					logger.Error("idempotency: release key", zap.Error(err), zap.String("route", r.Pattern),
+						zap.String("client", client))
+				}
+			}()
+			// deferred after the release, so the lease is not extended any more when the key is released
+			stopExtending := extendIdempotencyLease(ctx, store, client, key, lockTTL, logger, r.Pattern)
+			defer stopExtending()
+
+			rw := &recordingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next(rw, r)
+
+			if rw.statusCode >= http.StatusInternalServerError {
+				return
+			}
+			// the request is done, releasing the key would let a retry do it twice
+			handled = true
+
+			err = retryIdempotencyStore(func() error {
+				return store.Complete(ctx, client, key, rw.statusCode, rw.body.Bytes())
+			})
+			if err == nil {
+				return
+			}
+			logger.Error("idempotency: store response", zap.Error(err), zap.String("route", r.Pattern),
+				zap.String("client", client))
+
+			// a lock would lapse and let a retry do the request again, so the key is kept as done instead
+			err = retryIdempotencyStore(func() error {
+				return store.MarkResponseLost(ctx, client, key)
+			})
+			if err != nil {
+				logger.Error("idempotency: mark response lost", zap.Error(err), zap.String("route", r.Pattern),
+					zap.String("client", client))
+			}
+		}
+	}, nil
+}
+
+// extendIdempotencyLease extends the lock of the key by lockTTL every third of it, until the returned func is called.
+func extendIdempotencyLease(ctx context.Context, store idempotencyStore, client, key string, lockTTL time.Duration,
+	logger internal.Logger, route string,
+) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(lockTTL / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := store.Extend(ctx, client, key, time.Now().Add(lockTTL))
+				if err != nil {
+					logger.Error("idempotency: extend lock", zap.Error(err), zap.String("route", route),
+						zap.String("client", client))
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func retryIdempotencyStore(f func() error) error {
+	var err error
+	for attempt := 1; attempt <= idempotencyStoreAttempts; attempt++ {
+		err = f()
+		if err == nil {
+			return nil
+		}
+		if attempt < idempotencyStoreAttempts {
+			time.Sleep(time.Duration(attempt) * idempotencyStoreBackoff)
+		}
+	}
+	return err
+}
+
+func idempotencyRequestHash(r *http.Request, body []byte) []byte {
+	hash := sha256.New()
+	_, _ = io.WriteString(hash, r.Method+" "+r.URL.RequestURI()+"\n")
+	_, _ = hash.Write(body)
+	return hash.Sum(nil)
+}
+
+// recordingResponseWriter passes the response through and keeps a copy of its status code and body.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (rw *recordingResponseWriter) WriteHeader(code int) {
+	if !rw.wroteHeader {
+		rw.statusCode = code
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingResponseWriter) Write(data []byte) (int, error) {
+	rw.wroteHeader = true
+	rw.body.Write(data)
+	return rw.ResponseWriter.Write(data)
+}
@@ -0,0 +1,344 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestCreateIdempotencyMiddleware(t *testing.T) {
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := CreateIdempotencyMiddleware(nil, time.Hour, time.Minute, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateIdempotencyMiddleware(NewMockidempotencyStore(ctrl), 0, time.Minute, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_lock_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateIdempotencyMiddleware(NewMockidempotencyStore(ctrl), time.Hour, 0, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.lock_ttl_greater_than_ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateIdempotencyMiddleware(NewMockidempotencyStore(ctrl), time.Minute, time.Hour, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateIdempotencyMiddleware(NewMockidempotencyStore(ctrl), time.Hour, time.Minute, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+	client := "user:" + userID.UUID().String()
+	body := `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05"}`
+
+	r := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+	requestHash := idempotencyRequestHash(r, []byte(body))
+
+	tests := []struct {
+		name        string
+		key         string
+		prepare     func(store *MockidempotencyStore)
+		handlerCode int
+		wantCalled  bool
+		wantCode    int
+		wantBody    string
+		wantReplay  bool
+	}{
+		{
+			name:        "success.without_key",
+			prepare:     func(store *MockidempotencyStore) {},
+			handlerCode: http.StatusCreated,
+			wantCalled:  true,
+			wantCode:    http.StatusCreated,
+			wantBody:    `{"id": "1"}`,
+		},
+		{
+			name: "success.first_request",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, true, nil)
+				store.EXPECT().
+					Complete(gomock.Any(), client, "key-1", http.StatusCreated, []byte(`{"id": "1"}`)).
+					Return(nil)
+			},
+			handlerCode: http.StatusCreated,
+			wantCalled:  true,
+			wantCode:    http.StatusCreated,
+			wantBody:    `{"id": "1"}`,
+		},
+		{
+			name: "success.first_request_client_error",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, true, nil)
+				store.EXPECT().
+					Complete(gomock.Any(), client, "key-1", http.StatusConflict, []byte(`{"id": "1"}`)).
+					Return(nil)
+			},
+			handlerCode: http.StatusConflict,
+			wantCalled:  true,
+			wantCode:    http.StatusConflict,
+			wantBody:    `{"id": "1"}`,
+		},
+		{
+			name: "success.first_request_server_error",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, true, nil)
+				store.EXPECT().
+					Release(gomock.Any(), client, "key-1").
+					Return(nil)
+			},
+			handlerCode: http.StatusInternalServerError,
+			wantCalled:  true,
+			wantCode:    http.StatusInternalServerError,
+			wantBody:    `{"id": "1"}`,
+		},
+		{
+			name: "success.replay",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{
+						RequestHash:  requestHash,
+						StatusCode:   http.StatusCreated,
+						ResponseBody: []byte(`{"id": "stored"}`),
+					}, false, nil)
+			},
+			wantCalled: false,
+			wantCode:   http.StatusCreated,
+			wantBody:   `{"id": "stored"}`,
+			wantReplay: true,
+		},
+		{
+			name: "businessError.different_request",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{
+						RequestHash:  []byte("other"),
+						StatusCode:   http.StatusCreated,
+						ResponseBody: []byte(`{"id": "stored"}`),
+					}, false, nil)
+			},
+			wantCalled: false,
+			wantCode:   http.StatusUnprocessableEntity,
+			wantBody:   `{"message": "idempotency key is already used for a different request"}`,
+		},
+		{
+			name: "businessError.in_progress",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{RequestHash: requestHash}, false, nil)
+			},
+			wantCalled: false,
+			wantCode:   http.StatusConflict,
+			wantBody:   `{"message": "request with this idempotency key is in progress"}`,
+		},
+		{
+			name: "businessError.response_lost",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{RequestHash: requestHash, ResponseLost: true}, false, nil)
+			},
+			wantCalled: false,
+			wantCode:   http.StatusConflict,
+			wantBody:   `{"message": "request with this idempotency key is done, but its response is not stored"}`,
+		},
+		{
+			name:       "businessError.key_too_long",
+			key:        strings.Repeat("k", maxIdempotencyKeyLength+1),
+			prepare:    func(store *MockidempotencyStore) {},
+			wantCalled: false,
+			wantCode:   http.StatusBadRequest,
+			wantBody:   `{"message": "idempotency key is too long"}`,
+		},
+		{
+			name: "error.Begin",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, false, assert.AnError)
+			},
+			wantCalled: false,
+			wantCode:   http.StatusInternalServerError,
+			wantBody:   `{"message": "internal server error"}`,
+		},
+		{
+			name: "success.Complete_retried",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, true, nil)
+				store.EXPECT().
+					Complete(gomock.Any(), client, "key-1", http.StatusCreated, []byte(`{"id": "1"}`)).
+					Return(assert.AnError)
+				store.EXPECT().
+					Complete(gomock.Any(), client, "key-1", http.StatusCreated, []byte(`{"id": "1"}`)).
+					Return(nil)
+			},
+			handlerCode: http.StatusCreated,
+			wantCalled:  true,
+			wantCode:    http.StatusCreated,
+			wantBody:    `{"id": "1"}`,
+		},
+		{
+			name: "error.Complete",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, true, nil)
+				// the key is not released, a retry must not add the product again
+				store.EXPECT().
+					Complete(gomock.Any(), client, "key-1", http.StatusCreated, []byte(`{"id": "1"}`)).
+					Return(assert.AnError).
+					Times(idempotencyStoreAttempts)
+				store.EXPECT().
+					MarkResponseLost(gomock.Any(), client, "key-1").
+					Return(nil)
+			},
+			handlerCode: http.StatusCreated,
+			wantCalled:  true,
+			wantCode:    http.StatusCreated,
+			wantBody:    `{"id": "1"}`,
+		},
+		{
+			name: "error.MarkResponseLost",
+			key:  "key-1",
+			prepare: func(store *MockidempotencyStore) {
+				store.EXPECT().
+					Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+					Return(model.IdempotencyRecord{}, true, nil)
+				store.EXPECT().
+					Complete(gomock.Any(), client, "key-1", http.StatusCreated, []byte(`{"id": "1"}`)).
+					Return(assert.AnError).
+					Times(idempotencyStoreAttempts)
+				store.EXPECT().
+					MarkResponseLost(gomock.Any(), client, "key-1").
+					Return(assert.AnError).
+					Times(idempotencyStoreAttempts)
+			},
+			handlerCode: http.StatusCreated,
+			wantCalled:  true,
+			wantCode:    http.StatusCreated,
+			wantBody:    `{"id": "1"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := NewMockidempotencyStore(ctrl)
+			tt.prepare(store)
+
+			mw, err := CreateIdempotencyMiddleware(store, time.Hour, time.Minute, zap.NewNop())
+			require.NoError(t, err)
+
+			called := false
+			handler := mw(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				// the handler reads the body after the middleware
+				gotBody, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, body, string(gotBody))
+
+				w.WriteHeader(tt.handlerCode)
+				_, _ = w.Write([]byte(`{"id": "1"}`))
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+			r.Pattern = "POST /receptions"
+			if tt.key != "" {
+				r.Header.Set(IdempotencyKeyHeader, tt.key)
+			}
+			r = r.WithContext(jwt.ContextWithTokenInfo(r.Context(), model.TokenInfo{UserID: userID, UserRole: model.UserRoleEmployee}))
+			w := httptest.NewRecorder()
+			handler(w, r)
+
+			assert.Equal(t, tt.wantCalled, called)
+			require.Equal(t, tt.wantCode, w.Code)
+			require.JSONEq(t, tt.wantBody, w.Body.String())
+			if tt.wantReplay {
+				require.Equal(t, "true", w.Header().Get(IdempotentReplayedHeader))
+			} else {
+				require.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+			}
+		})
+	}
+
+	t.Run("success.lease_extended", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := NewMockidempotencyStore(ctrl)
+		store.EXPECT().
+			Begin(gomock.Any(), client, "key-1", requestHash, gomock.Any(), gomock.Any()).
+			Return(model.IdempotencyRecord{}, true, nil)
+		store.EXPECT().
+			Extend(gomock.Any(), client, "key-1", gomock.Any()).
+			Return(nil).
+			MinTimes(1)
+		store.EXPECT().
+			Complete(gomock.Any(), client, "key-1", http.StatusCreated, []byte(`{"id": "1"}`)).
+			Return(nil)
+
+		mw, err := CreateIdempotencyMiddleware(store, time.Hour, 30*time.Millisecond, zap.NewNop())
+		require.NoError(t, err)
+
+		// the handler runs longer than the lock
+		handler := mw(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "1"}`))
+		})
+
+		r := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+		r.Header.Set(IdempotencyKeyHeader, "key-1")
+		r = r.WithContext(jwt.ContextWithTokenInfo(r.Context(), model.TokenInfo{UserID: userID, UserRole: model.UserRoleEmployee}))
+		w := httptest.NewRecorder()
+		handler(w, r)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("hash_depends_on_uri_and_body", func(t *testing.T) {
+		other := httptest.NewRequest(http.MethodPost, "/pvz/1/close_last_reception", nil)
+		assert.NotEqual(t, requestHash, idempotencyRequestHash(other, []byte(body)))
+		assert.NotEqual(t, requestHash, idempotencyRequestHash(r, []byte(`{}`)))
+		assert.Equal(t, requestHash, idempotencyRequestHash(r, []byte(body)))
+	})
+}
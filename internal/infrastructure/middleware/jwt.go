@@ -9,6 +9,7 @@ import (
 
 	"github.com/inna-maikut/avito-pvz/internal/api"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
 func CreateNoAuthMiddleware() (func(next http.Handler) http.Handler, error) {
@@ -27,7 +28,9 @@ func CreateNoAuthMiddleware() (func(next http.Handler) http.Handler, error) {
 	return validator, nil
 }
 
-func CreateAuthMiddleware(provider tokenProvider) (func(next http.Handler) http.Handler, error) {
+func CreateAuthMiddleware(provider tokenProvider, checker revocationChecker, users userChecker, apiKeys apiKeyStore,
+	rolePermissions model.RolePermissions, dummyTokens model.DummyTokenAccess,
+) (func(next http.Handler) http.Handler, error) {
 	spec, err := api.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("loading spec: %w", err)
@@ -36,7 +39,7 @@ func CreateAuthMiddleware(provider tokenProvider) (func(next http.Handler) http.
 	validator := middleware.OapiRequestValidatorWithOptions(spec,
 		&middleware.Options{
 			Options: openapi3filter.Options{
-				AuthenticationFunc: jwt.NewAuthenticator(provider),
+				AuthenticationFunc: jwt.NewAuthenticator(provider, checker, users, apiKeys, rolePermissions, dummyTokens),
 			},
 			SilenceServersWarning: true,
 		})
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
@@ -36,6 +39,34 @@ func TestCreateNoAuthMiddleware(t *testing.T) {
 				require.Equal(t, "request body has an error: value is required but missing\n", w.Body.String())
 			},
 		},
+		{
+			name: "validation_idempotency_key",
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				called := false
+				next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					called = true
+				})
+				handler := mw(next)
+
+				body := `{"pvzId": "6451927e-846b-4c97-9924-cba818687a05"}`
+				r := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+				r.Header.Set("Content-Type", "application/json")
+				r.Header.Set(IdempotencyKeyHeader, strings.Repeat("k", 256))
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				require.False(t, called)
+				require.Contains(t, w.Body.String(), `parameter "Idempotency-Key" in header has an error`)
+
+				r = httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+				r.Header.Set("Content-Type", "application/json")
+				r.Header.Set(IdempotencyKeyHeader, "6451927e-846b-4c97-9924-cba818687a06")
+				w = httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				require.True(t, called)
+			},
+		},
 		{
 			name: "pass_no_auth",
 			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
@@ -66,7 +97,10 @@ func TestCreateNoAuthMiddleware(t *testing.T) {
 
 func TestCreateAuthMiddleware(t *testing.T) {
 	type mocks struct {
-		tokenProvider *MocktokenProvider
+		tokenProvider     *MocktokenProvider
+		revocationChecker *MockrevocationChecker
+		userChecker       *MockuserChecker
+		apiKeyStore       *MockapiKeyStore
 	}
 
 	tests := []struct {
@@ -79,6 +113,7 @@ func TestCreateAuthMiddleware(t *testing.T) {
 			name: "validation_required",
 			prepare: func(_ *testing.T, m *mocks) {
 				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), model.TokenID{}).Return(false, nil)
 			},
 			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
 				called := false
@@ -96,6 +131,73 @@ func TestCreateAuthMiddleware(t *testing.T) {
 				assert.Equal(t, "request body has an error: value is required but missing\n", w.Body.String())
 			},
 		},
+		{
+			name: "forbidden_revoked",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), model.TokenID{}).Return(true, nil)
+			},
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				called := false
+				next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					called = true
+				})
+				handler := mw(next)
+
+				r := httptest.NewRequest(http.MethodGet, "/pvz", bytes.NewReader(nil))
+				r.Header.Set("Authorization", "asdf")
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				assert.False(t, called)
+				assert.Equal(t, "security requirements failed: token is revoked | getting api key: x-api-key header is missing\n", w.Body.String())
+			},
+		},
+		{
+			name: "forbidden_user_disabled",
+			prepare: func(_ *testing.T, m *mocks) {
+				userID := model.NewUserID()
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: userID}, nil)
+				m.revocationChecker.EXPECT().IsRevoked(gomock.Any(), model.TokenID{}).Return(false, nil)
+				m.userChecker.EXPECT().IsActive(gomock.Any(), userID).Return(false, nil)
+			},
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				called := false
+				next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					called = true
+				})
+				handler := mw(next)
+
+				r := httptest.NewRequest(http.MethodGet, "/pvz", bytes.NewReader(nil))
+				r.Header.Set("Authorization", "asdf")
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				assert.False(t, called)
+				assert.Equal(t, "security requirements failed: user is disabled | getting api key: x-api-key header is missing\n", w.Body.String())
+			},
+		},
+		{
+			name: "forbidden_dummy_token",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.tokenProvider.EXPECT().ParseToken("asdf").Return(model.TokenInfo{UserID: model.DefaultUserID, Dummy: true}, nil)
+			},
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				called := false
+				next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					called = true
+				})
+				handler := mw(next)
+
+				r := httptest.NewRequest(http.MethodGet, "/pvz", bytes.NewReader(nil))
+				r.Header.Set("Authorization", "asdf")
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				assert.False(t, called)
+				assert.Equal(t, "security requirements failed: dummy tokens are not accepted | getting api key: x-api-key header is missing\n", w.Body.String())
+			},
+		},
 		{
 			name:    "forbidden_no_header",
 			prepare: func(_ *testing.T, _ *mocks) {},
@@ -111,7 +213,82 @@ func TestCreateAuthMiddleware(t *testing.T) {
 				handler.ServeHTTP(w, r)
 
 				assert.False(t, called)
-				assert.Equal(t, "security requirements failed: getting jws: authorization header is missing\n", w.Body.String())
+				assert.Equal(t, "security requirements failed: getting jws: authorization header is missing | getting api key: x-api-key header is missing\n", w.Body.String())
+			},
+		},
+		{
+			name: "pass_api_key",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        model.NewAPIKeyID(),
+					UserRole:  model.UserRoleEmployee,
+					ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				m.apiKeyStore.EXPECT().TouchLastUsed(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				var tokenInfo model.TokenInfo
+				next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+					tokenInfo = jwt.TokenInfoFromContext(r.Context())
+				})
+				handler := mw(next)
+
+				r := httptest.NewRequest(http.MethodGet, "/pvz", bytes.NewReader(nil))
+				r.Header.Set("X-API-Key", "pvz_key")
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				assert.Equal(t, "", w.Body.String())
+				assert.Equal(t, model.UserRoleEmployee, tokenInfo.UserRole)
+				assert.True(t, tokenInfo.Permissions.Has(model.PermissionPVZRead))
+			},
+		},
+		{
+			name: "forbidden_api_key_revoked",
+			prepare: func(_ *testing.T, m *mocks) {
+				revokedAt := time.Now()
+				m.apiKeyStore.EXPECT().GetByHash(gomock.Any(), model.HashAPIKey("pvz_key")).Return(model.APIKey{
+					ID:        model.NewAPIKeyID(),
+					UserRole:  model.UserRoleEmployee,
+					ExpiresAt: time.Now().Add(time.Hour),
+					RevokedAt: &revokedAt,
+				}, nil)
+			},
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				called := false
+				next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					called = true
+				})
+				handler := mw(next)
+
+				r := httptest.NewRequest(http.MethodGet, "/pvz", bytes.NewReader(nil))
+				r.Header.Set("X-API-Key", "pvz_key")
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				assert.False(t, called)
+				assert.Equal(t, "security requirements failed: getting jws: authorization header is missing | "+
+					"api key is unknown, expired or revoked\n", w.Body.String())
+			},
+		},
+		{
+			name:    "forbidden_api_key_for_user_endpoint",
+			prepare: func(_ *testing.T, _ *mocks) {},
+			check: func(t *testing.T, mw func(next http.Handler) http.Handler) {
+				called := false
+				next := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					called = true
+				})
+				handler := mw(next)
+
+				r := httptest.NewRequest(http.MethodGet, "/api_keys", bytes.NewReader(nil))
+				r.Header.Set("X-API-Key", "pvz_key")
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+
+				assert.False(t, called)
+				assert.Equal(t, "security requirements failed: getting jws: authorization header is missing\n",
+					w.Body.String())
 			},
 		},
 	}
@@ -120,12 +297,16 @@ func TestCreateAuthMiddleware(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
-				tokenProvider: NewMocktokenProvider(ctrl),
+				tokenProvider:     NewMocktokenProvider(ctrl),
+				revocationChecker: NewMockrevocationChecker(ctrl),
+				userChecker:       NewMockuserChecker(ctrl),
+				apiKeyStore:       NewMockapiKeyStore(ctrl),
 			}
 
 			tt.prepare(t, m)
 
-			got, err := CreateAuthMiddleware(m.tokenProvider)
+			got, err := CreateAuthMiddleware(m.tokenProvider, m.revocationChecker, m.userChecker, m.apiKeyStore,
+				model.DefaultRolePermissions(), model.DummyTokenAccessDenied)
 			require.NoError(t, err)
 
 			tt.check(t, got)
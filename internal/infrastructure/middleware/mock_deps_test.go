@@ -10,7 +10,9 @@
 package middleware
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-pvz/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -78,3 +80,569 @@ func (c *MocktokenProviderParseTokenCall) DoAndReturn(f func(string) (model.Toke
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockrevocationChecker is a mock of revocationChecker interface.
+type MockrevocationChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevocationCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockrevocationCheckerMockRecorder is the mock recorder for MockrevocationChecker.
+type MockrevocationCheckerMockRecorder struct {
+	mock *MockrevocationChecker
+}
+
+// NewMockrevocationChecker creates a new mock instance.
+func NewMockrevocationChecker(ctrl *gomock.Controller) *MockrevocationChecker {
+	mock := &MockrevocationChecker{ctrl: ctrl}
+	mock.recorder = &MockrevocationCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevocationChecker) EXPECT() *MockrevocationCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsRevoked mocks base method.
+func (m *MockrevocationChecker) IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockrevocationCheckerMockRecorder) IsRevoked(ctx, tokenID any) *MockrevocationCheckerIsRevokedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockrevocationChecker)(nil).IsRevoked), ctx, tokenID)
+	return &MockrevocationCheckerIsRevokedCall{Call: call}
+}
+
+// MockrevocationCheckerIsRevokedCall wrap *gomock.Call
+type MockrevocationCheckerIsRevokedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrevocationCheckerIsRevokedCall) Return(arg0 bool, arg1 error) *MockrevocationCheckerIsRevokedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrevocationCheckerIsRevokedCall) Do(f func(context.Context, model.TokenID) (bool, error)) *MockrevocationCheckerIsRevokedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrevocationCheckerIsRevokedCall) DoAndReturn(f func(context.Context, model.TokenID) (bool, error)) *MockrevocationCheckerIsRevokedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserChecker is a mock of userChecker interface.
+type MockuserChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockuserCheckerMockRecorder is the mock recorder for MockuserChecker.
+type MockuserCheckerMockRecorder struct {
+	mock *MockuserChecker
+}
+
+// NewMockuserChecker creates a new mock instance.
+func NewMockuserChecker(ctrl *gomock.Controller) *MockuserChecker {
+	mock := &MockuserChecker{ctrl: ctrl}
+	mock.recorder = &MockuserCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserChecker) EXPECT() *MockuserCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsActive mocks base method.
+func (m *MockuserChecker) IsActive(ctx context.Context, userID model.UserID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockuserCheckerMockRecorder) IsActive(ctx, userID any) *MockuserCheckerIsActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockuserChecker)(nil).IsActive), ctx, userID)
+	return &MockuserCheckerIsActiveCall{Call: call}
+}
+
+// MockuserCheckerIsActiveCall wrap *gomock.Call
+type MockuserCheckerIsActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserCheckerIsActiveCall) Return(arg0 bool, arg1 error) *MockuserCheckerIsActiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserCheckerIsActiveCall) Do(f func(context.Context, model.UserID) (bool, error)) *MockuserCheckerIsActiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserCheckerIsActiveCall) DoAndReturn(f func(context.Context, model.UserID) (bool, error)) *MockuserCheckerIsActiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockapiKeyStore is a mock of apiKeyStore interface.
+type MockapiKeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyStoreMockRecorder is the mock recorder for MockapiKeyStore.
+type MockapiKeyStoreMockRecorder struct {
+	mock *MockapiKeyStore
+}
+
+// NewMockapiKeyStore creates a new mock instance.
+func NewMockapiKeyStore(ctrl *gomock.Controller) *MockapiKeyStore {
+	mock := &MockapiKeyStore{ctrl: ctrl}
+	mock.recorder = &MockapiKeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyStore) EXPECT() *MockapiKeyStoreMockRecorder {
+	return m.recorder
+}
+
+// GetByHash mocks base method.
+func (m *MockapiKeyStore) GetByHash(ctx context.Context, keyHash []byte) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, keyHash)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockapiKeyStoreMockRecorder) GetByHash(ctx, keyHash any) *MockapiKeyStoreGetByHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockapiKeyStore)(nil).GetByHash), ctx, keyHash)
+	return &MockapiKeyStoreGetByHashCall{Call: call}
+}
+
+// MockapiKeyStoreGetByHashCall wrap *gomock.Call
+type MockapiKeyStoreGetByHashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStoreGetByHashCall) Return(arg0 model.APIKey, arg1 error) *MockapiKeyStoreGetByHashCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStoreGetByHashCall) Do(f func(context.Context, []byte) (model.APIKey, error)) *MockapiKeyStoreGetByHashCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStoreGetByHashCall) DoAndReturn(f func(context.Context, []byte) (model.APIKey, error)) *MockapiKeyStoreGetByHashCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TouchLastUsed mocks base method.
+func (m *MockapiKeyStore) TouchLastUsed(ctx context.Context, apiKeyID model.APIKeyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchLastUsed", ctx, apiKeyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchLastUsed indicates an expected call of TouchLastUsed.
+func (mr *MockapiKeyStoreMockRecorder) TouchLastUsed(ctx, apiKeyID any) *MockapiKeyStoreTouchLastUsedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchLastUsed", reflect.TypeOf((*MockapiKeyStore)(nil).TouchLastUsed), ctx, apiKeyID)
+	return &MockapiKeyStoreTouchLastUsedCall{Call: call}
+}
+
+// MockapiKeyStoreTouchLastUsedCall wrap *gomock.Call
+type MockapiKeyStoreTouchLastUsedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStoreTouchLastUsedCall) Return(arg0 error) *MockapiKeyStoreTouchLastUsedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStoreTouchLastUsedCall) Do(f func(context.Context, model.APIKeyID) error) *MockapiKeyStoreTouchLastUsedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStoreTouchLastUsedCall) DoAndReturn(f func(context.Context, model.APIKeyID) error) *MockapiKeyStoreTouchLastUsedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrateLimiter is a mock of rateLimiter interface.
+type MockrateLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockrateLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockrateLimiterMockRecorder is the mock recorder for MockrateLimiter.
+type MockrateLimiterMockRecorder struct {
+	mock *MockrateLimiter
+}
+
+// NewMockrateLimiter creates a new mock instance.
+func NewMockrateLimiter(ctrl *gomock.Controller) *MockrateLimiter {
+	mock := &MockrateLimiter{ctrl: ctrl}
+	mock.recorder = &MockrateLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrateLimiter) EXPECT() *MockrateLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockrateLimiter) Allow(route, client string) (bool, time.Duration) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", route, client)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Duration)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockrateLimiterMockRecorder) Allow(route, client any) *MockrateLimiterAllowCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockrateLimiter)(nil).Allow), route, client)
+	return &MockrateLimiterAllowCall{Call: call}
+}
+
+// MockrateLimiterAllowCall wrap *gomock.Call
+type MockrateLimiterAllowCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrateLimiterAllowCall) Return(ok bool, retryAfter time.Duration) *MockrateLimiterAllowCall {
+	c.Call = c.Call.Return(ok, retryAfter)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrateLimiterAllowCall) Do(f func(string, string) (bool, time.Duration)) *MockrateLimiterAllowCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrateLimiterAllowCall) DoAndReturn(f func(string, string) (bool, time.Duration)) *MockrateLimiterAllowCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrateLimitMetrics is a mock of rateLimitMetrics interface.
+type MockrateLimitMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockrateLimitMetricsMockRecorder
+	isgomock struct{}
+}
+
+// MockrateLimitMetricsMockRecorder is the mock recorder for MockrateLimitMetrics.
+type MockrateLimitMetricsMockRecorder struct {
+	mock *MockrateLimitMetrics
+}
+
+// NewMockrateLimitMetrics creates a new mock instance.
+func NewMockrateLimitMetrics(ctrl *gomock.Controller) *MockrateLimitMetrics {
+	mock := &MockrateLimitMetrics{ctrl: ctrl}
+	mock.recorder = &MockrateLimitMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrateLimitMetrics) EXPECT() *MockrateLimitMetricsMockRecorder {
+	return m.recorder
+}
+
+// RateLimitedInc mocks base method.
+func (m *MockrateLimitMetrics) RateLimitedInc(route, clientType string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RateLimitedInc", route, clientType)
+}
+
+// RateLimitedInc indicates an expected call of RateLimitedInc.
+func (mr *MockrateLimitMetricsMockRecorder) RateLimitedInc(route, clientType any) *MockrateLimitMetricsRateLimitedIncCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateLimitedInc", reflect.TypeOf((*MockrateLimitMetrics)(nil).RateLimitedInc), route, clientType)
+	return &MockrateLimitMetricsRateLimitedIncCall{Call: call}
+}
+
+// MockrateLimitMetricsRateLimitedIncCall wrap *gomock.Call
+type MockrateLimitMetricsRateLimitedIncCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrateLimitMetricsRateLimitedIncCall) Return() *MockrateLimitMetricsRateLimitedIncCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrateLimitMetricsRateLimitedIncCall) Do(f func(string, string)) *MockrateLimitMetricsRateLimitedIncCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrateLimitMetricsRateLimitedIncCall) DoAndReturn(f func(string, string)) *MockrateLimitMetricsRateLimitedIncCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockidempotencyStore is a mock of idempotencyStore interface.
+type MockidempotencyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockidempotencyStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockidempotencyStoreMockRecorder is the mock recorder for MockidempotencyStore.
+type MockidempotencyStoreMockRecorder struct {
+	mock *MockidempotencyStore
+}
+
+// NewMockidempotencyStore creates a new mock instance.
+func NewMockidempotencyStore(ctrl *gomock.Controller) *MockidempotencyStore {
+	mock := &MockidempotencyStore{ctrl: ctrl}
+	mock.recorder = &MockidempotencyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockidempotencyStore) EXPECT() *MockidempotencyStoreMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockidempotencyStore) Begin(ctx context.Context, client, key string, requestHash []byte, lockedUntil, expiresAt time.Time) (model.IdempotencyRecord, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, client, key, requestHash, lockedUntil, expiresAt)
+	ret0, _ := ret[0].(model.IdempotencyRecord)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockidempotencyStoreMockRecorder) Begin(ctx, client, key, requestHash, lockedUntil, expiresAt any) *MockidempotencyStoreBeginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockidempotencyStore)(nil).Begin), ctx, client, key, requestHash, lockedUntil, expiresAt)
+	return &MockidempotencyStoreBeginCall{Call: call}
+}
+
+// MockidempotencyStoreBeginCall wrap *gomock.Call
+type MockidempotencyStoreBeginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockidempotencyStoreBeginCall) Return(arg0 model.IdempotencyRecord, arg1 bool, arg2 error) *MockidempotencyStoreBeginCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockidempotencyStoreBeginCall) Do(f func(context.Context, string, string, []byte, time.Time, time.Time) (model.IdempotencyRecord, bool, error)) *MockidempotencyStoreBeginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockidempotencyStoreBeginCall) DoAndReturn(f func(context.Context, string, string, []byte, time.Time, time.Time) (model.IdempotencyRecord, bool, error)) *MockidempotencyStoreBeginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Complete mocks base method.
+func (m *MockidempotencyStore) Complete(ctx context.Context, client, key string, statusCode int, responseBody []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, client, key, statusCode, responseBody)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockidempotencyStoreMockRecorder) Complete(ctx, client, key, statusCode, responseBody any) *MockidempotencyStoreCompleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockidempotencyStore)(nil).Complete), ctx, client, key, statusCode, responseBody)
+	return &MockidempotencyStoreCompleteCall{Call: call}
+}
+
+// MockidempotencyStoreCompleteCall wrap *gomock.Call
+type MockidempotencyStoreCompleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockidempotencyStoreCompleteCall) Return(arg0 error) *MockidempotencyStoreCompleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockidempotencyStoreCompleteCall) Do(f func(context.Context, string, string, int, []byte) error) *MockidempotencyStoreCompleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockidempotencyStoreCompleteCall) DoAndReturn(f func(context.Context, string, string, int, []byte) error) *MockidempotencyStoreCompleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Extend mocks base method.
+func (m *MockidempotencyStore) Extend(ctx context.Context, client, key string, lockedUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, client, key, lockedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockidempotencyStoreMockRecorder) Extend(ctx, client, key, lockedUntil any) *MockidempotencyStoreExtendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockidempotencyStore)(nil).Extend), ctx, client, key, lockedUntil)
+	return &MockidempotencyStoreExtendCall{Call: call}
+}
+
+// MockidempotencyStoreExtendCall wrap *gomock.Call
+type MockidempotencyStoreExtendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockidempotencyStoreExtendCall) Return(arg0 error) *MockidempotencyStoreExtendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockidempotencyStoreExtendCall) Do(f func(context.Context, string, string, time.Time) error) *MockidempotencyStoreExtendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockidempotencyStoreExtendCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockidempotencyStoreExtendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkResponseLost mocks base method.
+func (m *MockidempotencyStore) MarkResponseLost(ctx context.Context, client, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkResponseLost", ctx, client, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkResponseLost indicates an expected call of MarkResponseLost.
+func (mr *MockidempotencyStoreMockRecorder) MarkResponseLost(ctx, client, key any) *MockidempotencyStoreMarkResponseLostCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkResponseLost", reflect.TypeOf((*MockidempotencyStore)(nil).MarkResponseLost), ctx, client, key)
+	return &MockidempotencyStoreMarkResponseLostCall{Call: call}
+}
+
+// MockidempotencyStoreMarkResponseLostCall wrap *gomock.Call
+type MockidempotencyStoreMarkResponseLostCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockidempotencyStoreMarkResponseLostCall) Return(arg0 error) *MockidempotencyStoreMarkResponseLostCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockidempotencyStoreMarkResponseLostCall) Do(f func(context.Context, string, string) error) *MockidempotencyStoreMarkResponseLostCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockidempotencyStoreMarkResponseLostCall) DoAndReturn(f func(context.Context, string, string) error) *MockidempotencyStoreMarkResponseLostCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Release mocks base method.
+func (m *MockidempotencyStore) Release(ctx context.Context, client, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, client, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockidempotencyStoreMockRecorder) Release(ctx, client, key any) *MockidempotencyStoreReleaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockidempotencyStore)(nil).Release), ctx, client, key)
+	return &MockidempotencyStoreReleaseCall{Call: call}
+}
+
+// MockidempotencyStoreReleaseCall wrap *gomock.Call
+type MockidempotencyStoreReleaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockidempotencyStoreReleaseCall) Return(arg0 error) *MockidempotencyStoreReleaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockidempotencyStoreReleaseCall) Do(f func(context.Context, string, string) error) *MockidempotencyStoreReleaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockidempotencyStoreReleaseCall) DoAndReturn(f func(context.Context, string, string) error) *MockidempotencyStoreReleaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/api_handler"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	clientTypeUser   = "user"
+	clientTypeAPIKey = "api_key"
+	clientTypeIP     = "ip"
+)
+
+// beforeAuthRoute is the route of the IP limit, one bucket of an IP covers all routes behind the auth middleware.
+const beforeAuthRoute = "before_auth"
+
+// CreateRateLimitMiddleware limits requests by route and client, a rejected request gets 429 with Retry-After.
+// The route is the pattern the request was routed by, so the middleware wraps handlers registered in a mux.
+// The client is the user of the token or the API key, put into the context by the auth middleware. Requests
+// without a token and with /dummyLogin tokens, that have no user, are limited by IP.
+func CreateRateLimitMiddleware(limiter rateLimiter, metrics rateLimitMetrics,
+) (func(next http.HandlerFunc) http.HandlerFunc, error) {
+	if limiter == nil {
+		return nil, errors.New("limiter is nil")
+	}
+	if metrics == nil {
+		return nil, errors.New("metrics is nil")
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			clientType, client := requestClient(r)
+
+			ok, retryAfter := limiter.Allow(r.Pattern, clientType+":"+client)
+			if !ok {
+				metrics.RateLimitedInc(r.Pattern, clientType)
+				api_handler.TooManyRequests(w, "too many requests", retryAfter)
+				return
+			}
+
+			next(w, r)
+		}
+	}, nil
+}
+
+// CreateIPRateLimitMiddleware limits requests by IP before the auth middleware. CreateRateLimitMiddleware runs
+// after authentication, so requests with invalid tokens and API keys, that are rejected with 401, are limited here
+// and can't load the revocation checks and API key lookups without a bound.
+func CreateIPRateLimitMiddleware(limiter rateLimiter, metrics rateLimitMetrics,
+) (func(next http.Handler) http.Handler, error) {
+	if limiter == nil {
+		return nil, errors.New("limiter is nil")
+	}
+	if metrics == nil {
+		return nil, errors.New("metrics is nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ok, retryAfter := limiter.Allow(beforeAuthRoute, clientTypeIP+":"+api_handler.ClientIP(r))
+			if !ok {
+				metrics.RateLimitedInc(beforeAuthRoute, clientTypeIP)
+				api_handler.TooManyRequests(w, "too many requests", retryAfter)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// requestClient returns the API key or the user of the request, put into the context by the auth middleware,
+// or the IP for requests without a token and with /dummyLogin tokens, that have no user.
+func requestClient(r *http.Request) (clientType, client string) {
+	tokenInfo := jwt.TokenInfoFromContext(r.Context())
+	switch {
+	case tokenInfo.APIKeyID != model.APIKeyID{}:
+		return clientTypeAPIKey, tokenInfo.APIKeyID.UUID().String()
+	case tokenInfo.UserID != model.DefaultUserID:
+		return clientTypeUser, tokenInfo.UserID.UUID().String()
+	}
+	return clientTypeIP, api_handler.ClientIP(r)
+}
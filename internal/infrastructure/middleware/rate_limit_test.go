@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestCreateRateLimitMiddleware(t *testing.T) {
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateRateLimitMiddleware(nil, NewMockrateLimitMetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateRateLimitMiddleware(NewMockrateLimiter(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	userID, err := model.ParseUserID("6451927e-846b-4c97-9924-cba818687a02")
+	require.NoError(t, err)
+	apiKeyID, err := model.ParseAPIKeyID("6451927e-846b-4c97-9924-cba818687a03")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		tokenInfo  *model.TokenInfo
+		wantClient string
+		allow      bool
+		wantCalled bool
+		wantCode   int
+		wantType   string
+	}{
+		{
+			name:       "allowed_user",
+			tokenInfo:  &model.TokenInfo{UserID: userID, UserRole: model.UserRoleEmployee},
+			wantClient: "user:" + userID.UUID().String(),
+			allow:      true,
+			wantCalled: true,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "allowed_ip",
+			wantClient: "ip:192.0.2.1",
+			allow:      true,
+			wantCalled: true,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "rejected_user",
+			tokenInfo:  &model.TokenInfo{UserID: userID, UserRole: model.UserRoleEmployee},
+			wantClient: "user:" + userID.UUID().String(),
+			wantCode:   http.StatusTooManyRequests,
+			wantType:   "user",
+		},
+		{
+			name:       "rejected_api_key",
+			tokenInfo:  &model.TokenInfo{UserID: model.DefaultUserID, UserRole: model.UserRoleEmployee, APIKeyID: apiKeyID},
+			wantClient: "api_key:" + apiKeyID.UUID().String(),
+			wantCode:   http.StatusTooManyRequests,
+			wantType:   "api_key",
+		},
+		{
+			name:       "rejected_dummy_token",
+			tokenInfo:  &model.TokenInfo{UserID: model.DefaultUserID, UserRole: model.UserRoleModerator},
+			wantClient: "ip:192.0.2.1",
+			wantCode:   http.StatusTooManyRequests,
+			wantType:   "ip",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			limiter := NewMockrateLimiter(ctrl)
+			metrics := NewMockrateLimitMetrics(ctrl)
+
+			retryAfter := time.Duration(0)
+			if !tt.allow {
+				retryAfter = 1500 * time.Millisecond
+				metrics.EXPECT().RateLimitedInc("POST /products", tt.wantType)
+			}
+			limiter.EXPECT().Allow("POST /products", tt.wantClient).Return(tt.allow, retryAfter)
+
+			mw, err := CreateRateLimitMiddleware(limiter, metrics)
+			require.NoError(t, err)
+
+			called := false
+			handler := mw(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/products", nil)
+			r.Pattern = "POST /products"
+			if tt.tokenInfo != nil {
+				r = r.WithContext(jwt.ContextWithTokenInfo(r.Context(), *tt.tokenInfo))
+			}
+			w := httptest.NewRecorder()
+			handler(w, r)
+
+			assert.Equal(t, tt.wantCalled, called)
+			require.Equal(t, tt.wantCode, w.Code)
+			if !tt.allow {
+				require.Equal(t, "2", w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestCreateIPRateLimitMiddleware(t *testing.T) {
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateIPRateLimitMiddleware(nil, NewMockrateLimitMetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := CreateIPRateLimitMiddleware(NewMockrateLimiter(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	tests := []struct {
+		name       string
+		allow      bool
+		wantCalled bool
+		wantCode   int
+	}{
+		{
+			name:       "allowed",
+			allow:      true,
+			wantCalled: true,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:     "rejected",
+			wantCode: http.StatusTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			limiter := NewMockrateLimiter(ctrl)
+			metrics := NewMockrateLimitMetrics(ctrl)
+
+			retryAfter := time.Duration(0)
+			if !tt.allow {
+				retryAfter = 1500 * time.Millisecond
+				metrics.EXPECT().RateLimitedInc("before_auth", "ip")
+			}
+			limiter.EXPECT().Allow("before_auth", "ip:192.0.2.1").Return(tt.allow, retryAfter)
+
+			mw, err := CreateIPRateLimitMiddleware(limiter, metrics)
+			require.NoError(t, err)
+
+			called := false
+			handler := mw(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			// the request is not authenticated yet, an invalid token doesn't matter
+			r := httptest.NewRequest(http.MethodGet, "/pvz", nil)
+			r.Header.Set("Authorization", "invalid")
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantCalled, called)
+			require.Equal(t, tt.wantCode, w.Code)
+			if !tt.allow {
+				require.Equal(t, "2", w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package migrator
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []Migration
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fsys: fstest.MapFS{
+				"0002_second.up.sql":   {Data: []byte("up 2")},
+				"0002_second.down.sql": {Data: []byte("down 2")},
+				"0001_first.up.sql":    {Data: []byte("up 1")},
+				"0001_first.down.sql":  {Data: []byte("down 1")},
+				"migrations.go":        {Data: []byte("package migrations")},
+				"README.md":            {Data: []byte("readme")},
+			},
+			want: []Migration{
+				{Version: 1, Name: "first", UpSQL: "up 1", DownSQL: "down 1"},
+				{Version: 2, Name: "second", UpSQL: "up 2", DownSQL: "down 2"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error.no_down",
+			fsys: fstest.MapFS{
+				"0001_first.up.sql": {Data: []byte("up 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.no_up",
+			fsys: fstest.MapFS{
+				"0001_first.down.sql": {Data: []byte("down 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.different_names",
+			fsys: fstest.MapFS{
+				"0001_first.up.sql":     {Data: []byte("up 1")},
+				"0001_another.down.sql": {Data: []byte("down 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error.zero_version",
+			fsys: fstest.MapFS{
+				"0000_first.up.sql":   {Data: []byte("up 1")},
+				"0000_first.down.sql": {Data: []byte("down 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "error.empty",
+			fsys:    fstest.MapFS{},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Load(tc.fsys)
+			require.Equal(t, tc.wantErr, err != nil, err)
+			require.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestLoad_EmbeddedMigrations(t *testing.T) {
+	res, err := Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	for i, migration := range res {
+		require.Equal(t, int64(i+1), migration.Version, "migration versions must be sequential")
+	}
+}
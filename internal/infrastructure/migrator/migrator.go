@@ -0,0 +1,235 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal"
+)
+
+// lockID is a key of the session advisory lock, which guarantees that only one migrator
+// changes the schema at a time, e.g. when several service instances start simultaneously.
+const lockID = 8_263_540_117
+
+var ErrNoAppliedMigrations = errors.New("no applied migrations")
+
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+	logger     internal.Logger
+}
+
+func New(db *sqlx.DB, fsys fs.FS, logger internal.Logger) (*Migrator, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if fsys == nil {
+		return nil, errors.New("fsys is nil")
+	}
+	if logger == nil {
+		return nil, errors.New("logger is nil")
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     logger,
+	}, nil
+}
+
+// Up applies all not yet applied migrations in version order, each one in its own transaction.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return fmt.Errorf("appliedVersions: %w", err)
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			err = inTx(ctx, conn, func(tx *sqlx.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.UpSQL); err != nil {
+					return fmt.Errorf("exec up sql: %w", err)
+				}
+
+				q := `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`
+				if _, err := tx.ExecContext(ctx, q, migration.Version, migration.Name); err != nil {
+					return fmt.Errorf("insert schema_migrations: %w", err)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			m.logger.Info("migration applied", zap.Int64("version", migration.Version), zap.String("name", migration.Name))
+		}
+
+		return nil
+	})
+}
+
+// Down rolls back the last applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		var version int64
+		err := conn.GetContext(ctx, &version, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`)
+		if err != nil {
+			return fmt.Errorf("select last version: %w", err)
+		}
+		if version == 0 {
+			return ErrNoAppliedMigrations
+		}
+
+		var migration *Migration
+		for i := range m.migrations {
+			if m.migrations[i].Version == version {
+				migration = &m.migrations[i]
+				break
+			}
+		}
+		if migration == nil {
+			return fmt.Errorf("migration %d is applied but its files are not found", version)
+		}
+
+		err = inTx(ctx, conn, func(tx *sqlx.Tx) error {
+			if _, err := tx.ExecContext(ctx, migration.DownSQL); err != nil {
+				return fmt.Errorf("exec down sql: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, version); err != nil {
+				return fmt.Errorf("delete schema_migrations: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("rollback migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		m.logger.Info("migration rolled back", zap.Int64("version", migration.Version), zap.String("name", migration.Name))
+
+		return nil
+	})
+}
+
+// Status returns all known migrations with information whether they are applied.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var res []MigrationStatus
+
+	err := m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return fmt.Errorf("appliedVersions: %w", err)
+		}
+
+		res = make([]MigrationStatus, 0, len(m.migrations))
+		for _, migration := range m.migrations {
+			status := MigrationStatus{Migration: migration}
+			if appliedAt, ok := applied[migration.Version]; ok {
+				status.Applied = true
+				status.AppliedAt = &appliedAt
+			}
+			res = append(res, status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sqlx.Conn) error) error {
+	// session advisory lock and migrations must use the same connection
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("db.Connx: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	_, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID)
+	if err != nil {
+		return fmt.Errorf("pg_advisory_lock: %w", err)
+	}
+	defer func() {
+		_, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID)
+		if unlockErr != nil {
+			m.logger.Error("pg_advisory_unlock", zap.Error(unlockErr))
+		}
+	}()
+
+	q := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+	)`
+	_, err = conn.ExecContext(ctx, q)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func appliedVersions(ctx context.Context, conn *sqlx.Conn) (map[int64]time.Time, error) {
+	var rows []struct {
+		Version   int64     `db:"version"`
+		AppliedAt time.Time `db:"applied_at"`
+	}
+
+	err := conn.SelectContext(ctx, &rows, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("conn.SelectContext: %w", err)
+	}
+
+	res := make(map[int64]time.Time, len(rows))
+	for _, row := range rows {
+		res[row.Version] = row.AppliedAt
+	}
+
+	return res, nil
+}
+
+func inTx(ctx context.Context, conn *sqlx.Conn, fn func(tx *sqlx.Tx) error) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("conn.BeginTxx: %w", err)
+	}
+
+	err = fn(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+//go:build integration
+
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // register "pgx" database/sql driver
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
+)
+
+const testSchema = "migrator_test"
+
+// setUp connects to the test database with search_path pointed to a separate schema,
+// so test migrations don't touch the service tables.
+func setUp(t *testing.T) *sqlx.DB {
+	cfg := config.Load()
+
+	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%d/%s",
+		cfg.DatabaseUser, cfg.DatabasePassword, cfg.DatabaseHost,
+		cfg.DatabasePort, cfg.DatabaseName)
+
+	db, err := sql.Open("pgx", databaseURL+"?search_path="+testSchema)
+	require.NoError(t, err)
+
+	_, err = db.Exec("DROP SCHEMA IF EXISTS " + testSchema + " CASCADE")
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE SCHEMA " + testSchema)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = db.Exec("DROP SCHEMA IF EXISTS " + testSchema + " CASCADE")
+		_ = db.Close()
+	})
+
+	return sqlx.NewDb(db, "pgx")
+}
+
+func tableExists(t *testing.T, db *sqlx.DB, table string) bool {
+	var exists bool
+	err := db.Get(&exists, `SELECT EXISTS (
+		SELECT 1 FROM information_schema.tables WHERE table_schema = $1 AND table_name = $2
+	)`, testSchema, table)
+	require.NoError(t, err)
+
+	return exists
+}
+
+func TestNew(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_first.up.sql":   {Data: []byte("CREATE TABLE first (id INT)")},
+		"0001_first.down.sql": {Data: []byte("DROP TABLE first")},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		res, err := New(&sqlx.DB{}, fsys, zap.NewNop())
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil, fsys, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := New(&sqlx.DB{}, nil, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		res, err := New(&sqlx.DB{}, fsys, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.invalid_migrations", func(t *testing.T) {
+		res, err := New(&sqlx.DB{}, fstest.MapFS{}, zap.NewNop())
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	db := setUp(t)
+	ctx := context.Background()
+
+	m, err := New(db, fstest.MapFS{
+		"0001_first.up.sql":    {Data: []byte("CREATE TABLE first (id INT); CREATE TABLE first_2 (id INT);")},
+		"0001_first.down.sql":  {Data: []byte("DROP TABLE first; DROP TABLE first_2;")},
+		"0002_second.up.sql":   {Data: []byte("CREATE TABLE second (id INT)")},
+		"0002_second.down.sql": {Data: []byte("DROP TABLE second")},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.False(t, statuses[0].Applied)
+	require.False(t, statuses[1].Applied)
+
+	err = m.Down(ctx)
+	require.ErrorIs(t, err, ErrNoAppliedMigrations)
+
+	err = m.Up(ctx)
+	require.NoError(t, err)
+	require.True(t, tableExists(t, db, "first"))
+	require.True(t, tableExists(t, db, "first_2"))
+	require.True(t, tableExists(t, db, "second"))
+
+	// repeated up is a no-op
+	err = m.Up(ctx)
+	require.NoError(t, err)
+
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Applied)
+	require.NotNil(t, statuses[0].AppliedAt)
+	require.True(t, statuses[1].Applied)
+
+	err = m.Down(ctx)
+	require.NoError(t, err)
+	require.True(t, tableExists(t, db, "first"))
+	require.False(t, tableExists(t, db, "second"))
+
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Applied)
+	require.False(t, statuses[1].Applied)
+}
+
+func TestMigrator_Up_FailedMigrationIsRolledBack(t *testing.T) {
+	db := setUp(t)
+	ctx := context.Background()
+
+	m, err := New(db, fstest.MapFS{
+		"0001_first.up.sql":    {Data: []byte("CREATE TABLE first (id INT)")},
+		"0001_first.down.sql":  {Data: []byte("DROP TABLE first")},
+		"0002_broken.up.sql":   {Data: []byte("CREATE TABLE broken (id INT); SELECT * FROM not_existing_table;")},
+		"0002_broken.down.sql": {Data: []byte("DROP TABLE broken")},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	err = m.Up(ctx)
+	require.Error(t, err)
+	require.True(t, tableExists(t, db, "first"))
+	require.False(t, tableExists(t, db, "broken"))
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Applied)
+	require.False(t, statuses[1].Applied)
+}
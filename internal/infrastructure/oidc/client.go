@@ -0,0 +1,345 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwtlib "github.com/golang-jwt/jwt/v4"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// the JWKS of the provider is fetched again for an unknown kid, but not more often than this
+	keysRefreshInterval = time.Minute
+	maxResponseSize     = 1 << 20
+)
+
+var ErrUnknownKeyID = errors.New("unknown kid in ID token")
+
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// GroupsClaim is the ID token claim with the groups of the user
+	GroupsClaim string
+}
+
+// Client is the relying party of the OpenID Connect authorization code flow with PKCE.
+type Client struct {
+	cfg      Config
+	client   *http.Client
+	metadata metadata
+	now      func() time.Time
+
+	mu            sync.Mutex
+	keys          *jwt.KeySet
+	keysFetchedAt time.Time
+}
+
+// metadata is the part of the provider configuration document the client uses (OpenID Connect Discovery 1.0).
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// New loads the configuration of the issuer, the keys of the issuer are loaded on the first login.
+func New(ctx context.Context, cfg Config, timeout time.Duration) (*Client, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New("issuer is empty")
+	}
+	if cfg.ClientID == "" {
+		return nil, errors.New("clientID is empty")
+	}
+	if cfg.RedirectURL == "" {
+		return nil, errors.New("redirectURL is empty")
+	}
+	if cfg.GroupsClaim == "" {
+		return nil, errors.New("groupsClaim is empty")
+	}
+	if timeout <= 0 {
+		return nil, errors.New("timeout should be positive")
+	}
+
+	c := &Client{
+		cfg:    cfg,
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+
+	err := c.getJSON(ctx, strings.TrimSuffix(cfg.Issuer, "/")+discoveryPath, &c.metadata)
+	if err != nil {
+		return nil, fmt.Errorf("get provider configuration: %w", err)
+	}
+	if c.metadata.Issuer != cfg.Issuer {
+		return nil, fmt.Errorf("provider configuration is of issuer %s", c.metadata.Issuer)
+	}
+	if c.metadata.AuthorizationEndpoint == "" || c.metadata.TokenEndpoint == "" || c.metadata.JWKSURI == "" {
+		return nil, errors.New("provider configuration has no authorization_endpoint, token_endpoint or jwks_uri")
+	}
+
+	return c, nil
+}
+
+// AuthCodeURL is the authorization endpoint URL the user is redirected to for login.
+func (c *Client) AuthCodeURL(state model.OIDCState) string {
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.cfg.ClientID},
+		"redirect_uri":          {c.cfg.RedirectURL},
+		"scope":                 {strings.Join(c.cfg.Scopes, " ")},
+		"state":                 {state.State},
+		"nonce":                 {state.Nonce},
+		"code_challenge":        {state.CodeChallenge()},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(c.metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return c.metadata.AuthorizationEndpoint + separator + params.Encode()
+}
+
+// Exchange redeems the authorization code and verifies the returned ID token. A code or a token rejected
+// by the provider or by the verification gives model.ErrOIDCAuthFailed.
+func (c *Client) Exchange(ctx context.Context, code string, state model.OIDCState) (model.OIDCIdentity, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.cfg.RedirectURL},
+		"code_verifier": {state.CodeVerifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return model.OIDCIdentity{}, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return model.OIDCIdentity{}, fmt.Errorf("client.Do: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return model.OIDCIdentity{}, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		var errResp errorResponse
+		_ = json.Unmarshal(body, &errResp)
+		return model.OIDCIdentity{}, fmt.Errorf("%w: token endpoint error %q: %s", model.ErrOIDCAuthFailed,
+			errResp.Error, errResp.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return model.OIDCIdentity{}, fmt.Errorf("token endpoint: unexpected status code: %d", resp.StatusCode)
+	}
+
+	var tokens tokenResponse
+	err = json.Unmarshal(body, &tokens)
+	if err != nil {
+		return model.OIDCIdentity{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	if tokens.IDToken == "" {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: no id_token in token response", model.ErrOIDCAuthFailed)
+	}
+
+	return c.verify(ctx, tokens.IDToken, state.Nonce)
+}
+
+// verify checks the signature, issuer, audience, expiration and nonce of the ID token.
+func (c *Client) verify(ctx context.Context, idToken, nonce string) (model.OIDCIdentity, error) {
+	claims := jwtlib.MapClaims{}
+	parser := jwtlib.NewParser(jwtlib.WithValidMethods([]string{
+		jwtlib.SigningMethodES256.Alg(),
+		jwtlib.SigningMethodRS256.Alg(),
+	}))
+	_, err := parser.ParseWithClaims(idToken, claims, func(token *jwtlib.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := c.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.Method.Alg() != token.Method.Alg() {
+			return nil, fmt.Errorf("token alg %s doesn't match key alg %s", token.Method.Alg(), key.Method.Alg())
+		}
+		return key.Public, nil
+	})
+	if err != nil {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: %w", model.ErrOIDCAuthFailed, err)
+	}
+
+	if !claims.VerifyIssuer(c.metadata.Issuer, true) {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: wrong iss", model.ErrOIDCAuthFailed)
+	}
+	if !claims.VerifyAudience(c.cfg.ClientID, true) {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: wrong aud", model.ErrOIDCAuthFailed)
+	}
+	if azp, ok := claims["azp"]; ok && azp != c.cfg.ClientID {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: wrong azp", model.ErrOIDCAuthFailed)
+	}
+	if !claims.VerifyExpiresAt(c.now().Unix(), true) {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: no exp", model.ErrOIDCAuthFailed)
+	}
+	tokenNonce, _ := claims["nonce"].(string)
+	if subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: wrong nonce", model.ErrOIDCAuthFailed)
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: no sub", model.ErrOIDCAuthFailed)
+	}
+	email, _ := claims["email"].(string)
+	if email == "" {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: no email", model.ErrOIDCAuthFailed)
+	}
+	// the email links the identity to a local user, so it must be verified by the provider, a missing claim is
+	// treated as unverified
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		return model.OIDCIdentity{}, model.ErrOIDCEmailNotVerified
+	}
+
+	groups, err := parseGroups(claims[c.cfg.GroupsClaim])
+	if err != nil {
+		return model.OIDCIdentity{}, fmt.Errorf("%w: %w", model.ErrOIDCAuthFailed, err)
+	}
+
+	return model.OIDCIdentity{
+		Issuer:  c.metadata.Issuer,
+		Subject: subject,
+		Email:   email,
+		Groups:  groups,
+	}, nil
+}
+
+// key returns the key of the provider by kid, the keys are fetched again if the provider rotated them.
+func (c *Client) key(ctx context.Context, kid string) (jwt.Key, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keys != nil {
+		if key, ok := c.keys.Get(kid); ok {
+			return key, nil
+		}
+		if c.now().Sub(c.keysFetchedAt) < keysRefreshInterval {
+			return jwt.Key{}, ErrUnknownKeyID
+		}
+	}
+
+	keys, err := c.fetchKeys(ctx)
+	if err != nil {
+		return jwt.Key{}, fmt.Errorf("fetchKeys: %w", err)
+	}
+	c.keys = keys
+	c.keysFetchedAt = c.now()
+
+	key, ok := c.keys.Get(kid)
+	if !ok {
+		return jwt.Key{}, ErrUnknownKeyID
+	}
+	return key, nil
+}
+
+// fetchKeys skips keys that are not for signatures or are of unsupported types.
+func (c *Client) fetchKeys(ctx context.Context) (*jwt.KeySet, error) {
+	var jwks jwt.JWKS
+	err := c.getJSON(ctx, c.metadata.JWKSURI, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("get jwks: %w", err)
+	}
+
+	keys := make([]jwt.Key, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwt.ParseJWK(jwk)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return jwt.NewKeySet(keys...)
+}
+
+func (c *Client) getJSON(ctx context.Context, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("client.Do: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+	if err != nil {
+		return fmt.Errorf("json.Decode: %w", err)
+	}
+
+	return nil
+}
+
+// parseGroups accepts a list of groups or a single group, no claim means no groups.
+func parseGroups(claim any) ([]string, error) {
+	switch v := claim.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []any:
+		groups := make([]string, 0, len(v))
+		for _, item := range v {
+			group, ok := item.(string)
+			if !ok {
+				return nil, errors.New("groups claim should be a list of strings")
+			}
+			groups = append(groups, group)
+		}
+		return groups, nil
+	}
+	return nil, errors.New("groups claim should be a list of strings")
+}
@@ -0,0 +1,442 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jwtlib "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	testClientID     = "pvz-service"
+	testClientSecret = "client-secret"
+	testRedirectURL  = "https://pvz.example.com/auth/oidc/callback"
+	testCode         = "auth-code"
+)
+
+// testIssuer is a local OpenID Connect provider, it issues an ID token with the claims for testCode.
+type testIssuer struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *ecdsa.PrivateKey
+	kid    string
+	// signingKey signs ID tokens instead of the published key when set
+	signingKey *ecdsa.PrivateKey
+	// claims of the next ID token, the nonce is set from the state
+	claims      jwtlib.MapClaims
+	tokenStatus int
+	jwksFetches atomic.Int32
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	issuer := &testIssuer{t: t, tokenStatus: http.StatusOK}
+	issuer.rotateKey()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]string{
+			"issuer":                 issuer.url(),
+			"authorization_endpoint": issuer.url() + "/authorize",
+			"token_endpoint":         issuer.url() + "/token",
+			"jwks_uri":               issuer.url() + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		issuer.jwksFetches.Add(1)
+		key := jwt.Key{ID: issuer.kid, Method: jwtlib.SigningMethodES256, Public: &issuer.key.PublicKey}
+		writeJSON(t, w, jwt.JWKS{Keys: []jwt.JWK{
+			key.JWK(),
+			{Kty: "oct", Kid: "symmetric", Use: "sig"},
+			{Kty: "RSA", Kid: "encryption", Use: "enc"},
+		}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, testClientID, clientID)
+		assert.Equal(t, testClientSecret, clientSecret)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal(t, testRedirectURL, r.PostForm.Get("redirect_uri"))
+
+		if issuer.tokenStatus != http.StatusOK {
+			w.WriteHeader(issuer.tokenStatus)
+			writeJSON(t, w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		if r.PostForm.Get("code") != testCode {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(t, w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		writeJSON(t, w, map[string]string{
+			"access_token": "provider-access-token",
+			"token_type":   "Bearer",
+			"id_token":     issuer.sign(issuer.claims),
+		})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) url() string {
+	return i.server.URL
+}
+
+func (i *testIssuer) rotateKey() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(i.t, err)
+	i.key = key
+	i.kid = "key-" + time.Now().Format(time.RFC3339Nano)
+}
+
+func (i *testIssuer) sign(claims jwtlib.MapClaims) string {
+	token := jwtlib.NewWithClaims(jwtlib.SigningMethodES256, claims)
+	token.Header["kid"] = i.kid
+	key := i.key
+	if i.signingKey != nil {
+		key = i.signingKey
+	}
+	tokenStr, err := token.SignedString(key)
+	require.NoError(i.t, err)
+	return tokenStr
+}
+
+func (i *testIssuer) validClaims(nonce string) jwtlib.MapClaims {
+	return jwtlib.MapClaims{
+		"iss":            i.url(),
+		"sub":            "subject-1",
+		"aud":            testClientID,
+		"exp":            time.Now().Add(5 * time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "staff@example.com",
+		"email_verified": true,
+		"groups":         []string{"everyone", "pvz-staff"},
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func newTestClient(t *testing.T, issuer *testIssuer) *Client {
+	t.Helper()
+
+	client, err := New(context.Background(), Config{
+		Issuer:       issuer.url(),
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"openid", "email", "groups"},
+		GroupsClaim:  "groups",
+	}, time.Second)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestNew(t *testing.T) {
+	issuer := newTestIssuer(t)
+	cfg := Config{
+		Issuer:      issuer.url(),
+		ClientID:    testClientID,
+		RedirectURL: testRedirectURL,
+		GroupsClaim: "groups",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		res, err := New(context.Background(), cfg, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, issuer.url()+"/token", res.metadata.TokenEndpoint)
+		assert.Equal(t, int32(0), issuer.jwksFetches.Load())
+	})
+
+	t.Run("error.issuer_mismatch", func(t *testing.T) {
+		wrongCfg := cfg
+		wrongCfg.Issuer = issuer.url() + "/"
+		res, err := New(context.Background(), wrongCfg, time.Second)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("error.no_configuration", func(t *testing.T) {
+		wrongCfg := cfg
+		wrongCfg.Issuer = issuer.url() + "/realms/pvz"
+		res, err := New(context.Background(), wrongCfg, time.Second)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		timeout time.Duration
+	}{
+		{name: "error.issuer", modify: func(cfg *Config) { cfg.Issuer = "" }, timeout: time.Second},
+		{name: "error.client_id", modify: func(cfg *Config) { cfg.ClientID = "" }, timeout: time.Second},
+		{name: "error.redirect_url", modify: func(cfg *Config) { cfg.RedirectURL = "" }, timeout: time.Second},
+		{name: "error.groups_claim", modify: func(cfg *Config) { cfg.GroupsClaim = "" }, timeout: time.Second},
+		{name: "error.timeout", modify: func(_ *Config) {}, timeout: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrongCfg := cfg
+			tt.modify(&wrongCfg)
+			res, err := New(context.Background(), wrongCfg, tt.timeout)
+			require.Error(t, err)
+			require.Nil(t, res)
+		})
+	}
+}
+
+func TestClient_AuthCodeURL(t *testing.T) {
+	issuer := newTestIssuer(t)
+	client := newTestClient(t, issuer)
+
+	state, err := model.NewOIDCState()
+	require.NoError(t, err)
+
+	res, err := url.Parse(client.AuthCodeURL(state))
+	require.NoError(t, err)
+	assert.Equal(t, issuer.url()+"/authorize", res.Scheme+"://"+res.Host+res.Path)
+
+	query := res.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, testClientID, query.Get("client_id"))
+	assert.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+	assert.Equal(t, "openid email groups", query.Get("scope"))
+	assert.Equal(t, state.State, query.Get("state"))
+	assert.Equal(t, state.Nonce, query.Get("nonce"))
+	assert.Equal(t, state.CodeChallenge(), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Empty(t, query.Get("code_verifier"))
+}
+
+func TestClient_Exchange(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(issuer *testIssuer, claims jwtlib.MapClaims)
+		code    string
+		want    model.OIDCIdentity
+		wantErr error
+	}{
+		{
+			name:    "success",
+			prepare: func(_ *testIssuer, _ jwtlib.MapClaims) {},
+			want: model.OIDCIdentity{
+				Subject: "subject-1",
+				Email:   "staff@example.com",
+				Groups:  []string{"everyone", "pvz-staff"},
+			},
+		},
+		{
+			name: "success.single_group",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["groups"] = "pvz-staff"
+			},
+			want: model.OIDCIdentity{
+				Subject: "subject-1",
+				Email:   "staff@example.com",
+				Groups:  []string{"pvz-staff"},
+			},
+		},
+		{
+			name: "success.no_groups",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				delete(claims, "groups")
+			},
+			want: model.OIDCIdentity{
+				Subject: "subject-1",
+				Email:   "staff@example.com",
+			},
+		},
+		{
+			name:    "error.invalid_grant",
+			prepare: func(_ *testIssuer, _ jwtlib.MapClaims) {},
+			code:    "wrong-code",
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.wrong_nonce",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["nonce"] = "other"
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.wrong_aud",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["aud"] = "other-client"
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.wrong_azp",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["aud"] = []string{testClientID, "other-client"}
+				claims["azp"] = "other-client"
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.wrong_iss",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["iss"] = "https://evil.example.com"
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.expired",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.no_exp",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				delete(claims, "exp")
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.no_sub",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				delete(claims, "sub")
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.no_email",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				delete(claims, "email")
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.email_not_verified",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["email_verified"] = false
+			},
+			wantErr: model.ErrOIDCEmailNotVerified,
+		},
+		{
+			name: "error.no_email_verified",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				delete(claims, "email_verified")
+			},
+			wantErr: model.ErrOIDCEmailNotVerified,
+		},
+		{
+			name: "error.email_verified_not_bool",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["email_verified"] = "true"
+			},
+			wantErr: model.ErrOIDCEmailNotVerified,
+		},
+		{
+			name: "error.groups",
+			prepare: func(_ *testIssuer, claims jwtlib.MapClaims) {
+				claims["groups"] = []any{"pvz-staff", 1}
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "error.wrong_key",
+			prepare: func(issuer *testIssuer, _ jwtlib.MapClaims) {
+				other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				require.NoError(t, err)
+				issuer.signingKey = other
+			},
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := newTestIssuer(t)
+			client := newTestClient(t, issuer)
+
+			state, err := model.NewOIDCState()
+			require.NoError(t, err)
+
+			issuer.claims = issuer.validClaims(state.Nonce)
+			tt.prepare(issuer, issuer.claims)
+
+			code := testCode
+			if tt.code != "" {
+				code = tt.code
+			}
+
+			res, err := client.Exchange(context.Background(), code, state)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.want.Issuer = issuer.url()
+			assert.Equal(t, tt.want, res)
+		})
+	}
+
+	t.Run("error.token_endpoint_status", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		issuer.tokenStatus = http.StatusInternalServerError
+		client := newTestClient(t, issuer)
+
+		_, err := client.Exchange(context.Background(), testCode, model.OIDCState{Nonce: "nonce"})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, model.ErrOIDCAuthFailed)
+	})
+}
+
+func TestClient_Exchange_KeyRotation(t *testing.T) {
+	issuer := newTestIssuer(t)
+	client := newTestClient(t, issuer)
+
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	exchange := func() error {
+		state, err := model.NewOIDCState()
+		require.NoError(t, err)
+		issuer.claims = issuer.validClaims(state.Nonce)
+
+		_, err = client.Exchange(context.Background(), testCode, state)
+		return err
+	}
+
+	require.NoError(t, exchange())
+	require.NoError(t, exchange())
+	assert.Equal(t, int32(1), issuer.jwksFetches.Load())
+
+	// the keys were fetched recently, an unknown kid doesn't make the client fetch them again
+	issuer.rotateKey()
+	require.ErrorIs(t, exchange(), model.ErrOIDCAuthFailed)
+	assert.Equal(t, int32(1), issuer.jwksFetches.Load())
+
+	now = now.Add(keysRefreshInterval)
+	require.NoError(t, exchange())
+	assert.Equal(t, int32(2), issuer.jwksFetches.Load())
+}
@@ -0,0 +1,44 @@
+package oidc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// ParseGroupRoles parses mappings of provider groups to roles, e.g. "pvz-moderators=moderator;pvz-staff=employee".
+// The order is kept, the first mapping of a group the user is a member of gives the role.
+func ParseGroupRoles(s string) (model.OIDCGroupRoles, error) {
+	var groupRoles model.OIDCGroupRoles
+	seen := make(map[string]struct{})
+
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		group, rawRole, found := strings.Cut(item, "=")
+		if !found {
+			return nil, fmt.Errorf("no role for %q", item)
+		}
+		group = strings.TrimSpace(group)
+		if group == "" {
+			return nil, fmt.Errorf("no group for %q", item)
+		}
+
+		role, err := model.ParseUserRole(strings.TrimSpace(rawRole))
+		if err != nil {
+			return nil, fmt.Errorf("parse role of group %s: %w", group, err)
+		}
+
+		if _, ok := seen[group]; ok {
+			return nil, fmt.Errorf("duplicate mapping of group %s", group)
+		}
+		seen[group] = struct{}{}
+		groupRoles = append(groupRoles, model.OIDCGroupRole{Group: group, Role: role})
+	}
+
+	return groupRoles, nil
+}
@@ -0,0 +1,64 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestParseGroupRoles(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    model.OIDCGroupRoles
+		wantErr bool
+	}{
+		{
+			name: "success",
+			s:    "pvz-moderators=moderator; pvz-staff = employee;",
+			want: model.OIDCGroupRoles{
+				{Group: "pvz-moderators", Role: model.UserRoleModerator},
+				{Group: "pvz-staff", Role: model.UserRoleEmployee},
+			},
+		},
+		{
+			name: "empty",
+			s:    "",
+			want: nil,
+		},
+		{
+			name:    "error.no_role",
+			s:       "pvz-staff",
+			wantErr: true,
+		},
+		{
+			name:    "error.no_group",
+			s:       "=employee",
+			wantErr: true,
+		},
+		{
+			name:    "error.role",
+			s:       "pvz-staff=boss",
+			wantErr: true,
+		},
+		{
+			name:    "error.duplicate",
+			s:       "pvz-staff=employee;pvz-staff=moderator",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseGroupRoles(tt.s)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
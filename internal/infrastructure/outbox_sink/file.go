@@ -0,0 +1,67 @@
+package outbox_sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// FileSink appends every message as a JSON line to the file.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	if path == "" {
+		return nil, errors.New("path is empty")
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("os.OpenFile: %w", err)
+	}
+
+	return &FileSink{
+		file: file,
+	}, nil
+}
+
+func (s *FileSink) Send(_ context.Context, outboxMessage model.OutboxMessage) error {
+	line, err := MarshalMessage(outboxMessage)
+	if err != nil {
+		return fmt.Errorf("MarshalMessage: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.file.Write(line)
+	if err != nil {
+		return fmt.Errorf("file.Write: %w", err)
+	}
+
+	// the message is marked delivered right after Send, so it must not be lost in the page cache
+	err = s.file.Sync()
+	if err != nil {
+		return fmt.Errorf("file.Sync: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.file.Close()
+	if err != nil {
+		return fmt.Errorf("file.Close: %w", err)
+	}
+	return nil
+}
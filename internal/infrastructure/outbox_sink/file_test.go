@@ -0,0 +1,49 @@
+package outbox_sink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileSink(t *testing.T) {
+	t.Run("error.empty_path", func(t *testing.T) {
+		res, err := NewFileSink("")
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.no_dir", func(t *testing.T) {
+		res, err := NewFileSink(filepath.Join(t.TempDir(), "missing", "outbox.jsonl"))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestFileSink_Send(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.jsonl")
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), testMessage()))
+	require.NoError(t, sink.Send(context.Background(), testMessage()))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		require.JSONEq(t, testMessageJSON, line)
+	}
+
+	t.Run("error.closed", func(t *testing.T) {
+		err = sink.Send(context.Background(), testMessage())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,35 @@
+package outbox_sink
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// MemorySink keeps delivered messages in memory, it is meant for tests and local runs.
+type MemorySink struct {
+	mu       sync.Mutex
+	messages []model.OutboxMessage
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Send(_ context.Context, outboxMessage model.OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, outboxMessage)
+	return nil
+}
+
+// Messages returns the delivered messages in the order of delivery.
+func (s *MemorySink) Messages() []model.OutboxMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.messages)
+}
@@ -0,0 +1,25 @@
+package outbox_sink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestMemorySink_Send(t *testing.T) {
+	sink := NewMemorySink()
+	require.Empty(t, sink.Messages())
+
+	message := testMessage()
+	require.NoError(t, sink.Send(context.Background(), message))
+
+	messages := sink.Messages()
+	require.Equal(t, []model.OutboxMessage{message}, messages)
+
+	// the returned slice is a copy
+	messages[0].Type = model.OutboxEventProductAdded
+	require.Equal(t, model.OutboxEventReceptionClosed, sink.Messages()[0].Type)
+}
@@ -0,0 +1,34 @@
+package outbox_sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// message is the envelope delivered by every sink. Consumers should deduplicate events by ID,
+// because delivery is at-least-once.
+type message struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// MarshalMessage encodes the message envelope sent to consumers.
+func MarshalMessage(outboxMessage model.OutboxMessage) ([]byte, error) {
+	data, err := json.Marshal(message{
+		ID:        outboxMessage.ID.UUID(),
+		Type:      outboxMessage.Type.String(),
+		CreatedAt: outboxMessage.CreatedAt,
+		Payload:   outboxMessage.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,33 @@
+package outbox_sink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type Sink interface {
+	Send(ctx context.Context, outboxMessage model.OutboxMessage) error
+}
+
+// MultiSink sends every message to all sinks in order. It stops on the first error, so sinks
+// that already received the message will get it again on retry.
+type MultiSink struct {
+	sinks []Sink
+}
+
+func NewMultiSink(sinks ...Sink) *MultiSink {
+	return &MultiSink{
+		sinks: sinks,
+	}
+}
+
+func (s *MultiSink) Send(ctx context.Context, outboxMessage model.OutboxMessage) error {
+	for i, sink := range s.sinks {
+		if err := sink.Send(ctx, outboxMessage); err != nil {
+			return fmt.Errorf("sinks[%d].Send: %w", i, err)
+		}
+	}
+	return nil
+}
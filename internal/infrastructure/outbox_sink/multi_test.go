@@ -0,0 +1,38 @@
+package outbox_sink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type failingSink struct{}
+
+func (failingSink) Send(context.Context, model.OutboxMessage) error {
+	return assert.AnError
+}
+
+func TestMultiSink_Send(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		first, second := NewMemorySink(), NewMemorySink()
+		sink := NewMultiSink(first, second)
+
+		message := testMessage()
+		require.NoError(t, sink.Send(context.Background(), message))
+
+		require.Equal(t, []model.OutboxMessage{message}, first.Messages())
+		require.Equal(t, []model.OutboxMessage{message}, second.Messages())
+	})
+	t.Run("error.stops_on_first_error", func(t *testing.T) {
+		last := NewMemorySink()
+		sink := NewMultiSink(failingSink{}, last)
+
+		err := sink.Send(context.Background(), testMessage())
+		require.ErrorIs(t, err, assert.AnError)
+		require.Empty(t, last.Messages())
+	})
+}
@@ -0,0 +1,60 @@
+package outbox_sink
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// WebhookSink posts every message as JSON to the URL, any 2xx response means the message is delivered.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, timeout time.Duration) (*WebhookSink, error) {
+	if url == "" {
+		return nil, errors.New("url is empty")
+	}
+	if timeout <= 0 {
+		return nil, errors.New("timeout should be positive")
+	}
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *WebhookSink) Send(ctx context.Context, outboxMessage model.OutboxMessage) error {
+	body, err := MarshalMessage(outboxMessage)
+	if err != nil {
+		return fmt.Errorf("MarshalMessage: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("client.Do: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
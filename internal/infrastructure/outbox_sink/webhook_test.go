@@ -0,0 +1,92 @@
+package outbox_sink
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func testMessage() model.OutboxMessage {
+	return model.OutboxMessage{
+		ID:        model.OutboxMessageID(uuid.MustParse("6451927e-846b-4c97-9924-cba818687a11")),
+		Type:      model.OutboxEventReceptionClosed,
+		Payload:   []byte(`{"reception": {"id": "6451927e-846b-4c97-9924-cba818687a21"}}`),
+		CreatedAt: time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC),
+	}
+}
+
+const testMessageJSON = `{
+	"id": "6451927e-846b-4c97-9924-cba818687a11",
+	"type": "reception.closed",
+	"createdAt": "2025-04-09T20:55:59Z",
+	"payload": {"reception": {"id": "6451927e-846b-4c97-9924-cba818687a21"}}
+}`
+
+func TestNewWebhookSink(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewWebhookSink("http://localhost", time.Second)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.empty_url", func(t *testing.T) {
+		res, err := NewWebhookSink("", time.Second)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_timeout", func(t *testing.T) {
+		res, err := NewWebhookSink("http://localhost", 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestWebhookSink_Send(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testMessageJSON, string(body))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		sink, err := NewWebhookSink(server.URL, time.Second)
+		require.NoError(t, err)
+
+		err = sink.Send(context.Background(), testMessage())
+		require.NoError(t, err)
+	})
+	t.Run("error.status_code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sink, err := NewWebhookSink(server.URL, time.Second)
+		require.NoError(t, err)
+
+		err = sink.Send(context.Background(), testMessage())
+		require.EqualError(t, err, "unexpected status code: 503")
+	})
+	t.Run("error.unavailable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+		server.Close()
+
+		sink, err := NewWebhookSink(server.URL, time.Second)
+		require.NoError(t, err)
+
+		err = sink.Send(context.Background(), testMessage())
+		require.Error(t, err)
+	})
+}
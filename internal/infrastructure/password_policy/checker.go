@@ -0,0 +1,77 @@
+package password_policy
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// Checker checks new passwords against the policy and the list of common and breached passwords.
+type Checker struct {
+	policy   model.PasswordPolicy
+	breached map[string]struct{}
+}
+
+func NewChecker(policy model.PasswordPolicy, breached []string) (*Checker, error) {
+	err := policy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("policy.Validate: %w", err)
+	}
+
+	c := &Checker{
+		policy:   policy,
+		breached: make(map[string]struct{}, len(breached)),
+	}
+	for _, password := range breached {
+		c.breached[strings.ToLower(password)] = struct{}{}
+	}
+
+	return c, nil
+}
+
+// LoadBreachedList reads the newline-separated list of passwords, empty lines and lines starting with # are skipped.
+// Nothing is loaded when path is empty.
+func LoadBreachedList(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var passwords []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords = append(passwords, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanner.Err: %w", err)
+	}
+
+	return passwords, nil
+}
+
+// Check returns PasswordPolicyError with all violated rules, or nil if the password is fine.
+// The breached list is matched case-insensitively, so "Qwerty123" is as weak as "qwerty123".
+func (c *Checker) Check(password, email string) error {
+	violations := c.policy.Check(password, email)
+	if _, ok := c.breached[strings.ToLower(password)]; ok {
+		violations = append(violations, model.PasswordRuleNotBreached)
+	}
+
+	if len(violations) > 0 {
+		return &model.PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
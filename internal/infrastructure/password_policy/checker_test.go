@@ -0,0 +1,71 @@
+package password_policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewChecker(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewChecker(model.PasswordPolicy{MinLength: 8, MaxLength: 72}, nil)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.invalid_policy", func(t *testing.T) {
+		res, err := NewChecker(model.PasswordPolicy{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestChecker_Check(t *testing.T) {
+	checker, err := NewChecker(model.PasswordPolicy{MinLength: 8, MaxLength: 72, RequireDigit: true},
+		[]string{"qwerty123", "password1"})
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, checker.Check("pvz-password-1", "user@gmail.com"))
+	})
+	t.Run("error.breached", func(t *testing.T) {
+		err := checker.Check("Qwerty123", "user@gmail.com")
+		var policyErr *model.PasswordPolicyError
+		require.ErrorAs(t, err, &policyErr)
+		assert.Equal(t, []model.PasswordRule{model.PasswordRuleNotBreached}, policyErr.Violations)
+	})
+	t.Run("error.policy_and_breached", func(t *testing.T) {
+		err := checker.Check("password", "user@gmail.com")
+		var policyErr *model.PasswordPolicyError
+		require.ErrorAs(t, err, &policyErr)
+		assert.Equal(t, []model.PasswordRule{model.PasswordRuleDigit}, policyErr.Violations)
+
+		err = checker.Check("password1", "password1@gmail.com")
+		require.ErrorAs(t, err, &policyErr)
+		assert.Equal(t, []model.PasswordRule{model.PasswordRuleNotEmail, model.PasswordRuleNotBreached}, policyErr.Violations)
+	})
+}
+
+func TestLoadBreachedList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "breached_passwords.txt")
+		require.NoError(t, os.WriteFile(path, []byte("# common passwords\r\n123456\r\n\r\nqwerty\n"), 0o600))
+
+		res, err := LoadBreachedList(path)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"123456", "qwerty"}, res)
+	})
+	t.Run("success.empty_path", func(t *testing.T) {
+		res, err := LoadBreachedList("")
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+	t.Run("error.not_found", func(t *testing.T) {
+		_, err := LoadBreachedList(filepath.Join(t.TempDir(), "missing.txt"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,124 @@
+package rate_limit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Rule allows Rate requests per second on average with bursts up to Burst requests. A zero Rate disables the limit.
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// Limiter is a token bucket per route and client. Buckets live in the process memory,
+// so every instance limits its own share of requests.
+type Limiter struct {
+	defaultRule Rule
+	routeRules  map[string]Rule
+	now         func() time.Time
+
+	mu          sync.Mutex
+	buckets     map[bucketKey]*bucket
+	lastCleanup time.Time
+}
+
+type bucketKey struct {
+	route  string
+	client string
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// cleanupInterval is how often buckets that have refilled completely are dropped.
+const cleanupInterval = time.Minute
+
+func NewLimiter(defaultRule Rule, routeRules map[string]Rule) (*Limiter, error) {
+	err := defaultRule.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid default rule: %w", err)
+	}
+	for route, rule := range routeRules {
+		err = rule.validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule of route %s: %w", route, err)
+		}
+	}
+
+	return &Limiter{
+		defaultRule: defaultRule,
+		routeRules:  routeRules,
+		now:         time.Now,
+		buckets:     make(map[bucketKey]*bucket),
+	}, nil
+}
+
+func (r Rule) validate() error {
+	if r.Rate < 0 || math.IsNaN(r.Rate) || math.IsInf(r.Rate, 0) {
+		return errors.New("rate should be a non-negative number")
+	}
+	if r.Rate > 0 && r.Burst < 1 {
+		return errors.New("burst should be positive")
+	}
+	return nil
+}
+
+// Allow takes a token from the bucket of the client for the route. If the bucket is empty,
+// the request is rejected and retryAfter is the time until the next token.
+func (l *Limiter) Allow(route, client string) (ok bool, retryAfter time.Duration) {
+	rule, found := l.routeRules[route]
+	if !found {
+		rule = l.defaultRule
+	}
+	if rule.Rate == 0 {
+		return true, 0
+	}
+
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cleanup(now)
+
+	key := bucketKey{route: route, client: client}
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: float64(rule.Burst), updatedAt: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(rule.Burst), b.tokens+now.Sub(b.updatedAt).Seconds()*rule.Rate)
+	b.updatedAt = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / rule.Rate * float64(time.Second))
+}
+
+// cleanup drops buckets that have refilled completely, they are the same as new ones.
+func (l *Limiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < cleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+
+	for key, b := range l.buckets {
+		rule, found := l.routeRules[key.route]
+		if !found {
+			rule = l.defaultRule
+		}
+		if b.tokens+now.Sub(b.updatedAt).Seconds()*rule.Rate >= float64(rule.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
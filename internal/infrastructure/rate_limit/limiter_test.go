@@ -0,0 +1,86 @@
+package rate_limit
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLimiter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewLimiter(Rule{Rate: 1, Burst: 1}, map[string]Rule{"POST /login": {Rate: 0}})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.default_rule", func(t *testing.T) {
+		res, err := NewLimiter(Rule{Rate: 1, Burst: 0}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.route_rule", func(t *testing.T) {
+		res, err := NewLimiter(Rule{Rate: 1, Burst: 1}, map[string]Rule{"POST /login": {Rate: math.NaN(), Burst: 1}})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Date(2025, 4, 9, 20, 0, 0, 0, time.UTC)
+
+	limiter, err := NewLimiter(Rule{Rate: 2, Burst: 3}, map[string]Rule{
+		"POST /products": {Rate: 1, Burst: 1},
+		"GET /pvz":       {Rate: 0},
+	})
+	require.NoError(t, err)
+	limiter.now = func() time.Time { return now }
+
+	t.Run("burst", func(t *testing.T) {
+		for range 3 {
+			ok, _ := limiter.Allow("POST /receptions", "user1")
+			require.True(t, ok)
+		}
+
+		ok, retryAfter := limiter.Allow("POST /receptions", "user1")
+		require.False(t, ok)
+		require.Equal(t, 500*time.Millisecond, retryAfter)
+
+		// other clients have own buckets
+		ok, _ = limiter.Allow("POST /receptions", "user2")
+		require.True(t, ok)
+	})
+	t.Run("refill", func(t *testing.T) {
+		now = now.Add(time.Second)
+
+		for range 2 {
+			ok, _ := limiter.Allow("POST /receptions", "user1")
+			require.True(t, ok)
+		}
+
+		ok, _ := limiter.Allow("POST /receptions", "user1")
+		require.False(t, ok)
+	})
+	t.Run("route_rule", func(t *testing.T) {
+		ok, _ := limiter.Allow("POST /products", "user1")
+		require.True(t, ok)
+
+		ok, retryAfter := limiter.Allow("POST /products", "user1")
+		require.False(t, ok)
+		require.Equal(t, time.Second, retryAfter)
+	})
+	t.Run("no_limit", func(t *testing.T) {
+		for range 10 {
+			ok, _ := limiter.Allow("GET /pvz", "user1")
+			require.True(t, ok)
+		}
+	})
+	t.Run("cleanup", func(t *testing.T) {
+		now = now.Add(time.Hour)
+
+		ok, _ := limiter.Allow("POST /products", "user3")
+		require.True(t, ok)
+		require.Len(t, limiter.buckets, 1)
+	})
+}
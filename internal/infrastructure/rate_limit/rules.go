@@ -0,0 +1,49 @@
+package rate_limit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseRouteRules parses rules of routes in the form "POST /products=5:10;POST /login=1:5",
+// where the route is the pattern of the HTTP handler, then the rate per second and the burst.
+// An empty string gives no rules.
+func ParseRouteRules(s string) (map[string]Rule, error) {
+	rules := make(map[string]Rule)
+
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		route, rawRule, found := strings.Cut(item, "=")
+		if !found {
+			return nil, fmt.Errorf("no rule for %q", item)
+		}
+		route = strings.TrimSpace(route)
+
+		rawRate, rawBurst, found := strings.Cut(rawRule, ":")
+		if !found {
+			return nil, fmt.Errorf("rule of route %s should be rate:burst", route)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rawRate), 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse rate of route %s: %w", route, err)
+		}
+
+		burst, err := strconv.Atoi(strings.TrimSpace(rawBurst))
+		if err != nil {
+			return nil, fmt.Errorf("parse burst of route %s: %w", route, err)
+		}
+
+		if _, ok := rules[route]; ok {
+			return nil, fmt.Errorf("duplicate rule of route %s", route)
+		}
+		rules[route] = Rule{Rate: rate, Burst: burst}
+	}
+
+	return rules, nil
+}
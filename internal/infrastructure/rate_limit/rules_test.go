@@ -0,0 +1,67 @@
+package rate_limit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRouteRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    map[string]Rule
+		wantErr bool
+	}{
+		{
+			name: "success",
+			s:    "POST /products=5:10; POST /login = 0.5:5;",
+			want: map[string]Rule{
+				"POST /products": {Rate: 5, Burst: 10},
+				"POST /login":    {Rate: 0.5, Burst: 5},
+			},
+		},
+		{
+			name: "empty",
+			s:    "",
+			want: map[string]Rule{},
+		},
+		{
+			name:    "error.no_rule",
+			s:       "POST /products",
+			wantErr: true,
+		},
+		{
+			name:    "error.no_burst",
+			s:       "POST /products=5",
+			wantErr: true,
+		},
+		{
+			name:    "error.rate",
+			s:       "POST /products=five:10",
+			wantErr: true,
+		},
+		{
+			name:    "error.burst",
+			s:       "POST /products=5:ten",
+			wantErr: true,
+		},
+		{
+			name:    "error.duplicate",
+			s:       "POST /products=5:10;POST /products=1:1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRouteRules(tt.s)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
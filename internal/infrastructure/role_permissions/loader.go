@@ -0,0 +1,50 @@
+package role_permissions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// Load returns the default role permissions overridden by the JSON file at path, e.g.
+// {"auditor": ["pvz:read", "reception:read"]}. A role listed in the file gets exactly the listed permissions,
+// other roles keep the defaults. The defaults are returned as is when path is empty.
+func Load(path string) (model.RolePermissions, error) {
+	rolePermissions := model.DefaultRolePermissions()
+	if path == "" {
+		return rolePermissions, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	var raw map[string][]string
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	for rawRole, rawPermissions := range raw {
+		role, err := model.ParseUserRole(rawRole)
+		if err != nil {
+			return nil, fmt.Errorf("parse role %s: %w", rawRole, err)
+		}
+
+		permissions := make([]model.Permission, 0, len(rawPermissions))
+		for _, rawPermission := range rawPermissions {
+			permission, err := model.ParsePermission(rawPermission)
+			if err != nil {
+				return nil, fmt.Errorf("parse permission %s of role %s: %w", rawPermission, rawRole, err)
+			}
+			permissions = append(permissions, permission)
+		}
+
+		rolePermissions[role] = model.NewPermissionSet(permissions...)
+	}
+
+	return rolePermissions, nil
+}
@@ -0,0 +1,49 @@
+package role_permissions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestLoad(t *testing.T) {
+	writeFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "role_permissions.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("success.defaults", func(t *testing.T) {
+		res, err := Load("")
+		require.NoError(t, err)
+		assert.Equal(t, model.DefaultRolePermissions(), res)
+	})
+	t.Run("success.override", func(t *testing.T) {
+		res, err := Load(writeFile(t, `{"auditor": ["pvz:read", "webhook:manage"], "employee": []}`))
+		require.NoError(t, err)
+		assert.Equal(t, model.NewPermissionSet(model.PermissionPVZRead, model.PermissionWebhookManage), res.Of(model.UserRoleAuditor))
+		assert.Empty(t, res.Of(model.UserRoleEmployee))
+		assert.Equal(t, model.DefaultRolePermissions().Of(model.UserRoleModerator), res.Of(model.UserRoleModerator))
+	})
+	t.Run("error.no_file", func(t *testing.T) {
+		_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+	})
+	t.Run("error.invalid_json", func(t *testing.T) {
+		_, err := Load(writeFile(t, `{"auditor": "pvz:read"}`))
+		require.Error(t, err)
+	})
+	t.Run("error.unknown_role", func(t *testing.T) {
+		_, err := Load(writeFile(t, `{"admin": ["pvz:read"]}`))
+		require.Error(t, err)
+	})
+	t.Run("error.unknown_permission", func(t *testing.T) {
+		_, err := Load(writeFile(t, `{"auditor": ["pvz:delete"]}`))
+		require.Error(t, err)
+	})
+}
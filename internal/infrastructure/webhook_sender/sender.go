@@ -0,0 +1,90 @@
+package webhook_sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/outbox_sink"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// Sender posts outbox messages to subscription URLs. The body is signed with the subscription secret,
+// see Sign for the signature format.
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func New(timeout time.Duration) (*Sender, error) {
+	if timeout <= 0 {
+		return nil, errors.New("timeout should be positive")
+	}
+	return &Sender{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}, nil
+}
+
+// Send returns the response status code, it is zero when the request failed before getting a response.
+// Any 2xx response means the delivery succeeded.
+func (s *Sender) Send(ctx context.Context, task model.WebhookDeliveryTask) (int, error) {
+	body, err := outbox_sink.MarshalMessage(task.Delivery.Message)
+	if err != nil {
+		return 0, fmt.Errorf("outbox_sink.MarshalMessage: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, task.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, task.Delivery.Message.Type.String())
+	req.Header.Set(HeaderDelivery, task.Delivery.ID.UUID().String())
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(task.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("client.Do: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the X-Webhook-Signature value: "sha256=" and hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+// Receivers should compute it with their copy of the secret and reject stale timestamps.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
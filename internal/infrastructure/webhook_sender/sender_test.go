@@ -0,0 +1,116 @@
+package webhook_sender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func testTask(url string) model.WebhookDeliveryTask {
+	return model.WebhookDeliveryTask{
+		Delivery: model.WebhookDelivery{
+			ID: model.WebhookDeliveryID(uuid.MustParse("6451927e-846b-4c97-9924-cba818687a31")),
+			Message: model.OutboxMessage{
+				ID:        model.OutboxMessageID(uuid.MustParse("6451927e-846b-4c97-9924-cba818687a11")),
+				Type:      model.OutboxEventReceptionClosed,
+				Payload:   []byte(`{"reception": {"id": "6451927e-846b-4c97-9924-cba818687a21"}}`),
+				CreatedAt: time.Date(2025, 4, 9, 20, 55, 59, 0, time.UTC),
+			},
+		},
+		URL:    url,
+		Secret: "0123456789abcdef",
+	}
+}
+
+const testBodyJSON = `{
+	"id": "6451927e-846b-4c97-9924-cba818687a11",
+	"type": "reception.closed",
+	"createdAt": "2025-04-09T20:55:59Z",
+	"payload": {"reception": {"id": "6451927e-846b-4c97-9924-cba818687a21"}}
+}`
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := New(time.Second)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.zero_timeout", func(t *testing.T) {
+		res, err := New(0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1744232159.{}' | openssl dgst -sha256 -hmac secret
+	require.Equal(t,
+		"sha256=e33183544ab746d115f38d3bfc118b3377235419227bfb9e4cd7d0ad1173bbd2",
+		Sign("secret", "1744232159", []byte("{}")),
+	)
+	require.NotEqual(t, Sign("secret", "1744232159", []byte("{}")), Sign("other", "1744232159", []byte("{}")))
+	require.NotEqual(t, Sign("secret", "1744232159", []byte("{}")), Sign("secret", "1744232160", []byte("{}")))
+}
+
+func TestSender_Send(t *testing.T) {
+	now := time.Date(2025, 4, 9, 21, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "reception.closed", r.Header.Get(HeaderEvent))
+			assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a31", r.Header.Get(HeaderDelivery))
+			assert.Equal(t, "1744232400", r.Header.Get(HeaderTimestamp))
+
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testBodyJSON, string(body))
+			assert.Equal(t, Sign("0123456789abcdef", "1744232400", body), r.Header.Get(HeaderSignature))
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sender, err := New(time.Second)
+		require.NoError(t, err)
+		sender.now = func() time.Time { return now }
+
+		statusCode, err := sender.Send(context.Background(), testTask(server.URL))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, statusCode)
+	})
+	t.Run("error.status_code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		sender, err := New(time.Second)
+		require.NoError(t, err)
+
+		statusCode, err := sender.Send(context.Background(), testTask(server.URL))
+		require.EqualError(t, err, "unexpected status code: 500")
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+	t.Run("error.unavailable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+		server.Close()
+
+		sender, err := New(time.Second)
+		require.NoError(t, err)
+
+		statusCode, err := sender.Send(context.Background(), testTask(server.URL))
+		require.Error(t, err)
+		require.Zero(t, statusCode)
+	})
+}
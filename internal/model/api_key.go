@@ -0,0 +1,85 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyBytes = 32
+	// APIKeyPrefix marks API keys, so a leaked key is easy to recognize, e.g. by secret scanners
+	APIKeyPrefix = "pvz_"
+)
+
+// APIKey lets a machine client, like a sorting-centre integration, call the API without a user. Only the hash of
+// the key is stored. The key acts with the permissions of UserRole, PVZIDs limits it to the PVZs even if the role
+// may change any PVZ.
+type APIKey struct {
+	ID         APIKeyID
+	Name       string
+	KeyHash    []byte
+	UserRole   UserRole
+	PVZIDs     []PVZID
+	CreatedBy  UserID
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+type APIKeyID uuid.UUID
+
+// NewAPIKey generates a random API key, the returned string is given to the client once.
+func NewAPIKey(name string, role UserRole, pvzIDs []PVZID, createdBy UserID, expiresAt time.Time) (string, APIKey, error) {
+	raw := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", APIKey{}, fmt.Errorf("rand.Read: %w", err)
+	}
+	key := APIKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	return key, APIKey{
+		ID:        NewAPIKeyID(),
+		Name:      name,
+		KeyHash:   HashAPIKey(key),
+		UserRole:  role,
+		PVZIDs:    pvzIDs,
+		CreatedBy: createdBy,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func HashAPIKey(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
+
+func (k APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && now.Before(k.ExpiresAt)
+}
+
+// PVZAccess is the access of the key before the role permissions are applied, see APIKey.
+func (k APIKey) PVZAccess() PVZAccess {
+	return PVZAccess{PVZIDs: k.PVZIDs}
+}
+
+func NewAPIKeyID() APIKeyID {
+	return APIKeyID(uuid.New())
+}
+
+func (id APIKeyID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func ParseAPIKeyID(s string) (APIKeyID, error) {
+	ID, err := uuid.Parse(s)
+	if err != nil {
+		return APIKeyID{}, fmt.Errorf("uuid.parse: %w", err)
+	}
+
+	return APIKeyID(ID), nil
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	userID := NewUserID()
+	pvzID := NewPVZID()
+	expiresAt := time.Now().Add(time.Hour)
+
+	key, apiKey, err := NewAPIKey("sorting centre", UserRoleEmployee, []PVZID{pvzID}, userID, expiresAt)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, APIKeyPrefix))
+	require.Len(t, key, len(APIKeyPrefix)+43)
+	assert.NotEqual(t, APIKeyID{}, apiKey.ID)
+	assert.Equal(t, "sorting centre", apiKey.Name)
+	assert.Equal(t, HashAPIKey(key), apiKey.KeyHash)
+	assert.Equal(t, UserRoleEmployee, apiKey.UserRole)
+	assert.Equal(t, []PVZID{pvzID}, apiKey.PVZIDs)
+	assert.Equal(t, userID, apiKey.CreatedBy)
+	assert.Equal(t, expiresAt, apiKey.ExpiresAt)
+	assert.Nil(t, apiKey.LastUsedAt)
+	assert.Nil(t, apiKey.RevokedAt)
+
+	other, otherAPIKey, err := NewAPIKey("sorting centre", UserRoleEmployee, nil, userID, expiresAt)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	assert.NotEqual(t, apiKey.ID, otherAPIKey.ID)
+}
+
+func TestAPIKey_IsActive(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+
+	assert.True(t, APIKey{ExpiresAt: now.Add(time.Minute)}.IsActive(now))
+	assert.False(t, APIKey{ExpiresAt: now.Add(-time.Minute)}.IsActive(now))
+	assert.False(t, APIKey{ExpiresAt: now.Add(time.Minute), RevokedAt: &revokedAt}.IsActive(now))
+}
+
+func TestParseAPIKeyID(t *testing.T) {
+	id, err := ParseAPIKeyID("6451927e-846b-4c97-9924-cba818687a01")
+	require.NoError(t, err)
+	assert.Equal(t, "6451927e-846b-4c97-9924-cba818687a01", id.UUID().String())
+
+	_, err = ParseAPIKeyID("invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction int16
+
+const (
+	AuditActionPVZRegistered     AuditAction = 1
+	AuditActionReceptionCreated  AuditAction = 2
+	AuditActionReceptionClosed   AuditAction = 3
+	AuditActionReceptionReopened AuditAction = 4
+	AuditActionProductAdded      AuditAction = 5
+	AuditActionProductRemoved    AuditAction = 6
+)
+
+// Actor is who makes a change: a user, or a machine client with an API key.
+// API key requests belong to no user, their UserID is DefaultUserID and APIKeyID is set.
+type Actor struct {
+	UserID   UserID
+	APIKeyID *APIKeyID
+}
+
+// NewActor returns the actor of a request authenticated with the token info.
+func NewActor(tokenInfo TokenInfo) Actor {
+	actor := Actor{UserID: tokenInfo.UserID}
+	if tokenInfo.APIKeyID != (APIKeyID{}) {
+		apiKeyID := tokenInfo.APIKeyID
+		actor.APIKeyID = &apiKeyID
+	}
+	return actor
+}
+
+// AuditEvent records who changed what. ReceptionID and ProductID are nil when the action doesn't concern them,
+// APIKeyID is nil when the change was made by a user.
+type AuditEvent struct {
+	ID          AuditEventID
+	UserID      UserID
+	APIKeyID    *APIKeyID
+	Action      AuditAction
+	PVZID       PVZID
+	ReceptionID *ReceptionID
+	ProductID   *ProductID
+	CreatedAt   time.Time
+}
+
+type AuditEventID uuid.UUID
+
+// AuditFilter narrows the audit events search, nil fields are not filtered by.
+type AuditFilter struct {
+	UserID   *UserID
+	APIKeyID *APIKeyID
+	PVZID    *PVZID
+	Action   *AuditAction
+	From     *time.Time
+	To       *time.Time
+}
+
+func (a AuditAction) String() string {
+	switch a {
+	case AuditActionPVZRegistered:
+		return "pvz_registered"
+	case AuditActionReceptionCreated:
+		return "reception_created"
+	case AuditActionReceptionClosed:
+		return "reception_closed"
+	case AuditActionReceptionReopened:
+		return "reception_reopened"
+	case AuditActionProductAdded:
+		return "product_added"
+	case AuditActionProductRemoved:
+		return "product_removed"
+	}
+	return ""
+}
+
+func ParseAuditAction(s string) (AuditAction, error) {
+	switch s {
+	case "pvz_registered":
+		return AuditActionPVZRegistered, nil
+	case "reception_created":
+		return AuditActionReceptionCreated, nil
+	case "reception_closed":
+		return AuditActionReceptionClosed, nil
+	case "reception_reopened":
+		return AuditActionReceptionReopened, nil
+	case "product_added":
+		return AuditActionProductAdded, nil
+	case "product_removed":
+		return AuditActionProductRemoved, nil
+	}
+	return AuditAction(0), errors.New("audit action not found")
+}
+
+func (id AuditEventID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
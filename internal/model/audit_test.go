@@ -0,0 +1,49 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuditAction(t *testing.T) {
+	actions := []AuditAction{
+		AuditActionPVZRegistered,
+		AuditActionReceptionCreated,
+		AuditActionReceptionClosed,
+		AuditActionReceptionReopened,
+		AuditActionProductAdded,
+		AuditActionProductRemoved,
+	}
+	for _, action := range actions {
+		t.Run("valid."+action.String(), func(t *testing.T) {
+			res, err := ParseAuditAction(action.String())
+			require.NoError(t, err)
+			require.Equal(t, action, res)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		res, err := ParseAuditAction("product_sold")
+		require.Error(t, err)
+		require.Equal(t, AuditAction(0), res)
+	})
+	t.Run("empty", func(t *testing.T) {
+		res, err := ParseAuditAction("")
+		require.Error(t, err)
+		require.Equal(t, AuditAction(0), res)
+	})
+}
+
+func TestNewActor(t *testing.T) {
+	t.Run("user", func(t *testing.T) {
+		userID := NewUserID()
+		res := NewActor(TokenInfo{UserID: userID, UserRole: UserRoleEmployee})
+		require.Equal(t, Actor{UserID: userID}, res)
+	})
+	t.Run("api_key", func(t *testing.T) {
+		apiKeyID := NewAPIKeyID()
+		res := NewActor(TokenInfo{UserID: DefaultUserID, APIKeyID: apiKeyID})
+		require.Equal(t, Actor{UserID: DefaultUserID, APIKeyID: &apiKeyID}, res)
+	})
+}
@@ -0,0 +1,77 @@
+package model
+
+import "errors"
+
+// AuthMode is the authentication mode of the environment the service runs in.
+type AuthMode int8
+
+const (
+	AuthModeDevelopment AuthMode = 1
+	AuthModeTest        AuthMode = 2
+	AuthModeProduction  AuthMode = 3
+)
+
+// DummyTokenAccess limits what tokens of /dummyLogin may do.
+type DummyTokenAccess int8
+
+const (
+	// DummyTokenAccessFull gives dummy tokens all permissions of their role
+	DummyTokenAccessFull DummyTokenAccess = 1
+	// DummyTokenAccessReadOnly leaves dummy tokens only the read permissions of their role
+	DummyTokenAccessReadOnly DummyTokenAccess = 2
+	// DummyTokenAccessDenied rejects dummy tokens
+	DummyTokenAccessDenied DummyTokenAccess = 3
+)
+
+func (m AuthMode) String() string {
+	switch m {
+	case AuthModeDevelopment:
+		return "development"
+	case AuthModeTest:
+		return "test"
+	case AuthModeProduction:
+		return "production"
+	}
+	return ""
+}
+
+func ParseAuthMode(s string) (AuthMode, error) {
+	switch s {
+	case "development":
+		return AuthModeDevelopment, nil
+	case "test":
+		return AuthModeTest, nil
+	case "production":
+		return AuthModeProduction, nil
+	}
+	return AuthMode(0), errors.New("auth mode not found")
+}
+
+// DummyLoginEnabled reports whether POST /dummyLogin issues tokens, it never does in production.
+func (m AuthMode) DummyLoginEnabled() bool {
+	return m == AuthModeDevelopment || m == AuthModeTest
+}
+
+// DummyTokenAccess returns what dummy tokens may do in the mode. Production denies them, there they can only come
+// from another environment sharing the signing keys. Elsewhere readOnly makes them read-only.
+func (m AuthMode) DummyTokenAccess(readOnly bool) DummyTokenAccess {
+	if !m.DummyLoginEnabled() {
+		return DummyTokenAccessDenied
+	}
+	if readOnly {
+		return DummyTokenAccessReadOnly
+	}
+	return DummyTokenAccessFull
+}
+
+func (a DummyTokenAccess) String() string {
+	switch a {
+	case DummyTokenAccessFull:
+		return "full"
+	case DummyTokenAccessReadOnly:
+		return "read_only"
+	case DummyTokenAccessDenied:
+		return "denied"
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuthMode(t *testing.T) {
+	for _, mode := range []AuthMode{AuthModeDevelopment, AuthModeTest, AuthModeProduction} {
+		res, err := ParseAuthMode(mode.String())
+		require.NoError(t, err)
+		assert.Equal(t, mode, res)
+	}
+
+	_, err := ParseAuthMode("prod")
+	require.Error(t, err)
+}
+
+func TestAuthMode_DummyTokenAccess(t *testing.T) {
+	assert.True(t, AuthModeDevelopment.DummyLoginEnabled())
+	assert.True(t, AuthModeTest.DummyLoginEnabled())
+	assert.False(t, AuthModeProduction.DummyLoginEnabled())
+
+	assert.Equal(t, DummyTokenAccessFull, AuthModeDevelopment.DummyTokenAccess(false))
+	assert.Equal(t, DummyTokenAccessReadOnly, AuthModeTest.DummyTokenAccess(true))
+	assert.Equal(t, DummyTokenAccessDenied, AuthModeProduction.DummyTokenAccess(false))
+	assert.Equal(t, DummyTokenAccessDenied, AuthModeProduction.DummyTokenAccess(true))
+}
@@ -5,10 +5,38 @@ import "errors"
 var (
 	ErrReceptionNotFound      = errors.New("reception not found")
 	ErrReceptionAlreadyExists = errors.New("reception already exists")
+	ErrReceptionClosed        = errors.New("reception is closed")
+	ErrReceptionNotClosed     = errors.New("reception is not closed")
 
-	ErrProductNotFound = errors.New("product not found")
+	ErrProductNotFound       = errors.New("product not found")
+	ErrProductAlreadyScanned = errors.New("product already scanned")
 
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrWrongUserPassword = errors.New(("wrong user password"))
-	ErrUserNotFound      = errors.New("user not found")
+	ErrPVZNotFound           = errors.New("pvz not found")
+	ErrPVZAccessDenied       = errors.New("user is not assigned to the pvz")
+	ErrPVZAssignmentNotFound = errors.New("pvz assignment not found")
+
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookSubscriptionInvalid  = errors.New("webhook subscription is invalid")
+
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrWrongUserPassword    = errors.New(("wrong user password"))
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserNotEmployee      = errors.New("user is not an employee")
+	ErrUserDisabled         = errors.New("user is disabled")
+	ErrPasswordPolicy       = errors.New("password does not meet the policy")
+	ErrUserRoleManagedBySSO = errors.New("user role is managed by sso")
+
+	ErrLoginThrottled = errors.New("too many login attempts")
+	ErrAccountLocked  = errors.New("account is temporarily locked")
+
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenInvalid  = errors.New("refresh token is expired or revoked")
+
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	ErrAPIKeyInvalid  = errors.New("api key is invalid")
+
+	ErrOIDCAuthFailed       = errors.New("oidc authentication failed")
+	ErrOIDCEmailNotVerified = errors.New("oidc email is not verified")
+	ErrOIDCNoRole           = errors.New("no role for the oidc groups")
+	ErrOIDCUserConflict     = errors.New("user is not linked to the oidc subject")
 )
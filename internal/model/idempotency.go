@@ -0,0 +1,33 @@
+package model
+
+import (
+	"bytes"
+	"time"
+)
+
+// IdempotencyRecord is a request sent with an idempotency key and, once handled, its response.
+// Client is the user or the API key the key belongs to, so keys of different clients never clash.
+type IdempotencyRecord struct {
+	Client       string
+	Key          string
+	RequestHash  []byte
+	StatusCode   int
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	// LockedUntil is the end of the lease of the request being handled, a retry after it takes the key over
+	LockedUntil *time.Time
+	// ResponseLost is set when the request is done but its response could not be stored
+	ResponseLost bool
+}
+
+// Completed tells if the response is stored, otherwise the first request with the key is still being handled
+// or its response is lost.
+func (r IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Matches tells if the key is reused for the same request rather than for a different one.
+func (r IdempotencyRecord) Matches(requestHash []byte) bool {
+	return bytes.Equal(r.RequestHash, requestHash)
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyRecord_Completed(t *testing.T) {
+	assert.False(t, IdempotencyRecord{}.Completed())
+	assert.True(t, IdempotencyRecord{StatusCode: 201}.Completed())
+}
+
+func TestIdempotencyRecord_Matches(t *testing.T) {
+	record := IdempotencyRecord{RequestHash: []byte{1, 2, 3}}
+	assert.True(t, record.Matches([]byte{1, 2, 3}))
+	assert.False(t, record.Matches([]byte{1, 2, 4}))
+	assert.False(t, record.Matches(nil))
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// LoginFailures counts recent failed login attempts by a key, see LoginFailureEmailKey and LoginFailureIPKey.
+type LoginFailures struct {
+	Failures      int
+	LastFailureAt time.Time
+}
+
+// LoginPolicy limits login attempts. Every failed attempt for an email doubles the delay before the next one
+// is allowed, starting from Delay. After MaxFailures the account is locked for Lockout.
+// After MaxFailuresPerIP failed attempts from an IP, whatever the email, the IP is blocked for Lockout.
+// Failures are forgotten Lockout after the last one.
+type LoginPolicy struct {
+	MaxFailures      int
+	MaxFailuresPerIP int
+	Delay            time.Duration
+	Lockout          time.Duration
+}
+
+// LoginLimit is the part of LoginPolicy for one key that the database checks when an attempt is reserved:
+// after a failure the next attempt waits Delay doubled by every previous failure, at most Lockout, and after
+// MaxFailures failures the key is blocked until Lockout after the last one. A zero Delay means no wait.
+type LoginLimit struct {
+	MaxFailures int
+	Delay       time.Duration
+	Lockout     time.Duration
+}
+
+// LoginBlockedError wraps ErrLoginThrottled or ErrAccountLocked, the next attempt is allowed after RetryAfter.
+type LoginBlockedError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *LoginBlockedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LoginBlockedError) Unwrap() error {
+	return e.Err
+}
+
+func LoginFailureEmailKey(email string) string {
+	return "email:" + strings.ToLower(email)
+}
+
+func LoginFailureIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+func (p LoginPolicy) Validate() error {
+	if p.MaxFailures <= 0 {
+		return errors.New("max failures should be positive")
+	}
+	if p.MaxFailuresPerIP <= 0 {
+		return errors.New("max failures per ip should be positive")
+	}
+	if p.Delay <= 0 {
+		return errors.New("delay should be positive")
+	}
+	if p.Lockout < p.Delay {
+		return errors.New("lockout should not be less than delay")
+	}
+	return nil
+}
+
+// EmailLimit is the limit of LoginFailureEmailKey keys, it is the same as CheckEmail.
+func (p LoginPolicy) EmailLimit() LoginLimit {
+	return LoginLimit{MaxFailures: p.MaxFailures, Delay: p.Delay, Lockout: p.Lockout}
+}
+
+// IPLimit is the limit of LoginFailureIPKey keys, it is the same as CheckIP.
+func (p LoginPolicy) IPLimit() LoginLimit {
+	return LoginLimit{MaxFailures: p.MaxFailuresPerIP, Lockout: p.Lockout}
+}
+
+// CheckEmail returns LoginBlockedError if the account is locked or the delay after the last failure has not passed.
+func (p LoginPolicy) CheckEmail(f LoginFailures, now time.Time) error {
+	if f.Failures <= 0 {
+		return nil
+	}
+
+	if f.Failures >= p.MaxFailures {
+		return blocked(ErrAccountLocked, f.LastFailureAt.Add(p.Lockout), now)
+	}
+
+	delay := p.Delay << (f.Failures - 1)
+	if delay <= 0 || delay > p.Lockout {
+		delay = p.Lockout
+	}
+
+	return blocked(ErrLoginThrottled, f.LastFailureAt.Add(delay), now)
+}
+
+// CheckIP returns LoginBlockedError if there were too many failures from the IP.
+func (p LoginPolicy) CheckIP(f LoginFailures, now time.Time) error {
+	if f.Failures < p.MaxFailuresPerIP {
+		return nil
+	}
+
+	return blocked(ErrLoginThrottled, f.LastFailureAt.Add(p.Lockout), now)
+}
+
+func blocked(err error, until, now time.Time) error {
+	if !now.Before(until) {
+		return nil
+	}
+	return &LoginBlockedError{Err: err, RetryAfter: until.Sub(now)}
+}
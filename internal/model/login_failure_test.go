@@ -0,0 +1,118 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginPolicy_CheckEmail(t *testing.T) {
+	policy := LoginPolicy{
+		MaxFailures:      3,
+		MaxFailuresPerIP: 10,
+		Delay:            time.Second,
+		Lockout:          time.Minute,
+	}
+	last := time.Date(2025, 4, 9, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		failures       int
+		now            time.Time
+		wantErr        error
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:     "no_failures",
+			failures: 0,
+			now:      last,
+		},
+		{
+			name:           "first_failure_delay",
+			failures:       1,
+			now:            last.Add(500 * time.Millisecond),
+			wantErr:        ErrLoginThrottled,
+			wantRetryAfter: 500 * time.Millisecond,
+		},
+		{
+			name:     "first_failure_delay_passed",
+			failures: 1,
+			now:      last.Add(time.Second),
+		},
+		{
+			name:           "second_failure_delay_doubles",
+			failures:       2,
+			now:            last.Add(time.Second),
+			wantErr:        ErrLoginThrottled,
+			wantRetryAfter: time.Second,
+		},
+		{
+			name:           "locked",
+			failures:       3,
+			now:            last.Add(10 * time.Second),
+			wantErr:        ErrAccountLocked,
+			wantRetryAfter: 50 * time.Second,
+		},
+		{
+			name:     "lockout_passed",
+			failures: 3,
+			now:      last.Add(time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.CheckEmail(LoginFailures{Failures: tt.failures, LastFailureAt: last}, tt.now)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.wantErr)
+			var blockedErr *LoginBlockedError
+			require.True(t, errors.As(err, &blockedErr))
+			assert.Equal(t, tt.wantRetryAfter, blockedErr.RetryAfter)
+		})
+	}
+}
+
+func TestLoginPolicy_CheckIP(t *testing.T) {
+	policy := LoginPolicy{
+		MaxFailures:      3,
+		MaxFailuresPerIP: 10,
+		Delay:            time.Second,
+		Lockout:          time.Minute,
+	}
+	last := time.Date(2025, 4, 9, 20, 0, 0, 0, time.UTC)
+
+	require.NoError(t, policy.CheckIP(LoginFailures{Failures: 9, LastFailureAt: last}, last))
+	require.ErrorIs(t, policy.CheckIP(LoginFailures{Failures: 10, LastFailureAt: last}, last), ErrLoginThrottled)
+	require.NoError(t, policy.CheckIP(LoginFailures{Failures: 10, LastFailureAt: last}, last.Add(time.Minute)))
+}
+
+func TestLoginPolicy_Limits(t *testing.T) {
+	policy := LoginPolicy{MaxFailures: 5, MaxFailuresPerIP: 20, Delay: time.Second, Lockout: time.Minute}
+
+	assert.Equal(t, LoginLimit{MaxFailures: 5, Delay: time.Second, Lockout: time.Minute}, policy.EmailLimit())
+	assert.Equal(t, LoginLimit{MaxFailures: 20, Lockout: time.Minute}, policy.IPLimit())
+}
+
+func TestLoginPolicy_Validate(t *testing.T) {
+	valid := LoginPolicy{MaxFailures: 5, MaxFailuresPerIP: 20, Delay: time.Second, Lockout: time.Minute}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.MaxFailures = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Lockout = time.Millisecond
+	require.Error(t, invalid.Validate())
+}
+
+func TestLoginFailureEmailKey(t *testing.T) {
+	assert.Equal(t, LoginFailureEmailKey("user@gmail.com"), LoginFailureEmailKey("User@Gmail.com"))
+	assert.NotEqual(t, LoginFailureEmailKey("1.1.1.1"), LoginFailureIPKey("1.1.1.1"))
+}
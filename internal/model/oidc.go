@@ -0,0 +1,89 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const oidcStateBytes = 32
+
+// OIDCIdentity is the user identity from a verified ID token of the OpenID Connect provider.
+type OIDCIdentity struct {
+	Issuer  string
+	Subject string
+	Email   string
+	Groups  []string
+}
+
+// OIDCState binds the callback of the authorization code flow to the login that started it: State protects
+// against CSRF, Nonce is checked in the ID token and CodeVerifier is the PKCE secret (RFC 7636).
+type OIDCState struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+func NewOIDCState() (OIDCState, error) {
+	values := make([]string, 3)
+	for i := range values {
+		raw := make([]byte, oidcStateBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return OIDCState{}, fmt.Errorf("rand.Read: %w", err)
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	return OIDCState{
+		State:        values[0],
+		Nonce:        values[1],
+		CodeVerifier: values[2],
+	}, nil
+}
+
+// String encodes the state to be kept by the client until the callback, e.g. in a cookie.
+func (s OIDCState) String() string {
+	return s.State + "." + s.Nonce + "." + s.CodeVerifier
+}
+
+// CodeChallenge is the S256 PKCE challenge of the code verifier.
+func (s OIDCState) CodeChallenge() string {
+	hash := sha256.Sum256([]byte(s.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func ParseOIDCState(s string) (OIDCState, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return OIDCState{}, errors.New("oidc state should be state.nonce.verifier")
+	}
+
+	return OIDCState{
+		State:        parts[0],
+		Nonce:        parts[1],
+		CodeVerifier: parts[2],
+	}, nil
+}
+
+// OIDCGroupRole maps a group of the identity provider to a role.
+type OIDCGroupRole struct {
+	Group string
+	Role  UserRole
+}
+
+// OIDCGroupRoles is ordered, the first mapping of a group the user is a member of gives the role.
+type OIDCGroupRoles []OIDCGroupRole
+
+func (r OIDCGroupRoles) Role(groups []string) (UserRole, bool) {
+	for _, groupRole := range r {
+		for _, group := range groups {
+			if group == groupRole.Group {
+				return groupRole.Role, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOIDCState(t *testing.T) {
+	state, err := NewOIDCState()
+	require.NoError(t, err)
+	require.Len(t, state.State, 43)
+	require.Len(t, state.Nonce, 43)
+	require.Len(t, state.CodeVerifier, 43)
+	assert.NotEqual(t, state.State, state.Nonce)
+	assert.NotEqual(t, state.Nonce, state.CodeVerifier)
+
+	other, err := NewOIDCState()
+	require.NoError(t, err)
+	assert.NotEqual(t, state, other)
+}
+
+func TestParseOIDCState(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		state, err := NewOIDCState()
+		require.NoError(t, err)
+
+		res, err := ParseOIDCState(state.String())
+		require.NoError(t, err)
+		assert.Equal(t, state, res)
+	})
+
+	for _, s := range []string{"", "a.b", "a.b.c.d", "a..c"} {
+		t.Run("error."+s, func(t *testing.T) {
+			_, err := ParseOIDCState(s)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestOIDCState_CodeChallenge(t *testing.T) {
+	// example of RFC 7636, Appendix B
+	state := OIDCState{CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"}
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", state.CodeChallenge())
+}
+
+func TestOIDCGroupRoles_Role(t *testing.T) {
+	groupRoles := OIDCGroupRoles{
+		{Group: "pvz-moderators", Role: UserRoleModerator},
+		{Group: "pvz-staff", Role: UserRoleEmployee},
+	}
+
+	tests := []struct {
+		name     string
+		groups   []string
+		wantRole UserRole
+		wantOK   bool
+	}{
+		{
+			name:     "success",
+			groups:   []string{"everyone", "pvz-staff"},
+			wantRole: UserRoleEmployee,
+			wantOK:   true,
+		},
+		{
+			name:     "success.first_mapping_wins",
+			groups:   []string{"pvz-staff", "pvz-moderators"},
+			wantRole: UserRoleModerator,
+			wantOK:   true,
+		},
+		{
+			name:   "no_role",
+			groups: []string{"everyone"},
+			wantOK: false,
+		},
+		{
+			name:   "no_groups",
+			groups: nil,
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, ok := groupRoles.Role(tt.groups)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantRole, role)
+		})
+	}
+}
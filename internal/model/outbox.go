@@ -0,0 +1,91 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEventType is published to downstream systems and webhook subscribers as "<entity>.<action>",
+// e.g. reception.closed.
+type OutboxEventType int16
+
+const (
+	OutboxEventReceptionCreated  OutboxEventType = 1
+	OutboxEventReceptionClosed   OutboxEventType = 2
+	OutboxEventReceptionReopened OutboxEventType = 3
+	OutboxEventProductAdded      OutboxEventType = 4
+	OutboxEventProductRemoved    OutboxEventType = 5
+)
+
+// OutboxEvent is a change downstream systems are notified about. Product is nil for reception events.
+type OutboxEvent struct {
+	Type      OutboxEventType
+	Reception Reception
+	Product   *Product
+}
+
+// OutboxMessage is a stored outbox event waiting for delivery. Payload is the JSON body of the event.
+type OutboxMessage struct {
+	ID        OutboxMessageID
+	Type      OutboxEventType
+	PVZID     PVZID
+	Payload   []byte
+	CreatedAt time.Time
+	// Attempts is the number of failed delivery attempts
+	Attempts int
+}
+
+type OutboxMessageID uuid.UUID
+
+func NewReceptionOutboxEvent(eventType OutboxEventType, reception Reception) OutboxEvent {
+	return OutboxEvent{
+		Type:      eventType,
+		Reception: reception,
+	}
+}
+
+func NewProductOutboxEvent(eventType OutboxEventType, reception Reception, product Product) OutboxEvent {
+	return OutboxEvent{
+		Type:      eventType,
+		Reception: reception,
+		Product:   &product,
+	}
+}
+
+func (t OutboxEventType) String() string {
+	switch t {
+	case OutboxEventReceptionCreated:
+		return "reception.created"
+	case OutboxEventReceptionClosed:
+		return "reception.closed"
+	case OutboxEventReceptionReopened:
+		return "reception.reopened"
+	case OutboxEventProductAdded:
+		return "product.added"
+	case OutboxEventProductRemoved:
+		return "product.removed"
+	}
+	return ""
+}
+
+func ParseOutboxEventType(s string) (OutboxEventType, error) {
+	switch s {
+	case "reception.created":
+		return OutboxEventReceptionCreated, nil
+	case "reception.closed":
+		return OutboxEventReceptionClosed, nil
+	case "reception.reopened":
+		return OutboxEventReceptionReopened, nil
+	case "product.added":
+		return OutboxEventProductAdded, nil
+	case "product.removed":
+		return OutboxEventProductRemoved, nil
+	}
+	return OutboxEventType(0), errors.New("outbox event type not found")
+}
+
+func (id OutboxMessageID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutboxEventType(t *testing.T) {
+	eventTypes := []OutboxEventType{
+		OutboxEventReceptionCreated,
+		OutboxEventReceptionClosed,
+		OutboxEventReceptionReopened,
+		OutboxEventProductAdded,
+		OutboxEventProductRemoved,
+	}
+	for _, eventType := range eventTypes {
+		t.Run("valid."+eventType.String(), func(t *testing.T) {
+			res, err := ParseOutboxEventType(eventType.String())
+			require.NoError(t, err)
+			require.Equal(t, eventType, res)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		res, err := ParseOutboxEventType("reception_closed")
+		require.Error(t, err)
+		require.Equal(t, OutboxEventType(0), res)
+	})
+}
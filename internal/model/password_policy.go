@@ -0,0 +1,113 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcryptMaxLength is the number of password bytes bcrypt takes into account, longer passwords are rejected.
+const bcryptMaxLength = 72
+
+// PasswordRule is a rule of the password policy, violated rules are returned to the client.
+type PasswordRule string
+
+const (
+	PasswordRuleMinLength   PasswordRule = "min_length"
+	PasswordRuleMaxLength   PasswordRule = "max_length"
+	PasswordRuleUppercase   PasswordRule = "uppercase"
+	PasswordRuleLowercase   PasswordRule = "lowercase"
+	PasswordRuleDigit       PasswordRule = "digit"
+	PasswordRuleSpecial     PasswordRule = "special"
+	PasswordRuleNotEmail    PasswordRule = "not_email"
+	PasswordRuleNotBreached PasswordRule = "not_breached"
+)
+
+// PasswordPolicy is the set of rules a new password should satisfy. MinLength counts characters,
+// MaxLength counts bytes since bcrypt ignores everything after 72 bytes.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+}
+
+// PasswordPolicyError wraps ErrPasswordPolicy, Violations are the rules the password violates.
+type PasswordPolicyError struct {
+	Violations []PasswordRule
+}
+
+func (e *PasswordPolicyError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, rule := range e.Violations {
+		violations = append(violations, string(rule))
+	}
+	return fmt.Sprintf("%s: %s", ErrPasswordPolicy, strings.Join(violations, ", "))
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicy
+}
+
+func (p PasswordPolicy) Validate() error {
+	if p.MinLength <= 0 {
+		return errors.New("min length should be positive")
+	}
+	if p.MaxLength < p.MinLength {
+		return errors.New("max length should not be less than min length")
+	}
+	if p.MaxLength > bcryptMaxLength {
+		return fmt.Errorf("max length should not be greater than %d", bcryptMaxLength)
+	}
+	return nil
+}
+
+// Check returns the rules the password violates, the breached passwords list is checked separately.
+// The password should not be the email or its local part, case-insensitively.
+func (p PasswordPolicy) Check(password, email string) []PasswordRule {
+	var violations []PasswordRule
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, PasswordRuleMinLength)
+	}
+	if len(password) > p.MaxLength {
+		violations = append(violations, PasswordRuleMaxLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSpecial = true
+		}
+	}
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, PasswordRuleUppercase)
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, PasswordRuleLowercase)
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, PasswordRuleDigit)
+	}
+	if p.RequireSpecial && !hasSpecial {
+		violations = append(violations, PasswordRuleSpecial)
+	}
+
+	localPart, _, _ := strings.Cut(email, "@")
+	if email != "" && (strings.EqualFold(password, email) || strings.EqualFold(password, localPart)) {
+		violations = append(violations, PasswordRuleNotEmail)
+	}
+
+	return violations
+}
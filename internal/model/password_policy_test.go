@@ -0,0 +1,118 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:        8,
+		MaxLength:        72,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+	}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		email    string
+		want     []PasswordRule
+	}{
+		{
+			name:     "valid",
+			policy:   policy,
+			password: "Pvz-pass-1",
+			email:    "user@gmail.com",
+		},
+		{
+			name:     "valid_cyrillic",
+			policy:   policy,
+			password: "Пароль-пвз-1",
+			email:    "user@gmail.com",
+		},
+		{
+			name:     "empty",
+			policy:   policy,
+			password: "",
+			email:    "user@gmail.com",
+			want: []PasswordRule{PasswordRuleMinLength, PasswordRuleUppercase, PasswordRuleLowercase,
+				PasswordRuleDigit, PasswordRuleSpecial},
+		},
+		{
+			name:     "min_length_counts_characters",
+			policy:   PasswordPolicy{MinLength: 8, MaxLength: 72},
+			password: "пароль",
+			email:    "user@gmail.com",
+			want:     []PasswordRule{PasswordRuleMinLength},
+		},
+		{
+			name:     "max_length_counts_bytes",
+			policy:   PasswordPolicy{MinLength: 6, MaxLength: 10},
+			password: "пароль",
+			email:    "user@gmail.com",
+			want:     []PasswordRule{PasswordRuleMaxLength},
+		},
+		{
+			name:     "only_digit_missing",
+			policy:   policy,
+			password: "Pvz-password",
+			email:    "user@gmail.com",
+			want:     []PasswordRule{PasswordRuleDigit},
+		},
+		{
+			name:     "email",
+			policy:   PasswordPolicy{MinLength: 1, MaxLength: 72},
+			password: "User@Gmail.com",
+			email:    "user@gmail.com",
+			want:     []PasswordRule{PasswordRuleNotEmail},
+		},
+		{
+			name:     "email_local_part",
+			policy:   PasswordPolicy{MinLength: 1, MaxLength: 72},
+			password: "user.name",
+			email:    "user.name@gmail.com",
+			want:     []PasswordRule{PasswordRuleNotEmail},
+		},
+		{
+			name:     "no_email",
+			policy:   PasswordPolicy{MinLength: 1, MaxLength: 72},
+			password: "password",
+			email:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Check(tt.password, tt.email))
+		})
+	}
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	valid := PasswordPolicy{MinLength: 8, MaxLength: 72}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.MinLength = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.MaxLength = 7
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.MaxLength = 100
+	require.Error(t, invalid.Validate())
+}
+
+func TestPasswordPolicyError(t *testing.T) {
+	err := error(&PasswordPolicyError{Violations: []PasswordRule{PasswordRuleMinLength, PasswordRuleDigit}})
+	require.ErrorIs(t, err, ErrPasswordPolicy)
+	assert.Equal(t, "password does not meet the policy: min_length, digit", err.Error())
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"errors"
+	"slices"
+)
+
+// Permission is an action a user may perform. Handlers check permissions, roles only map to permission sets.
+type Permission string
+
+const (
+	PermissionPVZCreate Permission = "pvz:create"
+	PermissionPVZRead   Permission = "pvz:read"
+	// PermissionPVZAny lifts the PVZ assignment check, the user may change receptions and products of any PVZ
+	PermissionPVZAny          Permission = "pvz:any"
+	PermissionPVZAssign       Permission = "pvz:assign"
+	PermissionReceptionRead   Permission = "reception:read"
+	PermissionReceptionCreate Permission = "reception:create"
+	PermissionReceptionClose  Permission = "reception:close"
+	PermissionReceptionReopen Permission = "reception:reopen"
+	PermissionProductAdd      Permission = "product:add"
+	PermissionProductDelete   Permission = "product:delete"
+	PermissionAuditRead       Permission = "audit:read"
+	PermissionWebhookManage   Permission = "webhook:manage"
+	PermissionUserUnlock      Permission = "user:unlock"
+	PermissionUserManage      Permission = "user:manage"
+	PermissionAPIKeyManage    Permission = "api_key:manage"
+)
+
+var permissions = []Permission{
+	PermissionPVZCreate,
+	PermissionPVZRead,
+	PermissionPVZAny,
+	PermissionPVZAssign,
+	PermissionReceptionRead,
+	PermissionReceptionCreate,
+	PermissionReceptionClose,
+	PermissionReceptionReopen,
+	PermissionProductAdd,
+	PermissionProductDelete,
+	PermissionAuditRead,
+	PermissionWebhookManage,
+	PermissionUserUnlock,
+	PermissionUserManage,
+	PermissionAPIKeyManage,
+}
+
+// readPermissions change nothing, read-only tokens keep them.
+var readPermissions = NewPermissionSet(
+	PermissionPVZRead,
+	PermissionReceptionRead,
+	PermissionAuditRead,
+)
+
+func ParsePermission(s string) (Permission, error) {
+	if !slices.Contains(permissions, Permission(s)) {
+		return Permission(""), errors.New("permission not found")
+	}
+	return Permission(s), nil
+}
+
+type PermissionSet map[Permission]struct{}
+
+func NewPermissionSet(permissions ...Permission) PermissionSet {
+	set := make(PermissionSet, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = struct{}{}
+	}
+	return set
+}
+
+func (s PermissionSet) Has(permission Permission) bool {
+	_, ok := s[permission]
+	return ok
+}
+
+// ReadOnly returns the read permissions of the set.
+func (s PermissionSet) ReadOnly() PermissionSet {
+	res := make(PermissionSet, len(s))
+	for permission := range s {
+		if readPermissions.Has(permission) {
+			res[permission] = struct{}{}
+		}
+	}
+	return res
+}
+
+// RolePermissions maps roles to the permissions granted to users with the role.
+type RolePermissions map[UserRole]PermissionSet
+
+// DefaultRolePermissions are used for roles that are not configured explicitly.
+func DefaultRolePermissions() RolePermissions {
+	return RolePermissions{
+		UserRoleModerator: NewPermissionSet(
+			PermissionPVZCreate,
+			PermissionPVZRead,
+			PermissionPVZAny,
+			PermissionPVZAssign,
+			PermissionReceptionRead,
+			PermissionReceptionReopen,
+			PermissionAuditRead,
+			PermissionWebhookManage,
+			PermissionUserUnlock,
+			PermissionUserManage,
+			PermissionAPIKeyManage,
+		),
+		UserRoleEmployee: NewPermissionSet(
+			PermissionPVZRead,
+			PermissionReceptionRead,
+			PermissionReceptionCreate,
+			PermissionReceptionClose,
+			PermissionProductAdd,
+			PermissionProductDelete,
+		),
+		UserRoleAuditor: NewPermissionSet(
+			PermissionPVZRead,
+			PermissionReceptionRead,
+			PermissionAuditRead,
+		),
+		UserRoleRegionalManager: NewPermissionSet(
+			PermissionPVZRead,
+			PermissionPVZAny,
+			PermissionPVZAssign,
+			PermissionReceptionRead,
+			PermissionReceptionClose,
+			PermissionReceptionReopen,
+			PermissionAuditRead,
+		),
+	}
+}
+
+// Of returns the permissions of the role, unknown roles have none.
+func (r RolePermissions) Of(role UserRole) PermissionSet {
+	return r[role]
+}
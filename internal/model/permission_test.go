@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePermission(t *testing.T) {
+	res, err := ParsePermission("reception:close")
+	require.NoError(t, err)
+	assert.Equal(t, PermissionReceptionClose, res)
+
+	_, err = ParsePermission("reception:burn")
+	require.Error(t, err)
+}
+
+func TestDefaultRolePermissions(t *testing.T) {
+	perms := DefaultRolePermissions()
+
+	assert.True(t, perms.Of(UserRoleModerator).Has(PermissionPVZCreate))
+	assert.False(t, perms.Of(UserRoleModerator).Has(PermissionProductAdd))
+	assert.True(t, perms.Of(UserRoleEmployee).Has(PermissionProductAdd))
+	assert.False(t, perms.Of(UserRoleEmployee).Has(PermissionPVZAny))
+	assert.True(t, perms.Of(UserRoleAuditor).Has(PermissionAuditRead))
+	assert.False(t, perms.Of(UserRoleAuditor).Has(PermissionReceptionClose))
+	assert.True(t, perms.Of(UserRoleRegionalManager).Has(PermissionReceptionReopen))
+	assert.False(t, perms.Of(UserRole(0)).Has(PermissionPVZRead))
+}
+
+func TestPermissionSet_ReadOnly(t *testing.T) {
+	res := DefaultRolePermissions().Of(UserRoleModerator).ReadOnly()
+	assert.Equal(t, NewPermissionSet(PermissionPVZRead, PermissionReceptionRead, PermissionAuditRead), res)
+
+	assert.Empty(t, NewPermissionSet(PermissionProductAdd).ReadOnly())
+}
@@ -21,10 +21,18 @@ type Product struct {
 	ReceptionID ReceptionID
 	Category    ProductCategory
 	AddedAt     time.Time
+	// Barcode is nil when the product was added without scanning
+	Barcode *string
 }
 
 type ProductID uuid.UUID
 
+// ProductInput is a product to be added to a reception, e.g. one item of a pallet imported in a batch.
+type ProductInput struct {
+	Category ProductCategory
+	Barcode  *string
+}
+
 func (s ProductCategory) String() string {
 	switch s {
 	case ProductCategoryElectronics:
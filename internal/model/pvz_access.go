@@ -0,0 +1,18 @@
+package model
+
+import "slices"
+
+// PVZAccess is the set of PVZs a user may change. Employees are assigned to PVZs by moderators,
+// All is set for tokens that are not bound to PVZs, like the test tokens of /dummyLogin.
+type PVZAccess struct {
+	All    bool
+	PVZIDs []PVZID
+}
+
+func AllPVZAccess() PVZAccess {
+	return PVZAccess{All: true}
+}
+
+func (a PVZAccess) Allows(pvzID PVZID) bool {
+	return a.All || slices.Contains(a.PVZIDs, pvzID)
+}
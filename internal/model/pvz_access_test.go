@@ -0,0 +1,17 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPVZAccess_Allows(t *testing.T) {
+	pvzID1 := NewPVZID()
+	pvzID2 := NewPVZID()
+
+	assert.True(t, AllPVZAccess().Allows(pvzID1))
+	assert.True(t, PVZAccess{PVZIDs: []PVZID{pvzID1}}.Allows(pvzID1))
+	assert.False(t, PVZAccess{PVZIDs: []PVZID{pvzID1}}.Allows(pvzID2))
+	assert.False(t, PVZAccess{}.Allows(pvzID1))
+}
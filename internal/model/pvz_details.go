@@ -0,0 +1,7 @@
+package model
+
+type PVZDetails struct {
+	PVZ PVZ
+	// InProgressReception is nil when the pvz has no open reception
+	InProgressReception *Reception
+}
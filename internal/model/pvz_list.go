@@ -4,4 +4,6 @@ type PVZList struct {
 	PVZs       []PVZ
 	Receptions []Reception
 	Products   []Product
+	// NextCursor is set by cursor-based listing when there are more receptions after this page
+	NextCursor *ReceptionCursor
 }
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReceptionCursor points to the last reception of a page in (recepted_at, id) order.
+type ReceptionCursor struct {
+	ReceptedAt time.Time
+	ID         ReceptionID
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+func NewReceptionCursor(reception Reception) ReceptionCursor {
+	return ReceptionCursor{
+		ReceptedAt: reception.ReceptedAt,
+		ID:         reception.ID,
+	}
+}
+
+// Encode returns opaque url-safe representation of the cursor.
+func (c ReceptionCursor) Encode() string {
+	raw := c.ReceptedAt.UTC().Format(time.RFC3339Nano) + "," + c.ID.UUID().String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseReceptionCursor(s string) (ReceptionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ReceptionCursor{}, fmt.Errorf("%w: base64 decode: %w", ErrInvalidCursor, err)
+	}
+
+	rawReceptedAt, rawID, found := strings.Cut(string(raw), ",")
+	if !found {
+		return ReceptionCursor{}, fmt.Errorf("%w: wrong format", ErrInvalidCursor)
+	}
+
+	receptedAt, err := time.Parse(time.RFC3339Nano, rawReceptedAt)
+	if err != nil {
+		return ReceptionCursor{}, fmt.Errorf("%w: parse time: %w", ErrInvalidCursor, err)
+	}
+
+	ID, err := ParseReceptionID(rawID)
+	if err != nil {
+		return ReceptionCursor{}, fmt.Errorf("%w: parse id: %w", ErrInvalidCursor, err)
+	}
+
+	return ReceptionCursor{
+		ReceptedAt: receptedAt,
+		ID:         ID,
+	}, nil
+}
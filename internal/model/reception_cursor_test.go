@@ -0,0 +1,61 @@
+package model
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionCursor_Encode(t *testing.T) {
+	ID, err := ParseReceptionID("95c386d5-d629-455d-994f-f64752bc3a2b")
+	require.NoError(t, err)
+
+	cursor := NewReceptionCursor(Reception{
+		ID:         ID,
+		ReceptedAt: time.Date(2025, 4, 9, 20, 55, 59, 123456000, time.UTC),
+	})
+
+	encoded := cursor.Encode()
+	require.Equal(t, "MjAyNS0wNC0wOVQyMDo1NTo1OS4xMjM0NTZaLDk1YzM4NmQ1LWQ2MjktNDU1ZC05OTRmLWY2NDc1MmJjM2EyYg", encoded)
+
+	res, err := ParseReceptionCursor(encoded)
+	require.NoError(t, err)
+	require.Equal(t, ID, res.ID)
+	require.True(t, cursor.ReceptedAt.Equal(res.ReceptedAt))
+}
+
+func TestParseReceptionCursor(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+	}{
+		{
+			name: "invalid.base64",
+			arg:  "!!!",
+		},
+		{
+			name: "invalid.no_separator",
+			arg:  base64.RawURLEncoding.EncodeToString([]byte("2025-04-09T20:55:59Z")),
+		},
+		{
+			name: "invalid.time",
+			arg:  base64.RawURLEncoding.EncodeToString([]byte("2025-04-09,95c386d5-d629-455d-994f-f64752bc3a2b")),
+		},
+		{
+			name: "invalid.id",
+			arg:  base64.RawURLEncoding.EncodeToString([]byte("2025-04-09T20:55:59Z,95c386d5")),
+		},
+		{
+			name: "empty",
+			arg:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseReceptionCursor(tt.arg)
+			require.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
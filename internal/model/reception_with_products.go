@@ -0,0 +1,6 @@
+package model
+
+type ReceptionWithProducts struct {
+	Reception Reception
+	Products  []Product
+}
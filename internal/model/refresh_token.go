@@ -0,0 +1,73 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const refreshTokenBytes = 32
+
+// TokenPair is returned on login and refresh. RefreshToken is empty for tokens that can't be refreshed.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshToken is a stored refresh token. Only the hash of the token is stored. Every refresh revokes the token
+// and issues a new one in the same family, so reuse of a revoked token reveals a leak and revokes the whole family.
+type RefreshToken struct {
+	ID        RefreshTokenID
+	FamilyID  RefreshTokenFamilyID
+	UserID    UserID
+	TokenHash []byte
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+type RefreshTokenID uuid.UUID
+
+type RefreshTokenFamilyID uuid.UUID
+
+// NewRefreshToken generates a random refresh token, the returned string is given to the client once.
+func NewRefreshToken(userID UserID, familyID RefreshTokenFamilyID, lifetime time.Duration) (string, RefreshToken, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", RefreshToken{}, fmt.Errorf("rand.Read: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, RefreshToken{
+		ID:        RefreshTokenID(uuid.New()),
+		FamilyID:  familyID,
+		UserID:    userID,
+		TokenHash: HashRefreshToken(token),
+		ExpiresAt: time.Now().Add(lifetime),
+	}, nil
+}
+
+func HashRefreshToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
+
+func NewRefreshTokenFamilyID() RefreshTokenFamilyID {
+	return RefreshTokenFamilyID(uuid.New())
+}
+
+func (t RefreshToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+func (id RefreshTokenID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (id RefreshTokenFamilyID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	userID := NewUserID()
+	familyID := NewRefreshTokenFamilyID()
+
+	token, refreshToken, err := NewRefreshToken(userID, familyID, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, token, 43)
+	assert.Equal(t, userID, refreshToken.UserID)
+	assert.Equal(t, familyID, refreshToken.FamilyID)
+	assert.Equal(t, HashRefreshToken(token), refreshToken.TokenHash)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), refreshToken.ExpiresAt, time.Minute)
+	assert.Nil(t, refreshToken.RevokedAt)
+
+	other, _, err := NewRefreshToken(userID, familyID, time.Hour)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestRefreshToken_IsActive(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+
+	assert.True(t, RefreshToken{ExpiresAt: now.Add(time.Minute)}.IsActive(now))
+	assert.False(t, RefreshToken{ExpiresAt: now.Add(-time.Minute)}.IsActive(now))
+	assert.False(t, RefreshToken{ExpiresAt: now.Add(time.Minute), RevokedAt: &revokedAt}.IsActive(now))
+}
@@ -1,7 +1,44 @@
 package model
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type TokenInfo struct {
 	UserID   UserID
 	Email    string
 	UserRole UserRole
+	// PVZAccess holds the PVZs the user was assigned to when the token was issued
+	PVZAccess PVZAccess
+	// Permissions are granted to the role of the user, they are resolved on every request and not stored in the token
+	Permissions PermissionSet
+	// Dummy tokens are issued by /dummyLogin for testing, they belong to no user
+	Dummy bool
+	// APIKeyID is set for requests with an API key instead of a token, they belong to no user
+	APIKeyID APIKeyID
+	// TokenID is the jti claim, it identifies the access token on logout
+	TokenID   TokenID
+	ExpiresAt time.Time
+}
+
+type TokenID uuid.UUID
+
+func NewTokenID() TokenID {
+	return TokenID(uuid.New())
+}
+
+func (id TokenID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func ParseTokenID(s string) (TokenID, error) {
+	ID, err := uuid.Parse(s)
+	if err != nil {
+		return TokenID{}, fmt.Errorf("uuid.parse: %w", err)
+	}
+
+	return TokenID(ID), nil
 }
@@ -11,6 +11,16 @@ type User struct {
 	Email    string
 	Password string
 	UserRole UserRole
+	// Disabled users can't log in, and their tokens are rejected
+	Disabled bool
+	// SSOLinked users log in with OpenID Connect, their role follows the groups of the identity provider
+	SSOLinked bool
+}
+
+// UserUpdate changes the fields that are not nil.
+type UserUpdate struct {
+	UserRole *UserRole
+	Disabled *bool
 }
 
 type UserID uuid.UUID
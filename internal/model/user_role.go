@@ -5,8 +5,10 @@ import "errors"
 type UserRole int16
 
 const (
-	UserRoleModerator UserRole = 1
-	UserRoleEmployee  UserRole = 2
+	UserRoleModerator       UserRole = 1
+	UserRoleEmployee        UserRole = 2
+	UserRoleAuditor         UserRole = 3
+	UserRoleRegionalManager UserRole = 4
 )
 
 func (s UserRole) String() string {
@@ -15,6 +17,10 @@ func (s UserRole) String() string {
 		return "moderator"
 	case UserRoleEmployee:
 		return "employee"
+	case UserRoleAuditor:
+		return "auditor"
+	case UserRoleRegionalManager:
+		return "regional_manager"
 	}
 	return ""
 }
@@ -25,6 +31,10 @@ func ParseUserRole(s string) (UserRole, error) {
 		return UserRoleModerator, nil
 	case "employee":
 		return UserRoleEmployee, nil
+	case "auditor":
+		return UserRoleAuditor, nil
+	case "regional_manager":
+		return UserRoleRegionalManager, nil
 	}
 	return UserRole(0), errors.New("role not found")
 }
@@ -25,6 +25,18 @@ func TestParseUserRole(t *testing.T) {
 			want:    UserRoleEmployee,
 			wantErr: false,
 		},
+		{
+			name:    "Valid.auditor",
+			arg:     "auditor",
+			want:    UserRoleAuditor,
+			wantErr: false,
+		},
+		{
+			name:    "Valid.regional_manager",
+			arg:     "regional_manager",
+			want:    UserRoleRegionalManager,
+			wantErr: false,
+		},
 		{
 			name:    "invalid.0",
 			arg:     "invalid",
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryStatus int16
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = 2
+	// WebhookDeliveryStatusDead means the delivery ran out of attempts and is not retried anymore
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = 3
+)
+
+// WebhookSubscription is a partner endpoint receiving the outbox events of the given types.
+type WebhookSubscription struct {
+	ID         WebhookSubscriptionID
+	URL        string
+	EventTypes []OutboxEventType
+	// PVZIDs limits the events to the pvz, empty means events of all pvz
+	PVZIDs []PVZID
+	// Secret is the HMAC key payloads are signed with
+	Secret    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookSubscriptionID uuid.UUID
+
+// WebhookDelivery is an outbox event sent to one subscription.
+type WebhookDelivery struct {
+	ID             WebhookDeliveryID
+	SubscriptionID WebhookSubscriptionID
+	Message        OutboxMessage
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	LastError      *string
+	// LastStatusCode is nil when the endpoint didn't respond
+	LastStatusCode *int
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+type WebhookDeliveryID uuid.UUID
+
+// WebhookDeliveryTask is a pending delivery together with the subscription endpoint it goes to.
+type WebhookDeliveryTask struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+func NewWebhookSubscriptionID() WebhookSubscriptionID {
+	return WebhookSubscriptionID(uuid.New())
+}
+
+func (id WebhookSubscriptionID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func ParseWebhookSubscriptionID(s string) (WebhookSubscriptionID, error) {
+	ID, err := uuid.Parse(s)
+	if err != nil {
+		return WebhookSubscriptionID{}, fmt.Errorf("uuid.parse: %w", err)
+	}
+
+	return WebhookSubscriptionID(ID), nil
+}
+
+func (id WebhookDeliveryID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+func (s WebhookDeliveryStatus) String() string {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return "pending"
+	case WebhookDeliveryStatusDelivered:
+		return "delivered"
+	case WebhookDeliveryStatusDead:
+		return "dead"
+	}
+	return ""
+}
+
+func ParseWebhookDeliveryStatus(s string) (WebhookDeliveryStatus, error) {
+	switch s {
+	case "pending":
+		return WebhookDeliveryStatusPending, nil
+	case "delivered":
+		return WebhookDeliveryStatusDelivered, nil
+	case "dead":
+		return WebhookDeliveryStatusDead, nil
+	}
+	return WebhookDeliveryStatus(0), errors.New("webhook delivery status not found")
+}
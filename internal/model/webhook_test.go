@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWebhookDeliveryStatus(t *testing.T) {
+	statuses := []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusDead,
+	}
+	for _, status := range statuses {
+		t.Run("valid."+status.String(), func(t *testing.T) {
+			res, err := ParseWebhookDeliveryStatus(status.String())
+			require.NoError(t, err)
+			require.Equal(t, status, res)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		res, err := ParseWebhookDeliveryStatus("failed")
+		require.Error(t, err)
+		require.Equal(t, WebhookDeliveryStatus(0), res)
+	})
+}
+
+func TestParseWebhookSubscriptionID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		res, err := ParseWebhookSubscriptionID("6451927e-846b-4c97-9924-cba818687a11")
+		require.NoError(t, err)
+		require.Equal(t, "6451927e-846b-4c97-9924-cba818687a11", res.UUID().String())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseWebhookSubscriptionID("abc")
+		require.Error(t, err)
+	})
+}
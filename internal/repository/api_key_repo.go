@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const apiKeyColumns = `id, name, key_hash, user_role, array_to_json(pvz_ids) AS pvz_ids, created_by, created_at,
+	expires_at, last_used_at, revoked_at`
+
+type APIKeyRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewAPIKeyRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*APIKeyRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &APIKeyRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *APIKeyRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, apiKey model.APIKey) (model.APIKey, error) {
+	var entity APIKey
+
+	q := `INSERT INTO api_keys (id, name, key_hash, user_role, pvz_ids, created_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5::UUID[], $6, $7)
+		RETURNING ` + apiKeyColumns
+
+	err := r.trOrDB(ctx).GetContext(ctx, &entity, q, apiKey.ID.UUID(), apiKey.Name, apiKey.KeyHash, apiKey.UserRole,
+		pvzIDsToDB(apiKey.PVZIDs), apiKey.CreatedBy.UUID(), apiKey.ExpiresAt)
+	if err != nil {
+		return model.APIKey{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertAPIKey(entity)
+}
+
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash []byte) (model.APIKey, error) {
+	var entity APIKey
+
+	q := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &entity, q, keyHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.APIKey{}, model.ErrAPIKeyNotFound
+		}
+		return model.APIKey{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertAPIKey(entity)
+}
+
+// List returns all keys including expired and revoked ones in the order of creation.
+func (r *APIKeyRepository) List(ctx context.Context) ([]model.APIKey, error) {
+	var entities []APIKey
+
+	q := `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY created_at, id`
+
+	err := r.trOrDB(ctx).SelectContext(ctx, &entities, q)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	apiKeys := make([]model.APIKey, 0, len(entities))
+	for _, entity := range entities {
+		apiKey, err := convertAPIKey(entity)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	return apiKeys, nil
+}
+
+// Revoke is idempotent, revoking a revoked key keeps the first revocation time.
+func (r *APIKeyRepository) Revoke(ctx context.Context, apiKeyID model.APIKeyID) error {
+	q := `UPDATE api_keys SET revoked_at = COALESCE(revoked_at, now()) WHERE id = $1`
+
+	result, err := r.trOrDB(ctx).ExecContext(ctx, q, apiKeyID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+	if count == 0 {
+		return model.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, apiKeyID model.APIKeyID) error {
+	q := `UPDATE api_keys SET last_used_at = now() WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, apiKeyID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func convertAPIKey(entity APIKey) (model.APIKey, error) {
+	var pvzUUIDs []uuid.UUID
+	err := json.Unmarshal(entity.PVZIDs, &pvzUUIDs)
+	if err != nil {
+		return model.APIKey{}, fmt.Errorf("json.Unmarshal pvz ids: %w", err)
+	}
+
+	var pvzIDs []model.PVZID
+	for _, pvzID := range pvzUUIDs {
+		pvzIDs = append(pvzIDs, model.PVZID(pvzID))
+	}
+
+	return model.APIKey{
+		ID:         model.APIKeyID(entity.ID),
+		Name:       entity.Name,
+		KeyHash:    entity.KeyHash,
+		UserRole:   model.UserRole(entity.Role),
+		PVZIDs:     pvzIDs,
+		CreatedBy:  model.UserID(entity.CreatedBy),
+		CreatedAt:  entity.CreatedAt,
+		ExpiresAt:  entity.ExpiresAt,
+		LastUsedAt: entity.LastUsedAt,
+		RevokedAt:  entity.RevokedAt,
+	}, nil
+}
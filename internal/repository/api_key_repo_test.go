@@ -0,0 +1,124 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewAPIKeyRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewAPIKeyRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewAPIKeyRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewAPIKeyRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestAPIKeyRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewAPIKeyRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	createdBy := model.NewUserID()
+	pvzID := model.NewPVZID()
+	expiresAt := time.Now().Add(time.Hour)
+
+	rawKey1, key1, err := model.NewAPIKey("sorting centre", model.UserRoleEmployee, []model.PVZID{pvzID}, createdBy, expiresAt)
+	require.NoError(t, err)
+	_, key2, err := model.NewAPIKey("reports", model.UserRoleAuditor, nil, createdBy, expiresAt)
+	require.NoError(t, err)
+
+	t.Run("success.Create", func(t *testing.T) {
+		res, err := repo.Create(ctx, key1)
+		require.NoError(t, err)
+		require.Equal(t, key1.ID, res.ID)
+		require.Equal(t, []model.PVZID{pvzID}, res.PVZIDs)
+		require.False(t, res.CreatedAt.IsZero())
+
+		res, err = repo.Create(ctx, key2)
+		require.NoError(t, err)
+		require.Empty(t, res.PVZIDs)
+	})
+
+	t.Run("success.GetByHash", func(t *testing.T) {
+		res, err := repo.GetByHash(ctx, model.HashAPIKey(rawKey1))
+		require.NoError(t, err)
+		require.Equal(t, key1.ID, res.ID)
+		require.Equal(t, "sorting centre", res.Name)
+		require.Equal(t, model.UserRoleEmployee, res.UserRole)
+		require.Equal(t, []model.PVZID{pvzID}, res.PVZIDs)
+		require.Equal(t, createdBy, res.CreatedBy)
+		require.WithinDuration(t, expiresAt, res.ExpiresAt, time.Millisecond)
+		require.Nil(t, res.LastUsedAt)
+		require.Nil(t, res.RevokedAt)
+	})
+
+	t.Run("businessError.GetByHash.NotFound", func(t *testing.T) {
+		_, err := repo.GetByHash(ctx, model.HashAPIKey("unknown"))
+		require.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+	})
+
+	t.Run("success.List", func(t *testing.T) {
+		res, err := repo.List(ctx)
+		require.NoError(t, err)
+
+		var ids []model.APIKeyID
+		for _, apiKey := range res {
+			ids = append(ids, apiKey.ID)
+		}
+		require.Contains(t, ids, key1.ID)
+		require.Contains(t, ids, key2.ID)
+	})
+
+	t.Run("success.TouchLastUsed", func(t *testing.T) {
+		require.NoError(t, repo.TouchLastUsed(ctx, key1.ID))
+
+		res, err := repo.GetByHash(ctx, key1.KeyHash)
+		require.NoError(t, err)
+		require.NotNil(t, res.LastUsedAt)
+	})
+
+	t.Run("success.Revoke", func(t *testing.T) {
+		require.NoError(t, repo.Revoke(ctx, key1.ID))
+
+		res, err := repo.GetByHash(ctx, key1.KeyHash)
+		require.NoError(t, err)
+		require.NotNil(t, res.RevokedAt)
+		revokedAt := *res.RevokedAt
+
+		require.NoError(t, repo.Revoke(ctx, key1.ID))
+
+		res, err = repo.GetByHash(ctx, key1.KeyHash)
+		require.NoError(t, err)
+		require.Equal(t, revokedAt, *res.RevokedAt)
+
+		res, err = repo.GetByHash(ctx, key2.KeyHash)
+		require.NoError(t, err)
+		require.Nil(t, res.RevokedAt)
+	})
+
+	t.Run("businessError.Revoke.NotFound", func(t *testing.T) {
+		err := repo.Revoke(ctx, model.NewAPIKeyID())
+		require.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+	})
+}
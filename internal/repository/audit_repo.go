@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type AuditRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewAuditRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*AuditRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &AuditRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *AuditRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Write stores the audit event in the current transaction, so it is saved only together with the change itself.
+func (r *AuditRepository) Write(ctx context.Context, event model.AuditEvent) error {
+	q := `INSERT INTO audit_events (user_id, api_key_id, action, pvz_id, reception_id, product_id)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, event.UserID, event.APIKeyID, event.Action, event.PVZID,
+		event.ReceptionID, event.ProductID)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// WriteBatch writes the events with one statement.
+func (r *AuditRepository) WriteBatch(ctx context.Context, events []model.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	userIDs := make([]model.UserID, 0, len(events))
+	apiKeyIDs := make([]*model.APIKeyID, 0, len(events))
+	actions := make([]int16, 0, len(events))
+	pvzIDs := make([]model.PVZID, 0, len(events))
+	receptionIDs := make([]*model.ReceptionID, 0, len(events))
+	productIDs := make([]*model.ProductID, 0, len(events))
+	for _, event := range events {
+		userIDs = append(userIDs, event.UserID)
+		apiKeyIDs = append(apiKeyIDs, event.APIKeyID)
+		actions = append(actions, int16(event.Action))
+		pvzIDs = append(pvzIDs, event.PVZID)
+		receptionIDs = append(receptionIDs, event.ReceptionID)
+		productIDs = append(productIDs, event.ProductID)
+	}
+
+	q := `INSERT INTO audit_events (user_id, api_key_id, action, pvz_id, reception_id, product_id)
+		SELECT * FROM unnest($1::UUID[], $2::UUID[], $3::SMALLINT[], $4::UUID[], $5::UUID[], $6::UUID[])`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, userIDs, apiKeyIDs, actions, pvzIDs, receptionIDs, productIDs)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// Search returns audit events matching the filter, the most recent first.
+func (r *AuditRepository) Search(ctx context.Context, filter model.AuditFilter, offset, limit int64) ([]model.AuditEvent, error) {
+	if offset < 0 {
+		return nil, errors.New("offset can't be negative")
+	}
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "user_id", "api_key_id", "action", "pvz_id", "reception_id", "product_id", "created_at").
+		From("audit_events").
+		OrderBy("created_at DESC", "id DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if filter.UserID != nil {
+		b = b.Where(sq.Expr("user_id = ?", *filter.UserID))
+	}
+	if filter.APIKeyID != nil {
+		b = b.Where(sq.Expr("api_key_id = ?", *filter.APIKeyID))
+	}
+	if filter.PVZID != nil {
+		b = b.Where(sq.Expr("pvz_id = ?", *filter.PVZID))
+	}
+	if filter.Action != nil {
+		b = b.Where(sq.Eq{"action": *filter.Action})
+	}
+	if filter.From != nil {
+		b = b.Where(sq.GtOrEq{"created_at": *filter.From})
+	}
+	if filter.To != nil {
+		b = b.Where(sq.LtOrEq{"created_at": *filter.To})
+	}
+
+	q, args, err := b.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	var entities []AuditEvent
+	err = r.trOrDB(ctx).SelectContext(ctx, &entities, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	events := make([]model.AuditEvent, 0, len(entities))
+
+	for _, event := range entities {
+		res := model.AuditEvent{
+			ID:        model.AuditEventID(event.ID),
+			UserID:    model.UserID(event.UserID),
+			Action:    model.AuditAction(event.Action),
+			PVZID:     model.PVZID(event.PVZID),
+			CreatedAt: event.CreatedAt,
+		}
+		if event.APIKeyID != nil {
+			apiKeyID := model.APIKeyID(*event.APIKeyID)
+			res.APIKeyID = &apiKeyID
+		}
+		if event.ReceptionID != nil {
+			receptionID := model.ReceptionID(*event.ReceptionID)
+			res.ReceptionID = &receptionID
+		}
+		if event.ProductID != nil {
+			productID := model.ProductID(*event.ProductID)
+			res.ProductID = &productID
+		}
+		events = append(events, res)
+	}
+	return events, nil
+}
@@ -0,0 +1,165 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewAuditRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewAuditRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewAuditRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewAuditRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestAuditRepository_WriteSearch(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewAuditRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	// unique user and pvz isolate the test from events written by other tests
+	userID := model.NewUserID()
+	pvzID := model.NewPVZID()
+	receptionID := model.NewReceptionID()
+	productID := model.NewProductID()
+
+	err = repo.Write(context.Background(), model.AuditEvent{
+		UserID:      userID,
+		Action:      model.AuditActionReceptionCreated,
+		PVZID:       pvzID,
+		ReceptionID: &receptionID,
+	})
+	require.NoError(t, err)
+	err = repo.Write(context.Background(), model.AuditEvent{
+		UserID:      userID,
+		Action:      model.AuditActionProductRemoved,
+		PVZID:       pvzID,
+		ReceptionID: &receptionID,
+		ProductID:   &productID,
+	})
+	require.NoError(t, err)
+	// a machine client belongs to no user
+	apiKeyID := model.NewAPIKeyID()
+	err = repo.Write(context.Background(), model.AuditEvent{
+		UserID:      model.DefaultUserID,
+		APIKeyID:    &apiKeyID,
+		Action:      model.AuditActionReceptionClosed,
+		PVZID:       pvzID,
+		ReceptionID: &receptionID,
+	})
+	require.NoError(t, err)
+
+	actions := func(events []model.AuditEvent) []model.AuditAction {
+		res := make([]model.AuditAction, 0, len(events))
+		for _, event := range events {
+			res = append(res, event.Action)
+		}
+		return res
+	}
+
+	t.Run("success.user", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), model.AuditFilter{UserID: &userID}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []model.AuditAction{model.AuditActionProductRemoved, model.AuditActionReceptionCreated}, actions(res))
+		require.Equal(t, pvzID, res[0].PVZID)
+		require.Equal(t, &receptionID, res[0].ReceptionID)
+		require.Equal(t, &productID, res[0].ProductID)
+		require.Nil(t, res[1].ProductID)
+		require.Nil(t, res[0].APIKeyID)
+	})
+	t.Run("success.api_key", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), model.AuditFilter{APIKeyID: &apiKeyID}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []model.AuditAction{model.AuditActionReceptionClosed}, actions(res))
+		require.Equal(t, &apiKeyID, res[0].APIKeyID)
+		require.Equal(t, model.DefaultUserID, res[0].UserID)
+	})
+	t.Run("success.pvz_action", func(t *testing.T) {
+		action := model.AuditActionReceptionCreated
+		res, err := repo.Search(context.Background(), model.AuditFilter{PVZID: &pvzID, Action: &action}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []model.AuditAction{model.AuditActionReceptionCreated}, actions(res))
+	})
+	t.Run("success.time_range", func(t *testing.T) {
+		from := time.Now().Add(time.Hour)
+		res, err := repo.Search(context.Background(), model.AuditFilter{UserID: &userID, From: &from}, 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("success.offset_limit", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), model.AuditFilter{UserID: &userID}, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, []model.AuditAction{model.AuditActionReceptionCreated}, actions(res))
+	})
+	t.Run("error.negative_offset", func(t *testing.T) {
+		_, err := repo.Search(context.Background(), model.AuditFilter{}, -1, 10)
+		require.Error(t, err)
+	})
+	t.Run("error.zero_limit", func(t *testing.T) {
+		_, err := repo.Search(context.Background(), model.AuditFilter{}, 0, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestAuditRepository_WriteBatch(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewAuditRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	userID := model.NewUserID()
+	pvzID := model.NewPVZID()
+	receptionID := model.NewReceptionID()
+	productID1 := model.NewProductID()
+	productID2 := model.NewProductID()
+
+	err = repo.WriteBatch(context.Background(), []model.AuditEvent{
+		{
+			UserID:      userID,
+			Action:      model.AuditActionProductAdded,
+			PVZID:       pvzID,
+			ReceptionID: &receptionID,
+			ProductID:   &productID1,
+		},
+		{
+			UserID:      userID,
+			Action:      model.AuditActionProductAdded,
+			PVZID:       pvzID,
+			ReceptionID: &receptionID,
+			ProductID:   &productID2,
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := repo.Search(context.Background(), model.AuditFilter{UserID: &userID}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.ElementsMatch(t, []*model.ProductID{&productID1, &productID2}, []*model.ProductID{res[0].ProductID, res[1].ProductID})
+	require.Equal(t, pvzID, res[0].PVZID)
+	require.Equal(t, &receptionID, res[0].ReceptionID)
+
+	t.Run("success.empty", func(t *testing.T) {
+		err := repo.WriteBatch(context.Background(), nil)
+		require.NoError(t, err)
+	})
+}
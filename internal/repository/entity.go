@@ -24,6 +24,7 @@ type Product struct {
 	ReceptionID uuid.UUID `db:"reception_id"`
 	Category    int16     `db:"category"`
 	AddedAt     time.Time `db:"added_at"`
+	Barcode     *string   `db:"barcode"`
 }
 
 type User struct {
@@ -31,5 +32,95 @@ type User struct {
 	Email      string    `db:"email"`
 	Password   string    `db:"password"`
 	Role       int16     `db:"user_role"`
+	Disabled   bool      `db:"disabled"`
+	SSOLinked  bool      `db:"sso_linked"`
 	CreateTime time.Time `db:"create_time"`
 }
+
+type AuditEvent struct {
+	ID          uuid.UUID  `db:"id"`
+	UserID      uuid.UUID  `db:"user_id"`
+	APIKeyID    *uuid.UUID `db:"api_key_id"`
+	Action      int16      `db:"action"`
+	PVZID       uuid.UUID  `db:"pvz_id"`
+	ReceptionID *uuid.UUID `db:"reception_id"`
+	ProductID   *uuid.UUID `db:"product_id"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+type OutboxMessage struct {
+	ID        uuid.UUID `db:"id"`
+	EventType int16     `db:"event_type"`
+	PVZID     uuid.UUID `db:"pvz_id"`
+	Payload   []byte    `db:"payload"`
+	CreatedAt time.Time `db:"created_at"`
+	Attempts  int       `db:"attempts"`
+}
+
+type WebhookSubscription struct {
+	ID         uuid.UUID `db:"id"`
+	URL        string    `db:"url"`
+	EventTypes []byte    `db:"event_types"` // selected as JSON array
+	PVZIDs     []byte    `db:"pvz_ids"`     // selected as JSON array
+	Secret     string    `db:"secret"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID  `db:"id"`
+	SubscriptionID uuid.UUID  `db:"subscription_id"`
+	OutboxID       uuid.UUID  `db:"outbox_id"`
+	EventType      int16      `db:"event_type"`
+	PVZID          uuid.UUID  `db:"pvz_id"`
+	Payload        []byte     `db:"payload"`
+	EventCreatedAt time.Time  `db:"event_created_at"`
+	Status         int16      `db:"status"`
+	Attempts       int        `db:"attempts"`
+	LastError      *string    `db:"last_error"`
+	LastStatusCode *int       `db:"last_status_code"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
+
+type WebhookDeliveryTask struct {
+	WebhookDelivery
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+type RefreshToken struct {
+	ID        uuid.UUID  `db:"id"`
+	FamilyID  uuid.UUID  `db:"family_id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	TokenHash []byte     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+type APIKey struct {
+	ID         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	KeyHash    []byte     `db:"key_hash"`
+	Role       int16      `db:"user_role"`
+	PVZIDs     []byte     `db:"pvz_ids"` // selected as JSON array
+	CreatedBy  uuid.UUID  `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+type IdempotencyKey struct {
+	Client       string     `db:"client"`
+	Key          string     `db:"key"`
+	RequestHash  []byte     `db:"request_hash"`
+	StatusCode   *int16     `db:"status_code"` // NULL while the request is being handled
+	ResponseBody []byte     `db:"response_body"`
+	CreatedAt    time.Time  `db:"created_at"`
+	ExpiresAt    time.Time  `db:"expires_at"`
+	LockedUntil  *time.Time `db:"locked_until"` // NULL once the response is stored or lost
+	ResponseLost bool       `db:"response_lost"`
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const idempotencyKeyColumns = `client, key, request_hash, status_code, response_body, created_at, expires_at, locked_until,
+	response_lost`
+
+// idempotencyBeginAttempts bounds retries of Begin when the existing record is released between its two queries.
+const idempotencyBeginAttempts = 3
+
+type IdempotencyKeyRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewIdempotencyKeyRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*IdempotencyKeyRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &IdempotencyKeyRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *IdempotencyKeyRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Begin stores the key of a request being handled, locked until lockedUntil, and returns started = true. If the client
+// already used the key and it has not expired, the existing record is returned instead with started = false.
+// An expired record is replaced, as if the key was never used, and so is a record of the same request whose lock
+// has passed without a stored response, e.g. the instance handling it died. A record with a lost response is kept
+// until it expires.
+func (r *IdempotencyKeyRepository) Begin(ctx context.Context, client, key string, requestHash []byte,
+	lockedUntil, expiresAt time.Time,
+) (record model.IdempotencyRecord, started bool, err error) {
+	insertQ := `INSERT INTO idempotency_keys (client, key, request_hash, locked_until, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (client, key) DO UPDATE SET request_hash = EXCLUDED.request_hash, status_code = NULL,
+			response_body = NULL, created_at = now(), locked_until = EXCLUDED.locked_until,
+			expires_at = EXCLUDED.expires_at, response_lost = false
+		WHERE idempotency_keys.expires_at <= now()
+			OR (idempotency_keys.status_code IS NULL AND NOT idempotency_keys.response_lost
+				AND idempotency_keys.locked_until <= now() AND idempotency_keys.request_hash = EXCLUDED.request_hash)
+		RETURNING ` + idempotencyKeyColumns
+	selectQ := `SELECT ` + idempotencyKeyColumns + ` FROM idempotency_keys WHERE client = $1 AND key = $2`
+
+	for range idempotencyBeginAttempts {
+		var entity IdempotencyKey
+
+		err = r.trOrDB(ctx).GetContext(ctx, &entity, insertQ, client, key, requestHash, lockedUntil, expiresAt)
+		if err == nil {
+			return convertIdempotencyKey(entity), true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return model.IdempotencyRecord{}, false, fmt.Errorf("db.GetContext: %w", err)
+		}
+
+		// the key is in use, no rows means it was released since, so the insert is tried again
+		err = r.trOrDB(ctx).GetContext(ctx, &entity, selectQ, client, key)
+		if err == nil {
+			return convertIdempotencyKey(entity), false, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return model.IdempotencyRecord{}, false, fmt.Errorf("db.GetContext: %w", err)
+		}
+	}
+
+	return model.IdempotencyRecord{}, false, fmt.Errorf("key is released concurrently %d times", idempotencyBeginAttempts)
+}
+
+// Complete stores the response of the request started by Begin.
+func (r *IdempotencyKeyRepository) Complete(ctx context.Context, client, key string, statusCode int, responseBody []byte) error {
+	q := `UPDATE idempotency_keys SET status_code = $3, response_body = $4, locked_until = NULL
+		WHERE client = $1 AND key = $2`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, client, key, statusCode, responseBody)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// Extend moves the lock of the request being handled to lockedUntil.
+func (r *IdempotencyKeyRepository) Extend(ctx context.Context, client, key string, lockedUntil time.Time) error {
+	q := `UPDATE idempotency_keys SET locked_until = $3
+		WHERE client = $1 AND key = $2 AND status_code IS NULL AND NOT response_lost`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, client, key, lockedUntil)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// MarkResponseLost keeps the key of a request that is done but whose response could not be stored, without a lock,
+// so a retry is not handled again until the key expires.
+func (r *IdempotencyKeyRepository) MarkResponseLost(ctx context.Context, client, key string) error {
+	q := `UPDATE idempotency_keys SET response_lost = true, locked_until = NULL
+		WHERE client = $1 AND key = $2 AND status_code IS NULL`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, client, key)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// Release removes the key of a request that was not completed, so a retry is handled again.
+func (r *IdempotencyKeyRepository) Release(ctx context.Context, client, key string) error {
+	q := `DELETE FROM idempotency_keys WHERE client = $1 AND key = $2 AND status_code IS NULL AND NOT response_lost`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, client, key)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes expired keys and returns how many were removed.
+func (r *IdempotencyKeyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	q := `DELETE FROM idempotency_keys WHERE expires_at <= now()`
+
+	result, err := r.trOrDB(ctx).ExecContext(ctx, q)
+	if err != nil {
+		return 0, fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	return count, nil
+}
+
+func convertIdempotencyKey(entity IdempotencyKey) model.IdempotencyRecord {
+	record := model.IdempotencyRecord{
+		Client:       entity.Client,
+		Key:          entity.Key,
+		RequestHash:  entity.RequestHash,
+		ResponseBody: entity.ResponseBody,
+		CreatedAt:    entity.CreatedAt,
+		ExpiresAt:    entity.ExpiresAt,
+		LockedUntil:  entity.LockedUntil,
+		ResponseLost: entity.ResponseLost,
+	}
+	if entity.StatusCode != nil {
+		record.StatusCode = int(*entity.StatusCode)
+	}
+	return record
+}
@@ -0,0 +1,184 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdempotencyKeyRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewIdempotencyKeyRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewIdempotencyKeyRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewIdempotencyKeyRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestIdempotencyKeyRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewIdempotencyKeyRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	// a unique client isolates the test from keys stored by other tests
+	client := "user:" + time.Now().Format(time.RFC3339Nano)
+	hash1 := []byte{1}
+	hash2 := []byte{2}
+	lockedUntil := time.Now().Add(time.Minute)
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("success.begin_complete_replay", func(t *testing.T) {
+		record, started, err := repo.Begin(ctx, client, "key-1", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+		require.False(t, record.Completed())
+
+		record, started, err = repo.Begin(ctx, client, "key-1", hash2, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.False(t, record.Completed())
+		require.True(t, record.Matches(hash1))
+
+		err = repo.Complete(ctx, client, "key-1", 201, []byte(`{"id": "1"}`))
+		require.NoError(t, err)
+
+		record, started, err = repo.Begin(ctx, client, "key-1", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.Equal(t, 201, record.StatusCode)
+		require.Equal(t, []byte(`{"id": "1"}`), record.ResponseBody)
+	})
+	t.Run("success.other_client", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client+"-other", "key-1", hash2, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+	})
+	t.Run("success.release", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-2", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+
+		err = repo.Release(ctx, client, "key-2")
+		require.NoError(t, err)
+
+		_, started, err = repo.Begin(ctx, client, "key-2", hash2, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+	})
+	t.Run("success.release_keeps_completed", func(t *testing.T) {
+		err := repo.Release(ctx, client, "key-1")
+		require.NoError(t, err)
+
+		record, started, err := repo.Begin(ctx, client, "key-1", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.True(t, record.Completed())
+	})
+	t.Run("success.expired", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-3", hash1, lockedUntil, time.Now().Add(-time.Second))
+		require.NoError(t, err)
+		require.True(t, started)
+
+		record, started, err := repo.Begin(ctx, client, "key-3", hash2, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+		require.True(t, record.Matches(hash2))
+	})
+	t.Run("success.lock_passed", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-5", hash1, time.Now().Add(-time.Second), expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+
+		// a different request does not take the key over
+		record, started, err := repo.Begin(ctx, client, "key-5", hash2, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.True(t, record.Matches(hash1))
+
+		record, started, err = repo.Begin(ctx, client, "key-5", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+		require.NotNil(t, record.LockedUntil)
+
+		record, started, err = repo.Begin(ctx, client, "key-5", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.False(t, record.Completed())
+	})
+	t.Run("success.completed_not_taken_over", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-6", hash1, time.Now().Add(-time.Second), expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+
+		err = repo.Complete(ctx, client, "key-6", 201, []byte(`{"id": "1"}`))
+		require.NoError(t, err)
+
+		record, started, err := repo.Begin(ctx, client, "key-6", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.True(t, record.Completed())
+		require.Nil(t, record.LockedUntil)
+	})
+	t.Run("success.extend", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-7", hash1, time.Now().Add(-time.Second), expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+
+		err = repo.Extend(ctx, client, "key-7", lockedUntil)
+		require.NoError(t, err)
+
+		record, started, err := repo.Begin(ctx, client, "key-7", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.False(t, record.Completed())
+	})
+	t.Run("success.response_lost_not_taken_over", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-8", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.True(t, started)
+
+		err = repo.MarkResponseLost(ctx, client, "key-8")
+		require.NoError(t, err)
+		err = repo.Extend(ctx, client, "key-8", lockedUntil)
+		require.NoError(t, err)
+		err = repo.Release(ctx, client, "key-8")
+		require.NoError(t, err)
+
+		record, started, err := repo.Begin(ctx, client, "key-8", hash1, lockedUntil, expiresAt)
+		require.NoError(t, err)
+		require.False(t, started)
+		require.True(t, record.ResponseLost)
+		require.Nil(t, record.LockedUntil)
+	})
+	t.Run("success.delete_expired", func(t *testing.T) {
+		_, started, err := repo.Begin(ctx, client, "key-4", hash1, lockedUntil, time.Now().Add(-time.Second))
+		require.NoError(t, err)
+		require.True(t, started)
+
+		count, err := repo.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, count, int64(1))
+
+		var exists bool
+		err = db.Get(&exists, `SELECT EXISTS(SELECT 1 FROM idempotency_keys WHERE client = $1 AND key = $2)`, client, "key-4")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
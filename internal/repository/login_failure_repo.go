@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type LoginFailureRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+type loginFailureRow struct {
+	Failures      int       `db:"failures"`
+	LastFailureAt time.Time `db:"last_failure_at"`
+}
+
+func NewLoginFailureRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*LoginFailureRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &LoginFailureRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *LoginFailureRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Get returns zero LoginFailures if there were no failures by the key.
+func (r *LoginFailureRepository) Get(ctx context.Context, key string) (model.LoginFailures, error) {
+	var row loginFailureRow
+
+	q := `SELECT failures, last_failure_at FROM login_failures WHERE key = $1`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &row, q, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.LoginFailures{}, nil
+		}
+		return model.LoginFailures{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return model.LoginFailures(row), nil
+}
+
+// Reserve counts an attempt by the key as a failure before the password is checked, if the limit allows one more
+// attempt at now. The limit is checked and the counter is incremented by one statement, so concurrent attempts
+// can't pass the check together. Failures older than the lockout are forgotten, the count restarts from one.
+// It returns false if the attempt is blocked, the counter is not changed then.
+func (r *LoginFailureRepository) Reserve(ctx context.Context, key string, now time.Time, limit model.LoginLimit) (bool, error) {
+	q := `INSERT INTO login_failures AS f (key, failures, last_failure_at) VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN f.last_failure_at <= $3 THEN 1 ELSE f.failures + 1 END,
+			last_failure_at = EXCLUDED.last_failure_at
+		WHERE f.last_failure_at <= $3 OR (f.failures < $4 AND (f.failures = 0 OR
+			f.last_failure_at + LEAST(make_interval(secs => $5 * power(2, LEAST(f.failures - 1, 30))),
+				make_interval(secs => $6)) <= $2))
+		RETURNING f.failures`
+
+	var failures int
+	err := r.trOrDB(ctx).GetContext(ctx, &failures, q, key, now, now.Add(-limit.Lockout), limit.MaxFailures,
+		limit.Delay.Seconds(), limit.Lockout.Seconds())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return true, nil
+}
+
+// Release takes back an attempt reserved by Reserve, that turned out not to be a failure.
+func (r *LoginFailureRepository) Release(ctx context.Context, key string) error {
+	q := `UPDATE login_failures SET failures = failures - 1 WHERE key = $1 AND failures > 0`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, key)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LoginFailureRepository) Reset(ctx context.Context, key string) error {
+	q := `DELETE FROM login_failures WHERE key = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, key)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewLoginFailureRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewLoginFailureRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewLoginFailureRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewLoginFailureRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestLoginFailureRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewLoginFailureRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := model.LoginFailureEmailKey(strconv.FormatInt(time.Now().UnixNano(), 10) + "@gmail.com")
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	failures, err := repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, model.LoginFailures{}, failures)
+
+	limit := model.LoginLimit{MaxFailures: 3, Delay: time.Second, Lockout: time.Minute}
+	reserve := func(at time.Time) bool {
+		reserved, err := repo.Reserve(ctx, key, at, limit)
+		require.NoError(t, err)
+		return reserved
+	}
+
+	require.True(t, reserve(now))
+	// the delay after the first failure has not passed
+	require.False(t, reserve(now.Add(500*time.Millisecond)))
+	require.True(t, reserve(now.Add(time.Second)))
+
+	failures, err = repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 2, failures.Failures)
+	require.True(t, now.Add(time.Second).Equal(failures.LastFailureAt))
+
+	// the delay doubles after the second failure
+	require.False(t, reserve(now.Add(2*time.Second)))
+	require.True(t, reserve(now.Add(3*time.Second)))
+	// locked after three failures until the lockout passes
+	require.False(t, reserve(now.Add(30*time.Second)))
+
+	require.NoError(t, repo.Release(ctx, key))
+	failures, err = repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 2, failures.Failures)
+
+	// the last failure is older than the lockout, counting restarts
+	require.True(t, reserve(now.Add(time.Hour)))
+
+	failures, err = repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 1, failures.Failures)
+
+	require.NoError(t, repo.Reset(ctx, key))
+
+	failures, err = repo.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, model.LoginFailures{}, failures)
+}
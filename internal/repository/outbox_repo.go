@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type OutboxRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+// outboxPayload is the JSON body of the event as it is delivered to the sinks.
+type outboxPayload struct {
+	Reception outboxReception `json:"reception"`
+	Product   *outboxProduct  `json:"product,omitempty"`
+}
+
+type outboxReception struct {
+	ID       uuid.UUID `json:"id"`
+	PVZID    uuid.UUID `json:"pvzId"`
+	DateTime time.Time `json:"dateTime"`
+	Status   string    `json:"status"`
+}
+
+type outboxProduct struct {
+	ID          uuid.UUID `json:"id"`
+	ReceptionID uuid.UUID `json:"receptionId"`
+	DateTime    time.Time `json:"dateTime"`
+	Type        string    `json:"type"`
+	Barcode     *string   `json:"barcode,omitempty"`
+}
+
+func NewOutboxRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*OutboxRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &OutboxRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *OutboxRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Add stores the event in the current transaction, so it is delivered only if the change itself is committed.
+func (r *OutboxRepository) Add(ctx context.Context, event model.OutboxEvent) error {
+	payload, err := outboxEventPayload(event)
+	if err != nil {
+		return err
+	}
+
+	q := `INSERT INTO outbox (event_type, pvz_id, payload) VALUES ($1, $2, $3)`
+
+	_, err = r.trOrDB(ctx).ExecContext(ctx, q, event.Type, event.Reception.PVZID, payload)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// AddBatch stores the events with one statement like Add. Events of one transaction share now(), so created_at
+// is shifted by a microsecond per event to keep them in the given order for the relay.
+func (r *OutboxRepository) AddBatch(ctx context.Context, events []model.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	eventTypes := make([]int16, 0, len(events))
+	pvzIDs := make([]model.PVZID, 0, len(events))
+	payloads := make([]string, 0, len(events))
+	for _, event := range events {
+		payload, err := outboxEventPayload(event)
+		if err != nil {
+			return err
+		}
+		eventTypes = append(eventTypes, int16(event.Type))
+		pvzIDs = append(pvzIDs, event.Reception.PVZID)
+		payloads = append(payloads, payload)
+	}
+
+	q := `INSERT INTO outbox (event_type, pvz_id, payload, created_at)
+		SELECT e.event_type, e.pvz_id, e.payload, now() + e.ord * INTERVAL '1 microsecond'
+		FROM unnest($1::SMALLINT[], $2::UUID[], $3::JSONB[]) WITH ORDINALITY AS e(event_type, pvz_id, payload, ord)`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, eventTypes, pvzIDs, payloads)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func outboxEventPayload(event model.OutboxEvent) (string, error) {
+	payload := outboxPayload{
+		Reception: outboxReception{
+			ID:       event.Reception.ID.UUID(),
+			PVZID:    event.Reception.PVZID.UUID(),
+			DateTime: event.Reception.ReceptedAt,
+			Status:   event.Reception.ReceptionStatus.String(),
+		},
+	}
+	if event.Product != nil {
+		payload.Product = &outboxProduct{
+			ID:          event.Product.ID.UUID(),
+			ReceptionID: event.Product.ReceptionID.UUID(),
+			DateTime:    event.Product.AddedAt,
+			Type:        event.Product.Category.String(),
+			Barcode:     event.Product.Barcode,
+		}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// ClaimPending returns undelivered messages due for an attempt, the oldest first, and postpones their next attempt
+// by lease. The messages are sent outside of a transaction, the lease keeps concurrent relays off them meanwhile,
+// and if the relay dies before marking them, they are sent again once the lease has passed.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]model.OutboxMessage, error) {
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+
+	q := `UPDATE outbox SET next_attempt_at = now() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE delivered_at IS NULL AND dead_at IS NULL AND next_attempt_at <= now()
+			ORDER BY created_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_type, pvz_id, payload, created_at, attempts`
+
+	var entities []OutboxMessage
+	err := r.trOrDB(ctx).SelectContext(ctx, &entities, q, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	// RETURNING order is not guaranteed
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].CreatedAt.Equal(entities[j].CreatedAt) {
+			return entities[i].ID.String() < entities[j].ID.String()
+		}
+		return entities[i].CreatedAt.Before(entities[j].CreatedAt)
+	})
+
+	messages := make([]model.OutboxMessage, 0, len(entities))
+	for _, entity := range entities {
+		messages = append(messages, model.OutboxMessage{
+			ID:        model.OutboxMessageID(entity.ID),
+			Type:      model.OutboxEventType(entity.EventType),
+			PVZID:     model.PVZID(entity.PVZID),
+			Payload:   entity.Payload,
+			CreatedAt: entity.CreatedAt,
+			Attempts:  entity.Attempts,
+		})
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, messageID model.OutboxMessageID) error {
+	q := `UPDATE outbox SET delivered_at = now(), last_error = NULL WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, messageID)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records the failed attempt and postpones the next one by retryAfter.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, messageID model.OutboxMessageID, lastError string, retryAfter time.Duration) error {
+	q := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = now() + make_interval(secs => $3)
+		WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, messageID, lastError, retryAfter.Seconds())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDead records the last failed attempt, the message is not sent anymore.
+func (r *OutboxRepository) MarkDead(ctx context.Context, messageID model.OutboxMessageID, lastError string) error {
+	q := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, dead_at = now() WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, messageID, lastError)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
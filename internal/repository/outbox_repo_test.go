@@ -0,0 +1,194 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewOutboxRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewOutboxRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewOutboxRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewOutboxRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestOutboxRepository_AddClaimMark(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewOutboxRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	// events left by other tests would be fetched together with the ones below
+	_, err = db.Exec(`UPDATE outbox SET delivered_at = now() WHERE delivered_at IS NULL`)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	barcode := "4600000000001"
+	reception := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           model.NewPVZID(),
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      now,
+	}
+	product := model.Product{
+		ID:          model.NewProductID(),
+		ReceptionID: reception.ID,
+		Category:    model.ProductCategoryShoes,
+		AddedAt:     now,
+		Barcode:     &barcode,
+	}
+
+	err = repo.Add(context.Background(), model.NewReceptionOutboxEvent(model.OutboxEventReceptionCreated, reception))
+	require.NoError(t, err)
+	err = repo.Add(context.Background(), model.NewProductOutboxEvent(model.OutboxEventProductAdded, reception, product))
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimPending(context.Background(), 10, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	require.Equal(t, model.OutboxEventReceptionCreated, messages[0].Type)
+	require.Equal(t, reception.PVZID, messages[0].PVZID)
+	require.Zero(t, messages[0].Attempts)
+	require.JSONEq(t, `{"reception": {"id": "`+reception.ID.UUID().String()+`", "pvzId": "`+reception.PVZID.UUID().String()+`",
+		"dateTime": "`+now.Format(time.RFC3339)+`", "status": "in_progress"}}`, string(messages[0].Payload))
+
+	require.Equal(t, model.OutboxEventProductAdded, messages[1].Type)
+	var payload struct {
+		Product map[string]any `json:"product"`
+	}
+	require.NoError(t, json.Unmarshal(messages[1].Payload, &payload))
+	require.Equal(t, product.ID.UUID().String(), payload.Product["id"])
+	require.Equal(t, "обувь", payload.Product["type"])
+	require.Equal(t, "4600000000001", payload.Product["barcode"])
+
+	t.Run("success.mark", func(t *testing.T) {
+		err = repo.MarkDelivered(context.Background(), messages[0].ID)
+		require.NoError(t, err)
+		err = repo.MarkFailed(context.Background(), messages[1].ID, "connection refused", time.Hour)
+		require.NoError(t, err)
+
+		res, err := repo.ClaimPending(context.Background(), 10, time.Hour)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		var attempts int
+		err = db.Get(&attempts, `SELECT attempts FROM outbox WHERE id = $1`, messages[1].ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, attempts)
+	})
+	t.Run("success.retry_due", func(t *testing.T) {
+		err = repo.MarkFailed(context.Background(), messages[1].ID, "connection refused", 0)
+		require.NoError(t, err)
+
+		res, err := repo.ClaimPending(context.Background(), 10, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, messages[1].ID, res[0].ID)
+		require.Equal(t, 2, res[0].Attempts)
+	})
+	t.Run("success.claimed_until_lease", func(t *testing.T) {
+		// the message claimed by success.retry_due is leased for an hour
+		res, err := repo.ClaimPending(context.Background(), 10, time.Hour)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		_, err = db.Exec(`UPDATE outbox SET next_attempt_at = now() WHERE id = $1`, messages[1].ID)
+		require.NoError(t, err)
+
+		res, err = repo.ClaimPending(context.Background(), 10, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, messages[1].ID, res[0].ID)
+	})
+	t.Run("success.dead", func(t *testing.T) {
+		err = repo.MarkDead(context.Background(), messages[1].ID, "connection refused")
+		require.NoError(t, err)
+
+		_, err = db.Exec(`UPDATE outbox SET next_attempt_at = now() WHERE id = $1`, messages[1].ID)
+		require.NoError(t, err)
+
+		res, err := repo.ClaimPending(context.Background(), 10, time.Hour)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		var attempts int
+		err = db.Get(&attempts, `SELECT attempts FROM outbox WHERE id = $1 AND dead_at IS NOT NULL`, messages[1].ID)
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+	t.Run("error.zero_limit", func(t *testing.T) {
+		_, err := repo.ClaimPending(context.Background(), 0, time.Hour)
+		require.Error(t, err)
+	})
+}
+
+func TestOutboxRepository_AddBatch(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewOutboxRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	// events left by other tests would be fetched together with the ones below
+	_, err = db.Exec(`UPDATE outbox SET delivered_at = now() WHERE delivered_at IS NULL`)
+	require.NoError(t, err)
+
+	reception := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           model.NewPVZID(),
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      time.Now(),
+	}
+	products := []model.Product{
+		{ID: model.NewProductID(), ReceptionID: reception.ID, Category: model.ProductCategoryShoes},
+		{ID: model.NewProductID(), ReceptionID: reception.ID, Category: model.ProductCategoryClothes},
+		{ID: model.NewProductID(), ReceptionID: reception.ID, Category: model.ProductCategoryElectronics},
+	}
+	events := make([]model.OutboxEvent, 0, len(products))
+	for _, product := range products {
+		events = append(events, model.NewProductOutboxEvent(model.OutboxEventProductAdded, reception, product))
+	}
+
+	err = repo.AddBatch(context.Background(), events)
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimPending(context.Background(), 10, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, messages, len(products))
+
+	for i, message := range messages {
+		require.Equal(t, model.OutboxEventProductAdded, message.Type)
+		require.Equal(t, reception.PVZID, message.PVZID)
+
+		var payload struct {
+			Product map[string]any `json:"product"`
+		}
+		require.NoError(t, json.Unmarshal(message.Payload, &payload))
+		require.Equal(t, products[i].ID.UUID().String(), payload.Product["id"])
+	}
+
+	t.Run("success.empty", func(t *testing.T) {
+		err := repo.AddBatch(context.Background(), nil)
+		require.NoError(t, err)
+	})
+}
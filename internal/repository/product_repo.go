@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
@@ -34,13 +36,13 @@ func (r *ProductRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
 	return r.getter.DefaultTrOrDB(ctx, r.db)
 }
 
-func (r *ProductRepository) Create(ctx context.Context, receptionID model.ReceptionID, category model.ProductCategory) (model.Product, error) {
+func (r *ProductRepository) Create(ctx context.Context, receptionID model.ReceptionID, category model.ProductCategory, barcode *string) (model.Product, error) {
 	var product Product
 
-	q := `INSERT INTO products (reception_id, category) VALUES ($1, $2)
-	RETURNING id, reception_id, category, added_at`
+	q := `INSERT INTO products (reception_id, category, barcode) VALUES ($1, $2, $3)
+	RETURNING id, reception_id, category, added_at, barcode`
 
-	err := r.trOrDB(ctx).GetContext(ctx, &product, q, receptionID, category)
+	err := r.trOrDB(ctx).GetContext(ctx, &product, q, receptionID, category, barcode)
 	if err != nil {
 		return model.Product{}, fmt.Errorf("db.GetContext: %w", err)
 	}
@@ -50,15 +52,111 @@ func (r *ProductRepository) Create(ctx context.Context, receptionID model.Recept
 		ReceptionID: receptionID,
 		Category:    category,
 		AddedAt:     product.AddedAt,
+		Barcode:     product.Barcode,
 	}, nil
 }
 
-func (r *ProductRepository) RemoveLast(ctx context.Context, receptionID model.ReceptionID) error {
-	q := `DELETE FROM products WHERE id IN (
-		SELECT id FROM products WHERE reception_id = $1 ORDER BY added_at DESC LIMIT 1
-	)`
+// CreateBatch inserts the products with one statement. Products of one transaction share now(), so added_at is
+// shifted by a microsecond per product to keep the given order, RemoveLast relies on it.
+func (r *ProductRepository) CreateBatch(ctx context.Context, receptionID model.ReceptionID, inputs []model.ProductInput) ([]model.Product, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	categories := make([]int16, 0, len(inputs))
+	barcodes := make([]*string, 0, len(inputs))
+	for _, input := range inputs {
+		categories = append(categories, int16(input.Category))
+		barcodes = append(barcodes, input.Barcode)
+	}
+
+	var entities []Product
+
+	q := `INSERT INTO products (reception_id, category, barcode, added_at)
+	SELECT $1, p.category, p.barcode, now() + p.ord * INTERVAL '1 microsecond'
+	FROM unnest($2::SMALLINT[], $3::TEXT[]) WITH ORDINALITY AS p(category, barcode, ord)
+	RETURNING id, reception_id, category, added_at, barcode`
+
+	err := r.trOrDB(ctx).SelectContext(ctx, &entities, q, receptionID, categories, barcodes)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	// RETURNING order is not guaranteed
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].AddedAt.Before(entities[j].AddedAt)
+	})
+
+	products := make([]model.Product, 0, len(entities))
+	for _, product := range entities {
+		products = append(products, model.Product{
+			ID:          model.ProductID(product.ID),
+			ReceptionID: receptionID,
+			Category:    model.ProductCategory(product.Category),
+			AddedAt:     product.AddedAt,
+			Barcode:     product.Barcode,
+		})
+	}
+	return products, nil
+}
+
+func (r *ProductRepository) ExistsByBarcode(ctx context.Context, receptionID model.ReceptionID, barcode string) (bool, error) {
+	var exists bool
+
+	q := `SELECT EXISTS(SELECT 1 FROM products WHERE reception_id = $1 AND barcode = $2)`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &exists, q, receptionID, barcode)
+	if err != nil {
+		return false, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ExistingBarcodes returns those of the barcodes already scanned in the reception.
+func (r *ProductRepository) ExistingBarcodes(ctx context.Context, receptionID model.ReceptionID, barcodes []string) ([]string, error) {
+	if len(barcodes) == 0 {
+		return nil, nil
+	}
+
+	var existing []string
+
+	q := `SELECT barcode FROM products WHERE reception_id = $1 AND barcode = ANY($2::TEXT[]) ORDER BY barcode`
 
-	result, err := r.trOrDB(ctx).ExecContext(ctx, q, receptionID)
+	err := r.trOrDB(ctx).SelectContext(ctx, &existing, q, receptionID, barcodes)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	return existing, nil
+}
+
+func (r *ProductRepository) GetByID(ctx context.Context, productID model.ProductID) (model.Product, error) {
+	var product Product
+
+	q := "SELECT id, reception_id, category, added_at, barcode FROM products WHERE id = $1"
+
+	err := r.trOrDB(ctx).GetContext(ctx, &product, q, productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Product{}, model.ErrProductNotFound
+		}
+		return model.Product{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return model.Product{
+		ID:          model.ProductID(product.ID),
+		ReceptionID: model.ReceptionID(product.ReceptionID),
+		Category:    model.ProductCategory(product.Category),
+		AddedAt:     product.AddedAt,
+		Barcode:     product.Barcode,
+	}, nil
+}
+
+func (r *ProductRepository) Remove(ctx context.Context, productID model.ProductID) error {
+	q := `DELETE FROM products WHERE id = $1`
+
+	result, err := r.trOrDB(ctx).ExecContext(ctx, q, productID)
 	if err != nil {
 		return fmt.Errorf("db.ExecContext: %w", err)
 	}
@@ -74,10 +172,35 @@ func (r *ProductRepository) RemoveLast(ctx context.Context, receptionID model.Re
 	return nil
 }
 
+// RemoveLast removes the most recently added product of the reception and returns it.
+func (r *ProductRepository) RemoveLast(ctx context.Context, receptionID model.ReceptionID) (model.Product, error) {
+	var product Product
+
+	q := `DELETE FROM products WHERE id IN (
+		SELECT id FROM products WHERE reception_id = $1 ORDER BY added_at DESC LIMIT 1
+	) RETURNING id, reception_id, category, added_at, barcode`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &product, q, receptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Product{}, model.ErrProductNotFound
+		}
+		return model.Product{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return model.Product{
+		ID:          model.ProductID(product.ID),
+		ReceptionID: model.ReceptionID(product.ReceptionID),
+		Category:    model.ProductCategory(product.Category),
+		AddedAt:     product.AddedAt,
+		Barcode:     product.Barcode,
+	}, nil
+}
+
 func (r *ProductRepository) GetByReceptionIDs(ctx context.Context, receptionIDs []model.ReceptionID) ([]model.Product, error) {
 	var entities []Product
 
-	q := "SELECT id, reception_id, category, added_at FROM products WHERE reception_id = ANY($1::UUID[]) ORDER BY added_at"
+	q := "SELECT id, reception_id, category, added_at, barcode FROM products WHERE reception_id = ANY($1::UUID[]) ORDER BY added_at"
 
 	err := r.trOrDB(ctx).SelectContext(ctx, &entities, q, receptionIDs)
 	if err != nil {
@@ -92,6 +215,7 @@ func (r *ProductRepository) GetByReceptionIDs(ctx context.Context, receptionIDs
 			ReceptionID: model.ReceptionID(product.ReceptionID),
 			Category:    model.ProductCategory(product.Category),
 			AddedAt:     product.AddedAt,
+			Barcode:     product.Barcode,
 		})
 	}
 	return products, nil
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,10 +41,12 @@ func TestProductRepository_Create(t *testing.T) {
 	ID1 := model.NewPVZID()
 	receptionID1 := model.NewReceptionID()
 	receptionID2 := model.NewReceptionID()
+	barcode := "4600000000001"
 
 	type args struct {
 		receptionID model.ReceptionID
 		category    model.ProductCategory
+		barcode     *string
 	}
 
 	testCases := []struct {
@@ -68,17 +71,51 @@ func TestProductRepository_Create(t *testing.T) {
 			},
 			check: func(t *testing.T, res model.Product) {
 				var product Product
-				err = db.Get(&product, "SELECT id, reception_id, category, added_at FROM products WHERE reception_id = $1", receptionID1)
+				err = db.Get(&product, "SELECT id, reception_id, category, added_at, barcode FROM products WHERE reception_id = $1", receptionID1)
 				require.NoError(t, err)
 
 				require.Equal(t, receptionID1.UUID(), product.ReceptionID)
 				require.Equal(t, int16(model.ProductCategoryElectronics), product.Category)
+				require.Nil(t, product.Barcode)
 				require.Equal(t, product.ID, res.ID.UUID())
 				require.Equal(t, receptionID1, res.ReceptionID)
 				require.Equal(t, model.ProductCategoryElectronics, res.Category)
+				require.Nil(t, res.Barcode)
 			},
 			wantErr: false,
 		},
+		{
+			name: "success_insert_barcode",
+			prepare: func(_ *testing.T) {
+			},
+			args: args{
+				receptionID: receptionID1,
+				category:    model.ProductCategoryShoes,
+				barcode:     &barcode,
+			},
+			check: func(t *testing.T, res model.Product) {
+				var product Product
+				err = db.Get(&product, "SELECT id, reception_id, category, added_at, barcode FROM products WHERE id = $1", res.ID)
+				require.NoError(t, err)
+
+				require.Equal(t, &barcode, product.Barcode)
+				require.Equal(t, &barcode, res.Barcode)
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate_barcode_error",
+			prepare: func(_ *testing.T) {
+			},
+			args: args{
+				receptionID: receptionID1,
+				category:    model.ProductCategoryShoes,
+				barcode:     &barcode,
+			},
+			check: func(_ *testing.T, _ model.Product) {
+			},
+			wantErr: true,
+		},
 		{
 			name: "no_reception_error",
 			prepare: func(_ *testing.T) {
@@ -97,7 +134,7 @@ func TestProductRepository_Create(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.prepare(t)
 
-			res, err := repo.Create(context.Background(), tc.args.receptionID, tc.args.category)
+			res, err := repo.Create(context.Background(), tc.args.receptionID, tc.args.category, tc.args.barcode)
 
 			require.Equal(t, err != nil, tc.wantErr)
 			tc.check(t, res)
@@ -105,6 +142,187 @@ func TestProductRepository_Create(t *testing.T) {
 	}
 }
 
+func TestProductRepository_ExistsByBarcode(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewProductRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	ID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, ID1, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3), ($4, $2, $5)`,
+		receptionID1, ID1, model.ReceptionStatusInProgress, receptionID2, model.ReceptionStatusClose)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO products(reception_id, category, barcode) VALUES($1, $2, $3)`,
+		receptionID1, model.ProductCategoryShoes, "4600000000001")
+	require.NoError(t, err)
+
+	t.Run("exists", func(t *testing.T) {
+		exists, err := repo.ExistsByBarcode(context.Background(), receptionID1, "4600000000001")
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+	t.Run("other_barcode", func(t *testing.T) {
+		exists, err := repo.ExistsByBarcode(context.Background(), receptionID1, "4600000000002")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+	t.Run("other_reception", func(t *testing.T) {
+		exists, err := repo.ExistsByBarcode(context.Background(), receptionID2, "4600000000001")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
+func TestProductRepository_CreateBatch(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewProductRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	ID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, ID1, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3)`,
+		receptionID1, ID1, model.ReceptionStatusInProgress)
+	require.NoError(t, err)
+
+	barcode1 := "4600000000001"
+	barcode2 := "4600000000002"
+	inputs := []model.ProductInput{
+		{Category: model.ProductCategoryShoes, Barcode: &barcode1},
+		{Category: model.ProductCategoryElectronics},
+		{Category: model.ProductCategoryClothes, Barcode: &barcode2},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		res, err := repo.CreateBatch(context.Background(), receptionID1, inputs)
+		require.NoError(t, err)
+		require.Len(t, res, len(inputs))
+		for i, product := range res {
+			require.NotEqual(t, uuid.Nil, product.ID.UUID())
+			require.Equal(t, receptionID1, product.ReceptionID)
+			require.Equal(t, inputs[i].Category, product.Category)
+			require.Equal(t, inputs[i].Barcode, product.Barcode)
+		}
+
+		stored, err := repo.GetByReceptionIDs(context.Background(), []model.ReceptionID{receptionID1})
+		require.NoError(t, err)
+		require.Equal(t, res, stored)
+
+		last, err := repo.RemoveLast(context.Background(), receptionID1)
+		require.NoError(t, err)
+		require.Equal(t, res[2].ID, last.ID)
+	})
+	t.Run("error.duplicate_barcode", func(t *testing.T) {
+		_, err := repo.CreateBatch(context.Background(), receptionID1, inputs[:1])
+		require.Error(t, err)
+	})
+	t.Run("success.empty", func(t *testing.T) {
+		res, err := repo.CreateBatch(context.Background(), receptionID1, nil)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+}
+
+func TestProductRepository_ExistingBarcodes(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewProductRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	ID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, ID1, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3), ($4, $2, $5)`,
+		receptionID1, ID1, model.ReceptionStatusInProgress, receptionID2, model.ReceptionStatusClose)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO products(reception_id, category, barcode) VALUES($1, $2, $3), ($1, $2, $4)`,
+		receptionID1, model.ProductCategoryShoes, "4600000000002", "4600000000001")
+	require.NoError(t, err)
+
+	t.Run("existing", func(t *testing.T) {
+		res, err := repo.ExistingBarcodes(context.Background(), receptionID1, []string{"4600000000001", "4600000000002", "4600000000003"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"4600000000001", "4600000000002"}, res)
+	})
+	t.Run("other_reception", func(t *testing.T) {
+		res, err := repo.ExistingBarcodes(context.Background(), receptionID2, []string{"4600000000001"})
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+}
+
+func TestProductRepository_GetByID(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewProductRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	ID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	productID1 := model.NewProductID()
+
+	now := time.Now().Truncate(time.Second)
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, ID1, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3)`,
+		receptionID1, ID1, model.ReceptionStatusInProgress)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO products(id, reception_id, category, added_at) VALUES($1, $2, $3, $4)`,
+		productID1, receptionID1, model.ProductCategoryClothes, now)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		res, err := repo.GetByID(context.Background(), productID1)
+		require.NoError(t, err)
+		require.Equal(t, productID1, res.ID)
+		require.Equal(t, receptionID1, res.ReceptionID)
+		require.Equal(t, model.ProductCategoryClothes, res.Category)
+		require.True(t, now.Equal(res.AddedAt))
+		require.Nil(t, res.Barcode)
+	})
+	t.Run("error.notFound", func(t *testing.T) {
+		_, err := repo.GetByID(context.Background(), model.NewProductID())
+		require.ErrorIs(t, err, model.ErrProductNotFound)
+	})
+}
+
+func TestProductRepository_Remove(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewProductRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	ID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	productID1 := model.NewProductID()
+	productID2 := model.NewProductID()
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, ID1, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3)`,
+		receptionID1, ID1, model.ReceptionStatusInProgress)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO products(id, reception_id, category) VALUES($1, $3, $4), ($2, $3, $4)`,
+		productID1, productID2, receptionID1, model.ProductCategoryClothes)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		err := repo.Remove(context.Background(), productID1)
+		require.NoError(t, err)
+
+		var ids []uuid.UUID
+		err = db.Select(&ids, `SELECT id FROM products WHERE reception_id = $1`, receptionID1)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{productID2.UUID()}, ids)
+	})
+	t.Run("error.notFound", func(t *testing.T) {
+		err := repo.Remove(context.Background(), productID1)
+		require.ErrorIs(t, err, model.ErrProductNotFound)
+	})
+}
+
 func TestProductRepository_RemoveLast(t *testing.T) {
 	db := setUp(t)
 	repo, err := NewProductRepository(db, trmsqlx.DefaultCtxGetter)
@@ -112,6 +330,7 @@ func TestProductRepository_RemoveLast(t *testing.T) {
 	ID1 := model.NewPVZID()
 	receptionID1 := model.NewReceptionID()
 	receptionID2 := model.NewReceptionID()
+	productID1 := model.NewProductID()
 
 	now := time.Now().Truncate(time.Second)
 
@@ -124,6 +343,7 @@ func TestProductRepository_RemoveLast(t *testing.T) {
 		prepare func(t *testing.T)
 		args    args
 		check   func(t *testing.T)
+		wantRes model.ProductID
 		wantErr error
 	}{
 		{
@@ -137,8 +357,8 @@ func TestProductRepository_RemoveLast(t *testing.T) {
 				_, err = db.Exec(`INSERT INTO products(reception_id, category, added_at) VALUES($1, $2, $3)`,
 					receptionID1, model.ProductCategoryClothes, now.Add(-time.Second))
 				require.NoError(t, err)
-				_, err = db.Exec(`INSERT INTO products(reception_id, category, added_at) VALUES($1, $2, $3)`,
-					receptionID1, model.ProductCategoryElectronics, now)
+				_, err = db.Exec(`INSERT INTO products(id, reception_id, category, added_at) VALUES($1, $2, $3, $4)`,
+					productID1, receptionID1, model.ProductCategoryElectronics, now)
 				require.NoError(t, err)
 				_, err = db.Exec(`INSERT INTO products(reception_id, category, added_at) VALUES($1, $2, $3)`,
 					receptionID1, model.ProductCategoryShoes, now.Add(-2*time.Second))
@@ -157,6 +377,7 @@ func TestProductRepository_RemoveLast(t *testing.T) {
 					model.ProductCategoryClothes,
 				}, categories)
 			},
+			wantRes: productID1,
 			wantErr: nil,
 		},
 		{
@@ -176,9 +397,10 @@ func TestProductRepository_RemoveLast(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.prepare(t)
 
-			err = repo.RemoveLast(context.Background(), tc.args.receptionID)
+			product, err := repo.RemoveLast(context.Background(), tc.args.receptionID)
 
 			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, product.ID)
 			tc.check(t)
 		})
 	}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type PVZAssignmentRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewPVZAssignmentRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*PVZAssignmentRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &PVZAssignmentRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *PVZAssignmentRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Assign is idempotent, assigning an already assigned employee keeps the first assignment.
+func (r *PVZAssignmentRepository) Assign(ctx context.Context, userID model.UserID, pvzID model.PVZID,
+	assignedBy model.UserID,
+) error {
+	q := `INSERT INTO user_pvz_assignments (user_id, pvz_id, assigned_by) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, pvz_id) DO NOTHING`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, userID.UUID(), pvzID.UUID(), assignedBy.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PVZAssignmentRepository) Unassign(ctx context.Context, userID model.UserID, pvzID model.PVZID) error {
+	q := `DELETE FROM user_pvz_assignments WHERE user_id = $1 AND pvz_id = $2`
+
+	result, err := r.trOrDB(ctx).ExecContext(ctx, q, userID.UUID(), pvzID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+	if count == 0 {
+		return model.ErrPVZAssignmentNotFound
+	}
+
+	return nil
+}
+
+func (r *PVZAssignmentRepository) GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error) {
+	var ids []uuid.UUID
+
+	q := `SELECT pvz_id FROM user_pvz_assignments WHERE user_id = $1 ORDER BY assigned_at, pvz_id`
+
+	err := r.trOrDB(ctx).SelectContext(ctx, &ids, q, userID.UUID())
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	pvzIDs := make([]model.PVZID, 0, len(ids))
+	for _, id := range ids {
+		pvzIDs = append(pvzIDs, model.PVZID(id))
+	}
+
+	return pvzIDs, nil
+}
@@ -0,0 +1,81 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewPVZAssignmentRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewPVZAssignmentRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewPVZAssignmentRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewPVZAssignmentRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestPVZAssignmentRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewPVZAssignmentRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	userID := model.NewUserID()
+	moderatorID := model.NewUserID()
+	pvzID1 := model.NewPVZID()
+	pvzID2 := model.NewPVZID()
+
+	_, err = db.Exec(`INSERT INTO users (id, email, password, user_role) VALUES ($1, $2, $3, $4)`,
+		userID, userID.UUID().String()+"@assignment", "password", model.UserRoleEmployee)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2), ($3, $2)`, pvzID1, "Москва", pvzID2)
+	require.NoError(t, err)
+
+	t.Run("success.GetPVZIDs.empty", func(t *testing.T) {
+		res, err := repo.GetPVZIDs(ctx, userID)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("success.Assign", func(t *testing.T) {
+		require.NoError(t, repo.Assign(ctx, userID, pvzID1, moderatorID))
+		require.NoError(t, repo.Assign(ctx, userID, pvzID2, moderatorID))
+		// repeated assignment is not an error
+		require.NoError(t, repo.Assign(ctx, userID, pvzID1, moderatorID))
+
+		res, err := repo.GetPVZIDs(ctx, userID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []model.PVZID{pvzID1, pvzID2}, res)
+	})
+
+	t.Run("success.Unassign", func(t *testing.T) {
+		require.NoError(t, repo.Unassign(ctx, userID, pvzID1))
+
+		res, err := repo.GetPVZIDs(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []model.PVZID{pvzID2}, res)
+	})
+
+	t.Run("businessError.Unassign.NotFound", func(t *testing.T) {
+		err := repo.Unassign(ctx, userID, pvzID1)
+		require.ErrorIs(t, err, model.ErrPVZAssignmentNotFound)
+	})
+}
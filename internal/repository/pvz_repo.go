@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
+	sq "github.com/Masterminds/squirrel"
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
 
@@ -67,3 +69,66 @@ func (r *PVZRepository) Get(ctx context.Context, pvzIDs []model.PVZID) ([]model.
 	}
 	return pvzs, nil
 }
+
+func (r *PVZRepository) GetByID(ctx context.Context, pvzID model.PVZID) (model.PVZ, error) {
+	var pvz PVZ
+
+	q := "SELECT id, city, registered_at FROM pvz WHERE id = $1"
+
+	err := r.trOrDB(ctx).GetContext(ctx, &pvz, q, pvzID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.PVZ{}, model.ErrPVZNotFound
+		}
+		return model.PVZ{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return model.PVZ{
+		ID:           model.PVZID(pvz.ID),
+		City:         pvz.City,
+		RegisteredAt: pvz.RegisteredAt,
+	}, nil
+}
+
+// Search returns pvz ordered by registration time, optionally filtered by city.
+func (r *PVZRepository) Search(ctx context.Context, city *string, offset, limit int64) ([]model.PVZ, error) {
+	if offset < 0 {
+		return nil, errors.New("offset can't be negative")
+	}
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "city", "registered_at").
+		From("pvz").
+		OrderBy("registered_at", "id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if city != nil {
+		b = b.Where(sq.Eq{"city": *city})
+	}
+
+	q, args, err := b.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	var entities []PVZ
+	err = r.trOrDB(ctx).SelectContext(ctx, &entities, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	pvzs := make([]model.PVZ, 0, len(entities))
+
+	for _, pvz := range entities {
+		pvzs = append(pvzs, model.PVZ{
+			ID:           model.PVZID(pvz.ID),
+			City:         pvz.City,
+			RegisteredAt: pvz.RegisteredAt,
+		})
+	}
+	return pvzs, nil
+}
@@ -150,3 +150,80 @@ func TestPVZRepository_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZRepository_GetByID(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewPVZRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	pvzID1 := model.NewPVZID()
+
+	now := time.Now().Truncate(time.Second)
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city, registered_at) VALUES($1, $2, $3)`, pvzID1, "Казань", now)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		res, err := repo.GetByID(context.Background(), pvzID1)
+		require.NoError(t, err)
+		require.Equal(t, pvzID1, res.ID)
+		require.Equal(t, "Казань", res.City)
+		require.True(t, now.Equal(res.RegisteredAt))
+	})
+	t.Run("error.notFound", func(t *testing.T) {
+		_, err := repo.GetByID(context.Background(), model.NewPVZID())
+		require.ErrorIs(t, err, model.ErrPVZNotFound)
+	})
+}
+
+func TestPVZRepository_Search(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewPVZRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	pvzID1 := model.NewPVZID()
+	pvzID2 := model.NewPVZID()
+	pvzID3 := model.NewPVZID()
+
+	// unique city isolates the test from pvz created by other tests
+	city := "city-" + pvzID1.UUID().String()
+	otherCity := "city-" + pvzID3.UUID().String()
+	now := time.Now().Truncate(time.Second)
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city, registered_at) VALUES($1, $2, $3)`, pvzID1, city, now.Add(time.Minute))
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO pvz(id, city, registered_at) VALUES($1, $2, $3)`, pvzID2, city, now)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO pvz(id, city, registered_at) VALUES($1, $2, $3)`, pvzID3, otherCity, now)
+	require.NoError(t, err)
+
+	pvzIDs := func(pvzs []model.PVZ) []model.PVZID {
+		res := make([]model.PVZID, 0, len(pvzs))
+		for _, pvz := range pvzs {
+			res = append(res, pvz.ID)
+		}
+		return res
+	}
+
+	t.Run("success.city", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), &city, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []model.PVZID{pvzID2, pvzID1}, pvzIDs(res))
+	})
+	t.Run("success.offset_limit", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), &city, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, []model.PVZID{pvzID1}, pvzIDs(res))
+	})
+	t.Run("success.no_city", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), nil, 0, 30)
+		require.NoError(t, err)
+		require.NotEmpty(t, res)
+	})
+	t.Run("error.negative_offset", func(t *testing.T) {
+		_, err := repo.Search(context.Background(), nil, -1, 10)
+		require.Error(t, err)
+	})
+	t.Run("error.zero_limit", func(t *testing.T) {
+		_, err := repo.Search(context.Background(), nil, 0, 0)
+		require.Error(t, err)
+	})
+}
@@ -61,6 +61,29 @@ func (r *ReceptionRepository) GetInProgress(ctx context.Context, pvzID model.PVZ
 	}, nil
 }
 
+func (r *ReceptionRepository) GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error) {
+	var reception Reception
+
+	q := `SELECT id, pvz_id, status, recepted_at
+	FROM receptions
+	WHERE id = $1`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &reception, q, receptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Reception{}, model.ErrReceptionNotFound
+		}
+		return model.Reception{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return model.Reception{
+		ID:              model.ReceptionID(reception.ID),
+		PVZID:           model.PVZID(reception.PVZID),
+		ReceptionStatus: model.ReceptionStatus(reception.Status),
+		ReceptedAt:      reception.ReceptedAt,
+	}, nil
+}
+
 func (r *ReceptionRepository) Create(ctx context.Context, pvzID model.PVZID, status model.ReceptionStatus) (model.Reception, error) {
 	var reception Reception
 
@@ -99,7 +122,60 @@ func (r *ReceptionRepository) SetStatus(ctx context.Context, receptionID model.R
 	return nil
 }
 
+// Reopen sets the closed reception back in progress and records who reopened it.
+func (r *ReceptionRepository) Reopen(ctx context.Context, receptionID model.ReceptionID, reopenedBy model.UserID) error {
+	q := `UPDATE receptions SET status = $1, reopened_by = $2, reopened_at = now()
+	WHERE id = $3 AND status = $4`
+
+	result, err := r.trOrDB(ctx).ExecContext(ctx, q, model.ReceptionStatusInProgress, reopenedBy, receptionID,
+		model.ReceptionStatusClose)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+	if count != 1 {
+		return model.ErrReceptionNotFound
+	}
+
+	return nil
+}
+
 func (r *ReceptionRepository) Search(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, offset, limit int64) ([]model.Reception, error) {
+	if offset < 0 {
+		return nil, errors.New("offset can't be negative")
+	}
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+	b := searchBuilder(receptedAtFrom, receptedAtTo).
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	return r.search(ctx, b)
+}
+
+// SearchAfter is a keyset pagination version of Search: it returns receptions
+// which follow the cursor in (recepted_at, id) order, or the first ones if cursor is nil.
+func (r *ReceptionRepository) SearchAfter(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, after *model.ReceptionCursor, limit int64) ([]model.Reception, error) {
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+	b := searchBuilder(receptedAtFrom, receptedAtTo).
+		Limit(uint64(limit))
+
+	if after != nil {
+		b = b.Where(sq.Expr("(recepted_at, id) > (?, ?)", after.ReceptedAt, after.ID))
+	}
+
+	return r.search(ctx, b)
+}
+
+// SearchByPVZ returns receptions of the pvz, the most recent first.
+func (r *ReceptionRepository) SearchByPVZ(ctx context.Context, pvzID model.PVZID, offset, limit int64) ([]model.Reception, error) {
 	if offset < 0 {
 		return nil, errors.New("offset can't be negative")
 	}
@@ -109,10 +185,44 @@ func (r *ReceptionRepository) Search(ctx context.Context, receptedAtFrom, recept
 	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("id", "pvz_id", "status", "recepted_at").
 		From("receptions").
-		OrderBy("recepted_at").
+		Where(sq.Expr("pvz_id = ?", pvzID)).
+		OrderBy("recepted_at DESC", "id DESC").
 		Offset(uint64(offset)).
 		Limit(uint64(limit))
 
+	return r.search(ctx, b)
+}
+
+// SearchByPVZIDs returns the latest limitPerPVZ receptions of every pvz within the optional date window,
+// the oldest first.
+func (r *ReceptionRepository) SearchByPVZIDs(ctx context.Context, pvzIDs []model.PVZID, receptedAtFrom, receptedAtTo *time.Time, limitPerPVZ int64) ([]model.Reception, error) {
+	if limitPerPVZ < 1 {
+		return nil, errors.New("limitPerPVZ should be positive")
+	}
+	ranked := filterReceptedAt(sq.Select("id", "pvz_id", "status", "recepted_at",
+		"ROW_NUMBER() OVER (PARTITION BY pvz_id ORDER BY recepted_at DESC, id DESC) AS n").
+		From("receptions").
+		Where(sq.Expr("pvz_id = ANY(?::UUID[])", pvzIDs)), receptedAtFrom, receptedAtTo)
+
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "pvz_id", "status", "recepted_at").
+		FromSelect(ranked, "r").
+		Where(sq.LtOrEq{"n": limitPerPVZ}).
+		OrderBy("recepted_at", "id")
+
+	return r.search(ctx, b)
+}
+
+func searchBuilder(receptedAtFrom, receptedAtTo *time.Time) sq.SelectBuilder {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "pvz_id", "status", "recepted_at").
+		From("receptions").
+		OrderBy("recepted_at", "id")
+
+	return filterReceptedAt(b, receptedAtFrom, receptedAtTo)
+}
+
+func filterReceptedAt(b sq.SelectBuilder, receptedAtFrom, receptedAtTo *time.Time) sq.SelectBuilder {
 	if receptedAtFrom != nil {
 		b = b.Where(sq.GtOrEq{
 			"recepted_at": *receptedAtFrom,
@@ -124,6 +234,11 @@ func (r *ReceptionRepository) Search(ctx context.Context, receptedAtFrom, recept
 			"recepted_at": *receptedAtTo,
 		})
 	}
+
+	return b
+}
+
+func (r *ReceptionRepository) search(ctx context.Context, b sq.SelectBuilder) ([]model.Reception, error) {
 	q, args, err := b.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("b.ToSql: %w", err)
@@ -109,6 +109,36 @@ func TestReceptionRepository_GetInProgress(t *testing.T) {
 	}
 }
 
+func TestReceptionRepository_GetByID(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	ID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+
+	_, err = db.Exec(`DELETE FROM receptions where pvz_id = $1`, ID1)
+	require.NoError(t, err)
+	_, err = db.Exec(`DELETE FROM pvz where id = $1`, ID1)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, ID1, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3)`, receptionID1, ID1, model.ReceptionStatusClose)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		res, err := repo.GetByID(context.Background(), receptionID1)
+		require.NoError(t, err)
+		require.Equal(t, receptionID1, res.ID)
+		require.Equal(t, ID1, res.PVZID)
+		require.Equal(t, model.ReceptionStatusClose, res.ReceptionStatus)
+		require.False(t, res.ReceptedAt.IsZero())
+	})
+	t.Run("error.notFound", func(t *testing.T) {
+		_, err := repo.GetByID(context.Background(), model.NewReceptionID())
+		require.ErrorIs(t, err, model.ErrReceptionNotFound)
+	})
+}
+
 func TestReceptionRepository_Create(t *testing.T) {
 	db := setUp(t)
 	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
@@ -255,6 +285,43 @@ func TestReceptionRepository_SetStatus(t *testing.T) {
 	}
 }
 
+func TestReceptionRepository_Reopen(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	pvzID := model.NewPVZID()
+	receptionID := model.NewReceptionID()
+	userID := model.NewUserID()
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, pvzID, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status) VALUES($1, $2, $3)`,
+		receptionID, pvzID, model.ReceptionStatusClose)
+	require.NoError(t, err)
+
+	err = repo.Reopen(context.Background(), receptionID, userID)
+	require.NoError(t, err)
+
+	var res struct {
+		Status     int16      `db:"status"`
+		ReopenedBy *string    `db:"reopened_by"`
+		ReopenedAt *time.Time `db:"reopened_at"`
+	}
+	err = db.Get(&res, "SELECT status, reopened_by, reopened_at FROM receptions WHERE id = $1", receptionID)
+	require.NoError(t, err)
+	require.Equal(t, int16(model.ReceptionStatusInProgress), res.Status)
+	require.NotNil(t, res.ReopenedBy)
+	require.Equal(t, userID.UUID().String(), *res.ReopenedBy)
+	require.NotNil(t, res.ReopenedAt)
+
+	// the reception is already in progress
+	err = repo.Reopen(context.Background(), receptionID, userID)
+	require.ErrorIs(t, err, model.ErrReceptionNotFound)
+
+	err = repo.Reopen(context.Background(), model.NewReceptionID(), userID)
+	require.ErrorIs(t, err, model.ErrReceptionNotFound)
+}
+
 func TestReceptionRepository_Search(t *testing.T) {
 	db := setUp(t)
 	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
@@ -335,3 +402,229 @@ func TestReceptionRepository_Search(t *testing.T) {
 		})
 	}
 }
+
+func TestReceptionRepository_SearchAfter(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	pvzID1 := model.NewPVZID()
+	receptionID1, err := model.ParseReceptionID("00000000-0000-0000-0000-000000000001")
+	require.NoError(t, err)
+	receptionID2, err := model.ParseReceptionID("00000000-0000-0000-0000-000000000002")
+	require.NoError(t, err)
+	receptionID3, err := model.ParseReceptionID("00000000-0000-0000-0000-000000000003")
+	require.NoError(t, err)
+
+	receptedAt1 := time.Now().Truncate(time.Second)
+	receptedAt2 := receptedAt1.Add(time.Hour)
+
+	prepare := func(t *testing.T) {
+		_, err = db.Exec(`DELETE FROM products WHERE TRUE`)
+		require.NoError(t, err)
+		_, err = db.Exec(`DELETE FROM receptions WHERE TRUE`)
+		require.NoError(t, err)
+		_, err = db.Exec(`DELETE FROM pvz where id = $1`, pvzID1)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2)`, pvzID1, "Москва")
+		require.NoError(t, err)
+		// receptions 2 and 3 have the same recepted_at, so id breaks the tie
+		_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID3, pvzID1, model.ReceptionStatusClose, receptedAt2)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID2, pvzID1, model.ReceptionStatusClose, receptedAt2)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID1, pvzID1, model.ReceptionStatusClose, receptedAt1)
+		require.NoError(t, err)
+	}
+
+	reception1 := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      receptedAt1,
+	}
+	reception2 := model.Reception{
+		ID:              receptionID2,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      receptedAt2,
+	}
+	reception3 := model.Reception{
+		ID:              receptionID3,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      receptedAt2,
+	}
+
+	type args struct {
+		receptedAtFrom, receptedAtTo *time.Time
+		after                        *model.ReceptionCursor
+		limit                        int64
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		wantRes []model.Reception
+	}{
+		{
+			name: "success.first_page",
+			args: args{
+				after: nil,
+				limit: 2,
+			},
+			wantRes: []model.Reception{reception1, reception2},
+		},
+		{
+			name: "success.after_same_time",
+			args: args{
+				after: &model.ReceptionCursor{ReceptedAt: receptedAt2, ID: receptionID2},
+				limit: 2,
+			},
+			wantRes: []model.Reception{reception3},
+		},
+		{
+			name: "success.after_with_filter",
+			args: args{
+				receptedAtTo: &receptedAt1,
+				after:        nil,
+				limit:        30,
+			},
+			wantRes: []model.Reception{reception1},
+		},
+		{
+			name: "success.end",
+			args: args{
+				after: &model.ReceptionCursor{ReceptedAt: receptedAt2, ID: receptionID3},
+				limit: 2,
+			},
+			wantRes: []model.Reception{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prepare(t)
+
+			res, err := repo.SearchAfter(context.Background(), tc.args.receptedAtFrom, tc.args.receptedAtTo, tc.args.after, tc.args.limit)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestReceptionRepository_SearchByPVZ(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	pvzID1 := model.NewPVZID()
+	pvzID2 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+	receptionID3 := model.NewReceptionID()
+	receptionID4 := model.NewReceptionID()
+
+	now := time.Now().Truncate(time.Second)
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $2), ($3, $2)`, pvzID1, "Москва", pvzID2)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID1, pvzID1, model.ReceptionStatusClose, now)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID2, pvzID1, model.ReceptionStatusClose, now.Add(time.Minute))
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID3, pvzID1, model.ReceptionStatusInProgress, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID4, pvzID2, model.ReceptionStatusInProgress, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	receptionIDs := func(receptions []model.Reception) []model.ReceptionID {
+		res := make([]model.ReceptionID, 0, len(receptions))
+		for _, reception := range receptions {
+			res = append(res, reception.ID)
+		}
+		return res
+	}
+
+	t.Run("success.first_page", func(t *testing.T) {
+		res, err := repo.SearchByPVZ(context.Background(), pvzID1, 0, 2)
+		require.NoError(t, err)
+		require.Equal(t, []model.ReceptionID{receptionID3, receptionID2}, receptionIDs(res))
+	})
+	t.Run("success.second_page", func(t *testing.T) {
+		res, err := repo.SearchByPVZ(context.Background(), pvzID1, 2, 2)
+		require.NoError(t, err)
+		require.Equal(t, []model.ReceptionID{receptionID1}, receptionIDs(res))
+	})
+	t.Run("success.no_receptions", func(t *testing.T) {
+		res, err := repo.SearchByPVZ(context.Background(), model.NewPVZID(), 0, 2)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("error.negative_offset", func(t *testing.T) {
+		_, err := repo.SearchByPVZ(context.Background(), pvzID1, -1, 2)
+		require.Error(t, err)
+	})
+	t.Run("error.zero_limit", func(t *testing.T) {
+		_, err := repo.SearchByPVZ(context.Background(), pvzID1, 0, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestReceptionRepository_SearchByPVZIDs(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	pvzID1 := model.NewPVZID()
+	pvzID2 := model.NewPVZID()
+	pvzID3 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+	receptionID3 := model.NewReceptionID()
+	receptionID4 := model.NewReceptionID()
+
+	now := time.Now().Truncate(time.Second)
+
+	_, err = db.Exec(`INSERT INTO pvz(id, city) VALUES($1, $4), ($2, $4), ($3, $4)`, pvzID1, pvzID2, pvzID3, "Москва")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID1, pvzID1, model.ReceptionStatusClose, now.Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID2, pvzID1, model.ReceptionStatusInProgress, now)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID3, pvzID2, model.ReceptionStatusInProgress, now.Add(time.Minute))
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO receptions(id, pvz_id, status, recepted_at) VALUES($1, $2, $3, $4)`, receptionID4, pvzID3, model.ReceptionStatusInProgress, now)
+	require.NoError(t, err)
+
+	receptionIDs := func(receptions []model.Reception) []model.ReceptionID {
+		res := make([]model.ReceptionID, 0, len(receptions))
+		for _, reception := range receptions {
+			res = append(res, reception.ID)
+		}
+		return res
+	}
+
+	t.Run("success", func(t *testing.T) {
+		res, err := repo.SearchByPVZIDs(context.Background(), []model.PVZID{pvzID1, pvzID2}, nil, nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []model.ReceptionID{receptionID1, receptionID2, receptionID3}, receptionIDs(res))
+	})
+	t.Run("success.filter_dates", func(t *testing.T) {
+		res, err := repo.SearchByPVZIDs(context.Background(), []model.PVZID{pvzID1, pvzID2}, &now, &now, 10)
+		require.NoError(t, err)
+		require.Equal(t, []model.ReceptionID{receptionID2}, receptionIDs(res))
+	})
+	t.Run("success.empty", func(t *testing.T) {
+		res, err := repo.SearchByPVZIDs(context.Background(), []model.PVZID{model.NewPVZID()}, nil, nil, 10)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("success.limit_per_pvz", func(t *testing.T) {
+		res, err := repo.SearchByPVZIDs(context.Background(), []model.PVZID{pvzID1, pvzID2}, nil, nil, 1)
+		require.NoError(t, err)
+		require.Equal(t, []model.ReceptionID{receptionID2, receptionID3}, receptionIDs(res))
+	})
+	t.Run("error.limit_per_pvz", func(t *testing.T) {
+		_, err := repo.SearchByPVZIDs(context.Background(), []model.PVZID{pvzID1}, nil, nil, 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const refreshTokenColumns = `id, family_id, user_id, token_hash, expires_at, created_at, revoked_at`
+
+type RefreshTokenRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewRefreshTokenRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*RefreshTokenRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &RefreshTokenRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *RefreshTokenRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token model.RefreshToken) error {
+	q := `INSERT INTO refresh_tokens (id, family_id, user_id, token_hash, expires_at) VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, token.ID.UUID(), token.FamilyID.UUID(), token.UserID.UUID(),
+		token.TokenHash, token.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHashForUpdate locks the token row, so concurrent refreshes with the same token are serialized.
+func (r *RefreshTokenRepository) GetByHashForUpdate(ctx context.Context, tokenHash []byte) (model.RefreshToken, error) {
+	var entity RefreshToken
+
+	q := `SELECT ` + refreshTokenColumns + ` FROM refresh_tokens WHERE token_hash = $1 FOR UPDATE`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &entity, q, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.RefreshToken{}, model.ErrRefreshTokenNotFound
+		}
+		return model.RefreshToken{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return model.RefreshToken{
+		ID:        model.RefreshTokenID(entity.ID),
+		FamilyID:  model.RefreshTokenFamilyID(entity.FamilyID),
+		UserID:    model.UserID(entity.UserID),
+		TokenHash: entity.TokenHash,
+		ExpiresAt: entity.ExpiresAt,
+		CreatedAt: entity.CreatedAt,
+		RevokedAt: entity.RevokedAt,
+	}, nil
+}
+
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, tokenID model.RefreshTokenID) error {
+	q := `UPDATE refresh_tokens SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, tokenID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeFamily revokes all tokens issued by refreshing the same login.
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID model.RefreshTokenFamilyID) error {
+	q := `UPDATE refresh_tokens SET revoked_at = now() WHERE family_id = $1 AND revoked_at IS NULL`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, familyID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeByUser revokes all tokens of the user, e.g. when the user is disabled or the password is changed.
+func (r *RefreshTokenRepository) RevokeByUser(ctx context.Context, userID model.UserID) error {
+	q := `UPDATE refresh_tokens SET revoked_at = now() WHERE user_id = $1 AND revoked_at IS NULL`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, userID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewRefreshTokenRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewRefreshTokenRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewRefreshTokenRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewRefreshTokenRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestRefreshTokenRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewRefreshTokenRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	userID := model.NewUserID()
+	familyID := model.NewRefreshTokenFamilyID()
+
+	rawToken1, token1, err := model.NewRefreshToken(userID, familyID, time.Hour)
+	require.NoError(t, err)
+	_, token2, err := model.NewRefreshToken(userID, familyID, time.Hour)
+	require.NoError(t, err)
+
+	t.Run("success.Create", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, token1))
+		require.NoError(t, repo.Create(ctx, token2))
+	})
+
+	t.Run("success.GetByHashForUpdate", func(t *testing.T) {
+		res, err := repo.GetByHashForUpdate(ctx, model.HashRefreshToken(rawToken1))
+		require.NoError(t, err)
+		require.Equal(t, token1.ID, res.ID)
+		require.Equal(t, familyID, res.FamilyID)
+		require.Equal(t, userID, res.UserID)
+		require.WithinDuration(t, token1.ExpiresAt, res.ExpiresAt, time.Millisecond)
+		require.Nil(t, res.RevokedAt)
+	})
+
+	t.Run("businessError.GetByHashForUpdate.NotFound", func(t *testing.T) {
+		_, err := repo.GetByHashForUpdate(ctx, model.HashRefreshToken("unknown"))
+		require.ErrorIs(t, err, model.ErrRefreshTokenNotFound)
+	})
+
+	t.Run("success.Revoke", func(t *testing.T) {
+		require.NoError(t, repo.Revoke(ctx, token1.ID))
+
+		res, err := repo.GetByHashForUpdate(ctx, token1.TokenHash)
+		require.NoError(t, err)
+		require.NotNil(t, res.RevokedAt)
+
+		res, err = repo.GetByHashForUpdate(ctx, token2.TokenHash)
+		require.NoError(t, err)
+		require.Nil(t, res.RevokedAt)
+	})
+
+	t.Run("success.RevokeFamily", func(t *testing.T) {
+		require.NoError(t, repo.RevokeFamily(ctx, familyID))
+
+		res, err := repo.GetByHashForUpdate(ctx, token2.TokenHash)
+		require.NoError(t, err)
+		require.NotNil(t, res.RevokedAt)
+	})
+
+	t.Run("success.RevokeByUser", func(t *testing.T) {
+		_, token3, err := model.NewRefreshToken(userID, model.NewRefreshTokenFamilyID(), time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, token3))
+
+		require.NoError(t, repo.RevokeByUser(ctx, userID))
+
+		res, err := repo.GetByHashForUpdate(ctx, token3.TokenHash)
+		require.NoError(t, err)
+		require.NotNil(t, res.RevokedAt)
+	})
+}
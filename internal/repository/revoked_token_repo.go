@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type RevokedTokenRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewRevokedTokenRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*RevokedTokenRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &RevokedTokenRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *RevokedTokenRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *RevokedTokenRepository) Revoke(ctx context.Context, tokenID model.TokenID, expiresAt time.Time) error {
+	q := `INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, tokenID.UUID(), expiresAt)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RevokedTokenRepository) IsRevoked(ctx context.Context, tokenID model.TokenID) (bool, error) {
+	var revoked bool
+
+	q := `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &revoked, q, tokenID.UUID())
+	if err != nil {
+		return false, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,56 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewRevokedTokenRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewRevokedTokenRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewRevokedTokenRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewRevokedTokenRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestRevokedTokenRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewRevokedTokenRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tokenID := model.NewTokenID()
+	expiresAt := time.Now().Add(time.Hour)
+
+	revoked, err := repo.IsRevoked(ctx, tokenID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, repo.Revoke(ctx, tokenID, expiresAt))
+	// revoking twice is not an error
+	require.NoError(t, repo.Revoke(ctx, tokenID, expiresAt))
+
+	revoked, err = repo.IsRevoked(ctx, tokenID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	sq "github.com/Masterminds/squirrel"
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -13,6 +14,9 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+// userColumns are selected into User, sso_linked tells if the user is linked to an OpenID Connect subject
+const userColumns = "id, email, password, user_role, disabled, oidc_subject IS NOT NULL AS sso_linked"
+
 type UserRepository struct {
 	db     *sqlx.DB
 	getter *trmsqlx.CtxGetter
@@ -39,7 +43,7 @@ func (r *UserRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user User
 
-	q := "SELECT id, email, password, user_role FROM users WHERE email = $1"
+	q := "SELECT " + userColumns + " FROM users WHERE email = $1"
 
 	err := r.trOrDB(ctx).GetContext(ctx, &user, q, email)
 	if err != nil {
@@ -49,12 +53,23 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*model.U
 		return nil, fmt.Errorf("db.GetContext: %w", err)
 	}
 
-	return &model.User{
-		UserID:   model.UserID(user.ID),
-		Email:    user.Email,
-		Password: user.Password,
-		UserRole: model.UserRole(user.Role),
-	}, nil
+	return convertUser(user), nil
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, userID model.UserID) (*model.User, error) {
+	var user User
+
+	q := "SELECT " + userColumns + " FROM users WHERE id = $1"
+
+	err := r.trOrDB(ctx).GetContext(ctx, &user, q, userID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertUser(user), nil
 }
 
 func (r *UserRepository) Create(ctx context.Context, email, passwordHash string, role model.UserRole) (*model.User, error) {
@@ -79,3 +94,148 @@ func (r *UserRepository) Create(ctx context.Context, email, passwordHash string,
 		UserRole: role,
 	}, nil
 }
+
+// Search returns users ordered by registration time, optionally filtered by role.
+func (r *UserRepository) Search(ctx context.Context, role *model.UserRole, offset, limit int64) ([]model.User, error) {
+	if offset < 0 {
+		return nil, errors.New("offset can't be negative")
+	}
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(userColumns).
+		From("users").
+		OrderBy("create_time", "id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if role != nil {
+		b = b.Where(sq.Eq{"user_role": *role})
+	}
+
+	q, args, err := b.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	var entities []User
+	err = r.trOrDB(ctx).SelectContext(ctx, &entities, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	users := make([]model.User, 0, len(entities))
+	for _, entity := range entities {
+		users = append(users, *convertUser(entity))
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, userID model.UserID, update model.UserUpdate) (*model.User, error) {
+	q := `UPDATE users SET user_role = COALESCE($2, user_role), disabled = COALESCE($3, disabled)
+		WHERE id = $1
+		RETURNING ` + userColumns
+
+	var user User
+	err := r.trOrDB(ctx).GetContext(ctx, &user, q, userID.UUID(), update.UserRole, update.Disabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertUser(user), nil
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, userID model.UserID, passwordHash string) error {
+	q := "UPDATE users SET password = $2 WHERE id = $1"
+
+	res, err := r.trOrDB(ctx).ExecContext(ctx, q, userID.UUID(), passwordHash)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected: %w", err)
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpsertOIDC creates or updates the user of the OpenID Connect identity, the user is matched by email.
+// An existing user without a linked subject, e.g. registered with a password, is linked to the identity only if
+// a moderator allowed it with AllowOIDCLink, otherwise model.ErrOIDCUserConflict is returned, as for a user linked
+// to another subject. The role of a linked user follows the groups of the identity provider on every login.
+func (r *UserRepository) UpsertOIDC(ctx context.Context, identity model.OIDCIdentity, role model.UserRole,
+) (*model.User, error) {
+	q := `INSERT INTO users (email, password, user_role, oidc_issuer, oidc_subject) VALUES ($1, '', $2, $3, $4)
+		ON CONFLICT (email) DO UPDATE SET user_role = EXCLUDED.user_role,
+			oidc_issuer = EXCLUDED.oidc_issuer, oidc_subject = EXCLUDED.oidc_subject, oidc_link_allowed = false
+		WHERE (users.oidc_subject IS NULL AND users.oidc_link_allowed)
+			OR (users.oidc_issuer = EXCLUDED.oidc_issuer AND users.oidc_subject = EXCLUDED.oidc_subject)
+		RETURNING ` + userColumns
+
+	var user User
+	err := r.trOrDB(ctx).GetContext(ctx, &user, q, identity.Email, role, identity.Issuer, identity.Subject)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrOIDCUserConflict
+		}
+		return nil, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertUser(user), nil
+}
+
+// AllowOIDCLink lets the next OpenID Connect login with the email of the user link the identity to the user.
+// A user already linked to a subject is not changed.
+func (r *UserRepository) AllowOIDCLink(ctx context.Context, userID model.UserID) error {
+	q := "UPDATE users SET oidc_link_allowed = oidc_subject IS NULL WHERE id = $1"
+
+	res, err := r.trOrDB(ctx).ExecContext(ctx, q, userID.UUID())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected: %w", err)
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// IsActive reports whether the user exists and is not disabled.
+func (r *UserRepository) IsActive(ctx context.Context, userID model.UserID) (bool, error) {
+	q := "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1 AND NOT disabled)"
+
+	var active bool
+	err := r.trOrDB(ctx).GetContext(ctx, &active, q, userID.UUID())
+	if err != nil {
+		return false, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return active, nil
+}
+
+func convertUser(user User) *model.User {
+	return &model.User{
+		UserID:    model.UserID(user.ID),
+		Email:     user.Email,
+		Password:  user.Password,
+		UserRole:  model.UserRole(user.Role),
+		Disabled:  user.Disabled,
+		SSOLinked: user.SSOLinked,
+	}
+}
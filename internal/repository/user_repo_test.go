@@ -95,6 +95,38 @@ func Test_GetByEmail(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetByID(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewUserRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	userID := model.NewUserID()
+
+	_, err = db.Exec(`DELETE FROM users where email = $1`, "get-by-id-1")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO users (id, email, password, user_role)
+		VALUES ($1, $2, $3, $4)`, userID, "get-by-id-1", "password", model.UserRoleEmployee)
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		res, err := repo.GetByID(context.Background(), userID)
+		require.NoError(t, err)
+		require.Equal(t, &model.User{
+			UserID:   userID,
+			Email:    "get-by-id-1",
+			Password: "password",
+			UserRole: model.UserRoleEmployee,
+		}, res)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		res, err := repo.GetByID(context.Background(), model.NewUserID())
+		require.ErrorIs(t, err, model.ErrUserNotFound)
+		require.Nil(t, res)
+	})
+}
+
 func Test_Create(t *testing.T) {
 	db := setUp(t)
 	repo, err := NewUserRepository(db, trmsqlx.DefaultCtxGetter)
@@ -167,3 +199,191 @@ func Test_Create(t *testing.T) {
 		})
 	}
 }
+
+func Test_UserSearch(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewUserRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	userID1 := model.NewUserID()
+	userID2 := model.NewUserID()
+
+	_, err = db.Exec(`DELETE FROM users where email IN ('search-1', 'search-2')`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO users (id, email, password, user_role, create_time)
+		VALUES ($1, 'search-1', 'password', $2, now() - interval '1 minute'), ($3, 'search-2', 'password', $4, now())`,
+		userID1, model.UserRoleEmployee, userID2, model.UserRoleAuditor)
+	require.NoError(t, err)
+
+	indexOf := func(users []model.User, userID model.UserID) int {
+		for i, user := range users {
+			if user.UserID == userID {
+				return i
+			}
+		}
+		return -1
+	}
+
+	t.Run("all", func(t *testing.T) {
+		res, err := repo.Search(context.Background(), nil, 0, 10000)
+		require.NoError(t, err)
+		require.NotEqual(t, -1, indexOf(res, userID1))
+		require.Greater(t, indexOf(res, userID2), indexOf(res, userID1))
+	})
+	t.Run("role", func(t *testing.T) {
+		role := model.UserRoleAuditor
+		res, err := repo.Search(context.Background(), &role, 0, 10000)
+		require.NoError(t, err)
+		require.Equal(t, -1, indexOf(res, userID1))
+		require.NotEqual(t, -1, indexOf(res, userID2))
+		for _, user := range res {
+			require.Equal(t, model.UserRoleAuditor, user.UserRole)
+		}
+	})
+	t.Run("error.offset", func(t *testing.T) {
+		_, err := repo.Search(context.Background(), nil, -1, 10)
+		require.Error(t, err)
+	})
+	t.Run("error.limit", func(t *testing.T) {
+		_, err := repo.Search(context.Background(), nil, 0, 0)
+		require.Error(t, err)
+	})
+}
+
+func Test_UserUpdate(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewUserRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	userID := model.NewUserID()
+
+	_, err = db.Exec(`DELETE FROM users where email = $1`, "update-1")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO users (id, email, password, user_role)
+		VALUES ($1, $2, $3, $4)`, userID, "update-1", "password", model.UserRoleEmployee)
+	require.NoError(t, err)
+
+	t.Run("role", func(t *testing.T) {
+		role := model.UserRoleAuditor
+		res, err := repo.Update(context.Background(), userID, model.UserUpdate{UserRole: &role})
+		require.NoError(t, err)
+		require.Equal(t, model.UserRoleAuditor, res.UserRole)
+		require.False(t, res.Disabled)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		disabled := true
+		res, err := repo.Update(context.Background(), userID, model.UserUpdate{Disabled: &disabled})
+		require.NoError(t, err)
+		require.Equal(t, model.UserRoleAuditor, res.UserRole)
+		require.True(t, res.Disabled)
+
+		active, err := repo.IsActive(context.Background(), userID)
+		require.NoError(t, err)
+		require.False(t, active)
+	})
+	t.Run("enabled", func(t *testing.T) {
+		disabled := false
+		_, err := repo.Update(context.Background(), userID, model.UserUpdate{Disabled: &disabled})
+		require.NoError(t, err)
+
+		active, err := repo.IsActive(context.Background(), userID)
+		require.NoError(t, err)
+		require.True(t, active)
+	})
+	t.Run("not_found", func(t *testing.T) {
+		res, err := repo.Update(context.Background(), model.NewUserID(), model.UserUpdate{})
+		require.ErrorIs(t, err, model.ErrUserNotFound)
+		require.Nil(t, res)
+
+		active, err := repo.IsActive(context.Background(), model.NewUserID())
+		require.NoError(t, err)
+		require.False(t, active)
+	})
+}
+
+func Test_UserUpdatePassword(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewUserRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	userID := model.NewUserID()
+
+	_, err = db.Exec(`DELETE FROM users where email = $1`, "update-password-1")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO users (id, email, password, user_role)
+		VALUES ($1, $2, $3, $4)`, userID, "update-password-1", "password", model.UserRoleEmployee)
+	require.NoError(t, err)
+
+	err = repo.UpdatePassword(context.Background(), userID, "password2")
+	require.NoError(t, err)
+
+	res, err := repo.GetByID(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, "password2", res.Password)
+
+	err = repo.UpdatePassword(context.Background(), model.NewUserID(), "password2")
+	require.ErrorIs(t, err, model.ErrUserNotFound)
+}
+
+func Test_UserUpsertOIDC(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewUserRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	passwordUserID := model.NewUserID()
+
+	_, err = db.Exec(`DELETE FROM users where email IN ($1, $2)`, "oidc-1", "oidc-2")
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO users (id, email, password, user_role)
+		VALUES ($1, $2, $3, $4)`, passwordUserID, "oidc-2", "password", model.UserRoleEmployee)
+	require.NoError(t, err)
+
+	identity := model.OIDCIdentity{Issuer: "https://sso.example.com", Subject: "subject-1", Email: "oidc-1"}
+
+	t.Run("create", func(t *testing.T) {
+		res, err := repo.UpsertOIDC(context.Background(), identity, model.UserRoleEmployee)
+		require.NoError(t, err)
+		require.Equal(t, "oidc-1", res.Email)
+		require.Equal(t, "", res.Password)
+		require.Equal(t, model.UserRoleEmployee, res.UserRole)
+
+		again, err := repo.UpsertOIDC(context.Background(), identity, model.UserRoleModerator)
+		require.NoError(t, err)
+		require.Equal(t, res.UserID, again.UserID)
+		require.Equal(t, model.UserRoleModerator, again.UserRole)
+	})
+	t.Run("link_password_user", func(t *testing.T) {
+		passwordIdentity := model.OIDCIdentity{
+			Issuer:  "https://sso.example.com",
+			Subject: "subject-2",
+			Email:   "oidc-2",
+		}
+
+		res, err := repo.UpsertOIDC(context.Background(), passwordIdentity, model.UserRoleAuditor)
+		require.ErrorIs(t, err, model.ErrOIDCUserConflict)
+		require.Nil(t, res)
+
+		err = repo.AllowOIDCLink(context.Background(), passwordUserID)
+		require.NoError(t, err)
+
+		res, err = repo.UpsertOIDC(context.Background(), passwordIdentity, model.UserRoleAuditor)
+		require.NoError(t, err)
+		require.Equal(t, passwordUserID, res.UserID)
+		require.Equal(t, "password", res.Password)
+		require.Equal(t, model.UserRoleAuditor, res.UserRole)
+		require.True(t, res.SSOLinked)
+	})
+	t.Run("allow_link_not_found", func(t *testing.T) {
+		err := repo.AllowOIDCLink(context.Background(), model.NewUserID())
+		require.ErrorIs(t, err, model.ErrUserNotFound)
+	})
+	t.Run("conflict", func(t *testing.T) {
+		res, err := repo.UpsertOIDC(context.Background(), model.OIDCIdentity{
+			Issuer:  "https://sso.example.com",
+			Subject: "subject-3",
+			Email:   "oidc-1",
+		}, model.UserRoleModerator)
+		require.ErrorIs(t, err, model.ErrOIDCUserConflict)
+		require.Nil(t, res)
+	})
+}
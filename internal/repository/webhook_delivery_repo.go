@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+var webhookDeliveryColumns = []string{
+	"d.id", "d.subscription_id", "d.outbox_id", "o.event_type", "o.pvz_id", "o.payload", "o.created_at AS event_created_at",
+	"d.status", "d.attempts", "d.last_error", "d.last_status_code", "d.next_attempt_at", "d.created_at", "d.delivered_at",
+}
+
+type WebhookDeliveryRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewWebhookDeliveryRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*WebhookDeliveryRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &WebhookDeliveryRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *WebhookDeliveryRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// AddDeliveries schedules the outbox message for the subscriptions. Already scheduled deliveries are skipped,
+// so the same message can be added again when the outbox relay retries it.
+func (r *WebhookDeliveryRepository) AddDeliveries(ctx context.Context, messageID model.OutboxMessageID, subscriptionIDs []model.WebhookSubscriptionID) error {
+	q := `INSERT INTO webhook_deliveries (subscription_id, outbox_id, status)
+		SELECT subscription_id, $2, $3 FROM unnest($1::UUID[]) AS subscription_id
+		ON CONFLICT (subscription_id, outbox_id) DO NOTHING`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, subscriptionIDs, messageID, model.WebhookDeliveryStatusPending)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimPending returns pending deliveries due for an attempt, the oldest first, and postpones their next attempt
+// by lease. The deliveries are sent outside of a transaction, the lease keeps concurrent workers off them meanwhile,
+// and if the worker dies before marking them, they are sent again once the lease has passed.
+func (r *WebhookDeliveryRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDeliveryTask, error) {
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+
+	q, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(webhookDeliveryColumns...).
+		Columns("s.url", "s.secret").
+		Prefix(`WITH claimed AS (
+			UPDATE webhook_deliveries SET next_attempt_at = now() + make_interval(secs => ?)
+			WHERE id IN (
+				SELECT id FROM webhook_deliveries
+				WHERE status = ? AND next_attempt_at <= now()
+				ORDER BY created_at, id
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		)`, lease.Seconds(), model.WebhookDeliveryStatusPending, limit).
+		From("claimed d").
+		Join("webhook_subscriptions s ON s.id = d.subscription_id").
+		Join("outbox o ON o.id = d.outbox_id").
+		OrderBy("d.created_at", "d.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	var entities []WebhookDeliveryTask
+	err = r.trOrDB(ctx).SelectContext(ctx, &entities, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	tasks := make([]model.WebhookDeliveryTask, 0, len(entities))
+	for _, entity := range entities {
+		tasks = append(tasks, model.WebhookDeliveryTask{
+			Delivery: convertWebhookDelivery(entity.WebhookDelivery),
+			URL:      entity.URL,
+			Secret:   entity.Secret,
+		})
+	}
+
+	return tasks, nil
+}
+
+func (r *WebhookDeliveryRepository) MarkDelivered(ctx context.Context, deliveryID model.WebhookDeliveryID, statusCode int) error {
+	q := `UPDATE webhook_deliveries SET status = $2, attempts = attempts + 1, last_error = NULL, last_status_code = $3,
+		delivered_at = now() WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, deliveryID, model.WebhookDeliveryStatusDelivered, statusCode)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records the failed attempt and postpones the next one by retryAfter.
+// statusCode is nil when the endpoint didn't respond.
+func (r *WebhookDeliveryRepository) MarkFailed(ctx context.Context, deliveryID model.WebhookDeliveryID, lastError string, statusCode *int, retryAfter time.Duration) error {
+	q := `UPDATE webhook_deliveries SET attempts = attempts + 1, last_error = $2, last_status_code = $3,
+		next_attempt_at = now() + make_interval(secs => $4) WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, deliveryID, lastError, statusCode, retryAfter.Seconds())
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDead records the last failed attempt, the delivery is not retried anymore.
+func (r *WebhookDeliveryRepository) MarkDead(ctx context.Context, deliveryID model.WebhookDeliveryID, lastError string, statusCode *int) error {
+	q := `UPDATE webhook_deliveries SET status = $2, attempts = attempts + 1, last_error = $3, last_status_code = $4
+		WHERE id = $1`
+
+	_, err := r.trOrDB(ctx).ExecContext(ctx, q, deliveryID, model.WebhookDeliveryStatusDead, lastError, statusCode)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// ListBySubscription returns deliveries of the subscription, the most recent first. Nil status means any status.
+func (r *WebhookDeliveryRepository) ListBySubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID,
+	status *model.WebhookDeliveryStatus, offset, limit int64,
+) ([]model.WebhookDelivery, error) {
+	if offset < 0 {
+		return nil, errors.New("offset can't be negative")
+	}
+	if limit < 1 {
+		return nil, errors.New("limit should be positive")
+	}
+
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(webhookDeliveryColumns...).
+		From("webhook_deliveries d").
+		Join("outbox o ON o.id = d.outbox_id").
+		Where(sq.Expr("d.subscription_id = ?", subscriptionID)).
+		OrderBy("d.created_at DESC", "d.id DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if status != nil {
+		b = b.Where(sq.Eq{"d.status": *status})
+	}
+
+	q, args, err := b.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	var entities []WebhookDelivery
+	err = r.trOrDB(ctx).SelectContext(ctx, &entities, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	deliveries := make([]model.WebhookDelivery, 0, len(entities))
+	for _, entity := range entities {
+		deliveries = append(deliveries, convertWebhookDelivery(entity))
+	}
+
+	return deliveries, nil
+}
+
+func convertWebhookDelivery(entity WebhookDelivery) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:             model.WebhookDeliveryID(entity.ID),
+		SubscriptionID: model.WebhookSubscriptionID(entity.SubscriptionID),
+		Message: model.OutboxMessage{
+			ID:        model.OutboxMessageID(entity.OutboxID),
+			Type:      model.OutboxEventType(entity.EventType),
+			PVZID:     model.PVZID(entity.PVZID),
+			Payload:   entity.Payload,
+			CreatedAt: entity.EventCreatedAt,
+		},
+		Status:         model.WebhookDeliveryStatus(entity.Status),
+		Attempts:       entity.Attempts,
+		LastError:      entity.LastError,
+		LastStatusCode: entity.LastStatusCode,
+		NextAttemptAt:  entity.NextAttemptAt,
+		CreatedAt:      entity.CreatedAt,
+		DeliveredAt:    entity.DeliveredAt,
+	}
+}
@@ -0,0 +1,150 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewWebhookDeliveryRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewWebhookDeliveryRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewWebhookDeliveryRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewWebhookDeliveryRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestWebhookDeliveryRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewWebhookDeliveryRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	outboxRepo, err := NewOutboxRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	subscriptionRepo, err := NewWebhookSubscriptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	// deliveries left by other tests would be fetched together with the ones below
+	_, err = db.Exec(`UPDATE webhook_deliveries SET status = $1 WHERE status = $2`,
+		model.WebhookDeliveryStatusDead, model.WebhookDeliveryStatusPending)
+	require.NoError(t, err)
+
+	reception := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           model.NewPVZID(),
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      time.Now(),
+	}
+	err = outboxRepo.Add(context.Background(), model.NewReceptionOutboxEvent(model.OutboxEventReceptionClosed, reception))
+	require.NoError(t, err)
+
+	var outboxID uuid.UUID
+	err = db.Get(&outboxID, `SELECT id FROM outbox WHERE pvz_id = $1`, reception.PVZID)
+	require.NoError(t, err)
+	messageID := model.OutboxMessageID(outboxID)
+
+	subscription, err := subscriptionRepo.Create(context.Background(), model.WebhookSubscription{
+		ID:         model.NewWebhookSubscriptionID(),
+		URL:        "http://partner.local/events",
+		EventTypes: []model.OutboxEventType{model.OutboxEventReceptionClosed},
+		Secret:     "secret",
+	})
+	require.NoError(t, err)
+
+	err = repo.AddDeliveries(context.Background(), messageID, []model.WebhookSubscriptionID{subscription.ID})
+	require.NoError(t, err)
+	// the relay may add the same message again
+	err = repo.AddDeliveries(context.Background(), messageID, []model.WebhookSubscriptionID{subscription.ID})
+	require.NoError(t, err)
+
+	tasks, err := repo.ClaimPending(context.Background(), 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "http://partner.local/events", tasks[0].URL)
+	require.Equal(t, "secret", tasks[0].Secret)
+	require.Equal(t, subscription.ID, tasks[0].Delivery.SubscriptionID)
+	require.Equal(t, messageID, tasks[0].Delivery.Message.ID)
+	require.Equal(t, model.OutboxEventReceptionClosed, tasks[0].Delivery.Message.Type)
+	require.Equal(t, reception.PVZID, tasks[0].Delivery.Message.PVZID)
+	require.Equal(t, model.WebhookDeliveryStatusPending, tasks[0].Delivery.Status)
+
+	// the claimed delivery is leased and not returned to another worker
+	leased, err := repo.ClaimPending(context.Background(), 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, leased)
+
+	deliveryID := tasks[0].Delivery.ID
+	statusCode := 503
+
+	t.Run("success.MarkFailed", func(t *testing.T) {
+		err = repo.MarkFailed(context.Background(), deliveryID, "unexpected status code: 503", &statusCode, time.Hour)
+		require.NoError(t, err)
+
+		res, err := repo.ClaimPending(context.Background(), 10, time.Minute)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		deliveries, err := repo.ListBySubscription(context.Background(), subscription.ID, nil, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		require.Equal(t, 1, deliveries[0].Attempts)
+		require.Equal(t, &statusCode, deliveries[0].LastStatusCode)
+		require.Equal(t, model.WebhookDeliveryStatusPending, deliveries[0].Status)
+	})
+	t.Run("success.MarkDead", func(t *testing.T) {
+		err = repo.MarkDead(context.Background(), deliveryID, "connection refused", nil)
+		require.NoError(t, err)
+
+		status := model.WebhookDeliveryStatusDead
+		deliveries, err := repo.ListBySubscription(context.Background(), subscription.ID, &status, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		require.Equal(t, 2, deliveries[0].Attempts)
+		require.Nil(t, deliveries[0].LastStatusCode)
+		require.Equal(t, "connection refused", *deliveries[0].LastError)
+	})
+	t.Run("success.MarkDelivered", func(t *testing.T) {
+		err = repo.MarkDelivered(context.Background(), deliveryID, 200)
+		require.NoError(t, err)
+
+		status := model.WebhookDeliveryStatusDelivered
+		deliveries, err := repo.ListBySubscription(context.Background(), subscription.ID, &status, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		require.NotNil(t, deliveries[0].DeliveredAt)
+		require.Nil(t, deliveries[0].LastError)
+	})
+	t.Run("success.cascade_delete", func(t *testing.T) {
+		err = subscriptionRepo.Delete(context.Background(), subscription.ID)
+		require.NoError(t, err)
+
+		deliveries, err := repo.ListBySubscription(context.Background(), subscription.ID, nil, 0, 10)
+		require.NoError(t, err)
+		require.Empty(t, deliveries)
+	})
+	t.Run("error.zero_limit", func(t *testing.T) {
+		_, err := repo.ClaimPending(context.Background(), 0, time.Minute)
+		require.Error(t, err)
+		_, err = repo.ListBySubscription(context.Background(), subscription.ID, nil, 0, 0)
+		require.Error(t, err)
+	})
+}
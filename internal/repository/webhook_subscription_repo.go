@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// arrays are selected as JSON, because database/sql can't scan postgres arrays into slices
+const webhookSubscriptionColumns = `id, url, array_to_json(event_types) AS event_types, array_to_json(pvz_ids) AS pvz_ids,
+	secret, created_at, updated_at`
+
+type WebhookSubscriptionRepository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewWebhookSubscriptionRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) (*WebhookSubscriptionRepository, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	if getter == nil {
+		return nil, errors.New("getter is nil")
+	}
+
+	return &WebhookSubscriptionRepository{
+		db:     db,
+		getter: getter,
+	}, nil
+}
+
+func (r *WebhookSubscriptionRepository) trOrDB(ctx context.Context) trmsqlx.Tr {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	var entity WebhookSubscription
+
+	q := `INSERT INTO webhook_subscriptions (id, url, event_types, pvz_ids, secret)
+		VALUES ($1, $2, $3::SMALLINT[], $4::UUID[], $5)
+		RETURNING ` + webhookSubscriptionColumns
+
+	err := r.trOrDB(ctx).GetContext(ctx, &entity, q, subscription.ID, subscription.URL,
+		eventTypesToDB(subscription.EventTypes), pvzIDsToDB(subscription.PVZIDs), subscription.Secret)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertWebhookSubscription(entity)
+}
+
+// Update replaces the subscription fields, the secret is kept when it's empty.
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	var entity WebhookSubscription
+
+	q := `UPDATE webhook_subscriptions
+		SET url = $2, event_types = $3::SMALLINT[], pvz_ids = $4::UUID[], secret = COALESCE(NULLIF($5, ''), secret), updated_at = now()
+		WHERE id = $1
+		RETURNING ` + webhookSubscriptionColumns
+
+	err := r.trOrDB(ctx).GetContext(ctx, &entity, q, subscription.ID, subscription.URL,
+		eventTypesToDB(subscription.EventTypes), pvzIDsToDB(subscription.PVZIDs), subscription.Secret)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookSubscription{}, model.ErrWebhookSubscriptionNotFound
+		}
+		return model.WebhookSubscription{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertWebhookSubscription(entity)
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, subscriptionID model.WebhookSubscriptionID) error {
+	q := `DELETE FROM webhook_subscriptions WHERE id = $1`
+
+	res, err := r.trOrDB(ctx).ExecContext(ctx, q, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected: %w", err)
+	}
+	if affected == 0 {
+		return model.ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) GetByID(ctx context.Context, subscriptionID model.WebhookSubscriptionID) (model.WebhookSubscription, error) {
+	var entity WebhookSubscription
+
+	q := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions WHERE id = $1`
+
+	err := r.trOrDB(ctx).GetContext(ctx, &entity, q, subscriptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookSubscription{}, model.ErrWebhookSubscriptionNotFound
+		}
+		return model.WebhookSubscription{}, fmt.Errorf("db.GetContext: %w", err)
+	}
+
+	return convertWebhookSubscription(entity)
+}
+
+// List returns all subscriptions in the order of creation.
+func (r *WebhookSubscriptionRepository) List(ctx context.Context) ([]model.WebhookSubscription, error) {
+	var entities []WebhookSubscription
+
+	q := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions ORDER BY created_at, id`
+
+	err := r.trOrDB(ctx).SelectContext(ctx, &entities, q)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	return convertWebhookSubscriptions(entities)
+}
+
+// FindMatching returns subscriptions to the event type with no pvz filter or with the pvz in it.
+func (r *WebhookSubscriptionRepository) FindMatching(ctx context.Context, eventType model.OutboxEventType, pvzID model.PVZID) ([]model.WebhookSubscription, error) {
+	var entities []WebhookSubscription
+
+	q := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions
+		WHERE $1::SMALLINT = ANY(event_types) AND (cardinality(pvz_ids) = 0 OR $2::UUID = ANY(pvz_ids))
+		ORDER BY created_at, id`
+
+	err := r.trOrDB(ctx).SelectContext(ctx, &entities, q, eventType, pvzID)
+	if err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	return convertWebhookSubscriptions(entities)
+}
+
+func eventTypesToDB(eventTypes []model.OutboxEventType) []int16 {
+	res := make([]int16, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		res = append(res, int16(eventType))
+	}
+	return res
+}
+
+func pvzIDsToDB(pvzIDs []model.PVZID) []model.PVZID {
+	// nil would be written as NULL
+	if pvzIDs == nil {
+		return []model.PVZID{}
+	}
+	return pvzIDs
+}
+
+func convertWebhookSubscriptions(entities []WebhookSubscription) ([]model.WebhookSubscription, error) {
+	subscriptions := make([]model.WebhookSubscription, 0, len(entities))
+	for _, entity := range entities {
+		subscription, err := convertWebhookSubscription(entity)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func convertWebhookSubscription(entity WebhookSubscription) (model.WebhookSubscription, error) {
+	var eventTypes []model.OutboxEventType
+	err := json.Unmarshal(entity.EventTypes, &eventTypes)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("json.Unmarshal event types: %w", err)
+	}
+
+	var pvzUUIDs []uuid.UUID
+	err = json.Unmarshal(entity.PVZIDs, &pvzUUIDs)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("json.Unmarshal pvz ids: %w", err)
+	}
+
+	pvzIDs := make([]model.PVZID, 0, len(pvzUUIDs))
+	for _, pvzID := range pvzUUIDs {
+		pvzIDs = append(pvzIDs, model.PVZID(pvzID))
+	}
+
+	return model.WebhookSubscription{
+		ID:         model.WebhookSubscriptionID(entity.ID),
+		URL:        entity.URL,
+		EventTypes: eventTypes,
+		PVZIDs:     pvzIDs,
+		Secret:     entity.Secret,
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,140 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNewWebhookSubscriptionRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		res, err := NewWebhookSubscriptionRepository(&sqlx.DB{}, &trmsqlx.CtxGetter{})
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := NewWebhookSubscriptionRepository(nil, &trmsqlx.CtxGetter{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		res, err := NewWebhookSubscriptionRepository(&sqlx.DB{}, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestWebhookSubscriptionRepository(t *testing.T) {
+	db := setUp(t)
+	repo, err := NewWebhookSubscriptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	pvzID1 := model.NewPVZID()
+	pvzID2 := model.NewPVZID()
+
+	// the event type filter is narrowed by a unique pvz, so subscriptions of other tests don't match
+	allPVZ := model.WebhookSubscription{
+		ID:         model.NewWebhookSubscriptionID(),
+		URL:        "http://partner.local/all",
+		EventTypes: []model.OutboxEventType{model.OutboxEventProductAdded},
+		Secret:     "secret-all",
+	}
+	onePVZ := model.WebhookSubscription{
+		ID:         model.NewWebhookSubscriptionID(),
+		URL:        "http://partner.local/one",
+		EventTypes: []model.OutboxEventType{model.OutboxEventReceptionCreated, model.OutboxEventReceptionClosed},
+		PVZIDs:     []model.PVZID{pvzID1},
+		Secret:     "secret-one",
+	}
+
+	t.Run("success.Create", func(t *testing.T) {
+		res, err := repo.Create(context.Background(), allPVZ)
+		require.NoError(t, err)
+		require.Equal(t, allPVZ.ID, res.ID)
+		require.Equal(t, allPVZ.EventTypes, res.EventTypes)
+		require.Empty(t, res.PVZIDs)
+		require.False(t, res.CreatedAt.IsZero())
+
+		res, err = repo.Create(context.Background(), onePVZ)
+		require.NoError(t, err)
+		require.Equal(t, []model.PVZID{pvzID1}, res.PVZIDs)
+	})
+	t.Run("success.GetByID", func(t *testing.T) {
+		res, err := repo.GetByID(context.Background(), onePVZ.ID)
+		require.NoError(t, err)
+		require.Equal(t, onePVZ.URL, res.URL)
+		require.Equal(t, onePVZ.EventTypes, res.EventTypes)
+		require.Equal(t, onePVZ.PVZIDs, res.PVZIDs)
+		require.Equal(t, "secret-one", res.Secret)
+	})
+	t.Run("success.FindMatching", func(t *testing.T) {
+		res, err := repo.FindMatching(context.Background(), model.OutboxEventReceptionClosed, pvzID1)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, onePVZ.ID, res[0].ID)
+
+		res, err = repo.FindMatching(context.Background(), model.OutboxEventReceptionClosed, pvzID2)
+		require.NoError(t, err)
+		for _, subscription := range res {
+			require.NotEqual(t, onePVZ.ID, subscription.ID)
+		}
+
+		res, err = repo.FindMatching(context.Background(), model.OutboxEventProductAdded, pvzID2)
+		require.NoError(t, err)
+		ids := make([]model.WebhookSubscriptionID, 0, len(res))
+		for _, subscription := range res {
+			ids = append(ids, subscription.ID)
+		}
+		require.Contains(t, ids, allPVZ.ID)
+	})
+	t.Run("success.Update", func(t *testing.T) {
+		update := onePVZ
+		update.URL = "https://partner.local/one"
+		update.PVZIDs = []model.PVZID{pvzID1, pvzID2}
+		update.Secret = ""
+
+		res, err := repo.Update(context.Background(), update)
+		require.NoError(t, err)
+		require.Equal(t, "https://partner.local/one", res.URL)
+		require.Equal(t, []model.PVZID{pvzID1, pvzID2}, res.PVZIDs)
+		// empty secret keeps the old one
+		require.Equal(t, "secret-one", res.Secret)
+	})
+	t.Run("success.List", func(t *testing.T) {
+		res, err := repo.List(context.Background())
+		require.NoError(t, err)
+		ids := make([]model.WebhookSubscriptionID, 0, len(res))
+		for _, subscription := range res {
+			ids = append(ids, subscription.ID)
+		}
+		require.Contains(t, ids, allPVZ.ID)
+		require.Contains(t, ids, onePVZ.ID)
+	})
+	t.Run("success.Delete", func(t *testing.T) {
+		err := repo.Delete(context.Background(), allPVZ.ID)
+		require.NoError(t, err)
+
+		_, err = repo.GetByID(context.Background(), allPVZ.ID)
+		require.ErrorIs(t, err, model.ErrWebhookSubscriptionNotFound)
+	})
+	t.Run("businessError.not_found", func(t *testing.T) {
+		missing := model.NewWebhookSubscriptionID()
+
+		_, err := repo.GetByID(context.Background(), missing)
+		require.ErrorIs(t, err, model.ErrWebhookSubscriptionNotFound)
+
+		_, err = repo.Update(context.Background(), model.WebhookSubscription{ID: missing})
+		require.ErrorIs(t, err, model.ErrWebhookSubscriptionNotFound)
+
+		err = repo.Delete(context.Background(), missing)
+		require.ErrorIs(t, err, model.ErrWebhookSubscriptionNotFound)
+	})
+}
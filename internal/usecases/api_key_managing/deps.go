@@ -0,0 +1,14 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package api_key_managing
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type apiKeyRepo interface {
+	Create(ctx context.Context, apiKey model.APIKey) (model.APIKey, error)
+	List(ctx context.Context) ([]model.APIKey, error)
+	Revoke(ctx context.Context, apiKeyID model.APIKeyID) error
+}
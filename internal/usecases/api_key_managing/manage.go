@@ -0,0 +1,82 @@
+package api_key_managing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// maxLifetime makes machine clients rotate their keys at least once a year
+const maxLifetime = 365 * 24 * time.Hour
+
+// keyRoles are the roles a key may act with. A key belongs to no user and outlives the one who created it,
+// so administrative roles, that manage users, keys and assignments, are not given to keys.
+var keyRoles = []model.UserRole{model.UserRoleEmployee, model.UserRoleAuditor}
+
+type UseCase struct {
+	apiKeyRepo apiKeyRepo
+}
+
+func New(apiKeyRepo apiKeyRepo) (*UseCase, error) {
+	if apiKeyRepo == nil {
+		return nil, errors.New("apiKeyRepo is nil")
+	}
+	return &UseCase{
+		apiKeyRepo: apiKeyRepo,
+	}, nil
+}
+
+// CreateKey issues a key acting with the permissions of the role, limited to pvzIDs if they are given.
+// The returned string is the key itself, it's not stored and can't be got again.
+func (uc *UseCase) CreateKey(ctx context.Context, name string, role model.UserRole, pvzIDs []model.PVZID,
+	createdBy model.UserID, expiresAt time.Time,
+) (string, model.APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", model.APIKey{}, fmt.Errorf("%w: name is required", model.ErrAPIKeyInvalid)
+	}
+	if !slices.Contains(keyRoles, role) {
+		return "", model.APIKey{}, fmt.Errorf("%w: role should be employee or auditor", model.ErrAPIKeyInvalid)
+	}
+	lifetime := time.Until(expiresAt)
+	if lifetime <= 0 || lifetime > maxLifetime {
+		return "", model.APIKey{}, fmt.Errorf("%w: expiresAt should be in the future and not later than in a year",
+			model.ErrAPIKeyInvalid)
+	}
+
+	key, apiKey, err := model.NewAPIKey(name, role, pvzIDs, createdBy, expiresAt)
+	if err != nil {
+		return "", model.APIKey{}, fmt.Errorf("model.NewAPIKey: %w", err)
+	}
+
+	apiKey, err = uc.apiKeyRepo.Create(ctx, apiKey)
+	if err != nil {
+		return "", model.APIKey{}, fmt.Errorf("apiKeyRepo.Create: %w", err)
+	}
+
+	return key, apiKey, nil
+}
+
+func (uc *UseCase) ListKeys(ctx context.Context) ([]model.APIKey, error) {
+	apiKeys, err := uc.apiKeyRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("apiKeyRepo.List: %w", err)
+	}
+
+	return apiKeys, nil
+}
+
+// RevokeKey rejects the requests with the key at once, the authenticator reads keys from the store on every request.
+func (uc *UseCase) RevokeKey(ctx context.Context, apiKeyID model.APIKeyID) error {
+	err := uc.apiKeyRepo.Revoke(ctx, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("apiKeyRepo.Revoke: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package api_key_managing
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockapiKeyRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_CreateKey(t *testing.T) {
+	type mocks struct {
+		apiKeyRepo *MockapiKeyRepo
+	}
+
+	userID := model.NewUserID()
+	pvzID := model.NewPVZID()
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+	created := model.APIKey{
+		ID:        model.NewAPIKeyID(),
+		Name:      "sorting centre",
+		UserRole:  model.UserRoleEmployee,
+		PVZIDs:    []model.PVZID{pvzID},
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}
+
+	testCases := []struct {
+		name      string
+		prepare   func(t *testing.T, m *mocks)
+		keyName   string
+		role      model.UserRole
+		expiresAt time.Time
+		wantErr   error
+		wantRes   model.APIKey
+	}{
+		{
+			name: "success",
+			prepare: func(t *testing.T, m *mocks) {
+				m.apiKeyRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, apiKey model.APIKey) (model.APIKey, error) {
+						require.NotEqual(t, model.APIKeyID{}, apiKey.ID)
+						require.Len(t, apiKey.KeyHash, 32)
+						require.Equal(t, "sorting centre", apiKey.Name)
+						require.Equal(t, model.UserRoleEmployee, apiKey.UserRole)
+						require.Equal(t, []model.PVZID{pvzID}, apiKey.PVZIDs)
+						require.Equal(t, userID, apiKey.CreatedBy)
+						require.Equal(t, expiresAt, apiKey.ExpiresAt)
+						return created, nil
+					})
+			},
+			keyName:   " sorting centre ",
+			role:      model.UserRoleEmployee,
+			expiresAt: expiresAt,
+			wantErr:   nil,
+			wantRes:   created,
+		},
+		{
+			name:      "businessError.empty_name",
+			prepare:   func(_ *testing.T, _ *mocks) {},
+			keyName:   " ",
+			role:      model.UserRoleEmployee,
+			expiresAt: expiresAt,
+			wantErr:   model.ErrAPIKeyInvalid,
+		},
+		{
+			name:      "businessError.expired",
+			prepare:   func(_ *testing.T, _ *mocks) {},
+			keyName:   "sorting centre",
+			role:      model.UserRoleEmployee,
+			expiresAt: time.Now().Add(-time.Minute),
+			wantErr:   model.ErrAPIKeyInvalid,
+		},
+		{
+			name:      "businessError.too_long_lifetime",
+			prepare:   func(_ *testing.T, _ *mocks) {},
+			keyName:   "sorting centre",
+			role:      model.UserRoleEmployee,
+			expiresAt: time.Now().Add(2 * 365 * 24 * time.Hour),
+			wantErr:   model.ErrAPIKeyInvalid,
+		},
+		{
+			name:      "businessError.moderator_role",
+			prepare:   func(_ *testing.T, _ *mocks) {},
+			keyName:   "sorting centre",
+			role:      model.UserRoleModerator,
+			expiresAt: expiresAt,
+			wantErr:   model.ErrAPIKeyInvalid,
+		},
+		{
+			name:      "businessError.regional_manager_role",
+			prepare:   func(_ *testing.T, _ *mocks) {},
+			keyName:   "sorting centre",
+			role:      model.UserRoleRegionalManager,
+			expiresAt: expiresAt,
+			wantErr:   model.ErrAPIKeyInvalid,
+		},
+		{
+			name: "error.Create",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.apiKeyRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(model.APIKey{}, assert.AnError)
+			},
+			keyName:   "sorting centre",
+			role:      model.UserRoleEmployee,
+			expiresAt: expiresAt,
+			wantErr:   assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				apiKeyRepo: NewMockapiKeyRepo(ctrl),
+			}
+
+			tc.prepare(t, m)
+
+			uc, err := New(m.apiKeyRepo)
+			require.NoError(t, err)
+
+			key, res, err := uc.CreateKey(context.Background(), tc.keyName, tc.role, []model.PVZID{pvzID},
+				userID, tc.expiresAt)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+			require.Equal(t, tc.wantErr == nil, strings.HasPrefix(key, model.APIKeyPrefix))
+		})
+	}
+}
+
+func TestUseCase_ListKeys(t *testing.T) {
+	apiKeys := []model.APIKey{{ID: model.NewAPIKeyID(), Name: "sorting centre"}}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		apiKeyRepo := NewMockapiKeyRepo(ctrl)
+		apiKeyRepo.EXPECT().List(gomock.Any()).Return(apiKeys, nil)
+
+		uc, err := New(apiKeyRepo)
+		require.NoError(t, err)
+
+		res, err := uc.ListKeys(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, apiKeys, res)
+	})
+	t.Run("error.List", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		apiKeyRepo := NewMockapiKeyRepo(ctrl)
+		apiKeyRepo.EXPECT().List(gomock.Any()).Return(nil, assert.AnError)
+
+		uc, err := New(apiKeyRepo)
+		require.NoError(t, err)
+
+		_, err = uc.ListKeys(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestUseCase_RevokeKey(t *testing.T) {
+	apiKeyID := model.NewAPIKeyID()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		apiKeyRepo := NewMockapiKeyRepo(ctrl)
+		apiKeyRepo.EXPECT().Revoke(gomock.Any(), apiKeyID).Return(nil)
+
+		uc, err := New(apiKeyRepo)
+		require.NoError(t, err)
+
+		require.NoError(t, uc.RevokeKey(context.Background(), apiKeyID))
+	})
+	t.Run("businessError.NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		apiKeyRepo := NewMockapiKeyRepo(ctrl)
+		apiKeyRepo.EXPECT().Revoke(gomock.Any(), apiKeyID).Return(model.ErrAPIKeyNotFound)
+
+		uc, err := New(apiKeyRepo)
+		require.NoError(t, err)
+
+		err = uc.RevokeKey(context.Background(), apiKeyID)
+		require.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+	})
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package api_key_managing -typed -destination mock_deps_test.go
+//
+
+// Package api_key_managing is a generated GoMock package.
+package api_key_managing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockapiKeyRepo is a mock of apiKeyRepo interface.
+type MockapiKeyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyRepoMockRecorder is the mock recorder for MockapiKeyRepo.
+type MockapiKeyRepoMockRecorder struct {
+	mock *MockapiKeyRepo
+}
+
+// NewMockapiKeyRepo creates a new mock instance.
+func NewMockapiKeyRepo(ctrl *gomock.Controller) *MockapiKeyRepo {
+	mock := &MockapiKeyRepo{ctrl: ctrl}
+	mock.recorder = &MockapiKeyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyRepo) EXPECT() *MockapiKeyRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockapiKeyRepo) Create(ctx context.Context, apiKey model.APIKey) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, apiKey)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockapiKeyRepoMockRecorder) Create(ctx, apiKey any) *MockapiKeyRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockapiKeyRepo)(nil).Create), ctx, apiKey)
+	return &MockapiKeyRepoCreateCall{Call: call}
+}
+
+// MockapiKeyRepoCreateCall wrap *gomock.Call
+type MockapiKeyRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyRepoCreateCall) Return(arg0 model.APIKey, arg1 error) *MockapiKeyRepoCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyRepoCreateCall) Do(f func(context.Context, model.APIKey) (model.APIKey, error)) *MockapiKeyRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyRepoCreateCall) DoAndReturn(f func(context.Context, model.APIKey) (model.APIKey, error)) *MockapiKeyRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockapiKeyRepo) List(ctx context.Context) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockapiKeyRepoMockRecorder) List(ctx any) *MockapiKeyRepoListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockapiKeyRepo)(nil).List), ctx)
+	return &MockapiKeyRepoListCall{Call: call}
+}
+
+// MockapiKeyRepoListCall wrap *gomock.Call
+type MockapiKeyRepoListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyRepoListCall) Return(arg0 []model.APIKey, arg1 error) *MockapiKeyRepoListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyRepoListCall) Do(f func(context.Context) ([]model.APIKey, error)) *MockapiKeyRepoListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyRepoListCall) DoAndReturn(f func(context.Context) ([]model.APIKey, error)) *MockapiKeyRepoListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Revoke mocks base method.
+func (m *MockapiKeyRepo) Revoke(ctx context.Context, apiKeyID model.APIKeyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, apiKeyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockapiKeyRepoMockRecorder) Revoke(ctx, apiKeyID any) *MockapiKeyRepoRevokeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockapiKeyRepo)(nil).Revoke), ctx, apiKeyID)
+	return &MockapiKeyRepoRevokeCall{Call: call}
+}
+
+// MockapiKeyRepoRevokeCall wrap *gomock.Call
+type MockapiKeyRepoRevokeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyRepoRevokeCall) Return(arg0 error) *MockapiKeyRepoRevokeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyRepoRevokeCall) Do(f func(context.Context, model.APIKeyID) error) *MockapiKeyRepoRevokeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyRepoRevokeCall) DoAndReturn(f func(context.Context, model.APIKeyID) error) *MockapiKeyRepoRevokeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package audit_getting
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type auditRepo interface {
+	Search(ctx context.Context, filter model.AuditFilter, offset, limit int64) ([]model.AuditEvent, error)
+}
@@ -0,0 +1,35 @@
+package audit_getting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	auditRepo auditRepo
+}
+
+func New(auditRepo auditRepo) (*UseCase, error) {
+	if auditRepo == nil {
+		return nil, errors.New("auditRepo is nil")
+	}
+
+	return &UseCase{
+		auditRepo: auditRepo,
+	}, nil
+}
+
+// GetAuditEvents returns the page of audit events matching the filter, the most recent first.
+func (uc *UseCase) GetAuditEvents(ctx context.Context, filter model.AuditFilter, page, limit int64) ([]model.AuditEvent, error) {
+	offset := (page - 1) * limit
+
+	events, err := uc.auditRepo.Search(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("auditRepo.Search: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,74 @@
+package audit_getting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockauditRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		res, err := New(nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_GetAuditEvents(t *testing.T) {
+	userID := model.NewUserID()
+	pvzID := model.NewPVZID()
+	action := model.AuditActionProductRemoved
+	filter := model.AuditFilter{
+		UserID: &userID,
+		PVZID:  &pvzID,
+		Action: &action,
+	}
+	events := []model.AuditEvent{
+		{
+			UserID:    userID,
+			Action:    action,
+			PVZID:     pvzID,
+			CreatedAt: time.Now(),
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		auditRepo := NewMockauditRepo(ctrl)
+		auditRepo.EXPECT().
+			Search(gomock.Any(), filter, int64(20), int64(10)).
+			Return(events, nil)
+
+		uc, err := New(auditRepo)
+		require.NoError(t, err)
+
+		res, err := uc.GetAuditEvents(context.Background(), filter, 3, 10)
+		require.NoError(t, err)
+		require.Equal(t, events, res)
+	})
+	t.Run("error.Search", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		auditRepo := NewMockauditRepo(ctrl)
+		auditRepo.EXPECT().
+			Search(gomock.Any(), filter, int64(0), int64(10)).
+			Return(nil, assert.AnError)
+
+		uc, err := New(auditRepo)
+		require.NoError(t, err)
+
+		_, err = uc.GetAuditEvents(context.Background(), filter, 1, 10)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
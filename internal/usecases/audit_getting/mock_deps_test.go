@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package audit_getting -typed -destination mock_deps_test.go
+//
+
+// Package audit_getting is a generated GoMock package.
+package audit_getting
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauditRepo is a mock of auditRepo interface.
+type MockauditRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRepoMockRecorder is the mock recorder for MockauditRepo.
+type MockauditRepoMockRecorder struct {
+	mock *MockauditRepo
+}
+
+// NewMockauditRepo creates a new mock instance.
+func NewMockauditRepo(ctrl *gomock.Controller) *MockauditRepo {
+	mock := &MockauditRepo{ctrl: ctrl}
+	mock.recorder = &MockauditRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRepo) EXPECT() *MockauditRepoMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockauditRepo) Search(ctx context.Context, filter model.AuditFilter, offset, limit int64) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, offset, limit)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockauditRepoMockRecorder) Search(ctx, filter, offset, limit any) *MockauditRepoSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockauditRepo)(nil).Search), ctx, filter, offset, limit)
+	return &MockauditRepoSearchCall{Call: call}
+}
+
+// MockauditRepoSearchCall wrap *gomock.Call
+type MockauditRepoSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditRepoSearchCall) Return(arg0 []model.AuditEvent, arg1 error) *MockauditRepoSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditRepoSearchCall) Do(f func(context.Context, model.AuditFilter, int64, int64) ([]model.AuditEvent, error)) *MockauditRepoSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditRepoSearchCall) DoAndReturn(f func(context.Context, model.AuditFilter, int64, int64) ([]model.AuditEvent, error)) *MockauditRepoSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -4,49 +4,188 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/inna-maikut/avito-pvz/internal/model"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type UseCase struct {
-	userRepo      userRepo
-	tokenProvider tokenProvider
+	userRepo             userRepo
+	tokenProvider        tokenProvider
+	refreshTokenRepo     refreshTokenRepo
+	assignmentRepo       assignmentRepo
+	loginFailureRepo     loginFailureRepo
+	loginPolicy          model.LoginPolicy
+	refreshTokenLifetime time.Duration
+	now                  func() time.Time
 }
 
-func New(userRepo userRepo, tokenProvider tokenProvider) (*UseCase, error) {
+func New(userRepo userRepo, tokenProvider tokenProvider, refreshTokenRepo refreshTokenRepo,
+	assignmentRepo assignmentRepo, loginFailureRepo loginFailureRepo, loginPolicy model.LoginPolicy,
+	refreshTokenLifetime time.Duration,
+) (*UseCase, error) {
 	if userRepo == nil {
 		return nil, errors.New("userRepo is nil")
 	}
 	if tokenProvider == nil {
 		return nil, errors.New("tokenProvider is nil")
 	}
+	if refreshTokenRepo == nil {
+		return nil, errors.New("refreshTokenRepo is nil")
+	}
+	if assignmentRepo == nil {
+		return nil, errors.New("assignmentRepo is nil")
+	}
+	if loginFailureRepo == nil {
+		return nil, errors.New("loginFailureRepo is nil")
+	}
+	if err := loginPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid loginPolicy: %w", err)
+	}
+	if refreshTokenLifetime <= 0 {
+		return nil, errors.New("refreshTokenLifetime must be positive")
+	}
 	return &UseCase{
-		userRepo:      userRepo,
-		tokenProvider: tokenProvider,
+		userRepo:             userRepo,
+		tokenProvider:        tokenProvider,
+		refreshTokenRepo:     refreshTokenRepo,
+		assignmentRepo:       assignmentRepo,
+		loginFailureRepo:     loginFailureRepo,
+		loginPolicy:          loginPolicy,
+		refreshTokenLifetime: refreshTokenLifetime,
+		now:                  time.Now,
 	}, nil
 }
 
-func (uc *UseCase) Auth(ctx context.Context, email, password string) (string, error) {
+// Auth checks the password and issues an access token and a refresh token, that starts a new refresh token family.
+// Attempts are counted by email and by client ip, an empty ip is not counted. Every attempt is reserved as a failure
+// before the password is checked, so concurrent attempts can't exceed the login policy, and the reservation is
+// taken back if the password is right. While attempts are blocked, the password is not checked and
+// model.LoginBlockedError is returned.
+func (uc *UseCase) Auth(ctx context.Context, email, password, ip string) (model.TokenPair, error) {
+	now := uc.now()
+
+	emailKey := model.LoginFailureEmailKey(email)
+	ipKey := ""
+	if ip != "" {
+		ipKey = model.LoginFailureIPKey(ip)
+	}
+
+	err := uc.reserveAttempt(ctx, emailKey, ipKey, now)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("reserveAttempt: %w", err)
+	}
+
 	user, err := uc.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", fmt.Errorf("userRepo.GetByEmail: %w", err)
+		return model.TokenPair{}, fmt.Errorf("userRepo.GetByEmail: %w", err)
 	}
 
 	err = uc.checkUserPassword(user.Password, password)
 	if err != nil {
-		return "", fmt.Errorf("checkUserPassword: %w", err)
+		return model.TokenPair{}, fmt.Errorf("checkUserPassword: %w", err)
+	}
+
+	// failures from the ip are kept, otherwise one known password would let an attacker guess others from the same ip
+	err = uc.loginFailureRepo.Reset(ctx, emailKey)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("loginFailureRepo.Reset: %w", err)
+	}
+	if ipKey != "" {
+		err = uc.loginFailureRepo.Release(ctx, ipKey)
+		if err != nil {
+			return model.TokenPair{}, fmt.Errorf("loginFailureRepo.Release: %w", err)
+		}
 	}
 
-	token, err := uc.tokenProvider.CreateToken(user.Email, user.UserID, user.UserRole)
+	// the password is checked first, so the status is not disclosed to someone who doesn't know it
+	if user.Disabled {
+		return model.TokenPair{}, model.ErrUserDisabled
+	}
+
+	refreshToken, refreshTokenEntity, err := model.NewRefreshToken(user.UserID, model.NewRefreshTokenFamilyID(),
+		uc.refreshTokenLifetime)
 	if err != nil {
-		return "", fmt.Errorf("tokenProvider.CreateToken: %w", err)
+		return model.TokenPair{}, fmt.Errorf("model.NewRefreshToken: %w", err)
 	}
 
-	return token, nil
+	err = uc.refreshTokenRepo.Create(ctx, refreshTokenEntity)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("refreshTokenRepo.Create: %w", err)
+	}
+
+	pvzIDs, err := uc.assignmentRepo.GetPVZIDs(ctx, user.UserID)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("assignmentRepo.GetPVZIDs: %w", err)
+	}
+
+	token, err := uc.tokenProvider.CreateToken(user.Email, user.UserID, user.UserRole, model.PVZAccess{PVZIDs: pvzIDs})
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("tokenProvider.CreateToken: %w", err)
+	}
+
+	return model.TokenPair{
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// reserveAttempt reserves the attempt by the ip and by the email, or returns model.LoginBlockedError.
+// The ip reservation is taken back if the email is blocked, only checked passwords count for the ip.
+func (uc *UseCase) reserveAttempt(ctx context.Context, emailKey, ipKey string, now time.Time) error {
+	if ipKey != "" {
+		reserved, err := uc.loginFailureRepo.Reserve(ctx, ipKey, now, uc.loginPolicy.IPLimit())
+		if err != nil {
+			return fmt.Errorf("loginFailureRepo.Reserve: %w", err)
+		}
+		if !reserved {
+			return uc.blockedError(ctx, ipKey, now, uc.loginPolicy.CheckIP)
+		}
+	}
+
+	reserved, err := uc.loginFailureRepo.Reserve(ctx, emailKey, now, uc.loginPolicy.EmailLimit())
+	if err != nil {
+		return fmt.Errorf("loginFailureRepo.Reserve: %w", err)
+	}
+	if reserved {
+		return nil
+	}
+
+	if ipKey != "" {
+		err = uc.loginFailureRepo.Release(ctx, ipKey)
+		if err != nil {
+			return fmt.Errorf("loginFailureRepo.Release: %w", err)
+		}
+	}
+
+	return uc.blockedError(ctx, emailKey, now, uc.loginPolicy.CheckEmail)
+}
+
+// blockedError returns model.LoginBlockedError for the key the attempt was not reserved by. The failures are read
+// again to tell the time of the next attempt, if they changed since and allow it, the delay of the policy is used.
+func (uc *UseCase) blockedError(ctx context.Context, key string, now time.Time,
+	check func(model.LoginFailures, time.Time) error,
+) error {
+	failures, err := uc.loginFailureRepo.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("loginFailureRepo.Get: %w", err)
+	}
+
+	err = check(failures, now)
+	if err != nil {
+		return err
+	}
+
+	return &model.LoginBlockedError{Err: model.ErrLoginThrottled, RetryAfter: uc.loginPolicy.Delay}
 }
 
 func (uc *UseCase) checkUserPassword(dbPassword, password string) error {
+	// users of the OpenID Connect login have no password
+	if dbPassword == "" {
+		return model.ErrWrongUserPassword
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(dbPassword), []byte(password))
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
@@ -3,6 +3,7 @@ package authenticating
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,22 +13,59 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+var testLoginPolicy = model.LoginPolicy{
+	MaxFailures:      5,
+	MaxFailuresPerIP: 20,
+	Delay:            time.Second,
+	Lockout:          time.Minute,
+}
+
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl))
+		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), NewMockloginFailureRepo(ctrl), testLoginPolicy, time.Hour)
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(nil, NewMocktokenProvider(ctrl))
+		res, err := New(nil, NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), NewMockloginFailureRepo(ctrl), testLoginPolicy, time.Hour)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.second_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMockuserRepo(ctrl), nil)
+		res, err := New(NewMockuserRepo(ctrl), nil, NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), NewMockloginFailureRepo(ctrl), testLoginPolicy, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), nil, NewMockassignmentRepo(ctrl), NewMockloginFailureRepo(ctrl), testLoginPolicy, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), nil, NewMockloginFailureRepo(ctrl), testLoginPolicy, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), nil, testLoginPolicy, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.invalid_loginPolicy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), NewMockloginFailureRepo(ctrl), model.LoginPolicy{}, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_refreshTokenLifetime", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), NewMockloginFailureRepo(ctrl), testLoginPolicy, 0)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -35,15 +73,55 @@ func TestNew(t *testing.T) {
 
 func TestUseCase_Auth(t *testing.T) {
 	type mocks struct {
-		userRepo      *MockuserRepo
-		tokenProvider *MocktokenProvider
+		userRepo         *MockuserRepo
+		tokenProvider    *MocktokenProvider
+		refreshTokenRepo *MockrefreshTokenRepo
+		assignmentRepo   *MockassignmentRepo
+		loginFailureRepo *MockloginFailureRepo
 	}
 	type args struct {
 		email    string
 		password string
+		ip       string
 	}
 
 	userID1 := model.NewUserID()
+	pvzID1 := model.NewPVZID()
+	now := time.Date(2025, 4, 9, 20, 0, 0, 0, time.UTC)
+	emailKey := model.LoginFailureEmailKey("test1")
+	ipKey := model.LoginFailureIPKey("10.0.0.1")
+
+	user := &model.User{
+		UserID:   userID1,
+		Email:    "test1",
+		Password: makePasswordHash("password1"),
+		UserRole: model.UserRoleEmployee,
+	}
+	defaultArgs := args{
+		email:    "test1",
+		password: "password1",
+		ip:       "10.0.0.1",
+	}
+	wrongPasswordArgs := args{
+		email:    "test1",
+		password: "password2",
+		ip:       "10.0.0.1",
+	}
+
+	prepareReserved := func(m *mocks) {
+		m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), ipKey, now, testLoginPolicy.IPLimit()).Return(true, nil)
+		m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), emailKey, now, testLoginPolicy.EmailLimit()).Return(true, nil)
+	}
+	// the email is blocked, the ip reservation is taken back
+	prepareEmailBlocked := func(m *mocks) {
+		m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), ipKey, now, testLoginPolicy.IPLimit()).Return(true, nil)
+		m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), emailKey, now, testLoginPolicy.EmailLimit()).Return(false, nil)
+		m.loginFailureRepo.EXPECT().Release(gomock.Any(), ipKey).Return(nil)
+	}
+	prepareSucceeded := func(m *mocks) {
+		m.loginFailureRepo.EXPECT().Reset(gomock.Any(), emailKey).Return(nil)
+		m.loginFailureRepo.EXPECT().Release(gomock.Any(), ipKey).Return(nil)
+	}
 
 	testCases := []struct {
 		name    string
@@ -55,15 +133,46 @@ func TestUseCase_Auth(t *testing.T) {
 		{
 			name: "success",
 			prepare: func(m *mocks) {
+				prepareReserved(m)
 				m.userRepo.EXPECT().
 					GetByEmail(gomock.Any(), "test1").
-					Return(&model.User{
-						UserID:   userID1,
-						Email:    "test1",
-						Password: makePasswordHash("password1"),
-						UserRole: model.UserRoleEmployee,
-					}, nil)
-				m.tokenProvider.EXPECT().CreateToken("test1", userID1, model.UserRoleEmployee).Return("654321", nil)
+					Return(user, nil)
+				prepareSucceeded(m)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+						require.Equal(t, userID1, token.UserID)
+						require.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+						return nil
+					})
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return([]model.PVZID{pvzID1}, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("test1", userID1, model.UserRoleEmployee, model.PVZAccess{PVZIDs: []model.PVZID{pvzID1}}).
+					Return("654321", nil)
+			},
+			args:    defaultArgs,
+			wantRes: "654321",
+			wantErr: nil,
+		},
+		{
+			name: "success.no_ip",
+			prepare: func(m *mocks) {
+				m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), emailKey, now, testLoginPolicy.EmailLimit()).Return(true, nil)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+				m.loginFailureRepo.EXPECT().Reset(gomock.Any(), emailKey).Return(nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return(nil, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("test1", userID1, model.UserRoleEmployee, model.PVZAccess{}).
+					Return("654321", nil)
 			},
 			args: args{
 				email:    "test1",
@@ -73,36 +182,234 @@ func TestUseCase_Auth(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "error.UserNotFound",
+			name: "businessError.AccountLocked",
+			prepare: func(m *mocks) {
+				prepareEmailBlocked(m)
+				m.loginFailureRepo.EXPECT().
+					Get(gomock.Any(), emailKey).
+					Return(model.LoginFailures{Failures: 5, LastFailureAt: now.Add(-time.Second)}, nil)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: model.ErrAccountLocked,
+		},
+		{
+			name: "businessError.LoginThrottled",
+			prepare: func(m *mocks) {
+				prepareEmailBlocked(m)
+				m.loginFailureRepo.EXPECT().
+					Get(gomock.Any(), emailKey).
+					Return(model.LoginFailures{Failures: 2, LastFailureAt: now.Add(-time.Second)}, nil)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: model.ErrLoginThrottled,
+		},
+		{
+			// the failures were reset by a concurrent login after the reservation was rejected
+			name: "businessError.LoginThrottled.changed",
+			prepare: func(m *mocks) {
+				prepareEmailBlocked(m)
+				m.loginFailureRepo.EXPECT().Get(gomock.Any(), emailKey).Return(model.LoginFailures{}, nil)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: model.ErrLoginThrottled,
+		},
+		{
+			name: "businessError.IPThrottled",
 			prepare: func(m *mocks) {
+				m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), ipKey, now, testLoginPolicy.IPLimit()).Return(false, nil)
+				m.loginFailureRepo.EXPECT().
+					Get(gomock.Any(), ipKey).
+					Return(model.LoginFailures{Failures: 20, LastFailureAt: now.Add(-time.Second)}, nil)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: model.ErrLoginThrottled,
+		},
+		{
+			name: "businessError.UserNotFound",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
 				m.userRepo.EXPECT().
 					GetByEmail(gomock.Any(), "test1").
 					Return(nil, model.ErrUserNotFound)
 			},
-			args: args{
-				email:    "test1",
-				password: "password1",
-			},
+			args:    defaultArgs,
 			wantRes: "",
 			wantErr: model.ErrUserNotFound,
 		},
 		{
-			name: "error.CreateToken",
+			name: "businessError.WrongPassword",
 			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+			},
+			args:    wrongPasswordArgs,
+			wantRes: "",
+			wantErr: model.ErrWrongUserPassword,
+		},
+		{
+			name: "businessError.NoPassword",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
 				m.userRepo.EXPECT().
 					GetByEmail(gomock.Any(), "test1").
 					Return(&model.User{
 						UserID:   userID1,
 						Email:    "test1",
-						Password: makePasswordHash("password1"),
 						UserRole: model.UserRoleEmployee,
 					}, nil)
-				m.tokenProvider.EXPECT().CreateToken("test1", userID1, model.UserRoleEmployee).Return("", assert.AnError)
 			},
-			args: args{
-				email:    "test1",
-				password: "password1",
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: model.ErrWrongUserPassword,
+		},
+		{
+			name: "businessError.UserDisabled",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(&model.User{
+						UserID:   userID1,
+						Email:    "test1",
+						Password: user.Password,
+						UserRole: model.UserRoleEmployee,
+						Disabled: true,
+					}, nil)
+				prepareSucceeded(m)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: model.ErrUserDisabled,
+		},
+		{
+			name: "error.loginFailureRepo.Reserve",
+			prepare: func(m *mocks) {
+				m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), ipKey, now, testLoginPolicy.IPLimit()).Return(false, assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.loginFailureRepo.Release.blocked",
+			prepare: func(m *mocks) {
+				m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), ipKey, now, testLoginPolicy.IPLimit()).Return(true, nil)
+				m.loginFailureRepo.EXPECT().Reserve(gomock.Any(), emailKey, now, testLoginPolicy.EmailLimit()).Return(false, nil)
+				m.loginFailureRepo.EXPECT().Release(gomock.Any(), ipKey).Return(assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.loginFailureRepo.Get",
+			prepare: func(m *mocks) {
+				prepareEmailBlocked(m)
+				m.loginFailureRepo.EXPECT().Get(gomock.Any(), emailKey).Return(model.LoginFailures{}, assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetByEmail",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(nil, assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.Reset",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+				m.loginFailureRepo.EXPECT().Reset(gomock.Any(), emailKey).Return(assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.Release",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+				m.loginFailureRepo.EXPECT().Reset(gomock.Any(), emailKey).Return(nil)
+				m.loginFailureRepo.EXPECT().Release(gomock.Any(), ipKey).Return(assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.refreshTokenRepo.Create",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+				prepareSucceeded(m)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetPVZIDs",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+				prepareSucceeded(m)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return(nil, assert.AnError)
+			},
+			args:    defaultArgs,
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.CreateToken",
+			prepare: func(m *mocks) {
+				prepareReserved(m)
+				m.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), "test1").
+					Return(user, nil)
+				prepareSucceeded(m)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return(nil, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("test1", userID1, model.UserRoleEmployee, model.PVZAccess{}).
+					Return("", assert.AnError)
 			},
+			args:    defaultArgs,
 			wantRes: "",
 			wantErr: assert.AnError,
 		},
@@ -113,19 +420,25 @@ func TestUseCase_Auth(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
-				userRepo:      NewMockuserRepo(ctrl),
-				tokenProvider: NewMocktokenProvider(ctrl),
+				userRepo:         NewMockuserRepo(ctrl),
+				tokenProvider:    NewMocktokenProvider(ctrl),
+				refreshTokenRepo: NewMockrefreshTokenRepo(ctrl),
+				assignmentRepo:   NewMockassignmentRepo(ctrl),
+				loginFailureRepo: NewMockloginFailureRepo(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.userRepo, m.tokenProvider)
+			uc, err := New(m.userRepo, m.tokenProvider, m.refreshTokenRepo, m.assignmentRepo, m.loginFailureRepo,
+				testLoginPolicy, time.Hour)
 			require.NoError(t, err)
+			uc.now = func() time.Time { return now }
 
-			res, err := uc.Auth(context.Background(), tc.args.email, tc.args.password)
+			res, err := uc.Auth(context.Background(), tc.args.email, tc.args.password, tc.args.ip)
 			require.ErrorIs(t, err, tc.wantErr)
 
-			require.Equal(t, tc.wantRes, res)
+			require.Equal(t, tc.wantRes, res.AccessToken)
+			require.Equal(t, tc.wantRes != "", res.RefreshToken != "")
 		})
 	}
 }
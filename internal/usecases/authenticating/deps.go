@@ -3,6 +3,7 @@ package authenticating
 
 import (
 	"context"
+	"time"
 
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
@@ -12,5 +13,20 @@ type userRepo interface {
 }
 
 type tokenProvider interface {
-	CreateToken(email string, userID model.UserID, role model.UserRole) (string, error)
+	CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess) (string, error)
+}
+
+type refreshTokenRepo interface {
+	Create(ctx context.Context, token model.RefreshToken) error
+}
+
+type loginFailureRepo interface {
+	Get(ctx context.Context, key string) (model.LoginFailures, error)
+	Reserve(ctx context.Context, key string, now time.Time, limit model.LoginLimit) (bool, error)
+	Release(ctx context.Context, key string) error
+	Reset(ctx context.Context, key string) error
+}
+
+type assignmentRepo interface {
+	GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error)
 }
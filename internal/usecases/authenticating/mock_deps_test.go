@@ -12,6 +12,7 @@ package authenticating
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/inna-maikut/avito-pvz/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -105,18 +106,18 @@ func (m *MocktokenProvider) EXPECT() *MocktokenProviderMockRecorder {
 }
 
 // CreateToken mocks base method.
-func (m *MocktokenProvider) CreateToken(email string, userID model.UserID, role model.UserRole) (string, error) {
+func (m *MocktokenProvider) CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", email, userID, role)
+	ret := m.ctrl.Call(m, "CreateToken", email, userID, role, access)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateToken indicates an expected call of CreateToken.
-func (mr *MocktokenProviderMockRecorder) CreateToken(email, userID, role any) *MocktokenProviderCreateTokenCall {
+func (mr *MocktokenProviderMockRecorder) CreateToken(email, userID, role, access any) *MocktokenProviderCreateTokenCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), email, userID, role)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), email, userID, role, access)
 	return &MocktokenProviderCreateTokenCall{Call: call}
 }
 
@@ -132,13 +133,316 @@ func (c *MocktokenProviderCreateTokenCall) Return(arg0 string, arg1 error) *Mock
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MocktokenProviderCreateTokenCall) Do(f func(string, model.UserID, model.UserRole) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateTokenCall) Do(f func(string, model.UserID, model.UserRole, model.PVZAccess) (string, error)) *MocktokenProviderCreateTokenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, model.UserID, model.UserRole) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, model.UserID, model.UserRole, model.PVZAccess) (string, error)) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrefreshTokenRepo is a mock of refreshTokenRepo interface.
+type MockrefreshTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenRepoMockRecorder is the mock recorder for MockrefreshTokenRepo.
+type MockrefreshTokenRepoMockRecorder struct {
+	mock *MockrefreshTokenRepo
+}
+
+// NewMockrefreshTokenRepo creates a new mock instance.
+func NewMockrefreshTokenRepo(ctrl *gomock.Controller) *MockrefreshTokenRepo {
+	mock := &MockrefreshTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenRepo) EXPECT() *MockrefreshTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockrefreshTokenRepo) Create(ctx context.Context, token model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockrefreshTokenRepoMockRecorder) Create(ctx, token any) *MockrefreshTokenRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockrefreshTokenRepo)(nil).Create), ctx, token)
+	return &MockrefreshTokenRepoCreateCall{Call: call}
+}
+
+// MockrefreshTokenRepoCreateCall wrap *gomock.Call
+type MockrefreshTokenRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoCreateCall) Return(arg0 error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoCreateCall) Do(f func(context.Context, model.RefreshToken) error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoCreateCall) DoAndReturn(f func(context.Context, model.RefreshToken) error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockloginFailureRepo is a mock of loginFailureRepo interface.
+type MockloginFailureRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockloginFailureRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockloginFailureRepoMockRecorder is the mock recorder for MockloginFailureRepo.
+type MockloginFailureRepoMockRecorder struct {
+	mock *MockloginFailureRepo
+}
+
+// NewMockloginFailureRepo creates a new mock instance.
+func NewMockloginFailureRepo(ctrl *gomock.Controller) *MockloginFailureRepo {
+	mock := &MockloginFailureRepo{ctrl: ctrl}
+	mock.recorder = &MockloginFailureRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloginFailureRepo) EXPECT() *MockloginFailureRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockloginFailureRepo) Get(ctx context.Context, key string) (model.LoginFailures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(model.LoginFailures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockloginFailureRepoMockRecorder) Get(ctx, key any) *MockloginFailureRepoGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockloginFailureRepo)(nil).Get), ctx, key)
+	return &MockloginFailureRepoGetCall{Call: call}
+}
+
+// MockloginFailureRepoGetCall wrap *gomock.Call
+type MockloginFailureRepoGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginFailureRepoGetCall) Return(arg0 model.LoginFailures, arg1 error) *MockloginFailureRepoGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginFailureRepoGetCall) Do(f func(context.Context, string) (model.LoginFailures, error)) *MockloginFailureRepoGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginFailureRepoGetCall) DoAndReturn(f func(context.Context, string) (model.LoginFailures, error)) *MockloginFailureRepoGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Release mocks base method.
+func (m *MockloginFailureRepo) Release(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockloginFailureRepoMockRecorder) Release(ctx, key any) *MockloginFailureRepoReleaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockloginFailureRepo)(nil).Release), ctx, key)
+	return &MockloginFailureRepoReleaseCall{Call: call}
+}
+
+// MockloginFailureRepoReleaseCall wrap *gomock.Call
+type MockloginFailureRepoReleaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginFailureRepoReleaseCall) Return(arg0 error) *MockloginFailureRepoReleaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginFailureRepoReleaseCall) Do(f func(context.Context, string) error) *MockloginFailureRepoReleaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginFailureRepoReleaseCall) DoAndReturn(f func(context.Context, string) error) *MockloginFailureRepoReleaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reserve mocks base method.
+func (m *MockloginFailureRepo) Reserve(ctx context.Context, key string, now time.Time, limit model.LoginLimit) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, key, now, limit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockloginFailureRepoMockRecorder) Reserve(ctx, key, now, limit any) *MockloginFailureRepoReserveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockloginFailureRepo)(nil).Reserve), ctx, key, now, limit)
+	return &MockloginFailureRepoReserveCall{Call: call}
+}
+
+// MockloginFailureRepoReserveCall wrap *gomock.Call
+type MockloginFailureRepoReserveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginFailureRepoReserveCall) Return(arg0 bool, arg1 error) *MockloginFailureRepoReserveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginFailureRepoReserveCall) Do(f func(context.Context, string, time.Time, model.LoginLimit) (bool, error)) *MockloginFailureRepoReserveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginFailureRepoReserveCall) DoAndReturn(f func(context.Context, string, time.Time, model.LoginLimit) (bool, error)) *MockloginFailureRepoReserveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reset mocks base method.
+func (m *MockloginFailureRepo) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockloginFailureRepoMockRecorder) Reset(ctx, key any) *MockloginFailureRepoResetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockloginFailureRepo)(nil).Reset), ctx, key)
+	return &MockloginFailureRepoResetCall{Call: call}
+}
+
+// MockloginFailureRepoResetCall wrap *gomock.Call
+type MockloginFailureRepoResetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginFailureRepoResetCall) Return(arg0 error) *MockloginFailureRepoResetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginFailureRepoResetCall) Do(f func(context.Context, string) error) *MockloginFailureRepoResetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginFailureRepoResetCall) DoAndReturn(f func(context.Context, string) error) *MockloginFailureRepoResetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockassignmentRepo is a mock of assignmentRepo interface.
+type MockassignmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockassignmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockassignmentRepoMockRecorder is the mock recorder for MockassignmentRepo.
+type MockassignmentRepoMockRecorder struct {
+	mock *MockassignmentRepo
+}
+
+// NewMockassignmentRepo creates a new mock instance.
+func NewMockassignmentRepo(ctrl *gomock.Controller) *MockassignmentRepo {
+	mock := &MockassignmentRepo{ctrl: ctrl}
+	mock.recorder = &MockassignmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockassignmentRepo) EXPECT() *MockassignmentRepoMockRecorder {
+	return m.recorder
+}
+
+// GetPVZIDs mocks base method.
+func (m *MockassignmentRepo) GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZIDs", ctx, userID)
+	ret0, _ := ret[0].([]model.PVZID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZIDs indicates an expected call of GetPVZIDs.
+func (mr *MockassignmentRepoMockRecorder) GetPVZIDs(ctx, userID any) *MockassignmentRepoGetPVZIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZIDs", reflect.TypeOf((*MockassignmentRepo)(nil).GetPVZIDs), ctx, userID)
+	return &MockassignmentRepoGetPVZIDsCall{Call: call}
+}
+
+// MockassignmentRepoGetPVZIDsCall wrap *gomock.Call
+type MockassignmentRepoGetPVZIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockassignmentRepoGetPVZIDsCall) Return(arg0 []model.PVZID, arg1 error) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockassignmentRepoGetPVZIDsCall) Do(f func(context.Context, model.UserID) ([]model.PVZID, error)) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockassignmentRepoGetPVZIDsCall) DoAndReturn(f func(context.Context, model.UserID) ([]model.PVZID, error)) *MockassignmentRepoGetPVZIDsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -21,10 +21,11 @@ func New(tokenProvider tokenProvider) (*UseCase, error) {
 	}, nil
 }
 
+// Auth issues a test token, it is not bound to PVZs and is marked as dummy.
 func (uc *UseCase) Auth(_ context.Context, role model.UserRole) (string, error) {
-	token, err := uc.tokenProvider.CreateToken("", model.DefaultUserID, role)
+	token, err := uc.tokenProvider.CreateDummyToken(role)
 	if err != nil {
-		return "", fmt.Errorf("tokenProvider.CreateToken: %w", err)
+		return "", fmt.Errorf("tokenProvider.CreateDummyToken: %w", err)
 	}
 
 	return token, nil
@@ -40,41 +40,41 @@ func (m *MocktokenProvider) EXPECT() *MocktokenProviderMockRecorder {
 	return m.recorder
 }
 
-// CreateToken mocks base method.
-func (m *MocktokenProvider) CreateToken(email string, userID model.UserID, role model.UserRole) (string, error) {
+// CreateDummyToken mocks base method.
+func (m *MocktokenProvider) CreateDummyToken(role model.UserRole) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", email, userID, role)
+	ret := m.ctrl.Call(m, "CreateDummyToken", role)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateToken indicates an expected call of CreateToken.
-func (mr *MocktokenProviderMockRecorder) CreateToken(email, userID, role any) *MocktokenProviderCreateTokenCall {
+// CreateDummyToken indicates an expected call of CreateDummyToken.
+func (mr *MocktokenProviderMockRecorder) CreateDummyToken(role any) *MocktokenProviderCreateDummyTokenCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), email, userID, role)
-	return &MocktokenProviderCreateTokenCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDummyToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateDummyToken), role)
+	return &MocktokenProviderCreateDummyTokenCall{Call: call}
 }
 
-// MocktokenProviderCreateTokenCall wrap *gomock.Call
-type MocktokenProviderCreateTokenCall struct {
+// MocktokenProviderCreateDummyTokenCall wrap *gomock.Call
+type MocktokenProviderCreateDummyTokenCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MocktokenProviderCreateTokenCall) Return(arg0 string, arg1 error) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateDummyTokenCall) Return(arg0 string, arg1 error) *MocktokenProviderCreateDummyTokenCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MocktokenProviderCreateTokenCall) Do(f func(string, model.UserID, model.UserRole) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateDummyTokenCall) Do(f func(model.UserRole) (string, error)) *MocktokenProviderCreateDummyTokenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, model.UserID, model.UserRole) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MocktokenProviderCreateDummyTokenCall) DoAndReturn(f func(model.UserRole) (string, error)) *MocktokenProviderCreateDummyTokenCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
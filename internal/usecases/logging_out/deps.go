@@ -0,0 +1,22 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package logging_out
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type refreshTokenRepo interface {
+	GetByHashForUpdate(ctx context.Context, tokenHash []byte) (model.RefreshToken, error)
+	RevokeFamily(ctx context.Context, familyID model.RefreshTokenFamilyID) error
+}
+
+type tokenRevoker interface {
+	Revoke(ctx context.Context, tokenID model.TokenID, expiresAt time.Time) error
+}
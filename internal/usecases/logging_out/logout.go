@@ -0,0 +1,68 @@
+package logging_out
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	trManager        trManager
+	refreshTokenRepo refreshTokenRepo
+	tokenRevoker     tokenRevoker
+}
+
+func New(trManager trManager, refreshTokenRepo refreshTokenRepo, tokenRevoker tokenRevoker) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if refreshTokenRepo == nil {
+		return nil, errors.New("refreshTokenRepo is nil")
+	}
+	if tokenRevoker == nil {
+		return nil, errors.New("tokenRevoker is nil")
+	}
+	return &UseCase{
+		trManager:        trManager,
+		refreshTokenRepo: refreshTokenRepo,
+		tokenRevoker:     tokenRevoker,
+	}, nil
+}
+
+// Logout revokes the access token the request is made with and, if given, the family of the refresh token.
+// Unknown refresh tokens and tokens of other users are ignored, so logout can be repeated.
+func (uc *UseCase) Logout(ctx context.Context, tokenInfo model.TokenInfo, refreshToken string) error {
+	if refreshToken != "" {
+		err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+			stored, err := uc.refreshTokenRepo.GetByHashForUpdate(ctx, model.HashRefreshToken(refreshToken))
+			if err != nil {
+				if errors.Is(err, model.ErrRefreshTokenNotFound) {
+					return nil
+				}
+				return fmt.Errorf("refreshTokenRepo.GetByHashForUpdate: %w", err)
+			}
+			if stored.UserID != tokenInfo.UserID {
+				return nil
+			}
+
+			err = uc.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID)
+			if err != nil {
+				return fmt.Errorf("refreshTokenRepo.RevokeFamily: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("trManager.Do: %w", err)
+		}
+	}
+
+	err := uc.tokenRevoker.Revoke(ctx, tokenInfo.TokenID, tokenInfo.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("tokenRevoker.Revoke: %w", err)
+	}
+
+	return nil
+}
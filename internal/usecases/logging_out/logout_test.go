@@ -0,0 +1,186 @@
+package logging_out
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), NewMocktokenRevoker(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockrefreshTokenRepo(ctrl), NewMocktokenRevoker(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), nil, NewMocktokenRevoker(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_Logout(t *testing.T) {
+	type mocks struct {
+		trManager        *MocktrManager
+		refreshTokenRepo *MockrefreshTokenRepo
+		tokenRevoker     *MocktokenRevoker
+	}
+
+	tokenInfo := model.TokenInfo{
+		UserID:    model.NewUserID(),
+		UserRole:  model.UserRoleEmployee,
+		TokenID:   model.NewTokenID(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	familyID := model.NewRefreshTokenFamilyID()
+	refreshToken, stored, err := model.NewRefreshToken(tokenInfo.UserID, familyID, time.Hour)
+	require.NoError(t, err)
+	otherUserToken := stored
+	otherUserToken.UserID = model.NewUserID()
+
+	withTransaction := func(m *mocks) {
+		m.trManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+				return do(ctx)
+			})
+	}
+
+	testCases := []struct {
+		name         string
+		prepare      func(m *mocks)
+		refreshToken string
+		wantErr      error
+	}{
+		{
+			name: "success.access_token_only",
+			prepare: func(m *mocks) {
+				m.tokenRevoker.EXPECT().
+					Revoke(gomock.Any(), tokenInfo.TokenID, tokenInfo.ExpiresAt).
+					Return(nil)
+			},
+			refreshToken: "",
+			wantErr:      nil,
+		},
+		{
+			name: "success.refresh_token",
+			prepare: func(m *mocks) {
+				withTransaction(m)
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), model.HashRefreshToken(refreshToken)).
+					Return(stored, nil)
+				m.refreshTokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), familyID).
+					Return(nil)
+				m.tokenRevoker.EXPECT().
+					Revoke(gomock.Any(), tokenInfo.TokenID, tokenInfo.ExpiresAt).
+					Return(nil)
+			},
+			refreshToken: refreshToken,
+			wantErr:      nil,
+		},
+		{
+			name: "success.unknown_refresh_token",
+			prepare: func(m *mocks) {
+				withTransaction(m)
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(model.RefreshToken{}, model.ErrRefreshTokenNotFound)
+				m.tokenRevoker.EXPECT().
+					Revoke(gomock.Any(), tokenInfo.TokenID, tokenInfo.ExpiresAt).
+					Return(nil)
+			},
+			refreshToken: "unknown",
+			wantErr:      nil,
+		},
+		{
+			name: "success.other_user_refresh_token",
+			prepare: func(m *mocks) {
+				withTransaction(m)
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(otherUserToken, nil)
+				m.tokenRevoker.EXPECT().
+					Revoke(gomock.Any(), tokenInfo.TokenID, tokenInfo.ExpiresAt).
+					Return(nil)
+			},
+			refreshToken: refreshToken,
+			wantErr:      nil,
+		},
+		{
+			name: "error.GetByHashForUpdate",
+			prepare: func(m *mocks) {
+				withTransaction(m)
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(model.RefreshToken{}, assert.AnError)
+			},
+			refreshToken: refreshToken,
+			wantErr:      assert.AnError,
+		},
+		{
+			name: "error.RevokeFamily",
+			prepare: func(m *mocks) {
+				withTransaction(m)
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.refreshTokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), familyID).
+					Return(assert.AnError)
+			},
+			refreshToken: refreshToken,
+			wantErr:      assert.AnError,
+		},
+		{
+			name: "error.tokenRevoker.Revoke",
+			prepare: func(m *mocks) {
+				m.tokenRevoker.EXPECT().
+					Revoke(gomock.Any(), tokenInfo.TokenID, tokenInfo.ExpiresAt).
+					Return(assert.AnError)
+			},
+			refreshToken: "",
+			wantErr:      assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:        NewMocktrManager(ctrl),
+				refreshTokenRepo: NewMockrefreshTokenRepo(ctrl),
+				tokenRevoker:     NewMocktokenRevoker(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.refreshTokenRepo, m.tokenRevoker)
+			require.NoError(t, err)
+
+			err = uc.Logout(context.Background(), tokenInfo, tc.refreshToken)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package logging_out -typed -destination mock_deps_test.go
+//
+
+// Package logging_out is a generated GoMock package.
+package logging_out
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrefreshTokenRepo is a mock of refreshTokenRepo interface.
+type MockrefreshTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenRepoMockRecorder is the mock recorder for MockrefreshTokenRepo.
+type MockrefreshTokenRepoMockRecorder struct {
+	mock *MockrefreshTokenRepo
+}
+
+// NewMockrefreshTokenRepo creates a new mock instance.
+func NewMockrefreshTokenRepo(ctrl *gomock.Controller) *MockrefreshTokenRepo {
+	mock := &MockrefreshTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenRepo) EXPECT() *MockrefreshTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockrefreshTokenRepo) GetByHashForUpdate(ctx context.Context, tokenHash []byte) (model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockrefreshTokenRepoMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockrefreshTokenRepo)(nil).GetByHashForUpdate), ctx, tokenHash)
+	return &MockrefreshTokenRepoGetByHashForUpdateCall{Call: call}
+}
+
+// MockrefreshTokenRepoGetByHashForUpdateCall wrap *gomock.Call
+type MockrefreshTokenRepoGetByHashForUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoGetByHashForUpdateCall) Return(arg0 model.RefreshToken, arg1 error) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoGetByHashForUpdateCall) Do(f func(context.Context, []byte) (model.RefreshToken, error)) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoGetByHashForUpdateCall) DoAndReturn(f func(context.Context, []byte) (model.RefreshToken, error)) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeFamily mocks base method.
+func (m *MockrefreshTokenRepo) RevokeFamily(ctx context.Context, familyID model.RefreshTokenFamilyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockrefreshTokenRepoMockRecorder) RevokeFamily(ctx, familyID any) *MockrefreshTokenRepoRevokeFamilyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockrefreshTokenRepo)(nil).RevokeFamily), ctx, familyID)
+	return &MockrefreshTokenRepoRevokeFamilyCall{Call: call}
+}
+
+// MockrefreshTokenRepoRevokeFamilyCall wrap *gomock.Call
+type MockrefreshTokenRepoRevokeFamilyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoRevokeFamilyCall) Return(arg0 error) *MockrefreshTokenRepoRevokeFamilyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoRevokeFamilyCall) Do(f func(context.Context, model.RefreshTokenFamilyID) error) *MockrefreshTokenRepoRevokeFamilyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoRevokeFamilyCall) DoAndReturn(f func(context.Context, model.RefreshTokenFamilyID) error) *MockrefreshTokenRepoRevokeFamilyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktokenRevoker is a mock of tokenRevoker interface.
+type MocktokenRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenRevokerMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenRevokerMockRecorder is the mock recorder for MocktokenRevoker.
+type MocktokenRevokerMockRecorder struct {
+	mock *MocktokenRevoker
+}
+
+// NewMocktokenRevoker creates a new mock instance.
+func NewMocktokenRevoker(ctrl *gomock.Controller) *MocktokenRevoker {
+	mock := &MocktokenRevoker{ctrl: ctrl}
+	mock.recorder = &MocktokenRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenRevoker) EXPECT() *MocktokenRevokerMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MocktokenRevoker) Revoke(ctx context.Context, tokenID model.TokenID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, tokenID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MocktokenRevokerMockRecorder) Revoke(ctx, tokenID, expiresAt any) *MocktokenRevokerRevokeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MocktokenRevoker)(nil).Revoke), ctx, tokenID, expiresAt)
+	return &MocktokenRevokerRevokeCall{Call: call}
+}
+
+// MocktokenRevokerRevokeCall wrap *gomock.Call
+type MocktokenRevokerRevokeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenRevokerRevokeCall) Return(arg0 error) *MocktokenRevokerRevokeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenRevokerRevokeCall) Do(f func(context.Context, model.TokenID, time.Time) error) *MocktokenRevokerRevokeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenRevokerRevokeCall) DoAndReturn(f func(context.Context, model.TokenID, time.Time) error) *MocktokenRevokerRevokeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
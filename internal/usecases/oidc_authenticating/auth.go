@@ -0,0 +1,114 @@
+package oidc_authenticating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	oidcClient           oidcClient
+	userRepo             userRepo
+	tokenProvider        tokenProvider
+	refreshTokenRepo     refreshTokenRepo
+	assignmentRepo       assignmentRepo
+	groupRoles           model.OIDCGroupRoles
+	refreshTokenLifetime time.Duration
+}
+
+func New(oidcClient oidcClient, userRepo userRepo, tokenProvider tokenProvider, refreshTokenRepo refreshTokenRepo,
+	assignmentRepo assignmentRepo, groupRoles model.OIDCGroupRoles, refreshTokenLifetime time.Duration,
+) (*UseCase, error) {
+	if oidcClient == nil {
+		return nil, errors.New("oidcClient is nil")
+	}
+	if userRepo == nil {
+		return nil, errors.New("userRepo is nil")
+	}
+	if tokenProvider == nil {
+		return nil, errors.New("tokenProvider is nil")
+	}
+	if refreshTokenRepo == nil {
+		return nil, errors.New("refreshTokenRepo is nil")
+	}
+	if assignmentRepo == nil {
+		return nil, errors.New("assignmentRepo is nil")
+	}
+	if len(groupRoles) == 0 {
+		return nil, errors.New("groupRoles is empty")
+	}
+	if refreshTokenLifetime <= 0 {
+		return nil, errors.New("refreshTokenLifetime must be positive")
+	}
+	return &UseCase{
+		oidcClient:           oidcClient,
+		userRepo:             userRepo,
+		tokenProvider:        tokenProvider,
+		refreshTokenRepo:     refreshTokenRepo,
+		assignmentRepo:       assignmentRepo,
+		groupRoles:           groupRoles,
+		refreshTokenLifetime: refreshTokenLifetime,
+	}, nil
+}
+
+// LoginURL starts the login, the client keeps the state until the callback.
+func (uc *UseCase) LoginURL() (string, model.OIDCState, error) {
+	state, err := model.NewOIDCState()
+	if err != nil {
+		return "", model.OIDCState{}, fmt.Errorf("model.NewOIDCState: %w", err)
+	}
+
+	return uc.oidcClient.AuthCodeURL(state), state, nil
+}
+
+// Auth redeems the authorization code of the callback, creates or updates the user with the role of its groups
+// and issues an access token and a refresh token, like a login with a password.
+func (uc *UseCase) Auth(ctx context.Context, code string, state model.OIDCState) (model.TokenPair, error) {
+	identity, err := uc.oidcClient.Exchange(ctx, code, state)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("oidcClient.Exchange: %w", err)
+	}
+
+	role, ok := uc.groupRoles.Role(identity.Groups)
+	if !ok {
+		return model.TokenPair{}, model.ErrOIDCNoRole
+	}
+
+	user, err := uc.userRepo.UpsertOIDC(ctx, identity, role)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("userRepo.UpsertOIDC: %w", err)
+	}
+
+	if user.Disabled {
+		return model.TokenPair{}, model.ErrUserDisabled
+	}
+
+	refreshToken, refreshTokenEntity, err := model.NewRefreshToken(user.UserID, model.NewRefreshTokenFamilyID(),
+		uc.refreshTokenLifetime)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("model.NewRefreshToken: %w", err)
+	}
+
+	err = uc.refreshTokenRepo.Create(ctx, refreshTokenEntity)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("refreshTokenRepo.Create: %w", err)
+	}
+
+	pvzIDs, err := uc.assignmentRepo.GetPVZIDs(ctx, user.UserID)
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("assignmentRepo.GetPVZIDs: %w", err)
+	}
+
+	token, err := uc.tokenProvider.CreateToken(user.Email, user.UserID, user.UserRole, model.PVZAccess{PVZIDs: pvzIDs})
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("tokenProvider.CreateToken: %w", err)
+	}
+
+	return model.TokenPair{
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+	}, nil
+}
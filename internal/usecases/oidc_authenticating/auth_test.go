@@ -0,0 +1,275 @@
+package oidc_authenticating
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+var testGroupRoles = model.OIDCGroupRoles{
+	{Group: "pvz-moderators", Role: model.UserRoleModerator},
+	{Group: "pvz-staff", Role: model.UserRoleEmployee},
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), testGroupRoles, time.Hour)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), testGroupRoles, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), nil, NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), testGroupRoles, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), NewMockuserRepo(ctrl), nil, NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), testGroupRoles, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), nil, NewMockassignmentRepo(ctrl), testGroupRoles, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), nil, testGroupRoles, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.empty_groupRoles", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), nil, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_refreshTokenLifetime", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoidcClient(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), testGroupRoles, 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_LoginURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := NewMockoidcClient(ctrl)
+
+	var gotState model.OIDCState
+	client.EXPECT().AuthCodeURL(gomock.Any()).DoAndReturn(func(state model.OIDCState) string {
+		gotState = state
+		return "https://sso.example.com/authorize?state=" + state.State
+	})
+
+	uc, err := New(client, NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockassignmentRepo(ctrl), testGroupRoles, time.Hour)
+	require.NoError(t, err)
+
+	loginURL, state, err := uc.LoginURL()
+	require.NoError(t, err)
+	assert.Equal(t, gotState, state)
+	assert.NotEmpty(t, state.Nonce)
+	assert.NotEmpty(t, state.CodeVerifier)
+	assert.Equal(t, "https://sso.example.com/authorize?state="+state.State, loginURL)
+}
+
+func TestUseCase_Auth(t *testing.T) {
+	type mocks struct {
+		oidcClient       *MockoidcClient
+		userRepo         *MockuserRepo
+		tokenProvider    *MocktokenProvider
+		refreshTokenRepo *MockrefreshTokenRepo
+		assignmentRepo   *MockassignmentRepo
+	}
+
+	userID1 := model.NewUserID()
+	pvzID1 := model.NewPVZID()
+	state := model.OIDCState{State: "state", Nonce: "nonce", CodeVerifier: "verifier"}
+
+	identity := model.OIDCIdentity{
+		Issuer:  "https://sso.example.com",
+		Subject: "subject-1",
+		Email:   "staff@example.com",
+		Groups:  []string{"everyone", "pvz-staff"},
+	}
+	user := &model.User{
+		UserID:   userID1,
+		Email:    "staff@example.com",
+		UserRole: model.UserRoleEmployee,
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantRes string
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+						require.Equal(t, userID1, token.UserID)
+						require.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+						return nil
+					})
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return([]model.PVZID{pvzID1}, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("staff@example.com", userID1, model.UserRoleEmployee, model.PVZAccess{PVZIDs: []model.PVZID{pvzID1}}).
+					Return("654321", nil)
+			},
+			wantRes: "654321",
+			wantErr: nil,
+		},
+		{
+			name: "businessError.AuthFailed",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(model.OIDCIdentity{}, model.ErrOIDCAuthFailed)
+			},
+			wantRes: "",
+			wantErr: model.ErrOIDCAuthFailed,
+		},
+		{
+			name: "businessError.NoRole",
+			prepare: func(m *mocks) {
+				noRoleIdentity := identity
+				noRoleIdentity.Groups = []string{"everyone"}
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(noRoleIdentity, nil)
+			},
+			wantRes: "",
+			wantErr: model.ErrOIDCNoRole,
+		},
+		{
+			name: "businessError.UserConflict",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(nil, model.ErrOIDCUserConflict)
+			},
+			wantRes: "",
+			wantErr: model.ErrOIDCUserConflict,
+		},
+		{
+			name: "businessError.UserDisabled",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(&model.User{UserID: userID1, Disabled: true}, nil)
+			},
+			wantRes: "",
+			wantErr: model.ErrUserDisabled,
+		},
+		{
+			name: "error.UpsertOIDC",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(nil, assert.AnError)
+			},
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.refreshTokenRepo.Create",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetPVZIDs",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return(nil, assert.AnError)
+			},
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.CreateToken",
+			prepare: func(m *mocks) {
+				m.oidcClient.EXPECT().Exchange(gomock.Any(), "code", state).Return(identity, nil)
+				m.userRepo.EXPECT().
+					UpsertOIDC(gomock.Any(), identity, model.UserRoleEmployee).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID1).
+					Return(nil, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("staff@example.com", userID1, model.UserRoleEmployee, model.PVZAccess{}).
+					Return("", assert.AnError)
+			},
+			wantRes: "",
+			wantErr: assert.AnError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				oidcClient:       NewMockoidcClient(ctrl),
+				userRepo:         NewMockuserRepo(ctrl),
+				tokenProvider:    NewMocktokenProvider(ctrl),
+				refreshTokenRepo: NewMockrefreshTokenRepo(ctrl),
+				assignmentRepo:   NewMockassignmentRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.oidcClient, m.userRepo, m.tokenProvider, m.refreshTokenRepo, m.assignmentRepo,
+				testGroupRoles, time.Hour)
+			require.NoError(t, err)
+
+			res, err := uc.Auth(context.Background(), "code", state)
+			require.ErrorIs(t, err, tc.wantErr)
+
+			require.Equal(t, tc.wantRes, res.AccessToken)
+			require.Equal(t, tc.wantRes != "", res.RefreshToken != "")
+		})
+	}
+}
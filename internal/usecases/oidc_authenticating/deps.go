@@ -0,0 +1,29 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package oidc_authenticating
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type oidcClient interface {
+	AuthCodeURL(state model.OIDCState) string
+	Exchange(ctx context.Context, code string, state model.OIDCState) (model.OIDCIdentity, error)
+}
+
+type userRepo interface {
+	UpsertOIDC(ctx context.Context, identity model.OIDCIdentity, role model.UserRole) (*model.User, error)
+}
+
+type tokenProvider interface {
+	CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess) (string, error)
+}
+
+type refreshTokenRepo interface {
+	Create(ctx context.Context, token model.RefreshToken) error
+}
+
+type assignmentRepo interface {
+	GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error)
+}
@@ -0,0 +1,370 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package oidc_authenticating -typed -destination mock_deps_test.go
+//
+
+// Package oidc_authenticating is a generated GoMock package.
+package oidc_authenticating
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockoidcClient is a mock of oidcClient interface.
+type MockoidcClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockoidcClientMockRecorder
+	isgomock struct{}
+}
+
+// MockoidcClientMockRecorder is the mock recorder for MockoidcClient.
+type MockoidcClientMockRecorder struct {
+	mock *MockoidcClient
+}
+
+// NewMockoidcClient creates a new mock instance.
+func NewMockoidcClient(ctrl *gomock.Controller) *MockoidcClient {
+	mock := &MockoidcClient{ctrl: ctrl}
+	mock.recorder = &MockoidcClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoidcClient) EXPECT() *MockoidcClientMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockoidcClient) AuthCodeURL(state model.OIDCState) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", state)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockoidcClientMockRecorder) AuthCodeURL(state any) *MockoidcClientAuthCodeURLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockoidcClient)(nil).AuthCodeURL), state)
+	return &MockoidcClientAuthCodeURLCall{Call: call}
+}
+
+// MockoidcClientAuthCodeURLCall wrap *gomock.Call
+type MockoidcClientAuthCodeURLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoidcClientAuthCodeURLCall) Return(arg0 string) *MockoidcClientAuthCodeURLCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoidcClientAuthCodeURLCall) Do(f func(model.OIDCState) string) *MockoidcClientAuthCodeURLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoidcClientAuthCodeURLCall) DoAndReturn(f func(model.OIDCState) string) *MockoidcClientAuthCodeURLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Exchange mocks base method.
+func (m *MockoidcClient) Exchange(ctx context.Context, code string, state model.OIDCState) (model.OIDCIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, state)
+	ret0, _ := ret[0].(model.OIDCIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockoidcClientMockRecorder) Exchange(ctx, code, state any) *MockoidcClientExchangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockoidcClient)(nil).Exchange), ctx, code, state)
+	return &MockoidcClientExchangeCall{Call: call}
+}
+
+// MockoidcClientExchangeCall wrap *gomock.Call
+type MockoidcClientExchangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoidcClientExchangeCall) Return(arg0 model.OIDCIdentity, arg1 error) *MockoidcClientExchangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoidcClientExchangeCall) Do(f func(context.Context, string, model.OIDCState) (model.OIDCIdentity, error)) *MockoidcClientExchangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoidcClientExchangeCall) DoAndReturn(f func(context.Context, string, model.OIDCState) (model.OIDCIdentity, error)) *MockoidcClientExchangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// UpsertOIDC mocks base method.
+func (m *MockuserRepo) UpsertOIDC(ctx context.Context, identity model.OIDCIdentity, role model.UserRole) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOIDC", ctx, identity, role)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertOIDC indicates an expected call of UpsertOIDC.
+func (mr *MockuserRepoMockRecorder) UpsertOIDC(ctx, identity, role any) *MockuserRepoUpsertOIDCCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOIDC", reflect.TypeOf((*MockuserRepo)(nil).UpsertOIDC), ctx, identity, role)
+	return &MockuserRepoUpsertOIDCCall{Call: call}
+}
+
+// MockuserRepoUpsertOIDCCall wrap *gomock.Call
+type MockuserRepoUpsertOIDCCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoUpsertOIDCCall) Return(arg0 *model.User, arg1 error) *MockuserRepoUpsertOIDCCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoUpsertOIDCCall) Do(f func(context.Context, model.OIDCIdentity, model.UserRole) (*model.User, error)) *MockuserRepoUpsertOIDCCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoUpsertOIDCCall) DoAndReturn(f func(context.Context, model.OIDCIdentity, model.UserRole) (*model.User, error)) *MockuserRepoUpsertOIDCCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktokenProvider is a mock of tokenProvider interface.
+type MocktokenProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenProviderMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenProviderMockRecorder is the mock recorder for MocktokenProvider.
+type MocktokenProviderMockRecorder struct {
+	mock *MocktokenProvider
+}
+
+// NewMocktokenProvider creates a new mock instance.
+func NewMocktokenProvider(ctrl *gomock.Controller) *MocktokenProvider {
+	mock := &MocktokenProvider{ctrl: ctrl}
+	mock.recorder = &MocktokenProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenProvider) EXPECT() *MocktokenProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MocktokenProvider) CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", email, userID, role, access)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MocktokenProviderMockRecorder) CreateToken(email, userID, role, access any) *MocktokenProviderCreateTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), email, userID, role, access)
+	return &MocktokenProviderCreateTokenCall{Call: call}
+}
+
+// MocktokenProviderCreateTokenCall wrap *gomock.Call
+type MocktokenProviderCreateTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenProviderCreateTokenCall) Return(arg0 string, arg1 error) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenProviderCreateTokenCall) Do(f func(string, model.UserID, model.UserRole, model.PVZAccess) (string, error)) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, model.UserID, model.UserRole, model.PVZAccess) (string, error)) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrefreshTokenRepo is a mock of refreshTokenRepo interface.
+type MockrefreshTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenRepoMockRecorder is the mock recorder for MockrefreshTokenRepo.
+type MockrefreshTokenRepoMockRecorder struct {
+	mock *MockrefreshTokenRepo
+}
+
+// NewMockrefreshTokenRepo creates a new mock instance.
+func NewMockrefreshTokenRepo(ctrl *gomock.Controller) *MockrefreshTokenRepo {
+	mock := &MockrefreshTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenRepo) EXPECT() *MockrefreshTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockrefreshTokenRepo) Create(ctx context.Context, token model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockrefreshTokenRepoMockRecorder) Create(ctx, token any) *MockrefreshTokenRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockrefreshTokenRepo)(nil).Create), ctx, token)
+	return &MockrefreshTokenRepoCreateCall{Call: call}
+}
+
+// MockrefreshTokenRepoCreateCall wrap *gomock.Call
+type MockrefreshTokenRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoCreateCall) Return(arg0 error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoCreateCall) Do(f func(context.Context, model.RefreshToken) error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoCreateCall) DoAndReturn(f func(context.Context, model.RefreshToken) error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockassignmentRepo is a mock of assignmentRepo interface.
+type MockassignmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockassignmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockassignmentRepoMockRecorder is the mock recorder for MockassignmentRepo.
+type MockassignmentRepoMockRecorder struct {
+	mock *MockassignmentRepo
+}
+
+// NewMockassignmentRepo creates a new mock instance.
+func NewMockassignmentRepo(ctrl *gomock.Controller) *MockassignmentRepo {
+	mock := &MockassignmentRepo{ctrl: ctrl}
+	mock.recorder = &MockassignmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockassignmentRepo) EXPECT() *MockassignmentRepoMockRecorder {
+	return m.recorder
+}
+
+// GetPVZIDs mocks base method.
+func (m *MockassignmentRepo) GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZIDs", ctx, userID)
+	ret0, _ := ret[0].([]model.PVZID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZIDs indicates an expected call of GetPVZIDs.
+func (mr *MockassignmentRepoMockRecorder) GetPVZIDs(ctx, userID any) *MockassignmentRepoGetPVZIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZIDs", reflect.TypeOf((*MockassignmentRepo)(nil).GetPVZIDs), ctx, userID)
+	return &MockassignmentRepoGetPVZIDsCall{Call: call}
+}
+
+// MockassignmentRepoGetPVZIDsCall wrap *gomock.Call
+type MockassignmentRepoGetPVZIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockassignmentRepoGetPVZIDsCall) Return(arg0 []model.PVZID, arg1 error) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockassignmentRepoGetPVZIDsCall) Do(f func(context.Context, model.UserID) ([]model.PVZID, error)) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockassignmentRepoGetPVZIDsCall) DoAndReturn(f func(context.Context, model.UserID) ([]model.PVZID, error)) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package outbox_relaying
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type outboxRepo interface {
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]model.OutboxMessage, error)
+	MarkDelivered(ctx context.Context, messageID model.OutboxMessageID) error
+	MarkFailed(ctx context.Context, messageID model.OutboxMessageID, lastError string, retryAfter time.Duration) error
+	MarkDead(ctx context.Context, messageID model.OutboxMessageID, lastError string) error
+}
+
+type sink interface {
+	Send(ctx context.Context, message model.OutboxMessage) error
+}
@@ -0,0 +1,258 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package outbox_relaying -typed -destination mock_deps_test.go
+//
+
+// Package outbox_relaying is a generated GoMock package.
+package outbox_relaying
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockoutboxRepo is a mock of outboxRepo interface.
+type MockoutboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxRepoMockRecorder is the mock recorder for MockoutboxRepo.
+type MockoutboxRepoMockRecorder struct {
+	mock *MockoutboxRepo
+}
+
+// NewMockoutboxRepo creates a new mock instance.
+func NewMockoutboxRepo(ctrl *gomock.Controller) *MockoutboxRepo {
+	mock := &MockoutboxRepo{ctrl: ctrl}
+	mock.recorder = &MockoutboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxRepo) EXPECT() *MockoutboxRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimPending mocks base method.
+func (m *MockoutboxRepo) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]model.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, limit, lease)
+	ret0, _ := ret[0].([]model.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockoutboxRepoMockRecorder) ClaimPending(ctx, limit, lease any) *MockoutboxRepoClaimPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockoutboxRepo)(nil).ClaimPending), ctx, limit, lease)
+	return &MockoutboxRepoClaimPendingCall{Call: call}
+}
+
+// MockoutboxRepoClaimPendingCall wrap *gomock.Call
+type MockoutboxRepoClaimPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepoClaimPendingCall) Return(arg0 []model.OutboxMessage, arg1 error) *MockoutboxRepoClaimPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepoClaimPendingCall) Do(f func(context.Context, int, time.Duration) ([]model.OutboxMessage, error)) *MockoutboxRepoClaimPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepoClaimPendingCall) DoAndReturn(f func(context.Context, int, time.Duration) ([]model.OutboxMessage, error)) *MockoutboxRepoClaimPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkDead mocks base method.
+func (m *MockoutboxRepo) MarkDead(ctx context.Context, messageID model.OutboxMessageID, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDead", ctx, messageID, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDead indicates an expected call of MarkDead.
+func (mr *MockoutboxRepoMockRecorder) MarkDead(ctx, messageID, lastError any) *MockoutboxRepoMarkDeadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDead", reflect.TypeOf((*MockoutboxRepo)(nil).MarkDead), ctx, messageID, lastError)
+	return &MockoutboxRepoMarkDeadCall{Call: call}
+}
+
+// MockoutboxRepoMarkDeadCall wrap *gomock.Call
+type MockoutboxRepoMarkDeadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepoMarkDeadCall) Return(arg0 error) *MockoutboxRepoMarkDeadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepoMarkDeadCall) Do(f func(context.Context, model.OutboxMessageID, string) error) *MockoutboxRepoMarkDeadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepoMarkDeadCall) DoAndReturn(f func(context.Context, model.OutboxMessageID, string) error) *MockoutboxRepoMarkDeadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkDelivered mocks base method.
+func (m *MockoutboxRepo) MarkDelivered(ctx context.Context, messageID model.OutboxMessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockoutboxRepoMockRecorder) MarkDelivered(ctx, messageID any) *MockoutboxRepoMarkDeliveredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockoutboxRepo)(nil).MarkDelivered), ctx, messageID)
+	return &MockoutboxRepoMarkDeliveredCall{Call: call}
+}
+
+// MockoutboxRepoMarkDeliveredCall wrap *gomock.Call
+type MockoutboxRepoMarkDeliveredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepoMarkDeliveredCall) Return(arg0 error) *MockoutboxRepoMarkDeliveredCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepoMarkDeliveredCall) Do(f func(context.Context, model.OutboxMessageID) error) *MockoutboxRepoMarkDeliveredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepoMarkDeliveredCall) DoAndReturn(f func(context.Context, model.OutboxMessageID) error) *MockoutboxRepoMarkDeliveredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkFailed mocks base method.
+func (m *MockoutboxRepo) MarkFailed(ctx context.Context, messageID model.OutboxMessageID, lastError string, retryAfter time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, messageID, lastError, retryAfter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockoutboxRepoMockRecorder) MarkFailed(ctx, messageID, lastError, retryAfter any) *MockoutboxRepoMarkFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockoutboxRepo)(nil).MarkFailed), ctx, messageID, lastError, retryAfter)
+	return &MockoutboxRepoMarkFailedCall{Call: call}
+}
+
+// MockoutboxRepoMarkFailedCall wrap *gomock.Call
+type MockoutboxRepoMarkFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxRepoMarkFailedCall) Return(arg0 error) *MockoutboxRepoMarkFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxRepoMarkFailedCall) Do(f func(context.Context, model.OutboxMessageID, string, time.Duration) error) *MockoutboxRepoMarkFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxRepoMarkFailedCall) DoAndReturn(f func(context.Context, model.OutboxMessageID, string, time.Duration) error) *MockoutboxRepoMarkFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Mocksink is a mock of sink interface.
+type Mocksink struct {
+	ctrl     *gomock.Controller
+	recorder *MocksinkMockRecorder
+	isgomock struct{}
+}
+
+// MocksinkMockRecorder is the mock recorder for Mocksink.
+type MocksinkMockRecorder struct {
+	mock *Mocksink
+}
+
+// NewMocksink creates a new mock instance.
+func NewMocksink(ctrl *gomock.Controller) *Mocksink {
+	mock := &Mocksink{ctrl: ctrl}
+	mock.recorder = &MocksinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksink) EXPECT() *MocksinkMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksink) Send(ctx context.Context, message model.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksinkMockRecorder) Send(ctx, message any) *MocksinkSendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksink)(nil).Send), ctx, message)
+	return &MocksinkSendCall{Call: call}
+}
+
+// MocksinkSendCall wrap *gomock.Call
+type MocksinkSendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksinkSendCall) Return(arg0 error) *MocksinkSendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksinkSendCall) Do(f func(context.Context, model.OutboxMessage) error) *MocksinkSendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksinkSendCall) DoAndReturn(f func(context.Context, model.OutboxMessage) error) *MocksinkSendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,103 @@
+package outbox_relaying
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/backoff"
+)
+
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = 10 * time.Minute
+)
+
+type UseCase struct {
+	outboxRepo  outboxRepo
+	sink        sink
+	batchSize   int
+	maxAttempts int
+	lease       time.Duration
+}
+
+// Result is the number of messages delivered, postponed and given up in one batch.
+type Result struct {
+	Delivered int
+	Failed    int
+	Dead      int
+}
+
+func New(outboxRepo outboxRepo, sink sink, batchSize, maxAttempts int, lease time.Duration) (*UseCase, error) {
+	if outboxRepo == nil {
+		return nil, errors.New("outboxRepo is nil")
+	}
+	if sink == nil {
+		return nil, errors.New("sink is nil")
+	}
+	if batchSize < 1 {
+		return nil, errors.New("batchSize should be positive")
+	}
+	if maxAttempts < 1 {
+		return nil, errors.New("maxAttempts should be positive")
+	}
+	if lease <= 0 {
+		return nil, errors.New("lease should be positive")
+	}
+	return &UseCase{
+		outboxRepo:  outboxRepo,
+		sink:        sink,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		lease:       lease,
+	}, nil
+}
+
+// RelayBatch sends pending outbox messages to the sink. Messages are claimed for the lease and sent outside
+// of a transaction, so a slow sink holds no locks. A message is marked delivered only after the sink accepted it,
+// so it is sent again if marking fails or the relay dies: delivery is at-least-once.
+// Failed messages are retried later with exponential backoff until maxAttempts is reached, then they are marked
+// dead and stay for inspection.
+func (uc *UseCase) RelayBatch(ctx context.Context) (Result, error) {
+	var res Result
+
+	messages, err := uc.outboxRepo.ClaimPending(ctx, uc.batchSize, uc.lease)
+	if err != nil {
+		return Result{}, fmt.Errorf("outboxRepo.ClaimPending: %w", err)
+	}
+
+	for _, message := range messages {
+		sendErr := uc.sink.Send(ctx, message)
+		if sendErr == nil {
+			err = uc.outboxRepo.MarkDelivered(ctx, message.ID)
+			if err != nil {
+				return Result{}, fmt.Errorf("outboxRepo.MarkDelivered: %w", err)
+			}
+			res.Delivered++
+			continue
+		}
+
+		if message.Attempts+1 >= uc.maxAttempts {
+			err = uc.outboxRepo.MarkDead(ctx, message.ID, sendErr.Error())
+			if err != nil {
+				return Result{}, fmt.Errorf("outboxRepo.MarkDead: %w", err)
+			}
+			res.Dead++
+			continue
+		}
+
+		err = uc.outboxRepo.MarkFailed(ctx, message.ID, sendErr.Error(), backoff.Exponential(message.Attempts, minRetryDelay, maxRetryDelay))
+		if err != nil {
+			return Result{}, fmt.Errorf("outboxRepo.MarkFailed: %w", err)
+		}
+		res.Failed++
+	}
+
+	return res, nil
+}
+
+// BatchSize is the maximum number of messages sent by one RelayBatch call.
+func (uc *UseCase) BatchSize() int {
+	return uc.batchSize
+}
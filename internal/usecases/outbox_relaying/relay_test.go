@@ -0,0 +1,215 @@
+package outbox_relaying
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoutboxRepo(ctrl), NewMocksink(ctrl), 10, 3, time.Minute)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, 10, res.BatchSize())
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMocksink(ctrl), 10, 3, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoutboxRepo(ctrl), nil, 10, 3, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_batch_size", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoutboxRepo(ctrl), NewMocksink(ctrl), 0, 3, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_max_attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoutboxRepo(ctrl), NewMocksink(ctrl), 10, 0, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_lease", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockoutboxRepo(ctrl), NewMocksink(ctrl), 10, 3, 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_RelayBatch(t *testing.T) {
+	type mocks struct {
+		outboxRepo *MockoutboxRepo
+		sink       *Mocksink
+	}
+
+	message1 := model.OutboxMessage{
+		ID:      model.OutboxMessageID(model.NewReceptionID()),
+		Type:    model.OutboxEventReceptionCreated,
+		Payload: []byte(`{"reception":{}}`),
+	}
+	message2 := model.OutboxMessage{
+		ID:       model.OutboxMessageID(model.NewProductID()),
+		Type:     model.OutboxEventProductAdded,
+		Payload:  []byte(`{"reception":{},"product":{}}`),
+		Attempts: 2,
+	}
+	// the last attempt
+	message3 := model.OutboxMessage{
+		ID:       model.OutboxMessageID(model.NewProductID()),
+		Type:     model.OutboxEventProductAdded,
+		Payload:  []byte(`{"reception":{},"product":{}}`),
+		Attempts: 4,
+	}
+	sendErr := errors.New("unexpected status code: 503")
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+		wantRes Result
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return([]model.OutboxMessage{message1, message2}, nil)
+				m.sink.EXPECT().
+					Send(gomock.Any(), message1).
+					Return(nil)
+				m.outboxRepo.EXPECT().
+					MarkDelivered(gomock.Any(), message1.ID).
+					Return(nil)
+				m.sink.EXPECT().
+					Send(gomock.Any(), message2).
+					Return(sendErr)
+				m.outboxRepo.EXPECT().
+					MarkFailed(gomock.Any(), message2.ID, "unexpected status code: 503", 4*time.Second).
+					Return(nil)
+			},
+			wantErr: nil,
+			wantRes: Result{Delivered: 1, Failed: 1},
+		},
+		{
+			name: "success.dead",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return([]model.OutboxMessage{message3}, nil)
+				m.sink.EXPECT().
+					Send(gomock.Any(), message3).
+					Return(sendErr)
+				m.outboxRepo.EXPECT().
+					MarkDead(gomock.Any(), message3.ID, "unexpected status code: 503").
+					Return(nil)
+			},
+			wantErr: nil,
+			wantRes: Result{Dead: 1},
+		},
+		{
+			name: "success.empty",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+			wantRes: Result{},
+		},
+		{
+			name: "error.ClaimPending",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+		{
+			name: "error.MarkDelivered",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return([]model.OutboxMessage{message1}, nil)
+				m.sink.EXPECT().
+					Send(gomock.Any(), message1).
+					Return(nil)
+				m.outboxRepo.EXPECT().
+					MarkDelivered(gomock.Any(), message1.ID).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+		{
+			name: "error.MarkFailed",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return([]model.OutboxMessage{message1}, nil)
+				m.sink.EXPECT().
+					Send(gomock.Any(), message1).
+					Return(sendErr)
+				m.outboxRepo.EXPECT().
+					MarkFailed(gomock.Any(), message1.ID, "unexpected status code: 503", time.Second).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+		{
+			name: "error.MarkDead",
+			prepare: func(m *mocks) {
+				m.outboxRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return([]model.OutboxMessage{message3}, nil)
+				m.sink.EXPECT().
+					Send(gomock.Any(), message3).
+					Return(sendErr)
+				m.outboxRepo.EXPECT().
+					MarkDead(gomock.Any(), message3.ID, "unexpected status code: 503").
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				outboxRepo: NewMockoutboxRepo(ctrl),
+				sink:       NewMocksink(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.outboxRepo, m.sink, 10, 5, time.Minute)
+			require.NoError(t, err)
+
+			res, err := uc.RelayBatch(context.Background())
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -13,10 +13,12 @@ type UseCase struct {
 	receptionRepo receptionRepo
 	productRepo   productRepo
 	pvzLocker     pvzLocker
+	auditWriter   auditWriter
+	outboxWriter  outboxWriter
 	metric        metrics
 }
 
-func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, productRepo productRepo, metric metrics) (*UseCase, error) {
+func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, productRepo productRepo, auditWriter auditWriter, outboxWriter outboxWriter, metric metrics) (*UseCase, error) {
 	if trManager == nil {
 		return nil, errors.New("trManager is nil")
 	}
@@ -29,6 +31,12 @@ func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker,
 	if productRepo == nil {
 		return nil, errors.New("productRepo is nil")
 	}
+	if auditWriter == nil {
+		return nil, errors.New("auditWriter is nil")
+	}
+	if outboxWriter == nil {
+		return nil, errors.New("outboxWriter is nil")
+	}
 	if metric == nil {
 		return nil, errors.New("metric is nil")
 	}
@@ -37,11 +45,15 @@ func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker,
 		receptionRepo: receptionRepo,
 		productRepo:   productRepo,
 		pvzLocker:     pvzLocker,
+		auditWriter:   auditWriter,
+		outboxWriter:  outboxWriter,
 		metric:        metric,
 	}, nil
 }
 
-func (uc *UseCase) AddProduct(ctx context.Context, pvzID model.PVZID, category model.ProductCategory) (model.Product, error) {
+// AddProduct adds the product to the in-progress reception of the pvz.
+// A barcode may be scanned only once per reception, otherwise model.ErrProductAlreadyScanned is returned.
+func (uc *UseCase) AddProduct(ctx context.Context, pvzID model.PVZID, category model.ProductCategory, barcode *string, actor model.Actor) (model.Product, error) {
 	var product model.Product
 
 	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
@@ -55,11 +67,39 @@ func (uc *UseCase) AddProduct(ctx context.Context, pvzID model.PVZID, category m
 			return fmt.Errorf("receptionRepo.GetInProgress: %w", err)
 		}
 
-		product, err = uc.productRepo.Create(ctx, reception.ID, category)
+		if barcode != nil {
+			var exists bool
+			exists, err = uc.productRepo.ExistsByBarcode(ctx, reception.ID, *barcode)
+			if err != nil {
+				return fmt.Errorf("productRepo.ExistsByBarcode: %w", err)
+			}
+			if exists {
+				return model.ErrProductAlreadyScanned
+			}
+		}
+
+		product, err = uc.productRepo.Create(ctx, reception.ID, category, barcode)
 		if err != nil {
 			return fmt.Errorf("productRepo.Create: %w", err)
 		}
 
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:      actor.UserID,
+			APIKeyID:    actor.APIKeyID,
+			Action:      model.AuditActionProductAdded,
+			PVZID:       pvzID,
+			ReceptionID: &reception.ID,
+			ProductID:   &product.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		err = uc.outboxWriter.Add(ctx, model.NewProductOutboxEvent(model.OutboxEventProductAdded, reception, product))
+		if err != nil {
+			return fmt.Errorf("outboxWriter.Add: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
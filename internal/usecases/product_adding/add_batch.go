@@ -0,0 +1,94 @@
+package product_adding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// AddProducts adds all the products to the in-progress reception of the pvz in one transaction, e.g. a whole pallet.
+// Either all products are added or none: if any barcode is repeated in the batch or was already scanned in the
+// reception, model.ErrProductAlreadyScanned is returned.
+func (uc *UseCase) AddProducts(ctx context.Context, pvzID model.PVZID, inputs []model.ProductInput, actor model.Actor) ([]model.Product, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	barcodes := make([]string, 0, len(inputs))
+	seen := make(map[string]struct{}, len(inputs))
+	for _, input := range inputs {
+		if input.Barcode == nil {
+			continue
+		}
+		if _, ok := seen[*input.Barcode]; ok {
+			return nil, fmt.Errorf("barcode %s is repeated: %w", *input.Barcode, model.ErrProductAlreadyScanned)
+		}
+		seen[*input.Barcode] = struct{}{}
+		barcodes = append(barcodes, *input.Barcode)
+	}
+
+	var products []model.Product
+
+	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
+		err = uc.pvzLocker.Lock(ctx, pvzID)
+		if err != nil {
+			return fmt.Errorf("pvzLocker.Lock: %w", err)
+		}
+
+		reception, err := uc.receptionRepo.GetInProgress(ctx, pvzID)
+		if err != nil {
+			return fmt.Errorf("receptionRepo.GetInProgress: %w", err)
+		}
+
+		if len(barcodes) > 0 {
+			var existing []string
+			existing, err = uc.productRepo.ExistingBarcodes(ctx, reception.ID, barcodes)
+			if err != nil {
+				return fmt.Errorf("productRepo.ExistingBarcodes: %w", err)
+			}
+			if len(existing) > 0 {
+				return fmt.Errorf("barcodes %s: %w", strings.Join(existing, ", "), model.ErrProductAlreadyScanned)
+			}
+		}
+
+		products, err = uc.productRepo.CreateBatch(ctx, reception.ID, inputs)
+		if err != nil {
+			return fmt.Errorf("productRepo.CreateBatch: %w", err)
+		}
+
+		auditEvents := make([]model.AuditEvent, 0, len(products))
+		outboxEvents := make([]model.OutboxEvent, 0, len(products))
+		for i := range products {
+			auditEvents = append(auditEvents, model.AuditEvent{
+				UserID:      actor.UserID,
+				APIKeyID:    actor.APIKeyID,
+				Action:      model.AuditActionProductAdded,
+				PVZID:       pvzID,
+				ReceptionID: &reception.ID,
+				ProductID:   &products[i].ID,
+			})
+			outboxEvents = append(outboxEvents, model.NewProductOutboxEvent(model.OutboxEventProductAdded, reception, products[i]))
+		}
+
+		err = uc.auditWriter.WriteBatch(ctx, auditEvents)
+		if err != nil {
+			return fmt.Errorf("auditWriter.WriteBatch: %w", err)
+		}
+
+		err = uc.outboxWriter.AddBatch(ctx, outboxEvents)
+		if err != nil {
+			return fmt.Errorf("outboxWriter.AddBatch: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	uc.metric.ProductAddedCountAdd(len(products))
+
+	return products, nil
+}
@@ -0,0 +1,309 @@
+package product_adding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestUseCase_AddProducts(t *testing.T) {
+	type mocks struct {
+		trManager     *MocktrManager
+		receptionRepo *MockreceptionRepo
+		productRepo   *MockproductRepo
+		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
+		metric        *Mockmetrics
+	}
+
+	ID1 := model.NewPVZID()
+	productID1 := model.NewProductID()
+	productID2 := model.NewProductID()
+	receptionID1 := model.NewReceptionID()
+	userID1 := model.NewUserID()
+	now := time.Now()
+	barcode1 := "4600000000001"
+	barcode2 := "4600000000002"
+
+	reception := model.Reception{
+		ID:              receptionID1,
+		PVZID:           ID1,
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      now,
+	}
+	inputs := []model.ProductInput{
+		{Category: model.ProductCategoryShoes, Barcode: &barcode1},
+		{Category: model.ProductCategoryElectronics},
+	}
+	products := []model.Product{
+		{
+			ID:          productID1,
+			ReceptionID: receptionID1,
+			Category:    model.ProductCategoryShoes,
+			AddedAt:     now,
+			Barcode:     &barcode1,
+		},
+		{
+			ID:          productID2,
+			ReceptionID: receptionID1,
+			Category:    model.ProductCategoryElectronics,
+			AddedAt:     now.Add(time.Microsecond),
+		},
+	}
+
+	inTransaction := func(m *mocks) {
+		m.trManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+				return do(ctx)
+			})
+		m.pvzLocker.EXPECT().
+			Lock(gomock.Any(), ID1).
+			Return(nil)
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		inputs  []model.ProductInput
+		wantErr error
+		wantRes []model.Product
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					ExistingBarcodes(gomock.Any(), receptionID1, []string{barcode1}).
+					Return(nil, nil)
+				m.productRepo.EXPECT().
+					CreateBatch(gomock.Any(), receptionID1, inputs).
+					Return(products, nil)
+				m.auditWriter.EXPECT().
+					WriteBatch(gomock.Any(), []model.AuditEvent{
+						{
+							UserID:      userID1,
+							Action:      model.AuditActionProductAdded,
+							PVZID:       ID1,
+							ReceptionID: &receptionID1,
+							ProductID:   &productID1,
+						},
+						{
+							UserID:      userID1,
+							Action:      model.AuditActionProductAdded,
+							PVZID:       ID1,
+							ReceptionID: &receptionID1,
+							ProductID:   &productID2,
+						},
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					AddBatch(gomock.Any(), []model.OutboxEvent{
+						model.NewProductOutboxEvent(model.OutboxEventProductAdded, reception, products[0]),
+						model.NewProductOutboxEvent(model.OutboxEventProductAdded, reception, products[1]),
+					}).
+					Return(nil)
+				m.metric.EXPECT().ProductAddedCountAdd(2)
+			},
+			inputs:  inputs,
+			wantErr: nil,
+			wantRes: products,
+		},
+		{
+			name: "success.without_barcodes",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					CreateBatch(gomock.Any(), receptionID1, inputs[1:]).
+					Return(products[1:], nil)
+				m.auditWriter.EXPECT().
+					WriteBatch(gomock.Any(), gomock.Len(1)).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					AddBatch(gomock.Any(), gomock.Len(1)).
+					Return(nil)
+				m.metric.EXPECT().ProductAddedCountAdd(1)
+			},
+			inputs:  inputs[1:],
+			wantErr: nil,
+			wantRes: products[1:],
+		},
+		{
+			name:    "success.empty",
+			prepare: func(m *mocks) {},
+			inputs:  nil,
+			wantErr: nil,
+			wantRes: nil,
+		},
+		{
+			name:    "businessError.RepeatedBarcode",
+			prepare: func(m *mocks) {},
+			inputs: []model.ProductInput{
+				{Category: model.ProductCategoryShoes, Barcode: &barcode1},
+				{Category: model.ProductCategoryShoes, Barcode: &barcode2},
+				{Category: model.ProductCategoryClothes, Barcode: &barcode1},
+			},
+			wantErr: model.ErrProductAlreadyScanned,
+			wantRes: nil,
+		},
+		{
+			name: "businessError.ErrProductAlreadyScanned",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					ExistingBarcodes(gomock.Any(), receptionID1, []string{barcode1}).
+					Return([]string{barcode1}, nil)
+			},
+			inputs:  inputs,
+			wantErr: model.ErrProductAlreadyScanned,
+			wantRes: nil,
+		},
+		{
+			name: "businessError.ErrReceptionNotFound",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+			},
+			inputs:  inputs,
+			wantErr: model.ErrReceptionNotFound,
+			wantRes: nil,
+		},
+		{
+			name: "error.Lock",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(assert.AnError)
+			},
+			inputs:  inputs,
+			wantErr: assert.AnError,
+			wantRes: nil,
+		},
+		{
+			name: "error.ExistingBarcodes",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					ExistingBarcodes(gomock.Any(), receptionID1, []string{barcode1}).
+					Return(nil, assert.AnError)
+			},
+			inputs:  inputs,
+			wantErr: assert.AnError,
+			wantRes: nil,
+		},
+		{
+			name: "error.CreateBatch",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					ExistingBarcodes(gomock.Any(), receptionID1, []string{barcode1}).
+					Return(nil, nil)
+				m.productRepo.EXPECT().
+					CreateBatch(gomock.Any(), receptionID1, inputs).
+					Return(nil, assert.AnError)
+			},
+			inputs:  inputs,
+			wantErr: assert.AnError,
+			wantRes: nil,
+		},
+		{
+			name: "error.auditWriter.WriteBatch",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					ExistingBarcodes(gomock.Any(), receptionID1, []string{barcode1}).
+					Return(nil, nil)
+				m.productRepo.EXPECT().
+					CreateBatch(gomock.Any(), receptionID1, inputs).
+					Return(products, nil)
+				m.auditWriter.EXPECT().
+					WriteBatch(gomock.Any(), gomock.Len(2)).
+					Return(assert.AnError)
+			},
+			inputs:  inputs,
+			wantErr: assert.AnError,
+			wantRes: nil,
+		},
+		{
+			name: "error.outboxWriter.AddBatch",
+			prepare: func(m *mocks) {
+				inTransaction(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					ExistingBarcodes(gomock.Any(), receptionID1, []string{barcode1}).
+					Return(nil, nil)
+				m.productRepo.EXPECT().
+					CreateBatch(gomock.Any(), receptionID1, inputs).
+					Return(products, nil)
+				m.auditWriter.EXPECT().
+					WriteBatch(gomock.Any(), gomock.Len(2)).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					AddBatch(gomock.Any(), gomock.Len(2)).
+					Return(assert.AnError)
+			},
+			inputs:  inputs,
+			wantErr: assert.AnError,
+			wantRes: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:     NewMocktrManager(ctrl),
+				receptionRepo: NewMockreceptionRepo(ctrl),
+				productRepo:   NewMockproductRepo(ctrl),
+				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
+				metric:        NewMockmetrics(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.productRepo, m.auditWriter, m.outboxWriter, m.metric)
+			require.NoError(t, err)
+
+			res, err := uc.AddProducts(context.Background(), ID1, tc.inputs, model.Actor{UserID: userID1})
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
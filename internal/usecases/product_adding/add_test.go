@@ -15,37 +15,49 @@ import (
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockmetrics(ctrl))
+		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.second_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.third_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockproductRepo(ctrl), NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.fourth_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil, NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil, NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.fifth_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), nil)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), nil, NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.sixth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), nil, NewMockmetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.seventh_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), nil)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -57,17 +69,22 @@ func TestUseCase_AddProduct(t *testing.T) {
 		receptionRepo *MockreceptionRepo
 		productRepo   *MockproductRepo
 		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
 		metric        *Mockmetrics
 	}
 	type args struct {
 		pvzID    model.PVZID
 		category model.ProductCategory
+		barcode  *string
 	}
 
 	ID1 := model.NewPVZID()
 	productID := model.NewProductID()
 	receptionID1 := model.NewReceptionID()
+	userID1 := model.NewUserID()
 	now := time.Now()
+	barcode := "4600000000001"
 
 	testCases := []struct {
 		name    string
@@ -96,13 +113,35 @@ func TestUseCase_AddProduct(t *testing.T) {
 						ReceptedAt:      now,
 					}, nil)
 				m.productRepo.EXPECT().
-					Create(gomock.Any(), receptionID1, model.ProductCategoryElectronics).
+					Create(gomock.Any(), receptionID1, model.ProductCategoryElectronics, nil).
 					Return(model.Product{
 						ID:          productID,
 						ReceptionID: receptionID1,
 						Category:    model.ProductCategoryElectronics,
 						AddedAt:     now,
 					}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionProductAdded,
+						PVZID:       ID1,
+						ReceptionID: &receptionID1,
+						ProductID:   &productID,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewProductOutboxEvent(model.OutboxEventProductAdded, model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, model.Product{
+						ID:          productID,
+						ReceptionID: receptionID1,
+						Category:    model.ProductCategoryElectronics,
+						AddedAt:     now,
+					})).
+					Return(nil)
 				m.metric.EXPECT().ProductAddedCountInc()
 			},
 			args: args{
@@ -180,6 +219,138 @@ func TestUseCase_AddProduct(t *testing.T) {
 			wantErr: assert.AnError,
 			wantRes: model.Product{},
 		},
+		{
+			name: "success.barcode",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					ExistsByBarcode(gomock.Any(), receptionID1, barcode).
+					Return(false, nil)
+				m.productRepo.EXPECT().
+					Create(gomock.Any(), receptionID1, model.ProductCategoryShoes, &barcode).
+					Return(model.Product{
+						ID:          productID,
+						ReceptionID: receptionID1,
+						Category:    model.ProductCategoryShoes,
+						AddedAt:     now,
+						Barcode:     &barcode,
+					}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionProductAdded,
+						PVZID:       ID1,
+						ReceptionID: &receptionID1,
+						ProductID:   &productID,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewProductOutboxEvent(model.OutboxEventProductAdded, model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, model.Product{
+						ID:          productID,
+						ReceptionID: receptionID1,
+						Category:    model.ProductCategoryShoes,
+						AddedAt:     now,
+						Barcode:     &barcode,
+					})).
+					Return(nil)
+				m.metric.EXPECT().ProductAddedCountInc()
+			},
+			args: args{
+				pvzID:    ID1,
+				category: model.ProductCategoryShoes,
+				barcode:  &barcode,
+			},
+			wantErr: nil,
+			wantRes: model.Product{
+				ID:          productID,
+				ReceptionID: receptionID1,
+				Category:    model.ProductCategoryShoes,
+				AddedAt:     now,
+				Barcode:     &barcode,
+			},
+		},
+		{
+			name: "businessError.ErrProductAlreadyScanned",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					ExistsByBarcode(gomock.Any(), receptionID1, barcode).
+					Return(true, nil)
+			},
+			args: args{
+				pvzID:    ID1,
+				category: model.ProductCategoryShoes,
+				barcode:  &barcode,
+			},
+			wantErr: model.ErrProductAlreadyScanned,
+			wantRes: model.Product{},
+		},
+		{
+			name: "error.ExistsByBarcode",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					ExistsByBarcode(gomock.Any(), receptionID1, barcode).
+					Return(false, assert.AnError)
+			},
+			args: args{
+				pvzID:    ID1,
+				category: model.ProductCategoryShoes,
+				barcode:  &barcode,
+			},
+			wantErr: assert.AnError,
+			wantRes: model.Product{},
+		},
 		{
 			name: "error.Create",
 			prepare: func(m *mocks) {
@@ -200,7 +371,7 @@ func TestUseCase_AddProduct(t *testing.T) {
 						ReceptedAt:      now,
 					}, nil)
 				m.productRepo.EXPECT().
-					Create(gomock.Any(), receptionID1, model.ProductCategoryElectronics).
+					Create(gomock.Any(), receptionID1, model.ProductCategoryElectronics, nil).
 					Return(model.Product{}, assert.AnError)
 			},
 			args: args{
@@ -210,6 +381,75 @@ func TestUseCase_AddProduct(t *testing.T) {
 			wantErr: assert.AnError,
 			wantRes: model.Product{},
 		},
+		{
+			name: "error.auditWriter.Write",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					Create(gomock.Any(), receptionID1, model.ProductCategoryElectronics, nil).
+					Return(model.Product{ID: productID, ReceptionID: receptionID1}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID:    ID1,
+				category: model.ProductCategoryElectronics,
+			},
+			wantErr: assert.AnError,
+			wantRes: model.Product{},
+		},
+		{
+			name: "error.outboxWriter.Add",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					Create(gomock.Any(), receptionID1, model.ProductCategoryElectronics, nil).
+					Return(model.Product{ID: productID, ReceptionID: receptionID1}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID:    ID1,
+				category: model.ProductCategoryElectronics,
+			},
+			wantErr: assert.AnError,
+			wantRes: model.Product{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -221,15 +461,17 @@ func TestUseCase_AddProduct(t *testing.T) {
 				receptionRepo: NewMockreceptionRepo(ctrl),
 				productRepo:   NewMockproductRepo(ctrl),
 				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
 				metric:        NewMockmetrics(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.productRepo, m.metric)
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.productRepo, m.auditWriter, m.outboxWriter, m.metric)
 			require.NoError(t, err)
 
-			product, err := uc.AddProduct(context.Background(), tc.args.pvzID, tc.args.category)
+			product, err := uc.AddProduct(context.Background(), tc.args.pvzID, tc.args.category, tc.args.barcode, model.Actor{UserID: userID1})
 			require.ErrorIs(t, err, tc.wantErr)
 			require.Equal(t, tc.wantRes, product)
 		})
@@ -16,13 +16,27 @@ type receptionRepo interface {
 }
 
 type productRepo interface {
-	Create(ctx context.Context, receptionID model.ReceptionID, category model.ProductCategory) (model.Product, error)
+	Create(ctx context.Context, receptionID model.ReceptionID, category model.ProductCategory, barcode *string) (model.Product, error)
+	ExistsByBarcode(ctx context.Context, receptionID model.ReceptionID, barcode string) (bool, error)
+	CreateBatch(ctx context.Context, receptionID model.ReceptionID, inputs []model.ProductInput) ([]model.Product, error)
+	ExistingBarcodes(ctx context.Context, receptionID model.ReceptionID, barcodes []string) ([]string, error)
 }
 
 type pvzLocker interface {
 	Lock(ctx context.Context, pvzID model.PVZID) error
 }
 
+type auditWriter interface {
+	Write(ctx context.Context, event model.AuditEvent) error
+	WriteBatch(ctx context.Context, events []model.AuditEvent) error
+}
+
+type outboxWriter interface {
+	Add(ctx context.Context, event model.OutboxEvent) error
+	AddBatch(ctx context.Context, events []model.OutboxEvent) error
+}
+
 type metrics interface {
 	ProductAddedCountInc()
+	ProductAddedCountAdd(count int)
 }
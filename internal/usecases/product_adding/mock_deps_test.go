@@ -167,18 +167,18 @@ func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockproductRepo) Create(ctx context.Context, receptionID model.ReceptionID, category model.ProductCategory) (model.Product, error) {
+func (m *MockproductRepo) Create(ctx context.Context, receptionID model.ReceptionID, category model.ProductCategory, barcode *string) (model.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, receptionID, category)
+	ret := m.ctrl.Call(m, "Create", ctx, receptionID, category, barcode)
 	ret0, _ := ret[0].(model.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockproductRepoMockRecorder) Create(ctx, receptionID, category any) *MockproductRepoCreateCall {
+func (mr *MockproductRepoMockRecorder) Create(ctx, receptionID, category, barcode any) *MockproductRepoCreateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockproductRepo)(nil).Create), ctx, receptionID, category)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockproductRepo)(nil).Create), ctx, receptionID, category, barcode)
 	return &MockproductRepoCreateCall{Call: call}
 }
 
@@ -194,13 +194,130 @@ func (c *MockproductRepoCreateCall) Return(arg0 model.Product, arg1 error) *Mock
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockproductRepoCreateCall) Do(f func(context.Context, model.ReceptionID, model.ProductCategory) (model.Product, error)) *MockproductRepoCreateCall {
+func (c *MockproductRepoCreateCall) Do(f func(context.Context, model.ReceptionID, model.ProductCategory, *string) (model.Product, error)) *MockproductRepoCreateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockproductRepoCreateCall) DoAndReturn(f func(context.Context, model.ReceptionID, model.ProductCategory) (model.Product, error)) *MockproductRepoCreateCall {
+func (c *MockproductRepoCreateCall) DoAndReturn(f func(context.Context, model.ReceptionID, model.ProductCategory, *string) (model.Product, error)) *MockproductRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateBatch mocks base method.
+func (m *MockproductRepo) CreateBatch(ctx context.Context, receptionID model.ReceptionID, inputs []model.ProductInput) ([]model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, receptionID, inputs)
+	ret0, _ := ret[0].([]model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockproductRepoMockRecorder) CreateBatch(ctx, receptionID, inputs any) *MockproductRepoCreateBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockproductRepo)(nil).CreateBatch), ctx, receptionID, inputs)
+	return &MockproductRepoCreateBatchCall{Call: call}
+}
+
+// MockproductRepoCreateBatchCall wrap *gomock.Call
+type MockproductRepoCreateBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoCreateBatchCall) Return(arg0 []model.Product, arg1 error) *MockproductRepoCreateBatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoCreateBatchCall) Do(f func(context.Context, model.ReceptionID, []model.ProductInput) ([]model.Product, error)) *MockproductRepoCreateBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoCreateBatchCall) DoAndReturn(f func(context.Context, model.ReceptionID, []model.ProductInput) ([]model.Product, error)) *MockproductRepoCreateBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExistingBarcodes mocks base method.
+func (m *MockproductRepo) ExistingBarcodes(ctx context.Context, receptionID model.ReceptionID, barcodes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistingBarcodes", ctx, receptionID, barcodes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistingBarcodes indicates an expected call of ExistingBarcodes.
+func (mr *MockproductRepoMockRecorder) ExistingBarcodes(ctx, receptionID, barcodes any) *MockproductRepoExistingBarcodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistingBarcodes", reflect.TypeOf((*MockproductRepo)(nil).ExistingBarcodes), ctx, receptionID, barcodes)
+	return &MockproductRepoExistingBarcodesCall{Call: call}
+}
+
+// MockproductRepoExistingBarcodesCall wrap *gomock.Call
+type MockproductRepoExistingBarcodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoExistingBarcodesCall) Return(arg0 []string, arg1 error) *MockproductRepoExistingBarcodesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoExistingBarcodesCall) Do(f func(context.Context, model.ReceptionID, []string) ([]string, error)) *MockproductRepoExistingBarcodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoExistingBarcodesCall) DoAndReturn(f func(context.Context, model.ReceptionID, []string) ([]string, error)) *MockproductRepoExistingBarcodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExistsByBarcode mocks base method.
+func (m *MockproductRepo) ExistsByBarcode(ctx context.Context, receptionID model.ReceptionID, barcode string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByBarcode", ctx, receptionID, barcode)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByBarcode indicates an expected call of ExistsByBarcode.
+func (mr *MockproductRepoMockRecorder) ExistsByBarcode(ctx, receptionID, barcode any) *MockproductRepoExistsByBarcodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByBarcode", reflect.TypeOf((*MockproductRepo)(nil).ExistsByBarcode), ctx, receptionID, barcode)
+	return &MockproductRepoExistsByBarcodeCall{Call: call}
+}
+
+// MockproductRepoExistsByBarcodeCall wrap *gomock.Call
+type MockproductRepoExistsByBarcodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoExistsByBarcodeCall) Return(arg0 bool, arg1 error) *MockproductRepoExistsByBarcodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoExistsByBarcodeCall) Do(f func(context.Context, model.ReceptionID, string) (bool, error)) *MockproductRepoExistsByBarcodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoExistsByBarcodeCall) DoAndReturn(f func(context.Context, model.ReceptionID, string) (bool, error)) *MockproductRepoExistsByBarcodeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -267,6 +384,206 @@ func (c *MockpvzLockerLockCall) DoAndReturn(f func(context.Context, model.PVZID)
 	return c
 }
 
+// MockauditWriter is a mock of auditWriter interface.
+type MockauditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockauditWriterMockRecorder is the mock recorder for MockauditWriter.
+type MockauditWriterMockRecorder struct {
+	mock *MockauditWriter
+}
+
+// NewMockauditWriter creates a new mock instance.
+func NewMockauditWriter(ctrl *gomock.Controller) *MockauditWriter {
+	mock := &MockauditWriter{ctrl: ctrl}
+	mock.recorder = &MockauditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditWriter) EXPECT() *MockauditWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockauditWriter) Write(ctx context.Context, event model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditWriterMockRecorder) Write(ctx, event any) *MockauditWriterWriteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditWriter)(nil).Write), ctx, event)
+	return &MockauditWriterWriteCall{Call: call}
+}
+
+// MockauditWriterWriteCall wrap *gomock.Call
+type MockauditWriterWriteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditWriterWriteCall) Return(arg0 error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditWriterWriteCall) Do(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditWriterWriteCall) DoAndReturn(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WriteBatch mocks base method.
+func (m *MockauditWriter) WriteBatch(ctx context.Context, events []model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteBatch", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteBatch indicates an expected call of WriteBatch.
+func (mr *MockauditWriterMockRecorder) WriteBatch(ctx, events any) *MockauditWriterWriteBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatch", reflect.TypeOf((*MockauditWriter)(nil).WriteBatch), ctx, events)
+	return &MockauditWriterWriteBatchCall{Call: call}
+}
+
+// MockauditWriterWriteBatchCall wrap *gomock.Call
+type MockauditWriterWriteBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditWriterWriteBatchCall) Return(arg0 error) *MockauditWriterWriteBatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditWriterWriteBatchCall) Do(f func(context.Context, []model.AuditEvent) error) *MockauditWriterWriteBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditWriterWriteBatchCall) DoAndReturn(f func(context.Context, []model.AuditEvent) error) *MockauditWriterWriteBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockoutboxWriter is a mock of outboxWriter interface.
+type MockoutboxWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxWriterMockRecorder is the mock recorder for MockoutboxWriter.
+type MockoutboxWriterMockRecorder struct {
+	mock *MockoutboxWriter
+}
+
+// NewMockoutboxWriter creates a new mock instance.
+func NewMockoutboxWriter(ctrl *gomock.Controller) *MockoutboxWriter {
+	mock := &MockoutboxWriter{ctrl: ctrl}
+	mock.recorder = &MockoutboxWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxWriter) EXPECT() *MockoutboxWriterMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockoutboxWriter) Add(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockoutboxWriterMockRecorder) Add(ctx, event any) *MockoutboxWriterAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockoutboxWriter)(nil).Add), ctx, event)
+	return &MockoutboxWriterAddCall{Call: call}
+}
+
+// MockoutboxWriterAddCall wrap *gomock.Call
+type MockoutboxWriterAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxWriterAddCall) Return(arg0 error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxWriterAddCall) Do(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxWriterAddCall) DoAndReturn(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddBatch mocks base method.
+func (m *MockoutboxWriter) AddBatch(ctx context.Context, events []model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBatch", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBatch indicates an expected call of AddBatch.
+func (mr *MockoutboxWriterMockRecorder) AddBatch(ctx, events any) *MockoutboxWriterAddBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBatch", reflect.TypeOf((*MockoutboxWriter)(nil).AddBatch), ctx, events)
+	return &MockoutboxWriterAddBatchCall{Call: call}
+}
+
+// MockoutboxWriterAddBatchCall wrap *gomock.Call
+type MockoutboxWriterAddBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxWriterAddBatchCall) Return(arg0 error) *MockoutboxWriterAddBatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxWriterAddBatchCall) Do(f func(context.Context, []model.OutboxEvent) error) *MockoutboxWriterAddBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxWriterAddBatchCall) DoAndReturn(f func(context.Context, []model.OutboxEvent) error) *MockoutboxWriterAddBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Mockmetrics is a mock of metrics interface.
 type Mockmetrics struct {
 	ctrl     *gomock.Controller
@@ -291,6 +608,42 @@ func (m *Mockmetrics) EXPECT() *MockmetricsMockRecorder {
 	return m.recorder
 }
 
+// ProductAddedCountAdd mocks base method.
+func (m *Mockmetrics) ProductAddedCountAdd(count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProductAddedCountAdd", count)
+}
+
+// ProductAddedCountAdd indicates an expected call of ProductAddedCountAdd.
+func (mr *MockmetricsMockRecorder) ProductAddedCountAdd(count any) *MockmetricsProductAddedCountAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductAddedCountAdd", reflect.TypeOf((*Mockmetrics)(nil).ProductAddedCountAdd), count)
+	return &MockmetricsProductAddedCountAddCall{Call: call}
+}
+
+// MockmetricsProductAddedCountAddCall wrap *gomock.Call
+type MockmetricsProductAddedCountAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockmetricsProductAddedCountAddCall) Return() *MockmetricsProductAddedCountAddCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockmetricsProductAddedCountAddCall) Do(f func(int)) *MockmetricsProductAddedCountAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockmetricsProductAddedCountAddCall) DoAndReturn(f func(int)) *MockmetricsProductAddedCountAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ProductAddedCountInc mocks base method.
 func (m *Mockmetrics) ProductAddedCountInc() {
 	m.ctrl.T.Helper()
@@ -13,12 +13,23 @@ type trManager interface {
 
 type receptionRepo interface {
 	GetInProgress(ctx context.Context, pvzID model.PVZID) (model.Reception, error)
+	GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error)
 }
 
 type productRepo interface {
-	RemoveLast(ctx context.Context, receptionID model.ReceptionID) error
+	RemoveLast(ctx context.Context, receptionID model.ReceptionID) (model.Product, error)
+	GetByID(ctx context.Context, productID model.ProductID) (model.Product, error)
+	Remove(ctx context.Context, productID model.ProductID) error
 }
 
 type pvzLocker interface {
 	Lock(ctx context.Context, pvzID model.PVZID) error
 }
+
+type auditWriter interface {
+	Write(ctx context.Context, event model.AuditEvent) error
+}
+
+type outboxWriter interface {
+	Add(ctx context.Context, event model.OutboxEvent) error
+}
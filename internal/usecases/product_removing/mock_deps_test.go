@@ -103,6 +103,45 @@ func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
 	return m.recorder
 }
 
+// GetByID mocks base method.
+func (m *MockreceptionRepo) GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, receptionID)
+	ret0, _ := ret[0].(model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockreceptionRepoMockRecorder) GetByID(ctx, receptionID any) *MockreceptionRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockreceptionRepo)(nil).GetByID), ctx, receptionID)
+	return &MockreceptionRepoGetByIDCall{Call: call}
+}
+
+// MockreceptionRepoGetByIDCall wrap *gomock.Call
+type MockreceptionRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoGetByIDCall) Return(arg0 model.Reception, arg1 error) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoGetByIDCall) Do(f func(context.Context, model.ReceptionID) (model.Reception, error)) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoGetByIDCall) DoAndReturn(f func(context.Context, model.ReceptionID) (model.Reception, error)) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetInProgress mocks base method.
 func (m *MockreceptionRepo) GetInProgress(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
 	m.ctrl.T.Helper()
@@ -166,14 +205,92 @@ func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
 	return m.recorder
 }
 
-// RemoveLast mocks base method.
-func (m *MockproductRepo) RemoveLast(ctx context.Context, receptionID model.ReceptionID) error {
+// GetByID mocks base method.
+func (m *MockproductRepo) GetByID(ctx context.Context, productID model.ProductID) (model.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveLast", ctx, receptionID)
+	ret := m.ctrl.Call(m, "GetByID", ctx, productID)
+	ret0, _ := ret[0].(model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockproductRepoMockRecorder) GetByID(ctx, productID any) *MockproductRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockproductRepo)(nil).GetByID), ctx, productID)
+	return &MockproductRepoGetByIDCall{Call: call}
+}
+
+// MockproductRepoGetByIDCall wrap *gomock.Call
+type MockproductRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoGetByIDCall) Return(arg0 model.Product, arg1 error) *MockproductRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoGetByIDCall) Do(f func(context.Context, model.ProductID) (model.Product, error)) *MockproductRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoGetByIDCall) DoAndReturn(f func(context.Context, model.ProductID) (model.Product, error)) *MockproductRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Remove mocks base method.
+func (m *MockproductRepo) Remove(ctx context.Context, productID model.ProductID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, productID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Remove indicates an expected call of Remove.
+func (mr *MockproductRepoMockRecorder) Remove(ctx, productID any) *MockproductRepoRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockproductRepo)(nil).Remove), ctx, productID)
+	return &MockproductRepoRemoveCall{Call: call}
+}
+
+// MockproductRepoRemoveCall wrap *gomock.Call
+type MockproductRepoRemoveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoRemoveCall) Return(arg0 error) *MockproductRepoRemoveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoRemoveCall) Do(f func(context.Context, model.ProductID) error) *MockproductRepoRemoveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoRemoveCall) DoAndReturn(f func(context.Context, model.ProductID) error) *MockproductRepoRemoveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveLast mocks base method.
+func (m *MockproductRepo) RemoveLast(ctx context.Context, receptionID model.ReceptionID) (model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLast", ctx, receptionID)
+	ret0, _ := ret[0].(model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // RemoveLast indicates an expected call of RemoveLast.
 func (mr *MockproductRepoMockRecorder) RemoveLast(ctx, receptionID any) *MockproductRepoRemoveLastCall {
 	mr.mock.ctrl.T.Helper()
@@ -187,19 +304,19 @@ type MockproductRepoRemoveLastCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockproductRepoRemoveLastCall) Return(arg0 error) *MockproductRepoRemoveLastCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockproductRepoRemoveLastCall) Return(arg0 model.Product, arg1 error) *MockproductRepoRemoveLastCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockproductRepoRemoveLastCall) Do(f func(context.Context, model.ReceptionID) error) *MockproductRepoRemoveLastCall {
+func (c *MockproductRepoRemoveLastCall) Do(f func(context.Context, model.ReceptionID) (model.Product, error)) *MockproductRepoRemoveLastCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockproductRepoRemoveLastCall) DoAndReturn(f func(context.Context, model.ReceptionID) error) *MockproductRepoRemoveLastCall {
+func (c *MockproductRepoRemoveLastCall) DoAndReturn(f func(context.Context, model.ReceptionID) (model.Product, error)) *MockproductRepoRemoveLastCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -265,3 +382,127 @@ func (c *MockpvzLockerLockCall) DoAndReturn(f func(context.Context, model.PVZID)
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockauditWriter is a mock of auditWriter interface.
+type MockauditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockauditWriterMockRecorder is the mock recorder for MockauditWriter.
+type MockauditWriterMockRecorder struct {
+	mock *MockauditWriter
+}
+
+// NewMockauditWriter creates a new mock instance.
+func NewMockauditWriter(ctrl *gomock.Controller) *MockauditWriter {
+	mock := &MockauditWriter{ctrl: ctrl}
+	mock.recorder = &MockauditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditWriter) EXPECT() *MockauditWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockauditWriter) Write(ctx context.Context, event model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditWriterMockRecorder) Write(ctx, event any) *MockauditWriterWriteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditWriter)(nil).Write), ctx, event)
+	return &MockauditWriterWriteCall{Call: call}
+}
+
+// MockauditWriterWriteCall wrap *gomock.Call
+type MockauditWriterWriteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditWriterWriteCall) Return(arg0 error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditWriterWriteCall) Do(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditWriterWriteCall) DoAndReturn(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockoutboxWriter is a mock of outboxWriter interface.
+type MockoutboxWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxWriterMockRecorder is the mock recorder for MockoutboxWriter.
+type MockoutboxWriterMockRecorder struct {
+	mock *MockoutboxWriter
+}
+
+// NewMockoutboxWriter creates a new mock instance.
+func NewMockoutboxWriter(ctrl *gomock.Controller) *MockoutboxWriter {
+	mock := &MockoutboxWriter{ctrl: ctrl}
+	mock.recorder = &MockoutboxWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxWriter) EXPECT() *MockoutboxWriterMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockoutboxWriter) Add(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockoutboxWriterMockRecorder) Add(ctx, event any) *MockoutboxWriterAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockoutboxWriter)(nil).Add), ctx, event)
+	return &MockoutboxWriterAddCall{Call: call}
+}
+
+// MockoutboxWriterAddCall wrap *gomock.Call
+type MockoutboxWriterAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxWriterAddCall) Return(arg0 error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxWriterAddCall) Do(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxWriterAddCall) DoAndReturn(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -13,9 +13,11 @@ type UseCase struct {
 	receptionRepo receptionRepo
 	productRepo   productRepo
 	pvzLocker     pvzLocker
+	auditWriter   auditWriter
+	outboxWriter  outboxWriter
 }
 
-func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, productRepo productRepo) (*UseCase, error) {
+func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, productRepo productRepo, auditWriter auditWriter, outboxWriter outboxWriter) (*UseCase, error) {
 	if trManager == nil {
 		return nil, errors.New("trManager is nil")
 	}
@@ -28,15 +30,23 @@ func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker,
 	if productRepo == nil {
 		return nil, errors.New("productRepo is nil")
 	}
+	if auditWriter == nil {
+		return nil, errors.New("auditWriter is nil")
+	}
+	if outboxWriter == nil {
+		return nil, errors.New("outboxWriter is nil")
+	}
 	return &UseCase{
 		trManager:     trManager,
 		receptionRepo: receptionRepo,
 		productRepo:   productRepo,
 		pvzLocker:     pvzLocker,
+		auditWriter:   auditWriter,
+		outboxWriter:  outboxWriter,
 	}, nil
 }
 
-func (uc *UseCase) RemoveLastProduct(ctx context.Context, pvzID model.PVZID) error {
+func (uc *UseCase) RemoveLastProduct(ctx context.Context, pvzID model.PVZID, actor model.Actor) error {
 	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
 		err = uc.pvzLocker.Lock(ctx, pvzID)
 		if err != nil {
@@ -48,11 +58,93 @@ func (uc *UseCase) RemoveLastProduct(ctx context.Context, pvzID model.PVZID) err
 			return fmt.Errorf("receptionRepo.GetInProgress: %w", err)
 		}
 
-		err = uc.productRepo.RemoveLast(ctx, reception.ID)
+		product, err := uc.productRepo.RemoveLast(ctx, reception.ID)
 		if err != nil {
 			return fmt.Errorf("productRepo.RemoveLast: %w", err)
 		}
 
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:      actor.UserID,
+			APIKeyID:    actor.APIKeyID,
+			Action:      model.AuditActionProductRemoved,
+			PVZID:       pvzID,
+			ReceptionID: &reception.ID,
+			ProductID:   &product.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		err = uc.outboxWriter.Add(ctx, model.NewProductOutboxEvent(model.OutboxEventProductRemoved, reception, product))
+		if err != nil {
+			return fmt.Errorf("outboxWriter.Add: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveProduct removes the product by ID if its reception is still in progress
+// and the user has access to the pvz of the reception.
+func (uc *UseCase) RemoveProduct(ctx context.Context, productID model.ProductID, actor model.Actor,
+	access model.PVZAccess,
+) error {
+	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
+		product, err := uc.productRepo.GetByID(ctx, productID)
+		if err != nil {
+			return fmt.Errorf("productRepo.GetByID: %w", err)
+		}
+
+		reception, err := uc.receptionRepo.GetByID(ctx, product.ReceptionID)
+		if err != nil {
+			return fmt.Errorf("receptionRepo.GetByID: %w", err)
+		}
+
+		if !access.Allows(reception.PVZID) {
+			return model.ErrPVZAccessDenied
+		}
+
+		err = uc.pvzLocker.Lock(ctx, reception.PVZID)
+		if err != nil {
+			return fmt.Errorf("pvzLocker.Lock: %w", err)
+		}
+
+		// the reception could be closed while waiting for the lock
+		inProgress, err := uc.receptionRepo.GetInProgress(ctx, reception.PVZID)
+		if err != nil && !errors.Is(err, model.ErrReceptionNotFound) {
+			return fmt.Errorf("receptionRepo.GetInProgress: %w", err)
+		}
+		if err != nil || inProgress.ID != product.ReceptionID {
+			return model.ErrReceptionClosed
+		}
+
+		err = uc.productRepo.Remove(ctx, productID)
+		if err != nil {
+			return fmt.Errorf("productRepo.Remove: %w", err)
+		}
+
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:      actor.UserID,
+			APIKeyID:    actor.APIKeyID,
+			Action:      model.AuditActionProductRemoved,
+			PVZID:       reception.PVZID,
+			ReceptionID: &product.ReceptionID,
+			ProductID:   &productID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		err = uc.outboxWriter.Add(ctx, model.NewProductOutboxEvent(model.OutboxEventProductRemoved, reception, product))
+		if err != nil {
+			return fmt.Errorf("outboxWriter.Add: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -15,31 +15,43 @@ import (
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl))
+		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.second_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl))
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.third_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockproductRepo(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.fourth_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil, NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), nil, NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.sixth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockproductRepo(ctrl), NewMockauditWriter(ctrl), nil)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -51,6 +63,8 @@ func TestUseCase_RemoveLastProduct(t *testing.T) {
 		receptionRepo *MockreceptionRepo
 		productRepo   *MockproductRepo
 		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
 	}
 	type args struct {
 		pvzID model.PVZID
@@ -58,8 +72,17 @@ func TestUseCase_RemoveLastProduct(t *testing.T) {
 
 	ID1 := model.NewPVZID()
 	receptionID1 := model.NewReceptionID()
+	productID1 := model.NewProductID()
+	userID1 := model.NewUserID()
 	now := time.Now()
 
+	product1 := model.Product{
+		ID:          productID1,
+		ReceptionID: receptionID1,
+		Category:    model.ProductCategoryElectronics,
+		AddedAt:     now,
+	}
+
 	testCases := []struct {
 		name    string
 		prepare func(m *mocks)
@@ -87,6 +110,23 @@ func TestUseCase_RemoveLastProduct(t *testing.T) {
 					}, nil)
 				m.productRepo.EXPECT().
 					RemoveLast(gomock.Any(), receptionID1).
+					Return(product1, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionProductRemoved,
+						PVZID:       ID1,
+						ReceptionID: &receptionID1,
+						ProductID:   &productID1,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewProductOutboxEvent(model.OutboxEventProductRemoved, model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, product1)).
 					Return(nil)
 			},
 			args: args{
@@ -135,7 +175,7 @@ func TestUseCase_RemoveLastProduct(t *testing.T) {
 					}, nil)
 				m.productRepo.EXPECT().
 					RemoveLast(gomock.Any(), receptionID1).
-					Return(model.ErrProductNotFound)
+					Return(model.Product{}, model.ErrProductNotFound)
 			},
 			args: args{
 				pvzID: ID1,
@@ -200,6 +240,71 @@ func TestUseCase_RemoveLastProduct(t *testing.T) {
 					}, nil)
 				m.productRepo.EXPECT().
 					RemoveLast(gomock.Any(), receptionID1).
+					Return(model.Product{}, assert.AnError)
+			},
+			args: args{
+				pvzID: ID1,
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.auditWriter.Write",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					RemoveLast(gomock.Any(), receptionID1).
+					Return(product1, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID: ID1,
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.outboxWriter.Add",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.productRepo.EXPECT().
+					RemoveLast(gomock.Any(), receptionID1).
+					Return(product1, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
 					Return(assert.AnError)
 			},
 			args: args{
@@ -218,14 +323,284 @@ func TestUseCase_RemoveLastProduct(t *testing.T) {
 				receptionRepo: NewMockreceptionRepo(ctrl),
 				productRepo:   NewMockproductRepo(ctrl),
 				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.productRepo, m.auditWriter, m.outboxWriter)
+			require.NoError(t, err)
+
+			err = uc.RemoveLastProduct(context.Background(), tc.args.pvzID, model.Actor{UserID: userID1})
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_RemoveProduct(t *testing.T) {
+	type mocks struct {
+		trManager     *MocktrManager
+		receptionRepo *MockreceptionRepo
+		productRepo   *MockproductRepo
+		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
+	}
+
+	pvzID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+	productID1 := model.NewProductID()
+	userID1 := model.NewUserID()
+	now := time.Now()
+
+	product := model.Product{
+		ID:          productID1,
+		ReceptionID: receptionID1,
+		Category:    model.ProductCategoryShoes,
+		AddedAt:     now,
+	}
+	reception := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      now,
+	}
+
+	prepareLocked := func(m *mocks) {
+		m.trManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+				return do(ctx)
+			})
+		m.productRepo.EXPECT().
+			GetByID(gomock.Any(), productID1).
+			Return(product, nil)
+		m.receptionRepo.EXPECT().
+			GetByID(gomock.Any(), receptionID1).
+			Return(reception, nil)
+		m.pvzLocker.EXPECT().
+			Lock(gomock.Any(), pvzID1).
+			Return(nil)
+	}
+
+	access := model.PVZAccess{PVZIDs: []model.PVZID{pvzID1}}
+
+	testCases := []struct {
+		name    string
+		access  model.PVZAccess
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name:   "success",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					Remove(gomock.Any(), productID1).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionProductRemoved,
+						PVZID:       pvzID1,
+						ReceptionID: &receptionID1,
+						ProductID:   &productID1,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewProductOutboxEvent(model.OutboxEventProductRemoved, reception, product)).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "businessError.ErrPVZAccessDenied",
+			access: model.PVZAccess{PVZIDs: []model.PVZID{model.NewPVZID()}},
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.productRepo.EXPECT().
+					GetByID(gomock.Any(), productID1).
+					Return(product, nil)
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(reception, nil)
+			},
+			wantErr: model.ErrPVZAccessDenied,
+		},
+		{
+			name:   "businessError.ErrProductNotFound",
+			access: access,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.productRepo.EXPECT().
+					GetByID(gomock.Any(), productID1).
+					Return(model.Product{}, model.ErrProductNotFound)
+			},
+			wantErr: model.ErrProductNotFound,
+		},
+		{
+			name:   "businessError.ErrReceptionClosed.no_reception_in_progress",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+			},
+			wantErr: model.ErrReceptionClosed,
+		},
+		{
+			name:   "businessError.ErrReceptionClosed.other_reception_in_progress",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{
+						ID:              receptionID2,
+						PVZID:           pvzID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+			},
+			wantErr: model.ErrReceptionClosed,
+		},
+		{
+			name:   "error.receptionRepo.GetByID",
+			access: access,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.productRepo.EXPECT().
+					GetByID(gomock.Any(), productID1).
+					Return(product, nil)
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(model.Reception{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:   "error.pvzLocker.Lock",
+			access: access,
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.productRepo.EXPECT().
+					GetByID(gomock.Any(), productID1).
+					Return(product, nil)
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(reception, nil)
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), pvzID1).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:   "error.receptionRepo.GetInProgress",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:   "error.productRepo.Remove",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					Remove(gomock.Any(), productID1).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:   "error.auditWriter.Write",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					Remove(gomock.Any(), productID1).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:   "error.outboxWriter.Add",
+			access: access,
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(reception, nil)
+				m.productRepo.EXPECT().
+					Remove(gomock.Any(), productID1).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:     NewMocktrManager(ctrl),
+				receptionRepo: NewMockreceptionRepo(ctrl),
+				productRepo:   NewMockproductRepo(ctrl),
+				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.productRepo)
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.productRepo, m.auditWriter, m.outboxWriter)
 			require.NoError(t, err)
 
-			err = uc.RemoveLastProduct(context.Background(), tc.args.pvzID)
+			err = uc.RemoveProduct(context.Background(), productID1, model.Actor{UserID: userID1}, tc.access)
 			require.ErrorIs(t, err, tc.wantErr)
 		})
 	}
@@ -0,0 +1,65 @@
+package pvz_assigning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	pvzRepo        pvzRepo
+	userRepo       userRepo
+	assignmentRepo assignmentRepo
+}
+
+func New(pvzRepo pvzRepo, userRepo userRepo, assignmentRepo assignmentRepo) (*UseCase, error) {
+	if pvzRepo == nil {
+		return nil, errors.New("pvzRepo is nil")
+	}
+	if userRepo == nil {
+		return nil, errors.New("userRepo is nil")
+	}
+	if assignmentRepo == nil {
+		return nil, errors.New("assignmentRepo is nil")
+	}
+	return &UseCase{
+		pvzRepo:        pvzRepo,
+		userRepo:       userRepo,
+		assignmentRepo: assignmentRepo,
+	}, nil
+}
+
+// Assign lets the employee work at the pvz. The employee gets access with the next issued token.
+func (uc *UseCase) Assign(ctx context.Context, pvzID model.PVZID, userID, assignedBy model.UserID) error {
+	_, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return fmt.Errorf("pvzRepo.GetByID: %w", err)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("userRepo.GetByID: %w", err)
+	}
+	if user.UserRole != model.UserRoleEmployee {
+		return model.ErrUserNotEmployee
+	}
+
+	err = uc.assignmentRepo.Assign(ctx, userID, pvzID, assignedBy)
+	if err != nil {
+		return fmt.Errorf("assignmentRepo.Assign: %w", err)
+	}
+
+	return nil
+}
+
+// Unassign takes the pvz away from the employee. Tokens issued before keep the access until they expire.
+func (uc *UseCase) Unassign(ctx context.Context, pvzID model.PVZID, userID model.UserID) error {
+	err := uc.assignmentRepo.Unassign(ctx, userID, pvzID)
+	if err != nil {
+		return fmt.Errorf("assignmentRepo.Unassign: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package pvz_assigning
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzRepo(ctrl), NewMockuserRepo(ctrl), NewMockassignmentRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockuserRepo(ctrl), NewMockassignmentRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzRepo(ctrl), nil, NewMockassignmentRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzRepo(ctrl), NewMockuserRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_Assign(t *testing.T) {
+	type mocks struct {
+		pvzRepo        *MockpvzRepo
+		userRepo       *MockuserRepo
+		assignmentRepo *MockassignmentRepo
+	}
+
+	pvzID := model.NewPVZID()
+	userID := model.NewUserID()
+	moderatorID := model.NewUserID()
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(model.PVZ{ID: pvzID}, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(&model.User{UserID: userID, UserRole: model.UserRoleEmployee}, nil)
+				m.assignmentRepo.EXPECT().Assign(gomock.Any(), userID, pvzID, moderatorID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "businessError.PVZNotFound",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(model.PVZ{}, model.ErrPVZNotFound)
+			},
+			wantErr: model.ErrPVZNotFound,
+		},
+		{
+			name: "businessError.UserNotFound",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(model.PVZ{ID: pvzID}, nil)
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, model.ErrUserNotFound)
+			},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name: "businessError.UserNotEmployee",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(model.PVZ{ID: pvzID}, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(&model.User{UserID: userID, UserRole: model.UserRoleModerator}, nil)
+			},
+			wantErr: model.ErrUserNotEmployee,
+		},
+		{
+			name: "error.Assign",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().GetByID(gomock.Any(), pvzID).Return(model.PVZ{ID: pvzID}, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(&model.User{UserID: userID, UserRole: model.UserRoleEmployee}, nil)
+				m.assignmentRepo.EXPECT().Assign(gomock.Any(), userID, pvzID, moderatorID).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				pvzRepo:        NewMockpvzRepo(ctrl),
+				userRepo:       NewMockuserRepo(ctrl),
+				assignmentRepo: NewMockassignmentRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.pvzRepo, m.userRepo, m.assignmentRepo)
+			require.NoError(t, err)
+
+			err = uc.Assign(context.Background(), pvzID, userID, moderatorID)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_Unassign(t *testing.T) {
+	pvzID := model.NewPVZID()
+	userID := model.NewUserID()
+
+	testCases := []struct {
+		name    string
+		repoErr error
+	}{
+		{name: "success"},
+		{name: "businessError.AssignmentNotFound", repoErr: model.ErrPVZAssignmentNotFound},
+		{name: "error.Unassign", repoErr: assert.AnError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			assignmentRepo := NewMockassignmentRepo(ctrl)
+			assignmentRepo.EXPECT().Unassign(gomock.Any(), userID, pvzID).Return(tc.repoErr)
+
+			uc, err := New(NewMockpvzRepo(ctrl), NewMockuserRepo(ctrl), assignmentRepo)
+			require.NoError(t, err)
+
+			err = uc.Unassign(context.Background(), pvzID, userID)
+			require.ErrorIs(t, err, tc.repoErr)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package pvz_assigning
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzRepo interface {
+	GetByID(ctx context.Context, pvzID model.PVZID) (model.PVZ, error)
+}
+
+type userRepo interface {
+	GetByID(ctx context.Context, userID model.UserID) (*model.User, error)
+}
+
+type assignmentRepo interface {
+	Assign(ctx context.Context, userID model.UserID, pvzID model.PVZID, assignedBy model.UserID) error
+	Unassign(ctx context.Context, userID model.UserID, pvzID model.PVZID) error
+}
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package pvz_assigning -typed -destination mock_deps_test.go
+//
+
+// Package pvz_assigning is a generated GoMock package.
+package pvz_assigning
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpvzRepo is a mock of pvzRepo interface.
+type MockpvzRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzRepoMockRecorder is the mock recorder for MockpvzRepo.
+type MockpvzRepoMockRecorder struct {
+	mock *MockpvzRepo
+}
+
+// NewMockpvzRepo creates a new mock instance.
+func NewMockpvzRepo(ctrl *gomock.Controller) *MockpvzRepo {
+	mock := &MockpvzRepo{ctrl: ctrl}
+	mock.recorder = &MockpvzRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzRepo) EXPECT() *MockpvzRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockpvzRepo) GetByID(ctx context.Context, pvzID model.PVZID) (model.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, pvzID)
+	ret0, _ := ret[0].(model.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockpvzRepoMockRecorder) GetByID(ctx, pvzID any) *MockpvzRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockpvzRepo)(nil).GetByID), ctx, pvzID)
+	return &MockpvzRepoGetByIDCall{Call: call}
+}
+
+// MockpvzRepoGetByIDCall wrap *gomock.Call
+type MockpvzRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzRepoGetByIDCall) Return(arg0 model.PVZ, arg1 error) *MockpvzRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzRepoGetByIDCall) Do(f func(context.Context, model.PVZID) (model.PVZ, error)) *MockpvzRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzRepoGetByIDCall) DoAndReturn(f func(context.Context, model.PVZID) (model.PVZ, error)) *MockpvzRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockuserRepo) GetByID(ctx context.Context, userID model.UserID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserRepoMockRecorder) GetByID(ctx, userID any) *MockuserRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserRepo)(nil).GetByID), ctx, userID)
+	return &MockuserRepoGetByIDCall{Call: call}
+}
+
+// MockuserRepoGetByIDCall wrap *gomock.Call
+type MockuserRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoGetByIDCall) Return(arg0 *model.User, arg1 error) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoGetByIDCall) Do(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoGetByIDCall) DoAndReturn(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockassignmentRepo is a mock of assignmentRepo interface.
+type MockassignmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockassignmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockassignmentRepoMockRecorder is the mock recorder for MockassignmentRepo.
+type MockassignmentRepoMockRecorder struct {
+	mock *MockassignmentRepo
+}
+
+// NewMockassignmentRepo creates a new mock instance.
+func NewMockassignmentRepo(ctrl *gomock.Controller) *MockassignmentRepo {
+	mock := &MockassignmentRepo{ctrl: ctrl}
+	mock.recorder = &MockassignmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockassignmentRepo) EXPECT() *MockassignmentRepoMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockassignmentRepo) Assign(ctx context.Context, userID model.UserID, pvzID model.PVZID, assignedBy model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, userID, pvzID, assignedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockassignmentRepoMockRecorder) Assign(ctx, userID, pvzID, assignedBy any) *MockassignmentRepoAssignCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockassignmentRepo)(nil).Assign), ctx, userID, pvzID, assignedBy)
+	return &MockassignmentRepoAssignCall{Call: call}
+}
+
+// MockassignmentRepoAssignCall wrap *gomock.Call
+type MockassignmentRepoAssignCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockassignmentRepoAssignCall) Return(arg0 error) *MockassignmentRepoAssignCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockassignmentRepoAssignCall) Do(f func(context.Context, model.UserID, model.PVZID, model.UserID) error) *MockassignmentRepoAssignCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockassignmentRepoAssignCall) DoAndReturn(f func(context.Context, model.UserID, model.PVZID, model.UserID) error) *MockassignmentRepoAssignCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Unassign mocks base method.
+func (m *MockassignmentRepo) Unassign(ctx context.Context, userID model.UserID, pvzID model.PVZID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, userID, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockassignmentRepoMockRecorder) Unassign(ctx, userID, pvzID any) *MockassignmentRepoUnassignCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockassignmentRepo)(nil).Unassign), ctx, userID, pvzID)
+	return &MockassignmentRepoUnassignCall{Call: call}
+}
+
+// MockassignmentRepoUnassignCall wrap *gomock.Call
+type MockassignmentRepoUnassignCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockassignmentRepoUnassignCall) Return(arg0 error) *MockassignmentRepoUnassignCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockassignmentRepoUnassignCall) Do(f func(context.Context, model.UserID, model.PVZID) error) *MockassignmentRepoUnassignCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockassignmentRepoUnassignCall) DoAndReturn(f func(context.Context, model.UserID, model.PVZID) error) *MockassignmentRepoUnassignCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
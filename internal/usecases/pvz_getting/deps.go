@@ -0,0 +1,21 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package pvz_getting
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzRepo interface {
+	GetByID(ctx context.Context, pvzID model.PVZID) (model.PVZ, error)
+}
+
+type receptionRepo interface {
+	GetInProgress(ctx context.Context, pvzID model.PVZID) (model.Reception, error)
+	SearchByPVZ(ctx context.Context, pvzID model.PVZID, offset, limit int64) ([]model.Reception, error)
+}
+
+type productRepo interface {
+	GetByReceptionIDs(ctx context.Context, receptionIDs []model.ReceptionID) ([]model.Product, error)
+}
@@ -0,0 +1,100 @@
+package pvz_getting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	pvzRepo       pvzRepo
+	receptionRepo receptionRepo
+	productRepo   productRepo
+}
+
+func New(
+	pvzRepo pvzRepo,
+	receptionRepo receptionRepo,
+	productRepo productRepo,
+) (*UseCase, error) {
+	if pvzRepo == nil {
+		return nil, errors.New("pvzRepo is nil")
+	}
+	if receptionRepo == nil {
+		return nil, errors.New("receptionRepo is nil")
+	}
+	if productRepo == nil {
+		return nil, errors.New("productRepo is nil")
+	}
+	return &UseCase{
+		pvzRepo:       pvzRepo,
+		receptionRepo: receptionRepo,
+		productRepo:   productRepo,
+	}, nil
+}
+
+func (uc *UseCase) GetPVZ(ctx context.Context, pvzID model.PVZID) (model.PVZDetails, error) {
+	pvz, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return model.PVZDetails{}, fmt.Errorf("pvzRepo.GetByID: %w", err)
+	}
+
+	res := model.PVZDetails{
+		PVZ: pvz,
+	}
+
+	reception, err := uc.receptionRepo.GetInProgress(ctx, pvzID)
+	if err != nil && !errors.Is(err, model.ErrReceptionNotFound) {
+		return model.PVZDetails{}, fmt.Errorf("receptionRepo.GetInProgress: %w", err)
+	}
+	if err == nil {
+		res.InProgressReception = &reception
+	}
+
+	return res, nil
+}
+
+// GetReceptions returns the page of pvz receptions with their products, the most recent reception first.
+func (uc *UseCase) GetReceptions(ctx context.Context, pvzID model.PVZID, page, limit int64) ([]model.ReceptionWithProducts, error) {
+	_, err := uc.pvzRepo.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, fmt.Errorf("pvzRepo.GetByID: %w", err)
+	}
+
+	offset := (page - 1) * limit
+	receptions, err := uc.receptionRepo.SearchByPVZ(ctx, pvzID, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("receptionRepo.SearchByPVZ: %w", err)
+	}
+
+	res := make([]model.ReceptionWithProducts, 0, len(receptions))
+	if len(receptions) == 0 {
+		return res, nil
+	}
+
+	receptionIDs := make([]model.ReceptionID, 0, len(receptions))
+	for _, reception := range receptions {
+		receptionIDs = append(receptionIDs, reception.ID)
+	}
+
+	products, err := uc.productRepo.GetByReceptionIDs(ctx, receptionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("productRepo.GetByReceptionIDs: %w", err)
+	}
+
+	productsByReception := make(map[model.ReceptionID][]model.Product, len(receptions))
+	for _, product := range products {
+		productsByReception[product.ReceptionID] = append(productsByReception[product.ReceptionID], product)
+	}
+
+	for _, reception := range receptions {
+		res = append(res, model.ReceptionWithProducts{
+			Reception: reception,
+			Products:  productsByReception[reception.ID],
+		})
+	}
+
+	return res, nil
+}
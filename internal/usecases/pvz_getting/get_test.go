@@ -0,0 +1,270 @@
+package pvz_getting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzRepo(ctrl), NewMockreceptionRepo(ctrl), NewMockproductRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockproductRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzRepo(ctrl), nil, NewMockproductRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockpvzRepo(ctrl), NewMockreceptionRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+type mocks struct {
+	pvzRepo       *MockpvzRepo
+	receptionRepo *MockreceptionRepo
+	productRepo   *MockproductRepo
+}
+
+func newUseCase(t *testing.T, prepare func(m *mocks)) *UseCase {
+	ctrl := gomock.NewController(t)
+
+	m := &mocks{
+		pvzRepo:       NewMockpvzRepo(ctrl),
+		receptionRepo: NewMockreceptionRepo(ctrl),
+		productRepo:   NewMockproductRepo(ctrl),
+	}
+
+	prepare(m)
+
+	uc, err := New(m.pvzRepo, m.receptionRepo, m.productRepo)
+	require.NoError(t, err)
+
+	return uc
+}
+
+func TestUseCase_GetPVZ(t *testing.T) {
+	pvzID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	now := time.Now()
+
+	pvz1 := model.PVZ{
+		ID:           pvzID1,
+		City:         "Москва",
+		RegisteredAt: now,
+	}
+	reception1 := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      now,
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+		wantRes model.PVZDetails
+	}{
+		{
+			name: "success.in_progress_reception",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(reception1, nil)
+			},
+			wantRes: model.PVZDetails{
+				PVZ:                 pvz1,
+				InProgressReception: &reception1,
+			},
+		},
+		{
+			name: "success.no_reception",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+			},
+			wantRes: model.PVZDetails{
+				PVZ: pvz1,
+			},
+		},
+		{
+			name: "businessError.ErrPVZNotFound",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(model.PVZ{}, model.ErrPVZNotFound)
+			},
+			wantErr: model.ErrPVZNotFound,
+		},
+		{
+			name: "error.GetInProgress",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			res, err := uc.GetPVZ(context.Background(), pvzID1)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_GetReceptions(t *testing.T) {
+	pvzID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+	productID1 := model.NewProductID()
+	now := time.Now()
+
+	pvz1 := model.PVZ{
+		ID:           pvzID1,
+		City:         "Москва",
+		RegisteredAt: now,
+	}
+	reception1 := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      now,
+	}
+	reception2 := model.Reception{
+		ID:              receptionID2,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      now.Add(-time.Hour),
+	}
+	product1 := model.Product{
+		ID:          productID1,
+		ReceptionID: receptionID2,
+		Category:    model.ProductCategoryElectronics,
+		AddedAt:     now.Add(-time.Minute),
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+		wantRes []model.ReceptionWithProducts
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZ(gomock.Any(), pvzID1, int64(10), int64(10)).
+					Return([]model.Reception{reception1, reception2}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{receptionID1, receptionID2}).
+					Return([]model.Product{product1}, nil)
+			},
+			wantRes: []model.ReceptionWithProducts{
+				{
+					Reception: reception1,
+				},
+				{
+					Reception: reception2,
+					Products:  []model.Product{product1},
+				},
+			},
+		},
+		{
+			name: "success.empty",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZ(gomock.Any(), pvzID1, int64(10), int64(10)).
+					Return([]model.Reception{}, nil)
+			},
+			wantRes: []model.ReceptionWithProducts{},
+		},
+		{
+			name: "businessError.ErrPVZNotFound",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(model.PVZ{}, model.ErrPVZNotFound)
+			},
+			wantErr: model.ErrPVZNotFound,
+		},
+		{
+			name: "error.SearchByPVZ",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZ(gomock.Any(), pvzID1, int64(10), int64(10)).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetByReceptionIDs",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					GetByID(gomock.Any(), pvzID1).
+					Return(pvz1, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZ(gomock.Any(), pvzID1, int64(10), int64(10)).
+					Return([]model.Reception{reception1}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{receptionID1}).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			res, err := uc.GetReceptions(context.Background(), pvzID1, 2, 10)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
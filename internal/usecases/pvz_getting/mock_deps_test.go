@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package pvz_getting -typed -destination mock_deps_test.go
+//
+
+// Package pvz_getting is a generated GoMock package.
+package pvz_getting
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockpvzRepo is a mock of pvzRepo interface.
+type MockpvzRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzRepoMockRecorder is the mock recorder for MockpvzRepo.
+type MockpvzRepoMockRecorder struct {
+	mock *MockpvzRepo
+}
+
+// NewMockpvzRepo creates a new mock instance.
+func NewMockpvzRepo(ctrl *gomock.Controller) *MockpvzRepo {
+	mock := &MockpvzRepo{ctrl: ctrl}
+	mock.recorder = &MockpvzRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzRepo) EXPECT() *MockpvzRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockpvzRepo) GetByID(ctx context.Context, pvzID model.PVZID) (model.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, pvzID)
+	ret0, _ := ret[0].(model.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockpvzRepoMockRecorder) GetByID(ctx, pvzID any) *MockpvzRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockpvzRepo)(nil).GetByID), ctx, pvzID)
+	return &MockpvzRepoGetByIDCall{Call: call}
+}
+
+// MockpvzRepoGetByIDCall wrap *gomock.Call
+type MockpvzRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzRepoGetByIDCall) Return(arg0 model.PVZ, arg1 error) *MockpvzRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzRepoGetByIDCall) Do(f func(context.Context, model.PVZID) (model.PVZ, error)) *MockpvzRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzRepoGetByIDCall) DoAndReturn(f func(context.Context, model.PVZID) (model.PVZ, error)) *MockpvzRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockreceptionRepo is a mock of receptionRepo interface.
+type MockreceptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreceptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockreceptionRepoMockRecorder is the mock recorder for MockreceptionRepo.
+type MockreceptionRepoMockRecorder struct {
+	mock *MockreceptionRepo
+}
+
+// NewMockreceptionRepo creates a new mock instance.
+func NewMockreceptionRepo(ctrl *gomock.Controller) *MockreceptionRepo {
+	mock := &MockreceptionRepo{ctrl: ctrl}
+	mock.recorder = &MockreceptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetInProgress mocks base method.
+func (m *MockreceptionRepo) GetInProgress(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInProgress", ctx, pvzID)
+	ret0, _ := ret[0].(model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInProgress indicates an expected call of GetInProgress.
+func (mr *MockreceptionRepoMockRecorder) GetInProgress(ctx, pvzID any) *MockreceptionRepoGetInProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInProgress", reflect.TypeOf((*MockreceptionRepo)(nil).GetInProgress), ctx, pvzID)
+	return &MockreceptionRepoGetInProgressCall{Call: call}
+}
+
+// MockreceptionRepoGetInProgressCall wrap *gomock.Call
+type MockreceptionRepoGetInProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoGetInProgressCall) Return(arg0 model.Reception, arg1 error) *MockreceptionRepoGetInProgressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoGetInProgressCall) Do(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionRepoGetInProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoGetInProgressCall) DoAndReturn(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionRepoGetInProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchByPVZ mocks base method.
+func (m *MockreceptionRepo) SearchByPVZ(ctx context.Context, pvzID model.PVZID, offset, limit int64) ([]model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByPVZ", ctx, pvzID, offset, limit)
+	ret0, _ := ret[0].([]model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByPVZ indicates an expected call of SearchByPVZ.
+func (mr *MockreceptionRepoMockRecorder) SearchByPVZ(ctx, pvzID, offset, limit any) *MockreceptionRepoSearchByPVZCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByPVZ", reflect.TypeOf((*MockreceptionRepo)(nil).SearchByPVZ), ctx, pvzID, offset, limit)
+	return &MockreceptionRepoSearchByPVZCall{Call: call}
+}
+
+// MockreceptionRepoSearchByPVZCall wrap *gomock.Call
+type MockreceptionRepoSearchByPVZCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoSearchByPVZCall) Return(arg0 []model.Reception, arg1 error) *MockreceptionRepoSearchByPVZCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoSearchByPVZCall) Do(f func(context.Context, model.PVZID, int64, int64) ([]model.Reception, error)) *MockreceptionRepoSearchByPVZCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoSearchByPVZCall) DoAndReturn(f func(context.Context, model.PVZID, int64, int64) ([]model.Reception, error)) *MockreceptionRepoSearchByPVZCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockproductRepo is a mock of productRepo interface.
+type MockproductRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockproductRepoMockRecorder is the mock recorder for MockproductRepo.
+type MockproductRepoMockRecorder struct {
+	mock *MockproductRepo
+}
+
+// NewMockproductRepo creates a new mock instance.
+func NewMockproductRepo(ctrl *gomock.Controller) *MockproductRepo {
+	mock := &MockproductRepo{ctrl: ctrl}
+	mock.recorder = &MockproductRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReceptionIDs mocks base method.
+func (m *MockproductRepo) GetByReceptionIDs(ctx context.Context, receptionIDs []model.ReceptionID) ([]model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReceptionIDs", ctx, receptionIDs)
+	ret0, _ := ret[0].([]model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReceptionIDs indicates an expected call of GetByReceptionIDs.
+func (mr *MockproductRepoMockRecorder) GetByReceptionIDs(ctx, receptionIDs any) *MockproductRepoGetByReceptionIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReceptionIDs", reflect.TypeOf((*MockproductRepo)(nil).GetByReceptionIDs), ctx, receptionIDs)
+	return &MockproductRepoGetByReceptionIDsCall{Call: call}
+}
+
+// MockproductRepoGetByReceptionIDsCall wrap *gomock.Call
+type MockproductRepoGetByReceptionIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoGetByReceptionIDsCall) Return(arg0 []model.Product, arg1 error) *MockproductRepoGetByReceptionIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoGetByReceptionIDsCall) Do(f func(context.Context, []model.ReceptionID) ([]model.Product, error)) *MockproductRepoGetByReceptionIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoGetByReceptionIDsCall) DoAndReturn(f func(context.Context, []model.ReceptionID) ([]model.Product, error)) *MockproductRepoGetByReceptionIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -10,10 +10,13 @@ import (
 
 type pvzRepo interface {
 	Get(ctx context.Context, pvzIDs []model.PVZID) ([]model.PVZ, error)
+	Search(ctx context.Context, city *string, offset, limit int64) ([]model.PVZ, error)
 }
 
 type receptionRepo interface {
 	Search(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, offset, limit int64) ([]model.Reception, error)
+	SearchAfter(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, after *model.ReceptionCursor, limit int64) ([]model.Reception, error)
+	SearchByPVZIDs(ctx context.Context, pvzIDs []model.PVZID, receptedAtFrom, receptedAtTo *time.Time, limitPerPVZ int64) ([]model.Reception, error)
 }
 
 type productRepo interface {
@@ -11,6 +11,10 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+// receptionsPerPVZ caps receptions attached to every pvz in GetPVZListByPVZ,
+// the full history is paged by the pvz receptions endpoint.
+const receptionsPerPVZ = 20
+
 type UseCase struct {
 	pvzRepo       pvzRepo
 	receptionRepo receptionRepo
@@ -44,10 +48,82 @@ func (uc *UseCase) GetPVZList(ctx context.Context, receptedAtFrom, receptedAtTo
 	if err != nil {
 		return model.PVZList{}, fmt.Errorf("receptionRepo.Search: %w", err)
 	}
-	if len(receptions) == 0 {
+
+	return uc.getPVZList(ctx, receptions)
+}
+
+// GetPVZListAfter returns the page of receptions following the cursor (the first page if it is nil).
+// NextCursor of the result is nil when there are no more receptions.
+func (uc *UseCase) GetPVZListAfter(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, after *model.ReceptionCursor, limit int64) (model.PVZList, error) {
+	// one extra reception shows whether the next page exists
+	receptions, err := uc.receptionRepo.SearchAfter(ctx, receptedAtFrom, receptedAtTo, after, limit+1)
+	if err != nil {
+		return model.PVZList{}, fmt.Errorf("receptionRepo.SearchAfter: %w", err)
+	}
+
+	var nextCursor *model.ReceptionCursor
+	if int64(len(receptions)) > limit {
+		receptions = receptions[:limit]
+		cursor := model.NewReceptionCursor(receptions[len(receptions)-1])
+		nextCursor = &cursor
+	}
+
+	pvzList, err := uc.getPVZList(ctx, receptions)
+	if err != nil {
+		return model.PVZList{}, err
+	}
+	pvzList.NextCursor = nextCursor
+
+	return pvzList, nil
+}
+
+// GetPVZListByPVZ pages over pvz instead of receptions, so pvz without receptions are listed too.
+// Only the latest receptionsPerPVZ receptions within the optional date window are attached, with their products.
+func (uc *UseCase) GetPVZListByPVZ(ctx context.Context, city *string, receptedAtFrom, receptedAtTo *time.Time, page, limit int64) (model.PVZList, error) {
+	offset := (page - 1) * limit
+	pvzs, err := uc.pvzRepo.Search(ctx, city, offset, limit)
+	if err != nil {
+		return model.PVZList{}, fmt.Errorf("pvzRepo.Search: %w", err)
+	}
+	if len(pvzs) == 0 {
 		return model.PVZList{}, nil
 	}
 
+	pvzIDs := make([]model.PVZID, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		pvzIDs = append(pvzIDs, pvz.ID)
+	}
+
+	receptions, err := uc.receptionRepo.SearchByPVZIDs(ctx, pvzIDs, receptedAtFrom, receptedAtTo, receptionsPerPVZ)
+	if err != nil {
+		return model.PVZList{}, fmt.Errorf("receptionRepo.SearchByPVZIDs: %w", err)
+	}
+
+	res := model.PVZList{
+		PVZs:       pvzs,
+		Receptions: receptions,
+	}
+	if len(receptions) == 0 {
+		return res, nil
+	}
+
+	receptionIDs := make([]model.ReceptionID, 0, len(receptions))
+	for _, reception := range receptions {
+		receptionIDs = append(receptionIDs, reception.ID)
+	}
+
+	res.Products, err = uc.productRepo.GetByReceptionIDs(ctx, receptionIDs)
+	if err != nil {
+		return model.PVZList{}, fmt.Errorf("productRepo.GetByReceptionIDs: %w", err)
+	}
+
+	return res, nil
+}
+
+func (uc *UseCase) getPVZList(ctx context.Context, receptions []model.Reception) (model.PVZList, error) {
+	if len(receptions) == 0 {
+		return model.PVZList{}, nil
+	}
 	receptionIDs := make([]model.ReceptionID, 0, len(receptions))
 	pvzIDs := make([]model.PVZID, 0, len(receptions))
 	pvzIDMap := make(map[model.PVZID]struct{}, len(receptions))
@@ -84,7 +160,7 @@ func (uc *UseCase) GetPVZList(ctx context.Context, receptedAtFrom, receptedAtTo
 		return nil
 	})
 
-	err = eg.Wait()
+	err := eg.Wait()
 	if err != nil {
 		return model.PVZList{}, fmt.Errorf("errgroup.Wait: %w", err)
 	}
@@ -346,3 +346,364 @@ func TestUseCase_GetPVZList(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_GetPVZListAfter(t *testing.T) {
+	type mocks struct {
+		pvzRepo       *MockpvzRepo
+		receptionRepo *MockreceptionRepo
+		productRepo   *MockproductRepo
+	}
+	type args struct {
+		receptedAtFrom, receptedAtTo *time.Time
+		after                        *model.ReceptionCursor
+		limit                        int64
+	}
+
+	from := time.Now().Add(-time.Hour * 24)
+	to := time.Now()
+
+	pvzID1 := model.NewPVZID()
+	reception1 := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      from,
+	}
+	reception2 := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      from.Add(time.Minute),
+	}
+	reception3 := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      from.Add(2 * time.Minute),
+	}
+	pvz1 := model.PVZ{
+		ID:           pvzID1,
+		City:         "test1",
+		RegisteredAt: from,
+	}
+	product1 := model.Product{
+		ID:          model.NewProductID(),
+		ReceptionID: reception2.ID,
+		Category:    model.ProductCategoryShoes,
+		AddedAt:     to,
+	}
+	cursor1 := model.NewReceptionCursor(reception1)
+	cursor2 := model.NewReceptionCursor(reception2)
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		args    args
+		wantRes model.PVZList
+		wantErr error
+	}{
+		{
+			name: "success.has_next_page",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					SearchAfter(gomock.Any(), &from, &to, (*model.ReceptionCursor)(nil), int64(3)).
+					Return([]model.Reception{reception1, reception2, reception3}, nil)
+				m.pvzRepo.EXPECT().
+					Get(gomock.Any(), []model.PVZID{pvzID1}).
+					Return([]model.PVZ{pvz1}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{reception1.ID, reception2.ID}).
+					Return([]model.Product{product1}, nil)
+			},
+			args: args{
+				receptedAtFrom: &from,
+				receptedAtTo:   &to,
+				after:          nil,
+				limit:          2,
+			},
+			wantRes: model.PVZList{
+				PVZs:       []model.PVZ{pvz1},
+				Receptions: []model.Reception{reception1, reception2},
+				Products:   []model.Product{product1},
+				NextCursor: &cursor2,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.last_page",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					SearchAfter(gomock.Any(), nil, nil, &cursor1, int64(3)).
+					Return([]model.Reception{reception2, reception3}, nil)
+				m.pvzRepo.EXPECT().
+					Get(gomock.Any(), []model.PVZID{pvzID1}).
+					Return([]model.PVZ{pvz1}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{reception2.ID, reception3.ID}).
+					Return([]model.Product{product1}, nil)
+			},
+			args: args{
+				after: &cursor1,
+				limit: 2,
+			},
+			wantRes: model.PVZList{
+				PVZs:       []model.PVZ{pvz1},
+				Receptions: []model.Reception{reception2, reception3},
+				Products:   []model.Product{product1},
+				NextCursor: nil,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "empty_result",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					SearchAfter(gomock.Any(), nil, nil, &cursor2, int64(3)).
+					Return([]model.Reception{}, nil)
+			},
+			args: args{
+				after: &cursor2,
+				limit: 2,
+			},
+			wantRes: model.PVZList{},
+			wantErr: nil,
+		},
+		{
+			name: "error.SearchAfter",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					SearchAfter(gomock.Any(), nil, nil, &cursor2, int64(3)).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				after: &cursor2,
+				limit: 2,
+			},
+			wantRes: model.PVZList{},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetByReceptionIDs",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					SearchAfter(gomock.Any(), nil, nil, (*model.ReceptionCursor)(nil), int64(3)).
+					Return([]model.Reception{reception1}, nil)
+				m.pvzRepo.EXPECT().
+					Get(gomock.Any(), []model.PVZID{pvzID1}).
+					Return([]model.PVZ{pvz1}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{reception1.ID}).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				limit: 2,
+			},
+			wantRes: model.PVZList{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				pvzRepo:       NewMockpvzRepo(ctrl),
+				receptionRepo: NewMockreceptionRepo(ctrl),
+				productRepo:   NewMockproductRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.pvzRepo, m.receptionRepo, m.productRepo)
+			require.NoError(t, err)
+
+			res, err := uc.GetPVZListAfter(context.Background(), tc.args.receptedAtFrom, tc.args.receptedAtTo, tc.args.after, tc.args.limit)
+
+			require.ErrorIs(t, err, tc.wantErr)
+
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_GetPVZListByPVZ(t *testing.T) {
+	type mocks struct {
+		pvzRepo       *MockpvzRepo
+		receptionRepo *MockreceptionRepo
+		productRepo   *MockproductRepo
+	}
+	type args struct {
+		city                         *string
+		receptedAtFrom, receptedAtTo *time.Time
+		page, limit                  int64
+	}
+
+	from := time.Now().Add(-time.Hour * 24)
+	to := time.Now()
+	city := "Москва"
+
+	pvz1 := model.PVZ{
+		ID:           model.NewPVZID(),
+		City:         city,
+		RegisteredAt: from,
+	}
+	pvz2 := model.PVZ{
+		ID:           model.NewPVZID(),
+		City:         city,
+		RegisteredAt: from.Add(time.Minute),
+	}
+	reception1 := model.Reception{
+		ID:              model.NewReceptionID(),
+		PVZID:           pvz1.ID,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      from.Add(time.Hour),
+	}
+	product1 := model.Product{
+		ID:          model.NewProductID(),
+		ReceptionID: reception1.ID,
+		Category:    model.ProductCategoryClothes,
+		AddedAt:     from.Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		args    args
+		wantRes model.PVZList
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					Search(gomock.Any(), &city, int64(10), int64(10)).
+					Return([]model.PVZ{pvz1, pvz2}, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZIDs(gomock.Any(), []model.PVZID{pvz1.ID, pvz2.ID}, &from, &to, int64(receptionsPerPVZ)).
+					Return([]model.Reception{reception1}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{reception1.ID}).
+					Return([]model.Product{product1}, nil)
+			},
+			args: args{
+				city:           &city,
+				receptedAtFrom: &from,
+				receptedAtTo:   &to,
+				page:           2,
+				limit:          10,
+			},
+			wantRes: model.PVZList{
+				PVZs:       []model.PVZ{pvz1, pvz2},
+				Receptions: []model.Reception{reception1},
+				Products:   []model.Product{product1},
+			},
+		},
+		{
+			name: "success.no_receptions",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					Search(gomock.Any(), nil, int64(0), int64(10)).
+					Return([]model.PVZ{pvz2}, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZIDs(gomock.Any(), []model.PVZID{pvz2.ID}, nil, nil, int64(receptionsPerPVZ)).
+					Return([]model.Reception{}, nil)
+			},
+			args: args{
+				page:  1,
+				limit: 10,
+			},
+			wantRes: model.PVZList{
+				PVZs:       []model.PVZ{pvz2},
+				Receptions: []model.Reception{},
+			},
+		},
+		{
+			name: "empty_result",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					Search(gomock.Any(), nil, int64(0), int64(10)).
+					Return([]model.PVZ{}, nil)
+			},
+			args: args{
+				page:  1,
+				limit: 10,
+			},
+			wantRes: model.PVZList{},
+		},
+		{
+			name: "error.Search",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					Search(gomock.Any(), nil, int64(0), int64(10)).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				page:  1,
+				limit: 10,
+			},
+			wantRes: model.PVZList{},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.SearchByPVZIDs",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					Search(gomock.Any(), nil, int64(0), int64(10)).
+					Return([]model.PVZ{pvz1}, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZIDs(gomock.Any(), []model.PVZID{pvz1.ID}, nil, nil, int64(receptionsPerPVZ)).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				page:  1,
+				limit: 10,
+			},
+			wantRes: model.PVZList{},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetByReceptionIDs",
+			prepare: func(m *mocks) {
+				m.pvzRepo.EXPECT().
+					Search(gomock.Any(), nil, int64(0), int64(10)).
+					Return([]model.PVZ{pvz1}, nil)
+				m.receptionRepo.EXPECT().
+					SearchByPVZIDs(gomock.Any(), []model.PVZID{pvz1.ID}, nil, nil, int64(receptionsPerPVZ)).
+					Return([]model.Reception{reception1}, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{reception1.ID}).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				page:  1,
+				limit: 10,
+			},
+			wantRes: model.PVZList{},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				pvzRepo:       NewMockpvzRepo(ctrl),
+				receptionRepo: NewMockreceptionRepo(ctrl),
+				productRepo:   NewMockproductRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.pvzRepo, m.receptionRepo, m.productRepo)
+			require.NoError(t, err)
+
+			res, err := uc.GetPVZListByPVZ(context.Background(), tc.args.city, tc.args.receptedAtFrom, tc.args.receptedAtTo, tc.args.page, tc.args.limit)
+
+			require.ErrorIs(t, err, tc.wantErr)
+
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -81,6 +81,45 @@ func (c *MockpvzRepoGetCall) DoAndReturn(f func(context.Context, []model.PVZID)
 	return c
 }
 
+// Search mocks base method.
+func (m *MockpvzRepo) Search(ctx context.Context, city *string, offset, limit int64) ([]model.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, city, offset, limit)
+	ret0, _ := ret[0].([]model.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockpvzRepoMockRecorder) Search(ctx, city, offset, limit any) *MockpvzRepoSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockpvzRepo)(nil).Search), ctx, city, offset, limit)
+	return &MockpvzRepoSearchCall{Call: call}
+}
+
+// MockpvzRepoSearchCall wrap *gomock.Call
+type MockpvzRepoSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzRepoSearchCall) Return(arg0 []model.PVZ, arg1 error) *MockpvzRepoSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzRepoSearchCall) Do(f func(context.Context, *string, int64, int64) ([]model.PVZ, error)) *MockpvzRepoSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzRepoSearchCall) DoAndReturn(f func(context.Context, *string, int64, int64) ([]model.PVZ, error)) *MockpvzRepoSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockreceptionRepo is a mock of receptionRepo interface.
 type MockreceptionRepo struct {
 	ctrl     *gomock.Controller
@@ -144,6 +183,84 @@ func (c *MockreceptionRepoSearchCall) DoAndReturn(f func(context.Context, *time.
 	return c
 }
 
+// SearchAfter mocks base method.
+func (m *MockreceptionRepo) SearchAfter(ctx context.Context, receptedAtFrom, receptedAtTo *time.Time, after *model.ReceptionCursor, limit int64) ([]model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAfter", ctx, receptedAtFrom, receptedAtTo, after, limit)
+	ret0, _ := ret[0].([]model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAfter indicates an expected call of SearchAfter.
+func (mr *MockreceptionRepoMockRecorder) SearchAfter(ctx, receptedAtFrom, receptedAtTo, after, limit any) *MockreceptionRepoSearchAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAfter", reflect.TypeOf((*MockreceptionRepo)(nil).SearchAfter), ctx, receptedAtFrom, receptedAtTo, after, limit)
+	return &MockreceptionRepoSearchAfterCall{Call: call}
+}
+
+// MockreceptionRepoSearchAfterCall wrap *gomock.Call
+type MockreceptionRepoSearchAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoSearchAfterCall) Return(arg0 []model.Reception, arg1 error) *MockreceptionRepoSearchAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoSearchAfterCall) Do(f func(context.Context, *time.Time, *time.Time, *model.ReceptionCursor, int64) ([]model.Reception, error)) *MockreceptionRepoSearchAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoSearchAfterCall) DoAndReturn(f func(context.Context, *time.Time, *time.Time, *model.ReceptionCursor, int64) ([]model.Reception, error)) *MockreceptionRepoSearchAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchByPVZIDs mocks base method.
+func (m *MockreceptionRepo) SearchByPVZIDs(ctx context.Context, pvzIDs []model.PVZID, receptedAtFrom, receptedAtTo *time.Time, limitPerPVZ int64) ([]model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByPVZIDs", ctx, pvzIDs, receptedAtFrom, receptedAtTo, limitPerPVZ)
+	ret0, _ := ret[0].([]model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByPVZIDs indicates an expected call of SearchByPVZIDs.
+func (mr *MockreceptionRepoMockRecorder) SearchByPVZIDs(ctx, pvzIDs, receptedAtFrom, receptedAtTo, limitPerPVZ any) *MockreceptionRepoSearchByPVZIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByPVZIDs", reflect.TypeOf((*MockreceptionRepo)(nil).SearchByPVZIDs), ctx, pvzIDs, receptedAtFrom, receptedAtTo, limitPerPVZ)
+	return &MockreceptionRepoSearchByPVZIDsCall{Call: call}
+}
+
+// MockreceptionRepoSearchByPVZIDsCall wrap *gomock.Call
+type MockreceptionRepoSearchByPVZIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoSearchByPVZIDsCall) Return(arg0 []model.Reception, arg1 error) *MockreceptionRepoSearchByPVZIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoSearchByPVZIDsCall) Do(f func(context.Context, []model.PVZID, *time.Time, *time.Time, int64) ([]model.Reception, error)) *MockreceptionRepoSearchByPVZIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoSearchByPVZIDsCall) DoAndReturn(f func(context.Context, []model.PVZID, *time.Time, *time.Time, int64) ([]model.Reception, error)) *MockreceptionRepoSearchByPVZIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockproductRepo is a mock of productRepo interface.
 type MockproductRepo struct {
 	ctrl     *gomock.Controller
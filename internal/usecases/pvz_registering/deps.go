@@ -7,10 +7,18 @@ import (
 	"github.com/inna-maikut/avito-pvz/internal/model"
 )
 
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
 type pvzRepo interface {
 	Register(ctx context.Context, pvz model.PVZ) error
 }
 
+type auditWriter interface {
+	Write(ctx context.Context, event model.AuditEvent) error
+}
+
 type metrics interface {
 	PVZRegisteredCountInc()
 }
@@ -17,6 +17,68 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockpvzRepo is a mock of pvzRepo interface.
 type MockpvzRepo struct {
 	ctrl     *gomock.Controller
@@ -79,6 +141,68 @@ func (c *MockpvzRepoRegisterCall) DoAndReturn(f func(context.Context, model.PVZ)
 	return c
 }
 
+// MockauditWriter is a mock of auditWriter interface.
+type MockauditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockauditWriterMockRecorder is the mock recorder for MockauditWriter.
+type MockauditWriterMockRecorder struct {
+	mock *MockauditWriter
+}
+
+// NewMockauditWriter creates a new mock instance.
+func NewMockauditWriter(ctrl *gomock.Controller) *MockauditWriter {
+	mock := &MockauditWriter{ctrl: ctrl}
+	mock.recorder = &MockauditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditWriter) EXPECT() *MockauditWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockauditWriter) Write(ctx context.Context, event model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditWriterMockRecorder) Write(ctx, event any) *MockauditWriterWriteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditWriter)(nil).Write), ctx, event)
+	return &MockauditWriterWriteCall{Call: call}
+}
+
+// MockauditWriterWriteCall wrap *gomock.Call
+type MockauditWriterWriteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditWriterWriteCall) Return(arg0 error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditWriterWriteCall) Do(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditWriterWriteCall) DoAndReturn(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Mockmetrics is a mock of metrics interface.
 type Mockmetrics struct {
 	ctrl     *gomock.Controller
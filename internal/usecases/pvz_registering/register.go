@@ -10,34 +10,61 @@ import (
 )
 
 type UseCase struct {
-	pvzRepo pvzRepo
-	metric  metrics
+	trManager   trManager
+	pvzRepo     pvzRepo
+	auditWriter auditWriter
+	metric      metrics
 }
 
-func New(pvzRepo pvzRepo, metric metrics) (*UseCase, error) {
+func New(trManager trManager, pvzRepo pvzRepo, auditWriter auditWriter, metric metrics) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
 	if pvzRepo == nil {
 		return nil, errors.New("pvzRepo is nil")
 	}
+	if auditWriter == nil {
+		return nil, errors.New("auditWriter is nil")
+	}
 	if metric == nil {
 		return nil, errors.New("metric is nil")
 	}
 
 	return &UseCase{
-		pvzRepo: pvzRepo,
-		metric:  metric,
+		trManager:   trManager,
+		pvzRepo:     pvzRepo,
+		auditWriter: auditWriter,
+		metric:      metric,
 	}, nil
 }
 
-func (uc *UseCase) RegisterPVZ(ctx context.Context, city string) (model.PVZ, error) {
+func (uc *UseCase) RegisterPVZ(ctx context.Context, city string, actor model.Actor) (model.PVZ, error) {
 	pvz := model.PVZ{
 		ID:           model.NewPVZID(),
 		City:         city,
 		RegisteredAt: time.Now(),
 	}
 
-	err := uc.pvzRepo.Register(ctx, pvz)
+	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
+		err = uc.pvzRepo.Register(ctx, pvz)
+		if err != nil {
+			return fmt.Errorf("pvzRepo.Register: %w", err)
+		}
+
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:   actor.UserID,
+			APIKeyID: actor.APIKeyID,
+			Action:   model.AuditActionPVZRegistered,
+			PVZID:    pvz.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return model.PVZ{}, fmt.Errorf("pvzRepo.Register: %w", err)
+		return model.PVZ{}, fmt.Errorf("trManager.Do: %w", err)
 	}
 
 	uc.metric.PVZRegisteredCountInc()
@@ -15,19 +15,31 @@ import (
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMockpvzRepo(ctrl), NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockpvzRepo(ctrl), NewMockauditWriter(ctrl), NewMockmetrics(ctrl))
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(nil, NewMockmetrics(ctrl))
+		res, err := New(nil, NewMockpvzRepo(ctrl), NewMockauditWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.second_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMockpvzRepo(ctrl), nil)
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockauditWriter(ctrl), NewMockmetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockpvzRepo(ctrl), nil, NewMockmetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockpvzRepo(ctrl), NewMockauditWriter(ctrl), nil)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -35,13 +47,17 @@ func TestNew(t *testing.T) {
 
 func TestUseCase_RegisterPVZ(t *testing.T) {
 	type mocks struct {
-		pvzRepo *MockpvzRepo
-		metric  *Mockmetrics
+		trManager   *MocktrManager
+		pvzRepo     *MockpvzRepo
+		auditWriter *MockauditWriter
+		metric      *Mockmetrics
 	}
 	type args struct {
 		city string
 	}
 
+	userID := model.NewUserID()
+
 	testCases := []struct {
 		name     string
 		prepare  func(t *testing.T, m *mocks)
@@ -52,11 +68,28 @@ func TestUseCase_RegisterPVZ(t *testing.T) {
 		{
 			name: "success.register",
 			prepare: func(t *testing.T, m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				var pvzID model.PVZID
 				m.pvzRepo.EXPECT().
 					Register(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, pvz model.PVZ) error {
 						require.Equal(t, "test1", pvz.City)
 						require.WithinDuration(t, time.Now(), pvz.RegisteredAt, time.Minute)
+						pvzID = pvz.ID
+						return nil
+					})
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, event model.AuditEvent) error {
+						require.Equal(t, model.AuditEvent{
+							UserID: userID,
+							Action: model.AuditActionPVZRegistered,
+							PVZID:  pvzID,
+						}, event)
 						return nil
 					})
 				m.metric.EXPECT().PVZRegisteredCountInc()
@@ -70,8 +103,34 @@ func TestUseCase_RegisterPVZ(t *testing.T) {
 		{
 			name: "error.Register",
 			prepare: func(_ *testing.T, m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzRepo.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				city: "test1",
+			},
+			wantErr:  assert.AnError,
+			wantCity: "",
+		},
+		{
+			name: "error.auditWriter.Write",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
 				m.pvzRepo.EXPECT().
 					Register(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
 					Return(assert.AnError)
 			},
 			args: args{
@@ -87,16 +146,18 @@ func TestUseCase_RegisterPVZ(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
-				pvzRepo: NewMockpvzRepo(ctrl),
-				metric:  NewMockmetrics(ctrl),
+				trManager:   NewMocktrManager(ctrl),
+				pvzRepo:     NewMockpvzRepo(ctrl),
+				auditWriter: NewMockauditWriter(ctrl),
+				metric:      NewMockmetrics(ctrl),
 			}
 
 			tc.prepare(t, m)
 
-			uc, err := New(m.pvzRepo, m.metric)
+			uc, err := New(m.trManager, m.pvzRepo, m.auditWriter, m.metric)
 			require.NoError(t, err)
 
-			pvz, err := uc.RegisterPVZ(context.Background(), tc.args.city)
+			pvz, err := uc.RegisterPVZ(context.Background(), tc.args.city, model.Actor{UserID: userID})
 			require.ErrorIs(t, err, tc.wantErr)
 			require.Equal(t, tc.wantCity, pvz.City)
 		})
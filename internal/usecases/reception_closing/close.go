@@ -12,9 +12,11 @@ type UseCase struct {
 	trManager     trManager
 	receptionRepo receptionRepo
 	pvzLocker     pvzLocker
+	auditWriter   auditWriter
+	outboxWriter  outboxWriter
 }
 
-func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker) (*UseCase, error) {
+func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, auditWriter auditWriter, outboxWriter outboxWriter) (*UseCase, error) {
 	if trManager == nil {
 		return nil, errors.New("trManager is nil")
 	}
@@ -24,15 +26,23 @@ func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker)
 	if pvzLocker == nil {
 		return nil, errors.New("pvzLocker is nil")
 	}
+	if auditWriter == nil {
+		return nil, errors.New("auditWriter is nil")
+	}
+	if outboxWriter == nil {
+		return nil, errors.New("outboxWriter is nil")
+	}
 
 	return &UseCase{
 		trManager:     trManager,
 		receptionRepo: receptionRepo,
 		pvzLocker:     pvzLocker,
+		auditWriter:   auditWriter,
+		outboxWriter:  outboxWriter,
 	}, nil
 }
 
-func (uc *UseCase) CloseReception(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
+func (uc *UseCase) CloseReception(ctx context.Context, pvzID model.PVZID, actor model.Actor) (model.Reception, error) {
 	var reception model.Reception
 
 	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
@@ -53,6 +63,22 @@ func (uc *UseCase) CloseReception(ctx context.Context, pvzID model.PVZID) (model
 			return fmt.Errorf("receptionRepo.SetStatus: %w", err)
 		}
 
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:      actor.UserID,
+			APIKeyID:    actor.APIKeyID,
+			Action:      model.AuditActionReceptionClosed,
+			PVZID:       pvzID,
+			ReceptionID: &reception.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		err = uc.outboxWriter.Add(ctx, model.NewReceptionOutboxEvent(model.OutboxEventReceptionClosed, reception))
+		if err != nil {
+			return fmt.Errorf("outboxWriter.Add: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -15,25 +15,37 @@ import (
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl))
+		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.second_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl))
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.third_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil, NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), nil)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -44,13 +56,19 @@ func TestUseCase_CloseReception(t *testing.T) {
 		trManager     *MocktrManager
 		receptionRepo *MockreceptionRepo
 		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
 	}
 	type args struct {
 		pvzID model.PVZID
+		actor model.Actor
 	}
 
 	ID1 := model.NewPVZID()
 	receptionID1 := model.NewReceptionID()
+	userID1 := model.NewUserID()
+	actor1 := model.Actor{UserID: userID1}
+	apiKeyID1 := model.NewAPIKeyID()
 	now := time.Now()
 
 	testCases := []struct {
@@ -82,9 +100,74 @@ func TestUseCase_CloseReception(t *testing.T) {
 				m.receptionRepo.EXPECT().
 					SetStatus(gomock.Any(), receptionID1, model.ReceptionStatusClose).
 					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionReceptionClosed,
+						PVZID:       ID1,
+						ReceptionID: &receptionID1,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewReceptionOutboxEvent(model.OutboxEventReceptionClosed, model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusClose,
+						ReceptedAt:      now,
+					})).
+					Return(nil)
+			},
+			args: args{
+				pvzID: ID1,
+				actor: actor1,
+			},
+			wantErr: nil,
+
+			wantRes: model.Reception{
+				ID:              receptionID1,
+				PVZID:           ID1,
+				ReceptionStatus: model.ReceptionStatusClose,
+				ReceptedAt:      now,
+			},
+		},
+		{
+			name: "success.api_key",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+				m.receptionRepo.EXPECT().
+					SetStatus(gomock.Any(), receptionID1, model.ReceptionStatusClose).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      model.DefaultUserID,
+						APIKeyID:    &apiKeyID1,
+						Action:      model.AuditActionReceptionClosed,
+						PVZID:       ID1,
+						ReceptionID: &receptionID1,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(nil)
 			},
 			args: args{
 				pvzID: ID1,
+				actor: model.Actor{UserID: model.DefaultUserID, APIKeyID: &apiKeyID1},
 			},
 			wantErr: nil,
 
@@ -112,6 +195,7 @@ func TestUseCase_CloseReception(t *testing.T) {
 			},
 			args: args{
 				pvzID: ID1,
+				actor: actor1,
 			},
 			wantErr: model.ErrReceptionNotFound,
 		},
@@ -129,6 +213,7 @@ func TestUseCase_CloseReception(t *testing.T) {
 			},
 			args: args{
 				pvzID: ID1,
+				actor: actor1,
 			},
 			wantErr: assert.AnError,
 		},
@@ -149,6 +234,7 @@ func TestUseCase_CloseReception(t *testing.T) {
 			},
 			args: args{
 				pvzID: ID1,
+				actor: actor1,
 			},
 			wantErr: assert.AnError,
 		},
@@ -176,6 +262,72 @@ func TestUseCase_CloseReception(t *testing.T) {
 			},
 			args: args{
 				pvzID: ID1,
+				actor: actor1,
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.auditWriter.Write",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+					}, nil)
+				m.receptionRepo.EXPECT().
+					SetStatus(gomock.Any(), receptionID1, model.ReceptionStatusClose).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID: ID1,
+				actor: actor1,
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.outboxWriter.Add",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+					}, nil)
+				m.receptionRepo.EXPECT().
+					SetStatus(gomock.Any(), receptionID1, model.ReceptionStatusClose).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID: ID1,
+				actor: actor1,
 			},
 			wantErr: assert.AnError,
 		},
@@ -189,14 +341,16 @@ func TestUseCase_CloseReception(t *testing.T) {
 				trManager:     NewMocktrManager(ctrl),
 				receptionRepo: NewMockreceptionRepo(ctrl),
 				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker)
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.auditWriter, m.outboxWriter)
 			require.NoError(t, err)
 
-			reception, err := uc.CloseReception(context.Background(), tc.args.pvzID)
+			reception, err := uc.CloseReception(context.Background(), tc.args.pvzID, tc.args.actor)
 			require.ErrorIs(t, err, tc.wantErr)
 			require.Equal(t, tc.wantRes, reception)
 		})
@@ -241,3 +241,127 @@ func (c *MockpvzLockerLockCall) DoAndReturn(f func(context.Context, model.PVZID)
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockauditWriter is a mock of auditWriter interface.
+type MockauditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockauditWriterMockRecorder is the mock recorder for MockauditWriter.
+type MockauditWriterMockRecorder struct {
+	mock *MockauditWriter
+}
+
+// NewMockauditWriter creates a new mock instance.
+func NewMockauditWriter(ctrl *gomock.Controller) *MockauditWriter {
+	mock := &MockauditWriter{ctrl: ctrl}
+	mock.recorder = &MockauditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditWriter) EXPECT() *MockauditWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockauditWriter) Write(ctx context.Context, event model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditWriterMockRecorder) Write(ctx, event any) *MockauditWriterWriteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditWriter)(nil).Write), ctx, event)
+	return &MockauditWriterWriteCall{Call: call}
+}
+
+// MockauditWriterWriteCall wrap *gomock.Call
+type MockauditWriterWriteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditWriterWriteCall) Return(arg0 error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditWriterWriteCall) Do(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditWriterWriteCall) DoAndReturn(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockoutboxWriter is a mock of outboxWriter interface.
+type MockoutboxWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxWriterMockRecorder is the mock recorder for MockoutboxWriter.
+type MockoutboxWriterMockRecorder struct {
+	mock *MockoutboxWriter
+}
+
+// NewMockoutboxWriter creates a new mock instance.
+func NewMockoutboxWriter(ctrl *gomock.Controller) *MockoutboxWriter {
+	mock := &MockoutboxWriter{ctrl: ctrl}
+	mock.recorder = &MockoutboxWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxWriter) EXPECT() *MockoutboxWriterMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockoutboxWriter) Add(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockoutboxWriterMockRecorder) Add(ctx, event any) *MockoutboxWriterAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockoutboxWriter)(nil).Add), ctx, event)
+	return &MockoutboxWriterAddCall{Call: call}
+}
+
+// MockoutboxWriterAddCall wrap *gomock.Call
+type MockoutboxWriterAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxWriterAddCall) Return(arg0 error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxWriterAddCall) Do(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxWriterAddCall) DoAndReturn(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -20,6 +20,14 @@ type pvzLocker interface {
 	Lock(ctx context.Context, pvzID model.PVZID) error
 }
 
+type auditWriter interface {
+	Write(ctx context.Context, event model.AuditEvent) error
+}
+
+type outboxWriter interface {
+	Add(ctx context.Context, event model.OutboxEvent) error
+}
+
 type metrics interface {
 	ReceptionCreatedCountInc()
 }
@@ -12,10 +12,12 @@ type UseCase struct {
 	trManager     trManager
 	receptionRepo receptionRepo
 	pvzLocker     pvzLocker
+	auditWriter   auditWriter
+	outboxWriter  outboxWriter
 	metric        metrics
 }
 
-func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, metric metrics) (*UseCase, error) {
+func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, auditWriter auditWriter, outboxWriter outboxWriter, metric metrics) (*UseCase, error) {
 	if trManager == nil {
 		return nil, errors.New("trManager is nil")
 	}
@@ -25,6 +27,12 @@ func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker,
 	if pvzLocker == nil {
 		return nil, errors.New("pvzLocker is nil")
 	}
+	if auditWriter == nil {
+		return nil, errors.New("auditWriter is nil")
+	}
+	if outboxWriter == nil {
+		return nil, errors.New("outboxWriter is nil")
+	}
 	if metric == nil {
 		return nil, errors.New("metric is nil")
 	}
@@ -33,11 +41,13 @@ func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker,
 		trManager:     trManager,
 		receptionRepo: receptionRepo,
 		pvzLocker:     pvzLocker,
+		auditWriter:   auditWriter,
+		outboxWriter:  outboxWriter,
 		metric:        metric,
 	}, nil
 }
 
-func (uc *UseCase) CreateReception(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
+func (uc *UseCase) CreateReception(ctx context.Context, pvzID model.PVZID, actor model.Actor) (model.Reception, error) {
 	var reception model.Reception
 
 	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
@@ -59,6 +69,22 @@ func (uc *UseCase) CreateReception(ctx context.Context, pvzID model.PVZID) (mode
 			return fmt.Errorf("receptionRepo.Create: %w", err)
 		}
 
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:      actor.UserID,
+			APIKeyID:    actor.APIKeyID,
+			Action:      model.AuditActionReceptionCreated,
+			PVZID:       pvzID,
+			ReceptionID: &reception.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		err = uc.outboxWriter.Add(ctx, model.NewReceptionOutboxEvent(model.OutboxEventReceptionCreated, reception))
+		if err != nil {
+			return fmt.Errorf("outboxWriter.Add: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -15,31 +15,43 @@ import (
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockmetrics(ctrl))
+		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.second_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.third_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockmetrics(ctrl))
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
 	t.Run("error.fourth_nil", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil, NewMockoutboxWriter(ctrl), NewMockmetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), nil, NewMockmetrics(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.sixth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl), nil)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -50,6 +62,8 @@ func TestUseCase_CreateReception(t *testing.T) {
 		trManager     *MocktrManager
 		receptionRepo *MockreceptionRepo
 		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
 		metric        *Mockmetrics
 	}
 	type args struct {
@@ -58,6 +72,7 @@ func TestUseCase_CreateReception(t *testing.T) {
 
 	ID1 := model.NewPVZID()
 	receptionID1 := model.NewReceptionID()
+	userID1 := model.NewUserID()
 	now := time.Now()
 
 	testCases := []struct {
@@ -89,6 +104,22 @@ func TestUseCase_CreateReception(t *testing.T) {
 						ReceptionStatus: model.ReceptionStatusInProgress,
 						ReceptedAt:      now,
 					}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionReceptionCreated,
+						PVZID:       ID1,
+						ReceptionID: &receptionID1,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewReceptionOutboxEvent(model.OutboxEventReceptionCreated, model.Reception{
+						ID:              receptionID1,
+						PVZID:           ID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					})).
+					Return(nil)
 				m.metric.EXPECT().ReceptionCreatedCountInc()
 			},
 			args: args{
@@ -188,6 +219,63 @@ func TestUseCase_CreateReception(t *testing.T) {
 			wantErr: assert.AnError,
 			wantRes: model.Reception{},
 		},
+		{
+			name: "error.auditWriter.Write",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+				m.receptionRepo.EXPECT().
+					Create(gomock.Any(), ID1, model.ReceptionStatusInProgress).
+					Return(model.Reception{ID: receptionID1, PVZID: ID1}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID: ID1,
+			},
+			wantErr: assert.AnError,
+			wantRes: model.Reception{},
+		},
+		{
+			name: "error.outboxWriter.Add",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), ID1).
+					Return(nil)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), ID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+				m.receptionRepo.EXPECT().
+					Create(gomock.Any(), ID1, model.ReceptionStatusInProgress).
+					Return(model.Reception{ID: receptionID1, PVZID: ID1}, nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			args: args{
+				pvzID: ID1,
+			},
+			wantErr: assert.AnError,
+			wantRes: model.Reception{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -198,15 +286,17 @@ func TestUseCase_CreateReception(t *testing.T) {
 				trManager:     NewMocktrManager(ctrl),
 				receptionRepo: NewMockreceptionRepo(ctrl),
 				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
 				metric:        NewMockmetrics(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.metric)
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.auditWriter, m.outboxWriter, m.metric)
 			require.NoError(t, err)
 
-			reception, err := uc.CreateReception(context.Background(), tc.args.pvzID)
+			reception, err := uc.CreateReception(context.Background(), tc.args.pvzID, model.Actor{UserID: userID1})
 			require.ErrorIs(t, err, tc.wantErr)
 			require.Equal(t, tc.wantRes, reception)
 		})
@@ -0,0 +1,16 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package reception_getting
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type receptionRepo interface {
+	GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error)
+}
+
+type productRepo interface {
+	GetByReceptionIDs(ctx context.Context, receptionIDs []model.ReceptionID) ([]model.Product, error)
+}
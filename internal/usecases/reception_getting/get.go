@@ -0,0 +1,44 @@
+package reception_getting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	receptionRepo receptionRepo
+	productRepo   productRepo
+}
+
+func New(receptionRepo receptionRepo, productRepo productRepo) (*UseCase, error) {
+	if receptionRepo == nil {
+		return nil, errors.New("receptionRepo is nil")
+	}
+	if productRepo == nil {
+		return nil, errors.New("productRepo is nil")
+	}
+	return &UseCase{
+		receptionRepo: receptionRepo,
+		productRepo:   productRepo,
+	}, nil
+}
+
+func (uc *UseCase) GetReception(ctx context.Context, receptionID model.ReceptionID) (model.ReceptionWithProducts, error) {
+	reception, err := uc.receptionRepo.GetByID(ctx, receptionID)
+	if err != nil {
+		return model.ReceptionWithProducts{}, fmt.Errorf("receptionRepo.GetByID: %w", err)
+	}
+
+	products, err := uc.productRepo.GetByReceptionIDs(ctx, []model.ReceptionID{receptionID})
+	if err != nil {
+		return model.ReceptionWithProducts{}, fmt.Errorf("productRepo.GetByReceptionIDs: %w", err)
+	}
+
+	return model.ReceptionWithProducts{
+		Reception: reception,
+		Products:  products,
+	}, nil
+}
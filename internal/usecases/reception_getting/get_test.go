@@ -0,0 +1,137 @@
+package reception_getting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockreceptionRepo(ctrl), NewMockproductRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockproductRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockreceptionRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_GetReception(t *testing.T) {
+	type mocks struct {
+		receptionRepo *MockreceptionRepo
+		productRepo   *MockproductRepo
+	}
+	type args struct {
+		receptionID model.ReceptionID
+	}
+
+	pvzID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	productID1 := model.NewProductID()
+	now := time.Now()
+
+	reception1 := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusInProgress,
+		ReceptedAt:      now,
+	}
+	product1 := model.Product{
+		ID:          productID1,
+		ReceptionID: receptionID1,
+		Category:    model.ProductCategoryShoes,
+		AddedAt:     now,
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		args    args
+		wantErr error
+		wantRes model.ReceptionWithProducts
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(reception1, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{receptionID1}).
+					Return([]model.Product{product1}, nil)
+			},
+			args: args{
+				receptionID: receptionID1,
+			},
+			wantErr: nil,
+			wantRes: model.ReceptionWithProducts{
+				Reception: reception1,
+				Products:  []model.Product{product1},
+			},
+		},
+		{
+			name: "businessError.ErrReceptionNotFound",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+			},
+			args: args{
+				receptionID: receptionID1,
+			},
+			wantErr: model.ErrReceptionNotFound,
+		},
+		{
+			name: "error.GetByReceptionIDs",
+			prepare: func(m *mocks) {
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(reception1, nil)
+				m.productRepo.EXPECT().
+					GetByReceptionIDs(gomock.Any(), []model.ReceptionID{receptionID1}).
+					Return(nil, assert.AnError)
+			},
+			args: args{
+				receptionID: receptionID1,
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				receptionRepo: NewMockreceptionRepo(ctrl),
+				productRepo:   NewMockproductRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.receptionRepo, m.productRepo)
+			require.NoError(t, err)
+
+			res, err := uc.GetReception(context.Background(), tc.args.receptionID)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package reception_getting -typed -destination mock_deps_test.go
+//
+
+// Package reception_getting is a generated GoMock package.
+package reception_getting
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreceptionRepo is a mock of receptionRepo interface.
+type MockreceptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreceptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockreceptionRepoMockRecorder is the mock recorder for MockreceptionRepo.
+type MockreceptionRepoMockRecorder struct {
+	mock *MockreceptionRepo
+}
+
+// NewMockreceptionRepo creates a new mock instance.
+func NewMockreceptionRepo(ctrl *gomock.Controller) *MockreceptionRepo {
+	mock := &MockreceptionRepo{ctrl: ctrl}
+	mock.recorder = &MockreceptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockreceptionRepo) GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, receptionID)
+	ret0, _ := ret[0].(model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockreceptionRepoMockRecorder) GetByID(ctx, receptionID any) *MockreceptionRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockreceptionRepo)(nil).GetByID), ctx, receptionID)
+	return &MockreceptionRepoGetByIDCall{Call: call}
+}
+
+// MockreceptionRepoGetByIDCall wrap *gomock.Call
+type MockreceptionRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoGetByIDCall) Return(arg0 model.Reception, arg1 error) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoGetByIDCall) Do(f func(context.Context, model.ReceptionID) (model.Reception, error)) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoGetByIDCall) DoAndReturn(f func(context.Context, model.ReceptionID) (model.Reception, error)) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockproductRepo is a mock of productRepo interface.
+type MockproductRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockproductRepoMockRecorder is the mock recorder for MockproductRepo.
+type MockproductRepoMockRecorder struct {
+	mock *MockproductRepo
+}
+
+// NewMockproductRepo creates a new mock instance.
+func NewMockproductRepo(ctrl *gomock.Controller) *MockproductRepo {
+	mock := &MockproductRepo{ctrl: ctrl}
+	mock.recorder = &MockproductRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByReceptionIDs mocks base method.
+func (m *MockproductRepo) GetByReceptionIDs(ctx context.Context, receptionIDs []model.ReceptionID) ([]model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReceptionIDs", ctx, receptionIDs)
+	ret0, _ := ret[0].([]model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReceptionIDs indicates an expected call of GetByReceptionIDs.
+func (mr *MockproductRepoMockRecorder) GetByReceptionIDs(ctx, receptionIDs any) *MockproductRepoGetByReceptionIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReceptionIDs", reflect.TypeOf((*MockproductRepo)(nil).GetByReceptionIDs), ctx, receptionIDs)
+	return &MockproductRepoGetByReceptionIDsCall{Call: call}
+}
+
+// MockproductRepoGetByReceptionIDsCall wrap *gomock.Call
+type MockproductRepoGetByReceptionIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockproductRepoGetByReceptionIDsCall) Return(arg0 []model.Product, arg1 error) *MockproductRepoGetByReceptionIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockproductRepoGetByReceptionIDsCall) Do(f func(context.Context, []model.ReceptionID) ([]model.Product, error)) *MockproductRepoGetByReceptionIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockproductRepoGetByReceptionIDsCall) DoAndReturn(f func(context.Context, []model.ReceptionID) ([]model.Product, error)) *MockproductRepoGetByReceptionIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,30 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package reception_reopening
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type receptionRepo interface {
+	GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error)
+	GetInProgress(ctx context.Context, pvzID model.PVZID) (model.Reception, error)
+	Reopen(ctx context.Context, receptionID model.ReceptionID, reopenedBy model.UserID) error
+}
+
+type pvzLocker interface {
+	Lock(ctx context.Context, pvzID model.PVZID) error
+}
+
+type auditWriter interface {
+	Write(ctx context.Context, event model.AuditEvent) error
+}
+
+type outboxWriter interface {
+	Add(ctx context.Context, event model.OutboxEvent) error
+}
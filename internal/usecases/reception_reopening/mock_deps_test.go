@@ -0,0 +1,406 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package reception_reopening -typed -destination mock_deps_test.go
+//
+
+// Package reception_reopening is a generated GoMock package.
+package reception_reopening
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockreceptionRepo is a mock of receptionRepo interface.
+type MockreceptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreceptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockreceptionRepoMockRecorder is the mock recorder for MockreceptionRepo.
+type MockreceptionRepoMockRecorder struct {
+	mock *MockreceptionRepo
+}
+
+// NewMockreceptionRepo creates a new mock instance.
+func NewMockreceptionRepo(ctrl *gomock.Controller) *MockreceptionRepo {
+	mock := &MockreceptionRepo{ctrl: ctrl}
+	mock.recorder = &MockreceptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockreceptionRepo) GetByID(ctx context.Context, receptionID model.ReceptionID) (model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, receptionID)
+	ret0, _ := ret[0].(model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockreceptionRepoMockRecorder) GetByID(ctx, receptionID any) *MockreceptionRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockreceptionRepo)(nil).GetByID), ctx, receptionID)
+	return &MockreceptionRepoGetByIDCall{Call: call}
+}
+
+// MockreceptionRepoGetByIDCall wrap *gomock.Call
+type MockreceptionRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoGetByIDCall) Return(arg0 model.Reception, arg1 error) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoGetByIDCall) Do(f func(context.Context, model.ReceptionID) (model.Reception, error)) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoGetByIDCall) DoAndReturn(f func(context.Context, model.ReceptionID) (model.Reception, error)) *MockreceptionRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetInProgress mocks base method.
+func (m *MockreceptionRepo) GetInProgress(ctx context.Context, pvzID model.PVZID) (model.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInProgress", ctx, pvzID)
+	ret0, _ := ret[0].(model.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInProgress indicates an expected call of GetInProgress.
+func (mr *MockreceptionRepoMockRecorder) GetInProgress(ctx, pvzID any) *MockreceptionRepoGetInProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInProgress", reflect.TypeOf((*MockreceptionRepo)(nil).GetInProgress), ctx, pvzID)
+	return &MockreceptionRepoGetInProgressCall{Call: call}
+}
+
+// MockreceptionRepoGetInProgressCall wrap *gomock.Call
+type MockreceptionRepoGetInProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoGetInProgressCall) Return(arg0 model.Reception, arg1 error) *MockreceptionRepoGetInProgressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoGetInProgressCall) Do(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionRepoGetInProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoGetInProgressCall) DoAndReturn(f func(context.Context, model.PVZID) (model.Reception, error)) *MockreceptionRepoGetInProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reopen mocks base method.
+func (m *MockreceptionRepo) Reopen(ctx context.Context, receptionID model.ReceptionID, reopenedBy model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, receptionID, reopenedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockreceptionRepoMockRecorder) Reopen(ctx, receptionID, reopenedBy any) *MockreceptionRepoReopenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockreceptionRepo)(nil).Reopen), ctx, receptionID, reopenedBy)
+	return &MockreceptionRepoReopenCall{Call: call}
+}
+
+// MockreceptionRepoReopenCall wrap *gomock.Call
+type MockreceptionRepoReopenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockreceptionRepoReopenCall) Return(arg0 error) *MockreceptionRepoReopenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockreceptionRepoReopenCall) Do(f func(context.Context, model.ReceptionID, model.UserID) error) *MockreceptionRepoReopenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockreceptionRepoReopenCall) DoAndReturn(f func(context.Context, model.ReceptionID, model.UserID) error) *MockreceptionRepoReopenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockpvzLocker is a mock of pvzLocker interface.
+type MockpvzLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzLockerMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzLockerMockRecorder is the mock recorder for MockpvzLocker.
+type MockpvzLockerMockRecorder struct {
+	mock *MockpvzLocker
+}
+
+// NewMockpvzLocker creates a new mock instance.
+func NewMockpvzLocker(ctrl *gomock.Controller) *MockpvzLocker {
+	mock := &MockpvzLocker{ctrl: ctrl}
+	mock.recorder = &MockpvzLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzLocker) EXPECT() *MockpvzLockerMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockpvzLocker) Lock(ctx context.Context, pvzID model.PVZID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockpvzLockerMockRecorder) Lock(ctx, pvzID any) *MockpvzLockerLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockpvzLocker)(nil).Lock), ctx, pvzID)
+	return &MockpvzLockerLockCall{Call: call}
+}
+
+// MockpvzLockerLockCall wrap *gomock.Call
+type MockpvzLockerLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpvzLockerLockCall) Return(arg0 error) *MockpvzLockerLockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpvzLockerLockCall) Do(f func(context.Context, model.PVZID) error) *MockpvzLockerLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpvzLockerLockCall) DoAndReturn(f func(context.Context, model.PVZID) error) *MockpvzLockerLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockauditWriter is a mock of auditWriter interface.
+type MockauditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockauditWriterMockRecorder is the mock recorder for MockauditWriter.
+type MockauditWriterMockRecorder struct {
+	mock *MockauditWriter
+}
+
+// NewMockauditWriter creates a new mock instance.
+func NewMockauditWriter(ctrl *gomock.Controller) *MockauditWriter {
+	mock := &MockauditWriter{ctrl: ctrl}
+	mock.recorder = &MockauditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditWriter) EXPECT() *MockauditWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockauditWriter) Write(ctx context.Context, event model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockauditWriterMockRecorder) Write(ctx, event any) *MockauditWriterWriteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockauditWriter)(nil).Write), ctx, event)
+	return &MockauditWriterWriteCall{Call: call}
+}
+
+// MockauditWriterWriteCall wrap *gomock.Call
+type MockauditWriterWriteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditWriterWriteCall) Return(arg0 error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditWriterWriteCall) Do(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditWriterWriteCall) DoAndReturn(f func(context.Context, model.AuditEvent) error) *MockauditWriterWriteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockoutboxWriter is a mock of outboxWriter interface.
+type MockoutboxWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxWriterMockRecorder is the mock recorder for MockoutboxWriter.
+type MockoutboxWriterMockRecorder struct {
+	mock *MockoutboxWriter
+}
+
+// NewMockoutboxWriter creates a new mock instance.
+func NewMockoutboxWriter(ctrl *gomock.Controller) *MockoutboxWriter {
+	mock := &MockoutboxWriter{ctrl: ctrl}
+	mock.recorder = &MockoutboxWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxWriter) EXPECT() *MockoutboxWriterMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockoutboxWriter) Add(ctx context.Context, event model.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockoutboxWriterMockRecorder) Add(ctx, event any) *MockoutboxWriterAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockoutboxWriter)(nil).Add), ctx, event)
+	return &MockoutboxWriterAddCall{Call: call}
+}
+
+// MockoutboxWriterAddCall wrap *gomock.Call
+type MockoutboxWriterAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoutboxWriterAddCall) Return(arg0 error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoutboxWriterAddCall) Do(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoutboxWriterAddCall) DoAndReturn(f func(context.Context, model.OutboxEvent) error) *MockoutboxWriterAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,100 @@
+package reception_reopening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	trManager     trManager
+	receptionRepo receptionRepo
+	pvzLocker     pvzLocker
+	auditWriter   auditWriter
+	outboxWriter  outboxWriter
+}
+
+func New(trManager trManager, receptionRepo receptionRepo, pvzLocker pvzLocker, auditWriter auditWriter, outboxWriter outboxWriter) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if receptionRepo == nil {
+		return nil, errors.New("receptionRepo is nil")
+	}
+	if pvzLocker == nil {
+		return nil, errors.New("pvzLocker is nil")
+	}
+	if auditWriter == nil {
+		return nil, errors.New("auditWriter is nil")
+	}
+	if outboxWriter == nil {
+		return nil, errors.New("outboxWriter is nil")
+	}
+	return &UseCase{
+		trManager:     trManager,
+		receptionRepo: receptionRepo,
+		pvzLocker:     pvzLocker,
+		auditWriter:   auditWriter,
+		outboxWriter:  outboxWriter,
+	}, nil
+}
+
+// ReopenReception sets the closed reception back in progress if there is no other open reception at the pvz.
+func (uc *UseCase) ReopenReception(ctx context.Context, receptionID model.ReceptionID, actor model.Actor) (model.Reception, error) {
+	var reception model.Reception
+
+	err := uc.trManager.Do(ctx, func(ctx context.Context) (err error) {
+		reception, err = uc.receptionRepo.GetByID(ctx, receptionID)
+		if err != nil {
+			return fmt.Errorf("receptionRepo.GetByID: %w", err)
+		}
+
+		err = uc.pvzLocker.Lock(ctx, reception.PVZID)
+		if err != nil {
+			return fmt.Errorf("pvzLocker.Lock: %w", err)
+		}
+
+		inProgress, err := uc.receptionRepo.GetInProgress(ctx, reception.PVZID)
+		if err == nil {
+			if inProgress.ID == receptionID {
+				return model.ErrReceptionNotClosed
+			}
+			return model.ErrReceptionAlreadyExists
+		}
+		if !errors.Is(err, model.ErrReceptionNotFound) {
+			return fmt.Errorf("receptionRepo.GetInProgress: %w", err)
+		}
+
+		err = uc.receptionRepo.Reopen(ctx, receptionID, actor.UserID)
+		if err != nil {
+			return fmt.Errorf("receptionRepo.Reopen: %w", err)
+		}
+
+		reception.ReceptionStatus = model.ReceptionStatusInProgress
+
+		err = uc.auditWriter.Write(ctx, model.AuditEvent{
+			UserID:      actor.UserID,
+			APIKeyID:    actor.APIKeyID,
+			Action:      model.AuditActionReceptionReopened,
+			PVZID:       reception.PVZID,
+			ReceptionID: &reception.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("auditWriter.Write: %w", err)
+		}
+
+		err = uc.outboxWriter.Add(ctx, model.NewReceptionOutboxEvent(model.OutboxEventReceptionReopened, reception))
+		if err != nil {
+			return fmt.Errorf("outboxWriter.Add: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Reception{}, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return reception, nil
+}
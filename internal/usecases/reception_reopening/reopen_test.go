@@ -0,0 +1,274 @@
+package reception_reopening
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), nil, NewMockauditWriter(ctrl), NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), nil, NewMockoutboxWriter(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockreceptionRepo(ctrl), NewMockpvzLocker(ctrl), NewMockauditWriter(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_ReopenReception(t *testing.T) {
+	type mocks struct {
+		trManager     *MocktrManager
+		receptionRepo *MockreceptionRepo
+		pvzLocker     *MockpvzLocker
+		auditWriter   *MockauditWriter
+		outboxWriter  *MockoutboxWriter
+	}
+
+	pvzID1 := model.NewPVZID()
+	receptionID1 := model.NewReceptionID()
+	receptionID2 := model.NewReceptionID()
+	userID1 := model.NewUserID()
+	now := time.Now()
+
+	closed := model.Reception{
+		ID:              receptionID1,
+		PVZID:           pvzID1,
+		ReceptionStatus: model.ReceptionStatusClose,
+		ReceptedAt:      now,
+	}
+
+	prepareLocked := func(m *mocks) {
+		m.trManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+				return do(ctx)
+			})
+		m.receptionRepo.EXPECT().
+			GetByID(gomock.Any(), receptionID1).
+			Return(closed, nil)
+		m.pvzLocker.EXPECT().
+			Lock(gomock.Any(), pvzID1).
+			Return(nil)
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+		wantRes model.Reception
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+				m.receptionRepo.EXPECT().
+					Reopen(gomock.Any(), receptionID1, userID1).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), model.AuditEvent{
+						UserID:      userID1,
+						Action:      model.AuditActionReceptionReopened,
+						PVZID:       pvzID1,
+						ReceptionID: &receptionID1,
+					}).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), model.NewReceptionOutboxEvent(model.OutboxEventReceptionReopened, model.Reception{
+						ID:              receptionID1,
+						PVZID:           pvzID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					})).
+					Return(nil)
+			},
+			wantErr: nil,
+			wantRes: model.Reception{
+				ID:              receptionID1,
+				PVZID:           pvzID1,
+				ReceptionStatus: model.ReceptionStatusInProgress,
+				ReceptedAt:      now,
+			},
+		},
+		{
+			name: "businessError.ErrReceptionNotFound",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+			},
+			wantErr: model.ErrReceptionNotFound,
+		},
+		{
+			name: "businessError.ErrReceptionNotClosed",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{
+						ID:              receptionID1,
+						PVZID:           pvzID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+			},
+			wantErr: model.ErrReceptionNotClosed,
+		},
+		{
+			name: "businessError.ErrReceptionAlreadyExists",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{
+						ID:              receptionID2,
+						PVZID:           pvzID1,
+						ReceptionStatus: model.ReceptionStatusInProgress,
+						ReceptedAt:      now,
+					}, nil)
+			},
+			wantErr: model.ErrReceptionAlreadyExists,
+		},
+		{
+			name: "error.pvzLocker.Lock",
+			prepare: func(m *mocks) {
+				m.trManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+						return do(ctx)
+					})
+				m.receptionRepo.EXPECT().
+					GetByID(gomock.Any(), receptionID1).
+					Return(closed, nil)
+				m.pvzLocker.EXPECT().
+					Lock(gomock.Any(), pvzID1).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.receptionRepo.GetInProgress",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.receptionRepo.Reopen",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+				m.receptionRepo.EXPECT().
+					Reopen(gomock.Any(), receptionID1, userID1).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.auditWriter.Write",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+				m.receptionRepo.EXPECT().
+					Reopen(gomock.Any(), receptionID1, userID1).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.outboxWriter.Add",
+			prepare: func(m *mocks) {
+				prepareLocked(m)
+				m.receptionRepo.EXPECT().
+					GetInProgress(gomock.Any(), pvzID1).
+					Return(model.Reception{}, model.ErrReceptionNotFound)
+				m.receptionRepo.EXPECT().
+					Reopen(gomock.Any(), receptionID1, userID1).
+					Return(nil)
+				m.auditWriter.EXPECT().
+					Write(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.outboxWriter.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:     NewMocktrManager(ctrl),
+				receptionRepo: NewMockreceptionRepo(ctrl),
+				pvzLocker:     NewMockpvzLocker(ctrl),
+				auditWriter:   NewMockauditWriter(ctrl),
+				outboxWriter:  NewMockoutboxWriter(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.trManager, m.receptionRepo, m.pvzLocker, m.auditWriter, m.outboxWriter)
+			require.NoError(t, err)
+
+			reception, err := uc.ReopenReception(context.Background(), receptionID1, model.Actor{UserID: userID1})
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, reception)
+		})
+	}
+}
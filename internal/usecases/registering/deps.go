@@ -10,3 +10,7 @@ import (
 type userRepo interface {
 	Create(ctx context.Context, email, passwordHash string, role model.UserRole) (*model.User, error)
 }
+
+type passwordChecker interface {
+	Check(password, email string) error
+}
@@ -80,65 +80,64 @@ func (c *MockuserRepoCreateCall) DoAndReturn(f func(context.Context, string, str
 	return c
 }
 
-// MocktokenProvider is a mock of tokenProvider interface.
-type MocktokenProvider struct {
+// MockpasswordChecker is a mock of passwordChecker interface.
+type MockpasswordChecker struct {
 	ctrl     *gomock.Controller
-	recorder *MocktokenProviderMockRecorder
+	recorder *MockpasswordCheckerMockRecorder
 	isgomock struct{}
 }
 
-// MocktokenProviderMockRecorder is the mock recorder for MocktokenProvider.
-type MocktokenProviderMockRecorder struct {
-	mock *MocktokenProvider
+// MockpasswordCheckerMockRecorder is the mock recorder for MockpasswordChecker.
+type MockpasswordCheckerMockRecorder struct {
+	mock *MockpasswordChecker
 }
 
-// NewMocktokenProvider creates a new mock instance.
-func NewMocktokenProvider(ctrl *gomock.Controller) *MocktokenProvider {
-	mock := &MocktokenProvider{ctrl: ctrl}
-	mock.recorder = &MocktokenProviderMockRecorder{mock}
+// NewMockpasswordChecker creates a new mock instance.
+func NewMockpasswordChecker(ctrl *gomock.Controller) *MockpasswordChecker {
+	mock := &MockpasswordChecker{ctrl: ctrl}
+	mock.recorder = &MockpasswordCheckerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktokenProvider) EXPECT() *MocktokenProviderMockRecorder {
+func (m *MockpasswordChecker) EXPECT() *MockpasswordCheckerMockRecorder {
 	return m.recorder
 }
 
-// CreateToken mocks base method.
-func (m *MocktokenProvider) CreateToken(email string, userID model.UserID, role model.UserRole) (string, error) {
+// Check mocks base method.
+func (m *MockpasswordChecker) Check(password, email string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", email, userID, role)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Check", password, email)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateToken indicates an expected call of CreateToken.
-func (mr *MocktokenProviderMockRecorder) CreateToken(email, userID, role any) *MocktokenProviderCreateTokenCall {
+// Check indicates an expected call of Check.
+func (mr *MockpasswordCheckerMockRecorder) Check(password, email any) *MockpasswordCheckerCheckCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), email, userID, role)
-	return &MocktokenProviderCreateTokenCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockpasswordChecker)(nil).Check), password, email)
+	return &MockpasswordCheckerCheckCall{Call: call}
 }
 
-// MocktokenProviderCreateTokenCall wrap *gomock.Call
-type MocktokenProviderCreateTokenCall struct {
+// MockpasswordCheckerCheckCall wrap *gomock.Call
+type MockpasswordCheckerCheckCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MocktokenProviderCreateTokenCall) Return(arg0 string, arg1 error) *MocktokenProviderCreateTokenCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockpasswordCheckerCheckCall) Return(arg0 error) *MockpasswordCheckerCheckCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MocktokenProviderCreateTokenCall) Do(f func(string, model.UserID, model.UserRole) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MockpasswordCheckerCheckCall) Do(f func(string, string) error) *MockpasswordCheckerCheckCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, model.UserID, model.UserRole) (string, error)) *MocktokenProviderCreateTokenCall {
+func (c *MockpasswordCheckerCheckCall) DoAndReturn(f func(string, string) error) *MockpasswordCheckerCheckCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -10,19 +10,30 @@ import (
 )
 
 type UseCase struct {
-	userRepo userRepo
+	userRepo        userRepo
+	passwordChecker passwordChecker
 }
 
-func New(userRepo userRepo) (*UseCase, error) {
+func New(userRepo userRepo, passwordChecker passwordChecker) (*UseCase, error) {
 	if userRepo == nil {
 		return nil, errors.New("userRepo is nil")
 	}
+	if passwordChecker == nil {
+		return nil, errors.New("passwordChecker is nil")
+	}
 	return &UseCase{
-		userRepo: userRepo,
+		userRepo:        userRepo,
+		passwordChecker: passwordChecker,
 	}, nil
 }
 
+// Register creates the user, the password is checked against the password policy, see model.PasswordPolicyError.
 func (uc *UseCase) Register(ctx context.Context, email, password string, role model.UserRole) (*model.User, error) {
+	err := uc.passwordChecker.Check(password, email)
+	if err != nil {
+		return nil, fmt.Errorf("passwordChecker.Check: %w", err)
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
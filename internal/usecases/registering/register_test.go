@@ -15,12 +15,19 @@ import (
 func TestNew(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
-		res, err := New(NewMockuserRepo(ctrl))
+		res, err := New(NewMockuserRepo(ctrl), NewMockpasswordChecker(ctrl))
 		require.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 	t.Run("error.first_nil", func(t *testing.T) {
-		res, err := New(nil)
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockpasswordChecker(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), nil)
 		require.Error(t, err)
 		require.Nil(t, res)
 	})
@@ -28,7 +35,8 @@ func TestNew(t *testing.T) {
 
 func TestUseCase_Register(t *testing.T) {
 	type mocks struct {
-		userRepo *MockuserRepo
+		userRepo        *MockuserRepo
+		passwordChecker *MockpasswordChecker
 	}
 	type args struct {
 		email    string
@@ -48,6 +56,7 @@ func TestUseCase_Register(t *testing.T) {
 		{
 			name: "success.Register",
 			prepare: func(m *mocks) {
+				m.passwordChecker.EXPECT().Check("password1", "test1").Return(nil)
 				m.userRepo.EXPECT().
 					Create(gomock.Any(), "test1", gomock.Any(), model.UserRoleEmployee).
 					Return(&model.User{
@@ -70,9 +79,24 @@ func TestUseCase_Register(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "businessError.PasswordPolicy",
+			prepare: func(m *mocks) {
+				m.passwordChecker.EXPECT().Check("password1", "test1").
+					Return(&model.PasswordPolicyError{Violations: []model.PasswordRule{model.PasswordRuleNotBreached}})
+			},
+			args: args{
+				email:    "test1",
+				password: "password1",
+				role:     model.UserRoleEmployee,
+			},
+			wantRes: nil,
+			wantErr: model.ErrPasswordPolicy,
+		},
 		{
 			name: "error.CreateUser",
 			prepare: func(m *mocks) {
+				m.passwordChecker.EXPECT().Check("password1", "test1").Return(nil)
 				m.userRepo.EXPECT().
 					Create(gomock.Any(), "test1", gomock.Any(), model.UserRoleEmployee).
 					Return(nil, assert.AnError)
@@ -88,6 +112,7 @@ func TestUseCase_Register(t *testing.T) {
 		{
 			name: "error.UserAlreadyExists",
 			prepare: func(m *mocks) {
+				m.passwordChecker.EXPECT().Check("password1", "test1").Return(nil)
 				m.userRepo.EXPECT().
 					Create(gomock.Any(), "test1", gomock.Any(), model.UserRoleEmployee).
 					Return(nil, model.ErrUserAlreadyExists)
@@ -107,12 +132,13 @@ func TestUseCase_Register(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			m := &mocks{
-				userRepo: NewMockuserRepo(ctrl),
+				userRepo:        NewMockuserRepo(ctrl),
+				passwordChecker: NewMockpasswordChecker(ctrl),
 			}
 
 			tc.prepare(m)
 
-			uc, err := New(m.userRepo)
+			uc, err := New(m.userRepo, m.passwordChecker)
 			require.NoError(t, err)
 
 			res, err := uc.Register(context.Background(), tc.args.email, tc.args.password, tc.args.role)
@@ -0,0 +1,31 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package token_refreshing
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type refreshTokenRepo interface {
+	Create(ctx context.Context, token model.RefreshToken) error
+	GetByHashForUpdate(ctx context.Context, tokenHash []byte) (model.RefreshToken, error)
+	Revoke(ctx context.Context, tokenID model.RefreshTokenID) error
+	RevokeFamily(ctx context.Context, familyID model.RefreshTokenFamilyID) error
+}
+
+type userRepo interface {
+	GetByID(ctx context.Context, userID model.UserID) (*model.User, error)
+}
+
+type tokenProvider interface {
+	CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess) (string, error)
+}
+
+type assignmentRepo interface {
+	GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error)
+}
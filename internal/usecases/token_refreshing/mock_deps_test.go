@@ -0,0 +1,446 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package token_refreshing -typed -destination mock_deps_test.go
+//
+
+// Package token_refreshing is a generated GoMock package.
+package token_refreshing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrefreshTokenRepo is a mock of refreshTokenRepo interface.
+type MockrefreshTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenRepoMockRecorder is the mock recorder for MockrefreshTokenRepo.
+type MockrefreshTokenRepoMockRecorder struct {
+	mock *MockrefreshTokenRepo
+}
+
+// NewMockrefreshTokenRepo creates a new mock instance.
+func NewMockrefreshTokenRepo(ctrl *gomock.Controller) *MockrefreshTokenRepo {
+	mock := &MockrefreshTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenRepo) EXPECT() *MockrefreshTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockrefreshTokenRepo) Create(ctx context.Context, token model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockrefreshTokenRepoMockRecorder) Create(ctx, token any) *MockrefreshTokenRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockrefreshTokenRepo)(nil).Create), ctx, token)
+	return &MockrefreshTokenRepoCreateCall{Call: call}
+}
+
+// MockrefreshTokenRepoCreateCall wrap *gomock.Call
+type MockrefreshTokenRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoCreateCall) Return(arg0 error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoCreateCall) Do(f func(context.Context, model.RefreshToken) error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoCreateCall) DoAndReturn(f func(context.Context, model.RefreshToken) error) *MockrefreshTokenRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockrefreshTokenRepo) GetByHashForUpdate(ctx context.Context, tokenHash []byte) (model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockrefreshTokenRepoMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockrefreshTokenRepo)(nil).GetByHashForUpdate), ctx, tokenHash)
+	return &MockrefreshTokenRepoGetByHashForUpdateCall{Call: call}
+}
+
+// MockrefreshTokenRepoGetByHashForUpdateCall wrap *gomock.Call
+type MockrefreshTokenRepoGetByHashForUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoGetByHashForUpdateCall) Return(arg0 model.RefreshToken, arg1 error) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoGetByHashForUpdateCall) Do(f func(context.Context, []byte) (model.RefreshToken, error)) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoGetByHashForUpdateCall) DoAndReturn(f func(context.Context, []byte) (model.RefreshToken, error)) *MockrefreshTokenRepoGetByHashForUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Revoke mocks base method.
+func (m *MockrefreshTokenRepo) Revoke(ctx context.Context, tokenID model.RefreshTokenID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockrefreshTokenRepoMockRecorder) Revoke(ctx, tokenID any) *MockrefreshTokenRepoRevokeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockrefreshTokenRepo)(nil).Revoke), ctx, tokenID)
+	return &MockrefreshTokenRepoRevokeCall{Call: call}
+}
+
+// MockrefreshTokenRepoRevokeCall wrap *gomock.Call
+type MockrefreshTokenRepoRevokeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoRevokeCall) Return(arg0 error) *MockrefreshTokenRepoRevokeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoRevokeCall) Do(f func(context.Context, model.RefreshTokenID) error) *MockrefreshTokenRepoRevokeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoRevokeCall) DoAndReturn(f func(context.Context, model.RefreshTokenID) error) *MockrefreshTokenRepoRevokeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeFamily mocks base method.
+func (m *MockrefreshTokenRepo) RevokeFamily(ctx context.Context, familyID model.RefreshTokenFamilyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockrefreshTokenRepoMockRecorder) RevokeFamily(ctx, familyID any) *MockrefreshTokenRepoRevokeFamilyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockrefreshTokenRepo)(nil).RevokeFamily), ctx, familyID)
+	return &MockrefreshTokenRepoRevokeFamilyCall{Call: call}
+}
+
+// MockrefreshTokenRepoRevokeFamilyCall wrap *gomock.Call
+type MockrefreshTokenRepoRevokeFamilyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoRevokeFamilyCall) Return(arg0 error) *MockrefreshTokenRepoRevokeFamilyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoRevokeFamilyCall) Do(f func(context.Context, model.RefreshTokenFamilyID) error) *MockrefreshTokenRepoRevokeFamilyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoRevokeFamilyCall) DoAndReturn(f func(context.Context, model.RefreshTokenFamilyID) error) *MockrefreshTokenRepoRevokeFamilyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockuserRepo) GetByID(ctx context.Context, userID model.UserID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserRepoMockRecorder) GetByID(ctx, userID any) *MockuserRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserRepo)(nil).GetByID), ctx, userID)
+	return &MockuserRepoGetByIDCall{Call: call}
+}
+
+// MockuserRepoGetByIDCall wrap *gomock.Call
+type MockuserRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoGetByIDCall) Return(arg0 *model.User, arg1 error) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoGetByIDCall) Do(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoGetByIDCall) DoAndReturn(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktokenProvider is a mock of tokenProvider interface.
+type MocktokenProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenProviderMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenProviderMockRecorder is the mock recorder for MocktokenProvider.
+type MocktokenProviderMockRecorder struct {
+	mock *MocktokenProvider
+}
+
+// NewMocktokenProvider creates a new mock instance.
+func NewMocktokenProvider(ctrl *gomock.Controller) *MocktokenProvider {
+	mock := &MocktokenProvider{ctrl: ctrl}
+	mock.recorder = &MocktokenProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenProvider) EXPECT() *MocktokenProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MocktokenProvider) CreateToken(email string, userID model.UserID, role model.UserRole, access model.PVZAccess) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", email, userID, role, access)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MocktokenProviderMockRecorder) CreateToken(email, userID, role, access any) *MocktokenProviderCreateTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MocktokenProvider)(nil).CreateToken), email, userID, role, access)
+	return &MocktokenProviderCreateTokenCall{Call: call}
+}
+
+// MocktokenProviderCreateTokenCall wrap *gomock.Call
+type MocktokenProviderCreateTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenProviderCreateTokenCall) Return(arg0 string, arg1 error) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenProviderCreateTokenCall) Do(f func(string, model.UserID, model.UserRole, model.PVZAccess) (string, error)) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenProviderCreateTokenCall) DoAndReturn(f func(string, model.UserID, model.UserRole, model.PVZAccess) (string, error)) *MocktokenProviderCreateTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockassignmentRepo is a mock of assignmentRepo interface.
+type MockassignmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockassignmentRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockassignmentRepoMockRecorder is the mock recorder for MockassignmentRepo.
+type MockassignmentRepoMockRecorder struct {
+	mock *MockassignmentRepo
+}
+
+// NewMockassignmentRepo creates a new mock instance.
+func NewMockassignmentRepo(ctrl *gomock.Controller) *MockassignmentRepo {
+	mock := &MockassignmentRepo{ctrl: ctrl}
+	mock.recorder = &MockassignmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockassignmentRepo) EXPECT() *MockassignmentRepoMockRecorder {
+	return m.recorder
+}
+
+// GetPVZIDs mocks base method.
+func (m *MockassignmentRepo) GetPVZIDs(ctx context.Context, userID model.UserID) ([]model.PVZID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZIDs", ctx, userID)
+	ret0, _ := ret[0].([]model.PVZID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZIDs indicates an expected call of GetPVZIDs.
+func (mr *MockassignmentRepoMockRecorder) GetPVZIDs(ctx, userID any) *MockassignmentRepoGetPVZIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZIDs", reflect.TypeOf((*MockassignmentRepo)(nil).GetPVZIDs), ctx, userID)
+	return &MockassignmentRepoGetPVZIDsCall{Call: call}
+}
+
+// MockassignmentRepoGetPVZIDsCall wrap *gomock.Call
+type MockassignmentRepoGetPVZIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockassignmentRepoGetPVZIDsCall) Return(arg0 []model.PVZID, arg1 error) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockassignmentRepoGetPVZIDsCall) Do(f func(context.Context, model.UserID) ([]model.PVZID, error)) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockassignmentRepoGetPVZIDsCall) DoAndReturn(f func(context.Context, model.UserID) ([]model.PVZID, error)) *MockassignmentRepoGetPVZIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
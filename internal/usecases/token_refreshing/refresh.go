@@ -0,0 +1,134 @@
+package token_refreshing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	trManager            trManager
+	refreshTokenRepo     refreshTokenRepo
+	userRepo             userRepo
+	tokenProvider        tokenProvider
+	assignmentRepo       assignmentRepo
+	refreshTokenLifetime time.Duration
+}
+
+func New(trManager trManager, refreshTokenRepo refreshTokenRepo, userRepo userRepo, tokenProvider tokenProvider,
+	assignmentRepo assignmentRepo, refreshTokenLifetime time.Duration,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if refreshTokenRepo == nil {
+		return nil, errors.New("refreshTokenRepo is nil")
+	}
+	if userRepo == nil {
+		return nil, errors.New("userRepo is nil")
+	}
+	if tokenProvider == nil {
+		return nil, errors.New("tokenProvider is nil")
+	}
+	if assignmentRepo == nil {
+		return nil, errors.New("assignmentRepo is nil")
+	}
+	if refreshTokenLifetime <= 0 {
+		return nil, errors.New("refreshTokenLifetime must be positive")
+	}
+	return &UseCase{
+		trManager:            trManager,
+		refreshTokenRepo:     refreshTokenRepo,
+		userRepo:             userRepo,
+		tokenProvider:        tokenProvider,
+		assignmentRepo:       assignmentRepo,
+		refreshTokenLifetime: refreshTokenLifetime,
+	}, nil
+}
+
+// Refresh exchanges the refresh token for a new token pair. The used refresh token is revoked, and presenting
+// an already revoked token revokes its whole family, so a stolen token stops working for both parties.
+func (uc *UseCase) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	var (
+		pair   model.TokenPair
+		reused bool
+	)
+
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		stored, err := uc.refreshTokenRepo.GetByHashForUpdate(ctx, model.HashRefreshToken(refreshToken))
+		if err != nil {
+			if errors.Is(err, model.ErrRefreshTokenNotFound) {
+				return model.ErrRefreshTokenInvalid
+			}
+			return fmt.Errorf("refreshTokenRepo.GetByHashForUpdate: %w", err)
+		}
+
+		if stored.RevokedAt != nil {
+			// the family revocation has to be committed, so the error is returned after the transaction
+			err = uc.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID)
+			if err != nil {
+				return fmt.Errorf("refreshTokenRepo.RevokeFamily: %w", err)
+			}
+			reused = true
+			return nil
+		}
+		if !stored.IsActive(time.Now()) {
+			return model.ErrRefreshTokenInvalid
+		}
+
+		user, err := uc.userRepo.GetByID(ctx, stored.UserID)
+		if err != nil {
+			if errors.Is(err, model.ErrUserNotFound) {
+				return model.ErrRefreshTokenInvalid
+			}
+			return fmt.Errorf("userRepo.GetByID: %w", err)
+		}
+		if user.Disabled {
+			return model.ErrRefreshTokenInvalid
+		}
+
+		err = uc.refreshTokenRepo.Revoke(ctx, stored.ID)
+		if err != nil {
+			return fmt.Errorf("refreshTokenRepo.Revoke: %w", err)
+		}
+
+		newRefreshToken, newStored, err := model.NewRefreshToken(user.UserID, stored.FamilyID, uc.refreshTokenLifetime)
+		if err != nil {
+			return fmt.Errorf("model.NewRefreshToken: %w", err)
+		}
+
+		err = uc.refreshTokenRepo.Create(ctx, newStored)
+		if err != nil {
+			return fmt.Errorf("refreshTokenRepo.Create: %w", err)
+		}
+
+		pvzIDs, err := uc.assignmentRepo.GetPVZIDs(ctx, user.UserID)
+		if err != nil {
+			return fmt.Errorf("assignmentRepo.GetPVZIDs: %w", err)
+		}
+
+		accessToken, err := uc.tokenProvider.CreateToken(user.Email, user.UserID, user.UserRole,
+			model.PVZAccess{PVZIDs: pvzIDs})
+		if err != nil {
+			return fmt.Errorf("tokenProvider.CreateToken: %w", err)
+		}
+
+		pair = model.TokenPair{
+			AccessToken:  accessToken,
+			RefreshToken: newRefreshToken,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.TokenPair{}, fmt.Errorf("trManager.Do: %w", err)
+	}
+	if reused {
+		return model.TokenPair{}, model.ErrRefreshTokenInvalid
+	}
+
+	return pair, nil
+}
@@ -0,0 +1,321 @@
+package token_refreshing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockassignmentRepo(ctrl), time.Hour)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockrefreshTokenRepo(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockassignmentRepo(ctrl), time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockassignmentRepo(ctrl), time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), nil, NewMocktokenProvider(ctrl), NewMockassignmentRepo(ctrl), time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockuserRepo(ctrl), nil, NewMockassignmentRepo(ctrl), time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), nil, time.Hour)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_refreshTokenLifetime", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockuserRepo(ctrl), NewMocktokenProvider(ctrl), NewMockassignmentRepo(ctrl), 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_Refresh(t *testing.T) {
+	type mocks struct {
+		trManager        *MocktrManager
+		refreshTokenRepo *MockrefreshTokenRepo
+		userRepo         *MockuserRepo
+		tokenProvider    *MocktokenProvider
+		assignmentRepo   *MockassignmentRepo
+	}
+
+	userID := model.NewUserID()
+	pvzID := model.NewPVZID()
+	familyID := model.NewRefreshTokenFamilyID()
+	refreshToken, stored, err := model.NewRefreshToken(userID, familyID, time.Hour)
+	require.NoError(t, err)
+
+	revokedAt := time.Now().Add(-time.Minute)
+	revoked := stored
+	revoked.RevokedAt = &revokedAt
+
+	expired := stored
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	user := &model.User{
+		UserID:   userID,
+		Email:    "test1",
+		UserRole: model.UserRoleEmployee,
+	}
+
+	testCases := []struct {
+		name             string
+		prepare          func(t *testing.T, m *mocks)
+		wantAccessToken  string
+		wantRefreshToken bool
+		wantErr          error
+	}{
+		{
+			name: "success",
+			prepare: func(t *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), model.HashRefreshToken(refreshToken)).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Revoke(gomock.Any(), stored.ID).
+					Return(nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+						require.NotEqual(t, stored.ID, token.ID)
+						require.Equal(t, familyID, token.FamilyID)
+						require.Equal(t, userID, token.UserID)
+						return nil
+					})
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID).
+					Return([]model.PVZID{pvzID}, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("test1", userID, model.UserRoleEmployee, model.PVZAccess{PVZIDs: []model.PVZID{pvzID}}).
+					Return("access1", nil)
+			},
+			wantAccessToken:  "access1",
+			wantRefreshToken: true,
+			wantErr:          nil,
+		},
+		{
+			name: "businessError.NotFound",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(model.RefreshToken{}, model.ErrRefreshTokenNotFound)
+			},
+			wantErr: model.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "businessError.Reused",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(revoked, nil)
+				m.refreshTokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), familyID).
+					Return(nil)
+			},
+			wantErr: model.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "businessError.Expired",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(expired, nil)
+			},
+			wantErr: model.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "businessError.UserNotFound",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(nil, model.ErrUserNotFound)
+			},
+			wantErr: model.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "businessError.UserDisabled",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(&model.User{UserID: userID, UserRole: model.UserRoleEmployee, Disabled: true}, nil)
+			},
+			wantErr: model.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "error.GetByHashForUpdate",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(model.RefreshToken{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.RevokeFamily",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(revoked, nil)
+				m.refreshTokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), familyID).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetByID",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.Revoke",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Revoke(gomock.Any(), stored.ID).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.Create",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Revoke(gomock.Any(), stored.ID).
+					Return(nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.GetPVZIDs",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Revoke(gomock.Any(), stored.ID).
+					Return(nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.CreateToken",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.refreshTokenRepo.EXPECT().
+					GetByHashForUpdate(gomock.Any(), gomock.Any()).
+					Return(stored, nil)
+				m.userRepo.EXPECT().
+					GetByID(gomock.Any(), userID).
+					Return(user, nil)
+				m.refreshTokenRepo.EXPECT().
+					Revoke(gomock.Any(), stored.ID).
+					Return(nil)
+				m.refreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				m.assignmentRepo.EXPECT().
+					GetPVZIDs(gomock.Any(), userID).
+					Return(nil, nil)
+				m.tokenProvider.EXPECT().
+					CreateToken("test1", userID, model.UserRoleEmployee, model.PVZAccess{}).
+					Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				trManager:        NewMocktrManager(ctrl),
+				refreshTokenRepo: NewMockrefreshTokenRepo(ctrl),
+				userRepo:         NewMockuserRepo(ctrl),
+				tokenProvider:    NewMocktokenProvider(ctrl),
+				assignmentRepo:   NewMockassignmentRepo(ctrl),
+			}
+
+			m.trManager.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+					return do(ctx)
+				})
+			tc.prepare(t, m)
+
+			uc, err := New(m.trManager, m.refreshTokenRepo, m.userRepo, m.tokenProvider, m.assignmentRepo, time.Hour)
+			require.NoError(t, err)
+
+			res, err := uc.Refresh(context.Background(), refreshToken)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantAccessToken, res.AccessToken)
+			require.Equal(t, tc.wantRefreshToken, res.RefreshToken != "")
+		})
+	}
+}
@@ -0,0 +1,32 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package user_managing
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type trManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type userRepo interface {
+	GetByID(ctx context.Context, userID model.UserID) (*model.User, error)
+	Search(ctx context.Context, role *model.UserRole, offset, limit int64) ([]model.User, error)
+	Update(ctx context.Context, userID model.UserID, update model.UserUpdate) (*model.User, error)
+	UpdatePassword(ctx context.Context, userID model.UserID, passwordHash string) error
+	AllowOIDCLink(ctx context.Context, userID model.UserID) error
+}
+
+type refreshTokenRepo interface {
+	RevokeByUser(ctx context.Context, userID model.UserID) error
+}
+
+type passwordChecker interface {
+	Check(password, email string) error
+}
+
+type activeUserCache interface {
+	Forget(userID model.UserID)
+}
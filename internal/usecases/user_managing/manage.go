@@ -0,0 +1,178 @@
+package user_managing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	trManager        trManager
+	userRepo         userRepo
+	refreshTokenRepo refreshTokenRepo
+	passwordChecker  passwordChecker
+	activeUserCache  activeUserCache
+}
+
+func New(trManager trManager, userRepo userRepo, refreshTokenRepo refreshTokenRepo, passwordChecker passwordChecker,
+	activeUserCache activeUserCache,
+) (*UseCase, error) {
+	if trManager == nil {
+		return nil, errors.New("trManager is nil")
+	}
+	if userRepo == nil {
+		return nil, errors.New("userRepo is nil")
+	}
+	if refreshTokenRepo == nil {
+		return nil, errors.New("refreshTokenRepo is nil")
+	}
+	if passwordChecker == nil {
+		return nil, errors.New("passwordChecker is nil")
+	}
+	if activeUserCache == nil {
+		return nil, errors.New("activeUserCache is nil")
+	}
+	return &UseCase{
+		trManager:        trManager,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		passwordChecker:  passwordChecker,
+		activeUserCache:  activeUserCache,
+	}, nil
+}
+
+// List returns the page of users ordered by registration time. Nil role means any role.
+func (uc *UseCase) List(ctx context.Context, role *model.UserRole, page, limit int64) ([]model.User, error) {
+	offset := (page - 1) * limit
+
+	users, err := uc.userRepo.Search(ctx, role, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("userRepo.Search: %w", err)
+	}
+
+	return users, nil
+}
+
+// Update changes the role and the disabled flag of the user. The new role gets into the next issued token.
+// Disabling revokes the refresh tokens, access tokens are rejected by this instance at once
+// and by other instances after their cached user status expires.
+// The role of a user linked to OpenID Connect follows the groups of the identity provider on every login,
+// so changing it returns model.ErrUserRoleManagedBySSO instead of being silently reverted.
+func (uc *UseCase) Update(ctx context.Context, userID model.UserID, update model.UserUpdate) (*model.User, error) {
+	var user *model.User
+
+	err := uc.trManager.Do(ctx, func(ctx context.Context) error {
+		if update.UserRole != nil {
+			current, err := uc.userRepo.GetByID(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("userRepo.GetByID: %w", err)
+			}
+			if current.SSOLinked && current.UserRole != *update.UserRole {
+				return model.ErrUserRoleManagedBySSO
+			}
+		}
+
+		var err error
+		user, err = uc.userRepo.Update(ctx, userID, update)
+		if err != nil {
+			return fmt.Errorf("userRepo.Update: %w", err)
+		}
+
+		if user.Disabled {
+			err = uc.refreshTokenRepo.RevokeByUser(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("refreshTokenRepo.RevokeByUser: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	uc.activeUserCache.Forget(userID)
+
+	return user, nil
+}
+
+// AllowSSOLink lets the next OpenID Connect login with the email of the user link the identity to the user,
+// e.g. to move a user registered with a password to the SSO. Without it such a login is rejected.
+func (uc *UseCase) AllowSSOLink(ctx context.Context, userID model.UserID) error {
+	err := uc.userRepo.AllowOIDCLink(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("userRepo.AllowOIDCLink: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password of the user and revokes the refresh tokens, so other sessions have to log in again.
+// The password is checked against the password policy, see model.PasswordPolicyError.
+func (uc *UseCase) ResetPassword(ctx context.Context, userID model.UserID, password string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("userRepo.GetByID: %w", err)
+	}
+
+	return uc.setPassword(ctx, *user, password)
+}
+
+// ChangePassword sets a new password of the user if the current one is right and the new one meets the password policy.
+// The refresh tokens are revoked, the access token of the request keeps working until it expires.
+func (uc *UseCase) ChangePassword(ctx context.Context, userID model.UserID, currentPassword, newPassword string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("userRepo.GetByID: %w", err)
+	}
+
+	// users of the OpenID Connect login have no password, a moderator can set one with ResetPassword
+	if user.Password == "" {
+		return model.ErrWrongUserPassword
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(currentPassword))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return model.ErrWrongUserPassword
+		}
+		return fmt.Errorf("bcrypt.CompareHashAndPassword: %w", err)
+	}
+
+	return uc.setPassword(ctx, *user, newPassword)
+}
+
+func (uc *UseCase) setPassword(ctx context.Context, user model.User, password string) error {
+	err := uc.passwordChecker.Check(password, user.Email)
+	if err != nil {
+		return fmt.Errorf("passwordChecker.Check: %w", err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
+	}
+
+	err = uc.trManager.Do(ctx, func(ctx context.Context) error {
+		err := uc.userRepo.UpdatePassword(ctx, user.UserID, string(hashedPassword))
+		if err != nil {
+			return fmt.Errorf("userRepo.UpdatePassword: %w", err)
+		}
+
+		err = uc.refreshTokenRepo.RevokeByUser(ctx, user.UserID)
+		if err != nil {
+			return fmt.Errorf("refreshTokenRepo.RevokeByUser: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("trManager.Do: %w", err)
+	}
+
+	return nil
+}
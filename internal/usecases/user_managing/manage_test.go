@@ -0,0 +1,421 @@
+package user_managing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockuserRepo(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockpasswordChecker(ctrl), NewMockactiveUserCache(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockuserRepo(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockpasswordChecker(ctrl), NewMockactiveUserCache(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), nil, NewMockrefreshTokenRepo(ctrl), NewMockpasswordChecker(ctrl), NewMockactiveUserCache(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.third_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockuserRepo(ctrl), nil, NewMockpasswordChecker(ctrl), NewMockactiveUserCache(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fourth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockuserRepo(ctrl), NewMockrefreshTokenRepo(ctrl), nil, NewMockactiveUserCache(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.fifth_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocktrManager(ctrl), NewMockuserRepo(ctrl), NewMockrefreshTokenRepo(ctrl), NewMockpasswordChecker(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+type mocks struct {
+	trManager        *MocktrManager
+	userRepo         *MockuserRepo
+	refreshTokenRepo *MockrefreshTokenRepo
+	passwordChecker  *MockpasswordChecker
+	activeUserCache  *MockactiveUserCache
+}
+
+func newUseCase(t *testing.T, prepare func(m *mocks)) *UseCase {
+	ctrl := gomock.NewController(t)
+
+	m := &mocks{
+		trManager:        NewMocktrManager(ctrl),
+		userRepo:         NewMockuserRepo(ctrl),
+		refreshTokenRepo: NewMockrefreshTokenRepo(ctrl),
+		passwordChecker:  NewMockpasswordChecker(ctrl),
+		activeUserCache:  NewMockactiveUserCache(ctrl),
+	}
+
+	m.trManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, do func(context.Context) error) error {
+			return do(ctx)
+		}).
+		AnyTimes()
+
+	prepare(m)
+
+	uc, err := New(m.trManager, m.userRepo, m.refreshTokenRepo, m.passwordChecker, m.activeUserCache)
+	require.NoError(t, err)
+
+	return uc
+}
+
+func TestUseCase_List(t *testing.T) {
+	role := model.UserRoleEmployee
+	users := []model.User{{UserID: model.NewUserID(), Email: "test1@gmail.com", UserRole: role}}
+
+	testCases := []struct {
+		name      string
+		prepare   func(m *mocks)
+		wantUsers []model.User
+		wantErr   error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().Search(gomock.Any(), &role, int64(30), int64(30)).Return(users, nil)
+			},
+			wantUsers: users,
+			wantErr:   nil,
+		},
+		{
+			name: "error.Search",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().Search(gomock.Any(), &role, int64(30), int64(30)).Return(nil, assert.AnError)
+			},
+			wantUsers: nil,
+			wantErr:   assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			res, err := uc.List(context.Background(), &role, 2, 30)
+			require.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantUsers, res)
+		})
+	}
+}
+
+func TestUseCase_Update(t *testing.T) {
+	userID := model.NewUserID()
+	disabled := true
+	enabled := false
+	moderator := model.UserRoleModerator
+
+	disableUpdate := model.UserUpdate{Disabled: &disabled}
+	disabledUser := &model.User{UserID: userID, UserRole: model.UserRoleEmployee, Disabled: true}
+
+	roleUpdate := model.UserUpdate{UserRole: &moderator, Disabled: &enabled}
+	moderatorUser := &model.User{UserID: userID, UserRole: model.UserRoleModerator}
+	employeeUser := &model.User{UserID: userID, UserRole: model.UserRoleEmployee}
+	ssoEmployeeUser := &model.User{UserID: userID, UserRole: model.UserRoleEmployee, SSOLinked: true}
+	ssoModeratorUser := &model.User{UserID: userID, UserRole: model.UserRoleModerator, SSOLinked: true}
+
+	testCases := []struct {
+		name     string
+		update   model.UserUpdate
+		prepare  func(m *mocks)
+		wantUser *model.User
+		wantErr  error
+	}{
+		{
+			name:   "success.disable",
+			update: disableUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().Update(gomock.Any(), userID, disableUpdate).Return(disabledUser, nil)
+				m.refreshTokenRepo.EXPECT().RevokeByUser(gomock.Any(), userID).Return(nil)
+				m.activeUserCache.EXPECT().Forget(userID)
+			},
+			wantUser: disabledUser,
+			wantErr:  nil,
+		},
+		{
+			name:   "success.role",
+			update: roleUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(employeeUser, nil)
+				m.userRepo.EXPECT().Update(gomock.Any(), userID, roleUpdate).Return(moderatorUser, nil)
+				m.activeUserCache.EXPECT().Forget(userID)
+			},
+			wantUser: moderatorUser,
+			wantErr:  nil,
+		},
+		{
+			name:   "success.sso_same_role",
+			update: roleUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(ssoModeratorUser, nil)
+				m.userRepo.EXPECT().Update(gomock.Any(), userID, roleUpdate).Return(ssoModeratorUser, nil)
+				m.activeUserCache.EXPECT().Forget(userID)
+			},
+			wantUser: ssoModeratorUser,
+			wantErr:  nil,
+		},
+		{
+			name:   "businessError.UserRoleManagedBySSO",
+			update: roleUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(ssoEmployeeUser, nil)
+			},
+			wantUser: nil,
+			wantErr:  model.ErrUserRoleManagedBySSO,
+		},
+		{
+			name:   "error.GetByID",
+			update: roleUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, assert.AnError)
+			},
+			wantUser: nil,
+			wantErr:  assert.AnError,
+		},
+		{
+			name:   "businessError.UserNotFound",
+			update: disableUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().Update(gomock.Any(), userID, disableUpdate).Return(nil, model.ErrUserNotFound)
+			},
+			wantUser: nil,
+			wantErr:  model.ErrUserNotFound,
+		},
+		{
+			name:   "error.Update",
+			update: disableUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().Update(gomock.Any(), userID, disableUpdate).Return(nil, assert.AnError)
+			},
+			wantUser: nil,
+			wantErr:  assert.AnError,
+		},
+		{
+			name:   "error.RevokeByUser",
+			update: disableUpdate,
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().Update(gomock.Any(), userID, disableUpdate).Return(disabledUser, nil)
+				m.refreshTokenRepo.EXPECT().RevokeByUser(gomock.Any(), userID).Return(assert.AnError)
+			},
+			wantUser: nil,
+			wantErr:  assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			res, err := uc.Update(context.Background(), userID, tc.update)
+			require.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantUser, res)
+		})
+	}
+}
+
+func TestUseCase_AllowSSOLink(t *testing.T) {
+	userID := model.NewUserID()
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().AllowOIDCLink(gomock.Any(), userID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "businessError.UserNotFound",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().AllowOIDCLink(gomock.Any(), userID).Return(model.ErrUserNotFound)
+			},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name: "error.AllowOIDCLink",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().AllowOIDCLink(gomock.Any(), userID).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			err := uc.AllowSSOLink(context.Background(), userID)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_ResetPassword(t *testing.T) {
+	userID := model.NewUserID()
+	user := &model.User{UserID: userID, Email: "test1@gmail.com", UserRole: model.UserRoleEmployee}
+	policyErr := &model.PasswordPolicyError{Violations: []model.PasswordRule{model.PasswordRuleNotBreached}}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.passwordChecker.EXPECT().Check("new_password", "test1@gmail.com").Return(nil)
+				m.userRepo.EXPECT().UpdatePassword(gomock.Any(), userID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ model.UserID, passwordHash string) error {
+						require.NoError(t, bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte("new_password")))
+						return nil
+					})
+				m.refreshTokenRepo.EXPECT().RevokeByUser(gomock.Any(), userID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "businessError.UserNotFound",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, model.ErrUserNotFound)
+			},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name: "businessError.PasswordPolicy",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.passwordChecker.EXPECT().Check("new_password", "test1@gmail.com").Return(policyErr)
+			},
+			wantErr: model.ErrPasswordPolicy,
+		},
+		{
+			name: "error.RevokeByUser",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.passwordChecker.EXPECT().Check("new_password", "test1@gmail.com").Return(nil)
+				m.userRepo.EXPECT().UpdatePassword(gomock.Any(), userID, gomock.Any()).Return(nil)
+				m.refreshTokenRepo.EXPECT().RevokeByUser(gomock.Any(), userID).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			err := uc.ResetPassword(context.Background(), userID, "new_password")
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestUseCase_ChangePassword(t *testing.T) {
+	userID := model.NewUserID()
+	hash, err := bcrypt.GenerateFromPassword([]byte("old_password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := &model.User{UserID: userID, Email: "test1@gmail.com", Password: string(hash), UserRole: model.UserRoleEmployee}
+
+	testCases := []struct {
+		name            string
+		currentPassword string
+		prepare         func(m *mocks)
+		wantErr         error
+	}{
+		{
+			name:            "success",
+			currentPassword: "old_password",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.passwordChecker.EXPECT().Check("new_password", "test1@gmail.com").Return(nil)
+				m.userRepo.EXPECT().UpdatePassword(gomock.Any(), userID, gomock.Any()).Return(nil)
+				m.refreshTokenRepo.EXPECT().RevokeByUser(gomock.Any(), userID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:            "businessError.WrongUserPassword",
+			currentPassword: "wrong_password",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+			},
+			wantErr: model.ErrWrongUserPassword,
+		},
+		{
+			name:            "businessError.NoPassword",
+			currentPassword: "",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).
+					Return(&model.User{UserID: userID, Email: "test1@gmail.com", UserRole: model.UserRoleEmployee}, nil)
+			},
+			wantErr: model.ErrWrongUserPassword,
+		},
+		{
+			name:            "businessError.UserNotFound",
+			currentPassword: "old_password",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, model.ErrUserNotFound)
+			},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name:            "businessError.PasswordPolicy",
+			currentPassword: "old_password",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.passwordChecker.EXPECT().Check("new_password", "test1@gmail.com").
+					Return(&model.PasswordPolicyError{Violations: []model.PasswordRule{model.PasswordRuleMinLength}})
+			},
+			wantErr: model.ErrPasswordPolicy,
+		},
+		{
+			name:            "error.UpdatePassword",
+			currentPassword: "old_password",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.passwordChecker.EXPECT().Check("new_password", "test1@gmail.com").Return(nil)
+				m.userRepo.EXPECT().UpdatePassword(gomock.Any(), userID, gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newUseCase(t, tc.prepare)
+
+			err := uc.ChangePassword(context.Background(), userID, tc.currentPassword, "new_password")
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
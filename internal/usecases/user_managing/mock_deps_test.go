@@ -0,0 +1,481 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_managing -typed -destination mock_deps_test.go
+//
+
+// Package user_managing is a generated GoMock package.
+package user_managing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocktrManager is a mock of trManager interface.
+type MocktrManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktrManagerMockRecorder is the mock recorder for MocktrManager.
+type MocktrManagerMockRecorder struct {
+	mock *MocktrManager
+}
+
+// NewMocktrManager creates a new mock instance.
+func NewMocktrManager(ctrl *gomock.Controller) *MocktrManager {
+	mock := &MocktrManager{ctrl: ctrl}
+	mock.recorder = &MocktrManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrManager) EXPECT() *MocktrManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktrManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktrManagerMockRecorder) Do(ctx, fn any) *MocktrManagerDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktrManager)(nil).Do), ctx, fn)
+	return &MocktrManagerDoCall{Call: call}
+}
+
+// MocktrManagerDoCall wrap *gomock.Call
+type MocktrManagerDoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktrManagerDoCall) Return(err error) *MocktrManagerDoCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktrManagerDoCall) Do(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktrManagerDoCall) DoAndReturn(f func(context.Context, func(context.Context) error) error) *MocktrManagerDoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// AllowOIDCLink mocks base method.
+func (m *MockuserRepo) AllowOIDCLink(ctx context.Context, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowOIDCLink", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllowOIDCLink indicates an expected call of AllowOIDCLink.
+func (mr *MockuserRepoMockRecorder) AllowOIDCLink(ctx, userID any) *MockuserRepoAllowOIDCLinkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowOIDCLink", reflect.TypeOf((*MockuserRepo)(nil).AllowOIDCLink), ctx, userID)
+	return &MockuserRepoAllowOIDCLinkCall{Call: call}
+}
+
+// MockuserRepoAllowOIDCLinkCall wrap *gomock.Call
+type MockuserRepoAllowOIDCLinkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoAllowOIDCLinkCall) Return(arg0 error) *MockuserRepoAllowOIDCLinkCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoAllowOIDCLinkCall) Do(f func(context.Context, model.UserID) error) *MockuserRepoAllowOIDCLinkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoAllowOIDCLinkCall) DoAndReturn(f func(context.Context, model.UserID) error) *MockuserRepoAllowOIDCLinkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockuserRepo) GetByID(ctx context.Context, userID model.UserID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserRepoMockRecorder) GetByID(ctx, userID any) *MockuserRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserRepo)(nil).GetByID), ctx, userID)
+	return &MockuserRepoGetByIDCall{Call: call}
+}
+
+// MockuserRepoGetByIDCall wrap *gomock.Call
+type MockuserRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoGetByIDCall) Return(arg0 *model.User, arg1 error) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoGetByIDCall) Do(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoGetByIDCall) DoAndReturn(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Search mocks base method.
+func (m *MockuserRepo) Search(ctx context.Context, role *model.UserRole, offset, limit int64) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, role, offset, limit)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockuserRepoMockRecorder) Search(ctx, role, offset, limit any) *MockuserRepoSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockuserRepo)(nil).Search), ctx, role, offset, limit)
+	return &MockuserRepoSearchCall{Call: call}
+}
+
+// MockuserRepoSearchCall wrap *gomock.Call
+type MockuserRepoSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoSearchCall) Return(arg0 []model.User, arg1 error) *MockuserRepoSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoSearchCall) Do(f func(context.Context, *model.UserRole, int64, int64) ([]model.User, error)) *MockuserRepoSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoSearchCall) DoAndReturn(f func(context.Context, *model.UserRole, int64, int64) ([]model.User, error)) *MockuserRepoSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockuserRepo) Update(ctx context.Context, userID model.UserID, update model.UserUpdate) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, update)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockuserRepoMockRecorder) Update(ctx, userID, update any) *MockuserRepoUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockuserRepo)(nil).Update), ctx, userID, update)
+	return &MockuserRepoUpdateCall{Call: call}
+}
+
+// MockuserRepoUpdateCall wrap *gomock.Call
+type MockuserRepoUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoUpdateCall) Return(arg0 *model.User, arg1 error) *MockuserRepoUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoUpdateCall) Do(f func(context.Context, model.UserID, model.UserUpdate) (*model.User, error)) *MockuserRepoUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoUpdateCall) DoAndReturn(f func(context.Context, model.UserID, model.UserUpdate) (*model.User, error)) *MockuserRepoUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePassword mocks base method.
+func (m *MockuserRepo) UpdatePassword(ctx context.Context, userID model.UserID, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userID, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockuserRepoMockRecorder) UpdatePassword(ctx, userID, passwordHash any) *MockuserRepoUpdatePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockuserRepo)(nil).UpdatePassword), ctx, userID, passwordHash)
+	return &MockuserRepoUpdatePasswordCall{Call: call}
+}
+
+// MockuserRepoUpdatePasswordCall wrap *gomock.Call
+type MockuserRepoUpdatePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoUpdatePasswordCall) Return(arg0 error) *MockuserRepoUpdatePasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoUpdatePasswordCall) Do(f func(context.Context, model.UserID, string) error) *MockuserRepoUpdatePasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoUpdatePasswordCall) DoAndReturn(f func(context.Context, model.UserID, string) error) *MockuserRepoUpdatePasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockrefreshTokenRepo is a mock of refreshTokenRepo interface.
+type MockrefreshTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenRepoMockRecorder is the mock recorder for MockrefreshTokenRepo.
+type MockrefreshTokenRepoMockRecorder struct {
+	mock *MockrefreshTokenRepo
+}
+
+// NewMockrefreshTokenRepo creates a new mock instance.
+func NewMockrefreshTokenRepo(ctrl *gomock.Controller) *MockrefreshTokenRepo {
+	mock := &MockrefreshTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenRepo) EXPECT() *MockrefreshTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// RevokeByUser mocks base method.
+func (m *MockrefreshTokenRepo) RevokeByUser(ctx context.Context, userID model.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUser indicates an expected call of RevokeByUser.
+func (mr *MockrefreshTokenRepoMockRecorder) RevokeByUser(ctx, userID any) *MockrefreshTokenRepoRevokeByUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUser", reflect.TypeOf((*MockrefreshTokenRepo)(nil).RevokeByUser), ctx, userID)
+	return &MockrefreshTokenRepoRevokeByUserCall{Call: call}
+}
+
+// MockrefreshTokenRepoRevokeByUserCall wrap *gomock.Call
+type MockrefreshTokenRepoRevokeByUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockrefreshTokenRepoRevokeByUserCall) Return(arg0 error) *MockrefreshTokenRepoRevokeByUserCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockrefreshTokenRepoRevokeByUserCall) Do(f func(context.Context, model.UserID) error) *MockrefreshTokenRepoRevokeByUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockrefreshTokenRepoRevokeByUserCall) DoAndReturn(f func(context.Context, model.UserID) error) *MockrefreshTokenRepoRevokeByUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockpasswordChecker is a mock of passwordChecker interface.
+type MockpasswordChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockpasswordCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockpasswordCheckerMockRecorder is the mock recorder for MockpasswordChecker.
+type MockpasswordCheckerMockRecorder struct {
+	mock *MockpasswordChecker
+}
+
+// NewMockpasswordChecker creates a new mock instance.
+func NewMockpasswordChecker(ctrl *gomock.Controller) *MockpasswordChecker {
+	mock := &MockpasswordChecker{ctrl: ctrl}
+	mock.recorder = &MockpasswordCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpasswordChecker) EXPECT() *MockpasswordCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockpasswordChecker) Check(password, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", password, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockpasswordCheckerMockRecorder) Check(password, email any) *MockpasswordCheckerCheckCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockpasswordChecker)(nil).Check), password, email)
+	return &MockpasswordCheckerCheckCall{Call: call}
+}
+
+// MockpasswordCheckerCheckCall wrap *gomock.Call
+type MockpasswordCheckerCheckCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpasswordCheckerCheckCall) Return(arg0 error) *MockpasswordCheckerCheckCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpasswordCheckerCheckCall) Do(f func(string, string) error) *MockpasswordCheckerCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpasswordCheckerCheckCall) DoAndReturn(f func(string, string) error) *MockpasswordCheckerCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockactiveUserCache is a mock of activeUserCache interface.
+type MockactiveUserCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockactiveUserCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockactiveUserCacheMockRecorder is the mock recorder for MockactiveUserCache.
+type MockactiveUserCacheMockRecorder struct {
+	mock *MockactiveUserCache
+}
+
+// NewMockactiveUserCache creates a new mock instance.
+func NewMockactiveUserCache(ctrl *gomock.Controller) *MockactiveUserCache {
+	mock := &MockactiveUserCache{ctrl: ctrl}
+	mock.recorder = &MockactiveUserCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockactiveUserCache) EXPECT() *MockactiveUserCacheMockRecorder {
+	return m.recorder
+}
+
+// Forget mocks base method.
+func (m *MockactiveUserCache) Forget(userID model.UserID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Forget", userID)
+}
+
+// Forget indicates an expected call of Forget.
+func (mr *MockactiveUserCacheMockRecorder) Forget(userID any) *MockactiveUserCacheForgetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Forget", reflect.TypeOf((*MockactiveUserCache)(nil).Forget), userID)
+	return &MockactiveUserCacheForgetCall{Call: call}
+}
+
+// MockactiveUserCacheForgetCall wrap *gomock.Call
+type MockactiveUserCacheForgetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockactiveUserCacheForgetCall) Return() *MockactiveUserCacheForgetCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockactiveUserCacheForgetCall) Do(f func(model.UserID)) *MockactiveUserCacheForgetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockactiveUserCacheForgetCall) DoAndReturn(f func(model.UserID)) *MockactiveUserCacheForgetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,16 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package user_unlocking
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type userRepo interface {
+	GetByID(ctx context.Context, userID model.UserID) (*model.User, error)
+}
+
+type loginFailureRepo interface {
+	Reset(ctx context.Context, key string) error
+}
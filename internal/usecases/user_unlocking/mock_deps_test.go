@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package user_unlocking -typed -destination mock_deps_test.go
+//
+
+// Package user_unlocking is a generated GoMock package.
+package user_unlocking
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockuserRepo) GetByID(ctx context.Context, userID model.UserID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockuserRepoMockRecorder) GetByID(ctx, userID any) *MockuserRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockuserRepo)(nil).GetByID), ctx, userID)
+	return &MockuserRepoGetByIDCall{Call: call}
+}
+
+// MockuserRepoGetByIDCall wrap *gomock.Call
+type MockuserRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockuserRepoGetByIDCall) Return(arg0 *model.User, arg1 error) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockuserRepoGetByIDCall) Do(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockuserRepoGetByIDCall) DoAndReturn(f func(context.Context, model.UserID) (*model.User, error)) *MockuserRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockloginFailureRepo is a mock of loginFailureRepo interface.
+type MockloginFailureRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockloginFailureRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockloginFailureRepoMockRecorder is the mock recorder for MockloginFailureRepo.
+type MockloginFailureRepoMockRecorder struct {
+	mock *MockloginFailureRepo
+}
+
+// NewMockloginFailureRepo creates a new mock instance.
+func NewMockloginFailureRepo(ctrl *gomock.Controller) *MockloginFailureRepo {
+	mock := &MockloginFailureRepo{ctrl: ctrl}
+	mock.recorder = &MockloginFailureRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloginFailureRepo) EXPECT() *MockloginFailureRepoMockRecorder {
+	return m.recorder
+}
+
+// Reset mocks base method.
+func (m *MockloginFailureRepo) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockloginFailureRepoMockRecorder) Reset(ctx, key any) *MockloginFailureRepoResetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockloginFailureRepo)(nil).Reset), ctx, key)
+	return &MockloginFailureRepoResetCall{Call: call}
+}
+
+// MockloginFailureRepoResetCall wrap *gomock.Call
+type MockloginFailureRepoResetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginFailureRepoResetCall) Return(arg0 error) *MockloginFailureRepoResetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginFailureRepoResetCall) Do(f func(context.Context, string) error) *MockloginFailureRepoResetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginFailureRepoResetCall) DoAndReturn(f func(context.Context, string) error) *MockloginFailureRepoResetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,44 @@
+package user_unlocking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type UseCase struct {
+	userRepo         userRepo
+	loginFailureRepo loginFailureRepo
+}
+
+func New(userRepo userRepo, loginFailureRepo loginFailureRepo) (*UseCase, error) {
+	if userRepo == nil {
+		return nil, errors.New("userRepo is nil")
+	}
+	if loginFailureRepo == nil {
+		return nil, errors.New("loginFailureRepo is nil")
+	}
+
+	return &UseCase{
+		userRepo:         userRepo,
+		loginFailureRepo: loginFailureRepo,
+	}, nil
+}
+
+// Unlock forgets failed login attempts for the user email, so the user may log in right away.
+// Failures counted by IP are kept.
+func (uc *UseCase) Unlock(ctx context.Context, userID model.UserID) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("userRepo.GetByID: %w", err)
+	}
+
+	err = uc.loginFailureRepo.Reset(ctx, model.LoginFailureEmailKey(user.Email))
+	if err != nil {
+		return fmt.Errorf("loginFailureRepo.Reset: %w", err)
+	}
+
+	return nil
+}
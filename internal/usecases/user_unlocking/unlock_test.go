@@ -0,0 +1,99 @@
+package user_unlocking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), NewMockloginFailureRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockloginFailureRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockuserRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_Unlock(t *testing.T) {
+	type mocks struct {
+		userRepo         *MockuserRepo
+		loginFailureRepo *MockloginFailureRepo
+	}
+
+	userID := model.NewUserID()
+	user := &model.User{UserID: userID, Email: "Test1@gmail.com", UserRole: model.UserRoleEmployee}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.loginFailureRepo.EXPECT().Reset(gomock.Any(), "email:test1@gmail.com").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "businessError.UserNotFound",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, model.ErrUserNotFound)
+			},
+			wantErr: model.ErrUserNotFound,
+		},
+		{
+			name: "error.GetByID",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.Reset",
+			prepare: func(m *mocks) {
+				m.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+				m.loginFailureRepo.EXPECT().Reset(gomock.Any(), "email:test1@gmail.com").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				userRepo:         NewMockuserRepo(ctrl),
+				loginFailureRepo: NewMockloginFailureRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.userRepo, m.loginFailureRepo)
+			require.NoError(t, err)
+
+			err = uc.Unlock(context.Background(), userID)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package webhook_delivering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/backoff"
+)
+
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = time.Hour
+)
+
+type UseCase struct {
+	deliveryRepo deliveryRepo
+	sender       sender
+	batchSize    int
+	maxAttempts  int
+	lease        time.Duration
+}
+
+// Result is the number of deliveries sent, postponed and given up in one batch.
+type Result struct {
+	Delivered int
+	Failed    int
+	Dead      int
+}
+
+func New(deliveryRepo deliveryRepo, sender sender, batchSize, maxAttempts int, lease time.Duration) (*UseCase, error) {
+	if deliveryRepo == nil {
+		return nil, errors.New("deliveryRepo is nil")
+	}
+	if sender == nil {
+		return nil, errors.New("sender is nil")
+	}
+	if batchSize < 1 {
+		return nil, errors.New("batchSize should be positive")
+	}
+	if maxAttempts < 1 {
+		return nil, errors.New("maxAttempts should be positive")
+	}
+	if lease <= 0 {
+		return nil, errors.New("lease should be positive")
+	}
+	return &UseCase{
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+		lease:        lease,
+	}, nil
+}
+
+// DeliverBatch sends pending webhook deliveries. Deliveries are claimed for the lease and sent outside
+// of a transaction, so a slow endpoint holds no locks. A failed delivery is retried with exponential backoff
+// until maxAttempts is reached, then it gets the dead status and stays for inspection.
+func (uc *UseCase) DeliverBatch(ctx context.Context) (Result, error) {
+	var res Result
+
+	tasks, err := uc.deliveryRepo.ClaimPending(ctx, uc.batchSize, uc.lease)
+	if err != nil {
+		return Result{}, fmt.Errorf("deliveryRepo.ClaimPending: %w", err)
+	}
+
+	for _, task := range tasks {
+		delivery := task.Delivery
+
+		statusCode, sendErr := uc.sender.Send(ctx, task)
+		if sendErr == nil {
+			err = uc.deliveryRepo.MarkDelivered(ctx, delivery.ID, statusCode)
+			if err != nil {
+				return Result{}, fmt.Errorf("deliveryRepo.MarkDelivered: %w", err)
+			}
+			res.Delivered++
+			continue
+		}
+
+		var lastStatusCode *int
+		if statusCode != 0 {
+			lastStatusCode = &statusCode
+		}
+
+		if delivery.Attempts+1 >= uc.maxAttempts {
+			err = uc.deliveryRepo.MarkDead(ctx, delivery.ID, sendErr.Error(), lastStatusCode)
+			if err != nil {
+				return Result{}, fmt.Errorf("deliveryRepo.MarkDead: %w", err)
+			}
+			res.Dead++
+			continue
+		}
+
+		retryAfter := backoff.Exponential(delivery.Attempts, minRetryDelay, maxRetryDelay)
+		err = uc.deliveryRepo.MarkFailed(ctx, delivery.ID, sendErr.Error(), lastStatusCode, retryAfter)
+		if err != nil {
+			return Result{}, fmt.Errorf("deliveryRepo.MarkFailed: %w", err)
+		}
+		res.Failed++
+	}
+
+	return res, nil
+}
+
+// BatchSize is the maximum number of deliveries sent by one DeliverBatch call.
+func (uc *UseCase) BatchSize() int {
+	return uc.batchSize
+}
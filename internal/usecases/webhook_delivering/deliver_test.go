@@ -0,0 +1,206 @@
+package webhook_delivering
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockdeliveryRepo(ctrl), NewMocksender(ctrl), 10, 5, time.Minute)
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, 10, res.BatchSize())
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMocksender(ctrl), 10, 5, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockdeliveryRepo(ctrl), nil, 10, 5, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_batch_size", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockdeliveryRepo(ctrl), NewMocksender(ctrl), 0, 5, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_max_attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockdeliveryRepo(ctrl), NewMocksender(ctrl), 10, 0, time.Minute)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.zero_lease", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMockdeliveryRepo(ctrl), NewMocksender(ctrl), 10, 5, 0)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_DeliverBatch(t *testing.T) {
+	type mocks struct {
+		deliveryRepo *MockdeliveryRepo
+		sender       *Mocksender
+	}
+
+	newTask := func(attempts int) model.WebhookDeliveryTask {
+		return model.WebhookDeliveryTask{
+			Delivery: model.WebhookDelivery{
+				ID:       model.WebhookDeliveryID(uuid.New()),
+				Status:   model.WebhookDeliveryStatusPending,
+				Attempts: attempts,
+			},
+			URL:    "http://partner.local/events",
+			Secret: "secret",
+		}
+	}
+	task1 := newTask(0)
+	task2 := newTask(2)
+	task3 := newTask(4)
+	statusCode := 500
+	sendErr := errors.New("unexpected status code: 500")
+
+	prepareClaimed := func(m *mocks, tasks ...model.WebhookDeliveryTask) {
+		m.deliveryRepo.EXPECT().
+			ClaimPending(gomock.Any(), 10, time.Minute).
+			Return(tasks, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+		wantRes Result
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				prepareClaimed(m, task1, task2, task3)
+				m.sender.EXPECT().
+					Send(gomock.Any(), task1).
+					Return(204, nil)
+				m.deliveryRepo.EXPECT().
+					MarkDelivered(gomock.Any(), task1.Delivery.ID, 204).
+					Return(nil)
+				m.sender.EXPECT().
+					Send(gomock.Any(), task2).
+					Return(statusCode, sendErr)
+				m.deliveryRepo.EXPECT().
+					MarkFailed(gomock.Any(), task2.Delivery.ID, "unexpected status code: 500", &statusCode, 4*time.Second).
+					Return(nil)
+				// the fifth attempt of five
+				m.sender.EXPECT().
+					Send(gomock.Any(), task3).
+					Return(0, sendErr)
+				m.deliveryRepo.EXPECT().
+					MarkDead(gomock.Any(), task3.Delivery.ID, "unexpected status code: 500", nil).
+					Return(nil)
+			},
+			wantErr: nil,
+			wantRes: Result{Delivered: 1, Failed: 1, Dead: 1},
+		},
+		{
+			name: "success.no_response",
+			prepare: func(m *mocks) {
+				prepareClaimed(m, task1)
+				m.sender.EXPECT().
+					Send(gomock.Any(), task1).
+					Return(0, sendErr)
+				m.deliveryRepo.EXPECT().
+					MarkFailed(gomock.Any(), task1.Delivery.ID, "unexpected status code: 500", nil, time.Second).
+					Return(nil)
+			},
+			wantErr: nil,
+			wantRes: Result{Failed: 1},
+		},
+		{
+			name: "error.ClaimPending",
+			prepare: func(m *mocks) {
+				m.deliveryRepo.EXPECT().
+					ClaimPending(gomock.Any(), 10, time.Minute).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+		{
+			name: "error.MarkDelivered",
+			prepare: func(m *mocks) {
+				prepareClaimed(m, task1)
+				m.sender.EXPECT().
+					Send(gomock.Any(), task1).
+					Return(200, nil)
+				m.deliveryRepo.EXPECT().
+					MarkDelivered(gomock.Any(), task1.Delivery.ID, 200).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+		{
+			name: "error.MarkFailed",
+			prepare: func(m *mocks) {
+				prepareClaimed(m, task1)
+				m.sender.EXPECT().
+					Send(gomock.Any(), task1).
+					Return(statusCode, sendErr)
+				m.deliveryRepo.EXPECT().
+					MarkFailed(gomock.Any(), task1.Delivery.ID, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+		{
+			name: "error.MarkDead",
+			prepare: func(m *mocks) {
+				prepareClaimed(m, task3)
+				m.sender.EXPECT().
+					Send(gomock.Any(), task3).
+					Return(statusCode, sendErr)
+				m.deliveryRepo.EXPECT().
+					MarkDead(gomock.Any(), task3.Delivery.ID, gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				deliveryRepo: NewMockdeliveryRepo(ctrl),
+				sender:       NewMocksender(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.deliveryRepo, m.sender, 10, 5, time.Minute)
+			require.NoError(t, err)
+
+			res, err := uc.DeliverBatch(context.Background())
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook_delivering
+
+import (
+	"context"
+	"time"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type deliveryRepo interface {
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDeliveryTask, error)
+	MarkDelivered(ctx context.Context, deliveryID model.WebhookDeliveryID, statusCode int) error
+	MarkFailed(ctx context.Context, deliveryID model.WebhookDeliveryID, lastError string, statusCode *int, retryAfter time.Duration) error
+	MarkDead(ctx context.Context, deliveryID model.WebhookDeliveryID, lastError string, statusCode *int) error
+}
+
+type sender interface {
+	// Send returns the response status code, zero if the endpoint didn't respond
+	Send(ctx context.Context, task model.WebhookDeliveryTask) (int, error)
+}
@@ -0,0 +1,259 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_delivering -typed -destination mock_deps_test.go
+//
+
+// Package webhook_delivering is a generated GoMock package.
+package webhook_delivering
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockdeliveryRepo is a mock of deliveryRepo interface.
+type MockdeliveryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeliveryRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockdeliveryRepoMockRecorder is the mock recorder for MockdeliveryRepo.
+type MockdeliveryRepoMockRecorder struct {
+	mock *MockdeliveryRepo
+}
+
+// NewMockdeliveryRepo creates a new mock instance.
+func NewMockdeliveryRepo(ctrl *gomock.Controller) *MockdeliveryRepo {
+	mock := &MockdeliveryRepo{ctrl: ctrl}
+	mock.recorder = &MockdeliveryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeliveryRepo) EXPECT() *MockdeliveryRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimPending mocks base method.
+func (m *MockdeliveryRepo) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDeliveryTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, limit, lease)
+	ret0, _ := ret[0].([]model.WebhookDeliveryTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockdeliveryRepoMockRecorder) ClaimPending(ctx, limit, lease any) *MockdeliveryRepoClaimPendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockdeliveryRepo)(nil).ClaimPending), ctx, limit, lease)
+	return &MockdeliveryRepoClaimPendingCall{Call: call}
+}
+
+// MockdeliveryRepoClaimPendingCall wrap *gomock.Call
+type MockdeliveryRepoClaimPendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockdeliveryRepoClaimPendingCall) Return(arg0 []model.WebhookDeliveryTask, arg1 error) *MockdeliveryRepoClaimPendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockdeliveryRepoClaimPendingCall) Do(f func(context.Context, int, time.Duration) ([]model.WebhookDeliveryTask, error)) *MockdeliveryRepoClaimPendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockdeliveryRepoClaimPendingCall) DoAndReturn(f func(context.Context, int, time.Duration) ([]model.WebhookDeliveryTask, error)) *MockdeliveryRepoClaimPendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkDead mocks base method.
+func (m *MockdeliveryRepo) MarkDead(ctx context.Context, deliveryID model.WebhookDeliveryID, lastError string, statusCode *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDead", ctx, deliveryID, lastError, statusCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDead indicates an expected call of MarkDead.
+func (mr *MockdeliveryRepoMockRecorder) MarkDead(ctx, deliveryID, lastError, statusCode any) *MockdeliveryRepoMarkDeadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDead", reflect.TypeOf((*MockdeliveryRepo)(nil).MarkDead), ctx, deliveryID, lastError, statusCode)
+	return &MockdeliveryRepoMarkDeadCall{Call: call}
+}
+
+// MockdeliveryRepoMarkDeadCall wrap *gomock.Call
+type MockdeliveryRepoMarkDeadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockdeliveryRepoMarkDeadCall) Return(arg0 error) *MockdeliveryRepoMarkDeadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockdeliveryRepoMarkDeadCall) Do(f func(context.Context, model.WebhookDeliveryID, string, *int) error) *MockdeliveryRepoMarkDeadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockdeliveryRepoMarkDeadCall) DoAndReturn(f func(context.Context, model.WebhookDeliveryID, string, *int) error) *MockdeliveryRepoMarkDeadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkDelivered mocks base method.
+func (m *MockdeliveryRepo) MarkDelivered(ctx context.Context, deliveryID model.WebhookDeliveryID, statusCode int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, deliveryID, statusCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockdeliveryRepoMockRecorder) MarkDelivered(ctx, deliveryID, statusCode any) *MockdeliveryRepoMarkDeliveredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockdeliveryRepo)(nil).MarkDelivered), ctx, deliveryID, statusCode)
+	return &MockdeliveryRepoMarkDeliveredCall{Call: call}
+}
+
+// MockdeliveryRepoMarkDeliveredCall wrap *gomock.Call
+type MockdeliveryRepoMarkDeliveredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockdeliveryRepoMarkDeliveredCall) Return(arg0 error) *MockdeliveryRepoMarkDeliveredCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockdeliveryRepoMarkDeliveredCall) Do(f func(context.Context, model.WebhookDeliveryID, int) error) *MockdeliveryRepoMarkDeliveredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockdeliveryRepoMarkDeliveredCall) DoAndReturn(f func(context.Context, model.WebhookDeliveryID, int) error) *MockdeliveryRepoMarkDeliveredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkFailed mocks base method.
+func (m *MockdeliveryRepo) MarkFailed(ctx context.Context, deliveryID model.WebhookDeliveryID, lastError string, statusCode *int, retryAfter time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, deliveryID, lastError, statusCode, retryAfter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockdeliveryRepoMockRecorder) MarkFailed(ctx, deliveryID, lastError, statusCode, retryAfter any) *MockdeliveryRepoMarkFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockdeliveryRepo)(nil).MarkFailed), ctx, deliveryID, lastError, statusCode, retryAfter)
+	return &MockdeliveryRepoMarkFailedCall{Call: call}
+}
+
+// MockdeliveryRepoMarkFailedCall wrap *gomock.Call
+type MockdeliveryRepoMarkFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockdeliveryRepoMarkFailedCall) Return(arg0 error) *MockdeliveryRepoMarkFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockdeliveryRepoMarkFailedCall) Do(f func(context.Context, model.WebhookDeliveryID, string, *int, time.Duration) error) *MockdeliveryRepoMarkFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockdeliveryRepoMarkFailedCall) DoAndReturn(f func(context.Context, model.WebhookDeliveryID, string, *int, time.Duration) error) *MockdeliveryRepoMarkFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+	isgomock struct{}
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, task model.WebhookDeliveryTask) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, task)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, task any) *MocksenderSendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, task)
+	return &MocksenderSendCall{Call: call}
+}
+
+// MocksenderSendCall wrap *gomock.Call
+type MocksenderSendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksenderSendCall) Return(arg0 int, arg1 error) *MocksenderSendCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksenderSendCall) Do(f func(context.Context, model.WebhookDeliveryTask) (int, error)) *MocksenderSendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksenderSendCall) DoAndReturn(f func(context.Context, model.WebhookDeliveryTask) (int, error)) *MocksenderSendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
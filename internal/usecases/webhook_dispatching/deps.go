@@ -0,0 +1,16 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook_dispatching
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type subscriptionRepo interface {
+	FindMatching(ctx context.Context, eventType model.OutboxEventType, pvzID model.PVZID) ([]model.WebhookSubscription, error)
+}
+
+type deliveryRepo interface {
+	AddDeliveries(ctx context.Context, messageID model.OutboxMessageID, subscriptionIDs []model.WebhookSubscriptionID) error
+}
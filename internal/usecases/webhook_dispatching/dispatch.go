@@ -0,0 +1,53 @@
+package webhook_dispatching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// UseCase fans the outbox messages out to the matching webhook subscriptions.
+// It is an outbox relay sink: the relay claims a message and calls Send outside of a transaction, and Send
+// only schedules deliveries, which are sent later by webhook_delivering. Scheduling is idempotent,
+// so a message sent again after a failed relay attempt doesn't duplicate deliveries.
+type UseCase struct {
+	subscriptionRepo subscriptionRepo
+	deliveryRepo     deliveryRepo
+}
+
+func New(subscriptionRepo subscriptionRepo, deliveryRepo deliveryRepo) (*UseCase, error) {
+	if subscriptionRepo == nil {
+		return nil, errors.New("subscriptionRepo is nil")
+	}
+	if deliveryRepo == nil {
+		return nil, errors.New("deliveryRepo is nil")
+	}
+	return &UseCase{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}, nil
+}
+
+func (uc *UseCase) Send(ctx context.Context, message model.OutboxMessage) error {
+	subscriptions, err := uc.subscriptionRepo.FindMatching(ctx, message.Type, message.PVZID)
+	if err != nil {
+		return fmt.Errorf("subscriptionRepo.FindMatching: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	subscriptionIDs := make([]model.WebhookSubscriptionID, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionIDs = append(subscriptionIDs, subscription.ID)
+	}
+
+	err = uc.deliveryRepo.AddDeliveries(ctx, message.ID, subscriptionIDs)
+	if err != nil {
+		return fmt.Errorf("deliveryRepo.AddDeliveries: %w", err)
+	}
+
+	return nil
+}
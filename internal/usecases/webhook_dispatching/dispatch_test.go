@@ -0,0 +1,117 @@
+package webhook_dispatching
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocksubscriptionRepo(ctrl), NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockdeliveryRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocksubscriptionRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestUseCase_Send(t *testing.T) {
+	type mocks struct {
+		subscriptionRepo *MocksubscriptionRepo
+		deliveryRepo     *MockdeliveryRepo
+	}
+
+	pvzID1 := model.NewPVZID()
+	subscriptionID1 := model.NewWebhookSubscriptionID()
+	subscriptionID2 := model.NewWebhookSubscriptionID()
+	message := model.OutboxMessage{
+		ID:    model.OutboxMessageID(model.NewReceptionID()),
+		Type:  model.OutboxEventReceptionClosed,
+		PVZID: pvzID1,
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					FindMatching(gomock.Any(), model.OutboxEventReceptionClosed, pvzID1).
+					Return([]model.WebhookSubscription{{ID: subscriptionID1}, {ID: subscriptionID2}}, nil)
+				m.deliveryRepo.EXPECT().
+					AddDeliveries(gomock.Any(), message.ID, []model.WebhookSubscriptionID{subscriptionID1, subscriptionID2}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success.no_subscriptions",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					FindMatching(gomock.Any(), model.OutboxEventReceptionClosed, pvzID1).
+					Return(nil, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error.FindMatching",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					FindMatching(gomock.Any(), model.OutboxEventReceptionClosed, pvzID1).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "error.AddDeliveries",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					FindMatching(gomock.Any(), model.OutboxEventReceptionClosed, pvzID1).
+					Return([]model.WebhookSubscription{{ID: subscriptionID1}}, nil)
+				m.deliveryRepo.EXPECT().
+					AddDeliveries(gomock.Any(), message.ID, []model.WebhookSubscriptionID{subscriptionID1}).
+					Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				subscriptionRepo: NewMocksubscriptionRepo(ctrl),
+				deliveryRepo:     NewMockdeliveryRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.subscriptionRepo, m.deliveryRepo)
+			require.NoError(t, err)
+
+			err = uc.Send(context.Background(), message)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_dispatching -typed -destination mock_deps_test.go
+//
+
+// Package webhook_dispatching is a generated GoMock package.
+package webhook_dispatching
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksubscriptionRepo is a mock of subscriptionRepo interface.
+type MocksubscriptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubscriptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MocksubscriptionRepoMockRecorder is the mock recorder for MocksubscriptionRepo.
+type MocksubscriptionRepoMockRecorder struct {
+	mock *MocksubscriptionRepo
+}
+
+// NewMocksubscriptionRepo creates a new mock instance.
+func NewMocksubscriptionRepo(ctrl *gomock.Controller) *MocksubscriptionRepo {
+	mock := &MocksubscriptionRepo{ctrl: ctrl}
+	mock.recorder = &MocksubscriptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksubscriptionRepo) EXPECT() *MocksubscriptionRepoMockRecorder {
+	return m.recorder
+}
+
+// FindMatching mocks base method.
+func (m *MocksubscriptionRepo) FindMatching(ctx context.Context, eventType model.OutboxEventType, pvzID model.PVZID) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMatching", ctx, eventType, pvzID)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMatching indicates an expected call of FindMatching.
+func (mr *MocksubscriptionRepoMockRecorder) FindMatching(ctx, eventType, pvzID any) *MocksubscriptionRepoFindMatchingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMatching", reflect.TypeOf((*MocksubscriptionRepo)(nil).FindMatching), ctx, eventType, pvzID)
+	return &MocksubscriptionRepoFindMatchingCall{Call: call}
+}
+
+// MocksubscriptionRepoFindMatchingCall wrap *gomock.Call
+type MocksubscriptionRepoFindMatchingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksubscriptionRepoFindMatchingCall) Return(arg0 []model.WebhookSubscription, arg1 error) *MocksubscriptionRepoFindMatchingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksubscriptionRepoFindMatchingCall) Do(f func(context.Context, model.OutboxEventType, model.PVZID) ([]model.WebhookSubscription, error)) *MocksubscriptionRepoFindMatchingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksubscriptionRepoFindMatchingCall) DoAndReturn(f func(context.Context, model.OutboxEventType, model.PVZID) ([]model.WebhookSubscription, error)) *MocksubscriptionRepoFindMatchingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockdeliveryRepo is a mock of deliveryRepo interface.
+type MockdeliveryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeliveryRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockdeliveryRepoMockRecorder is the mock recorder for MockdeliveryRepo.
+type MockdeliveryRepoMockRecorder struct {
+	mock *MockdeliveryRepo
+}
+
+// NewMockdeliveryRepo creates a new mock instance.
+func NewMockdeliveryRepo(ctrl *gomock.Controller) *MockdeliveryRepo {
+	mock := &MockdeliveryRepo{ctrl: ctrl}
+	mock.recorder = &MockdeliveryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeliveryRepo) EXPECT() *MockdeliveryRepoMockRecorder {
+	return m.recorder
+}
+
+// AddDeliveries mocks base method.
+func (m *MockdeliveryRepo) AddDeliveries(ctx context.Context, messageID model.OutboxMessageID, subscriptionIDs []model.WebhookSubscriptionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeliveries", ctx, messageID, subscriptionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeliveries indicates an expected call of AddDeliveries.
+func (mr *MockdeliveryRepoMockRecorder) AddDeliveries(ctx, messageID, subscriptionIDs any) *MockdeliveryRepoAddDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeliveries", reflect.TypeOf((*MockdeliveryRepo)(nil).AddDeliveries), ctx, messageID, subscriptionIDs)
+	return &MockdeliveryRepoAddDeliveriesCall{Call: call}
+}
+
+// MockdeliveryRepoAddDeliveriesCall wrap *gomock.Call
+type MockdeliveryRepoAddDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockdeliveryRepoAddDeliveriesCall) Return(arg0 error) *MockdeliveryRepoAddDeliveriesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockdeliveryRepoAddDeliveriesCall) Do(f func(context.Context, model.OutboxMessageID, []model.WebhookSubscriptionID) error) *MockdeliveryRepoAddDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockdeliveryRepoAddDeliveriesCall) DoAndReturn(f func(context.Context, model.OutboxMessageID, []model.WebhookSubscriptionID) error) *MockdeliveryRepoAddDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
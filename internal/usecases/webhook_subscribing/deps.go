@@ -0,0 +1,21 @@
+//go:generate mockgen -source deps.go -package $GOPACKAGE -typed -destination mock_deps_test.go
+package webhook_subscribing
+
+import (
+	"context"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type subscriptionRepo interface {
+	Create(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error)
+	Update(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error)
+	Delete(ctx context.Context, subscriptionID model.WebhookSubscriptionID) error
+	GetByID(ctx context.Context, subscriptionID model.WebhookSubscriptionID) (model.WebhookSubscription, error)
+	List(ctx context.Context) ([]model.WebhookSubscription, error)
+}
+
+type deliveryRepo interface {
+	ListBySubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID, status *model.WebhookDeliveryStatus,
+		offset, limit int64) ([]model.WebhookDelivery, error)
+}
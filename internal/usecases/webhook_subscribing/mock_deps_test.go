@@ -0,0 +1,299 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deps.go
+//
+// Generated by this command:
+//
+//	mockgen -source deps.go -package webhook_subscribing -typed -destination mock_deps_test.go
+//
+
+// Package webhook_subscribing is a generated GoMock package.
+package webhook_subscribing
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/inna-maikut/avito-pvz/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksubscriptionRepo is a mock of subscriptionRepo interface.
+type MocksubscriptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubscriptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MocksubscriptionRepoMockRecorder is the mock recorder for MocksubscriptionRepo.
+type MocksubscriptionRepoMockRecorder struct {
+	mock *MocksubscriptionRepo
+}
+
+// NewMocksubscriptionRepo creates a new mock instance.
+func NewMocksubscriptionRepo(ctrl *gomock.Controller) *MocksubscriptionRepo {
+	mock := &MocksubscriptionRepo{ctrl: ctrl}
+	mock.recorder = &MocksubscriptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksubscriptionRepo) EXPECT() *MocksubscriptionRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MocksubscriptionRepo) Create(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocksubscriptionRepoMockRecorder) Create(ctx, subscription any) *MocksubscriptionRepoCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocksubscriptionRepo)(nil).Create), ctx, subscription)
+	return &MocksubscriptionRepoCreateCall{Call: call}
+}
+
+// MocksubscriptionRepoCreateCall wrap *gomock.Call
+type MocksubscriptionRepoCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksubscriptionRepoCreateCall) Return(arg0 model.WebhookSubscription, arg1 error) *MocksubscriptionRepoCreateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksubscriptionRepoCreateCall) Do(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MocksubscriptionRepoCreateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksubscriptionRepoCreateCall) DoAndReturn(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MocksubscriptionRepoCreateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MocksubscriptionRepo) Delete(ctx context.Context, subscriptionID model.WebhookSubscriptionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocksubscriptionRepoMockRecorder) Delete(ctx, subscriptionID any) *MocksubscriptionRepoDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocksubscriptionRepo)(nil).Delete), ctx, subscriptionID)
+	return &MocksubscriptionRepoDeleteCall{Call: call}
+}
+
+// MocksubscriptionRepoDeleteCall wrap *gomock.Call
+type MocksubscriptionRepoDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksubscriptionRepoDeleteCall) Return(arg0 error) *MocksubscriptionRepoDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksubscriptionRepoDeleteCall) Do(f func(context.Context, model.WebhookSubscriptionID) error) *MocksubscriptionRepoDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksubscriptionRepoDeleteCall) DoAndReturn(f func(context.Context, model.WebhookSubscriptionID) error) *MocksubscriptionRepoDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MocksubscriptionRepo) GetByID(ctx context.Context, subscriptionID model.WebhookSubscriptionID) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, subscriptionID)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MocksubscriptionRepoMockRecorder) GetByID(ctx, subscriptionID any) *MocksubscriptionRepoGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MocksubscriptionRepo)(nil).GetByID), ctx, subscriptionID)
+	return &MocksubscriptionRepoGetByIDCall{Call: call}
+}
+
+// MocksubscriptionRepoGetByIDCall wrap *gomock.Call
+type MocksubscriptionRepoGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksubscriptionRepoGetByIDCall) Return(arg0 model.WebhookSubscription, arg1 error) *MocksubscriptionRepoGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksubscriptionRepoGetByIDCall) Do(f func(context.Context, model.WebhookSubscriptionID) (model.WebhookSubscription, error)) *MocksubscriptionRepoGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksubscriptionRepoGetByIDCall) DoAndReturn(f func(context.Context, model.WebhookSubscriptionID) (model.WebhookSubscription, error)) *MocksubscriptionRepoGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MocksubscriptionRepo) List(ctx context.Context) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MocksubscriptionRepoMockRecorder) List(ctx any) *MocksubscriptionRepoListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocksubscriptionRepo)(nil).List), ctx)
+	return &MocksubscriptionRepoListCall{Call: call}
+}
+
+// MocksubscriptionRepoListCall wrap *gomock.Call
+type MocksubscriptionRepoListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksubscriptionRepoListCall) Return(arg0 []model.WebhookSubscription, arg1 error) *MocksubscriptionRepoListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksubscriptionRepoListCall) Do(f func(context.Context) ([]model.WebhookSubscription, error)) *MocksubscriptionRepoListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksubscriptionRepoListCall) DoAndReturn(f func(context.Context) ([]model.WebhookSubscription, error)) *MocksubscriptionRepoListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MocksubscriptionRepo) Update(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, subscription)
+	ret0, _ := ret[0].(model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MocksubscriptionRepoMockRecorder) Update(ctx, subscription any) *MocksubscriptionRepoUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocksubscriptionRepo)(nil).Update), ctx, subscription)
+	return &MocksubscriptionRepoUpdateCall{Call: call}
+}
+
+// MocksubscriptionRepoUpdateCall wrap *gomock.Call
+type MocksubscriptionRepoUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksubscriptionRepoUpdateCall) Return(arg0 model.WebhookSubscription, arg1 error) *MocksubscriptionRepoUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksubscriptionRepoUpdateCall) Do(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MocksubscriptionRepoUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksubscriptionRepoUpdateCall) DoAndReturn(f func(context.Context, model.WebhookSubscription) (model.WebhookSubscription, error)) *MocksubscriptionRepoUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockdeliveryRepo is a mock of deliveryRepo interface.
+type MockdeliveryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeliveryRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockdeliveryRepoMockRecorder is the mock recorder for MockdeliveryRepo.
+type MockdeliveryRepoMockRecorder struct {
+	mock *MockdeliveryRepo
+}
+
+// NewMockdeliveryRepo creates a new mock instance.
+func NewMockdeliveryRepo(ctrl *gomock.Controller) *MockdeliveryRepo {
+	mock := &MockdeliveryRepo{ctrl: ctrl}
+	mock.recorder = &MockdeliveryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeliveryRepo) EXPECT() *MockdeliveryRepoMockRecorder {
+	return m.recorder
+}
+
+// ListBySubscription mocks base method.
+func (m *MockdeliveryRepo) ListBySubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID, status *model.WebhookDeliveryStatus, offset, limit int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySubscription", ctx, subscriptionID, status, offset, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySubscription indicates an expected call of ListBySubscription.
+func (mr *MockdeliveryRepoMockRecorder) ListBySubscription(ctx, subscriptionID, status, offset, limit any) *MockdeliveryRepoListBySubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySubscription", reflect.TypeOf((*MockdeliveryRepo)(nil).ListBySubscription), ctx, subscriptionID, status, offset, limit)
+	return &MockdeliveryRepoListBySubscriptionCall{Call: call}
+}
+
+// MockdeliveryRepoListBySubscriptionCall wrap *gomock.Call
+type MockdeliveryRepoListBySubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockdeliveryRepoListBySubscriptionCall) Return(arg0 []model.WebhookDelivery, arg1 error) *MockdeliveryRepoListBySubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockdeliveryRepoListBySubscriptionCall) Do(f func(context.Context, model.WebhookSubscriptionID, *model.WebhookDeliveryStatus, int64, int64) ([]model.WebhookDelivery, error)) *MockdeliveryRepoListBySubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockdeliveryRepoListBySubscriptionCall) DoAndReturn(f func(context.Context, model.WebhookSubscriptionID, *model.WebhookDeliveryStatus, int64, int64) ([]model.WebhookDelivery, error)) *MockdeliveryRepoListBySubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
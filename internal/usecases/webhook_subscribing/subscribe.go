@@ -0,0 +1,120 @@
+package webhook_subscribing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+const minSecretLength = 16
+
+type UseCase struct {
+	subscriptionRepo subscriptionRepo
+	deliveryRepo     deliveryRepo
+}
+
+func New(subscriptionRepo subscriptionRepo, deliveryRepo deliveryRepo) (*UseCase, error) {
+	if subscriptionRepo == nil {
+		return nil, errors.New("subscriptionRepo is nil")
+	}
+	if deliveryRepo == nil {
+		return nil, errors.New("deliveryRepo is nil")
+	}
+	return &UseCase{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}, nil
+}
+
+func (uc *UseCase) CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	if err := validate(subscription, true); err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	subscription.ID = model.NewWebhookSubscriptionID()
+
+	res, err := uc.subscriptionRepo.Create(ctx, subscription)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("subscriptionRepo.Create: %w", err)
+	}
+
+	return res, nil
+}
+
+// UpdateSubscription replaces the subscription fields. The secret is kept when it's empty.
+func (uc *UseCase) UpdateSubscription(ctx context.Context, subscription model.WebhookSubscription) (model.WebhookSubscription, error) {
+	if err := validate(subscription, false); err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	res, err := uc.subscriptionRepo.Update(ctx, subscription)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("subscriptionRepo.Update: %w", err)
+	}
+
+	return res, nil
+}
+
+// DeleteSubscription deletes the subscription with its deliveries.
+func (uc *UseCase) DeleteSubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID) error {
+	err := uc.subscriptionRepo.Delete(ctx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("subscriptionRepo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *UseCase) GetSubscription(ctx context.Context, subscriptionID model.WebhookSubscriptionID) (model.WebhookSubscription, error) {
+	res, err := uc.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return model.WebhookSubscription{}, fmt.Errorf("subscriptionRepo.GetByID: %w", err)
+	}
+
+	return res, nil
+}
+
+func (uc *UseCase) ListSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	res, err := uc.subscriptionRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("subscriptionRepo.List: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetDeliveries returns the page of subscription deliveries, the most recent first. Nil status means any status.
+func (uc *UseCase) GetDeliveries(ctx context.Context, subscriptionID model.WebhookSubscriptionID,
+	status *model.WebhookDeliveryStatus, page, limit int64,
+) ([]model.WebhookDelivery, error) {
+	_, err := uc.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("subscriptionRepo.GetByID: %w", err)
+	}
+
+	offset := (page - 1) * limit
+
+	deliveries, err := uc.deliveryRepo.ListBySubscription(ctx, subscriptionID, status, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("deliveryRepo.ListBySubscription: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func validate(subscription model.WebhookSubscription, secretRequired bool) error {
+	u, err := url.Parse(subscription.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url should be an absolute http or https url", model.ErrWebhookSubscriptionInvalid)
+	}
+	if len(subscription.EventTypes) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", model.ErrWebhookSubscriptionInvalid)
+	}
+	if (secretRequired || subscription.Secret != "") && len(subscription.Secret) < minSecretLength {
+		return fmt.Errorf("%w: secret should be at least %d characters long", model.ErrWebhookSubscriptionInvalid, minSecretLength)
+	}
+	return nil
+}
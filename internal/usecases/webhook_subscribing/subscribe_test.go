@@ -0,0 +1,379 @@
+package webhook_subscribing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocksubscriptionRepo(ctrl), NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("error.first_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(nil, NewMockdeliveryRepo(ctrl))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("error.second_nil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		res, err := New(NewMocksubscriptionRepo(ctrl), nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func testSubscription() model.WebhookSubscription {
+	return model.WebhookSubscription{
+		URL:        "https://partner.local/events",
+		EventTypes: []model.OutboxEventType{model.OutboxEventReceptionClosed},
+		PVZIDs:     []model.PVZID{model.NewPVZID()},
+		Secret:     "0123456789abcdef",
+	}
+}
+
+func TestUseCase_CreateSubscription(t *testing.T) {
+	type mocks struct {
+		subscriptionRepo *MocksubscriptionRepo
+	}
+
+	subscription := testSubscription()
+	created := subscription
+	created.CreatedAt = time.Now()
+
+	withURL := func(u string) model.WebhookSubscription {
+		res := testSubscription()
+		res.URL = u
+		return res
+	}
+
+	testCases := []struct {
+		name         string
+		prepare      func(t *testing.T, m *mocks)
+		subscription model.WebhookSubscription
+		wantErr      error
+		wantRes      model.WebhookSubscription
+	}{
+		{
+			name: "success",
+			prepare: func(t *testing.T, m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s model.WebhookSubscription) (model.WebhookSubscription, error) {
+						require.NotEqual(t, model.WebhookSubscriptionID{}, s.ID)
+						s.ID = model.WebhookSubscriptionID{}
+						require.Equal(t, subscription, s)
+						return created, nil
+					})
+			},
+			subscription: subscription,
+			wantErr:      nil,
+			wantRes:      created,
+		},
+		{
+			name:         "businessError.relative_url",
+			prepare:      func(_ *testing.T, _ *mocks) {},
+			subscription: withURL("/events"),
+			wantErr:      model.ErrWebhookSubscriptionInvalid,
+		},
+		{
+			name:         "businessError.url_scheme",
+			prepare:      func(_ *testing.T, _ *mocks) {},
+			subscription: withURL("ftp://partner.local/events"),
+			wantErr:      model.ErrWebhookSubscriptionInvalid,
+		},
+		{
+			name:    "businessError.no_event_types",
+			prepare: func(_ *testing.T, _ *mocks) {},
+			subscription: func() model.WebhookSubscription {
+				res := testSubscription()
+				res.EventTypes = nil
+				return res
+			}(),
+			wantErr: model.ErrWebhookSubscriptionInvalid,
+		},
+		{
+			name:    "businessError.no_secret",
+			prepare: func(_ *testing.T, _ *mocks) {},
+			subscription: func() model.WebhookSubscription {
+				res := testSubscription()
+				res.Secret = ""
+				return res
+			}(),
+			wantErr: model.ErrWebhookSubscriptionInvalid,
+		},
+		{
+			name:    "businessError.short_secret",
+			prepare: func(_ *testing.T, _ *mocks) {},
+			subscription: func() model.WebhookSubscription {
+				res := testSubscription()
+				res.Secret = "secret"
+				return res
+			}(),
+			wantErr: model.ErrWebhookSubscriptionInvalid,
+		},
+		{
+			name: "error.Create",
+			prepare: func(_ *testing.T, m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(model.WebhookSubscription{}, assert.AnError)
+			},
+			subscription: subscription,
+			wantErr:      assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				subscriptionRepo: NewMocksubscriptionRepo(ctrl),
+			}
+
+			tc.prepare(t, m)
+
+			uc, err := New(m.subscriptionRepo, NewMockdeliveryRepo(ctrl))
+			require.NoError(t, err)
+
+			res, err := uc.CreateSubscription(context.Background(), tc.subscription)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+func TestUseCase_UpdateSubscription(t *testing.T) {
+	subscription := testSubscription()
+	subscription.ID = model.NewWebhookSubscriptionID()
+
+	t.Run("success.keep_secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+
+		input := subscription
+		input.Secret = ""
+		subscriptionRepo.EXPECT().
+			Update(gomock.Any(), input).
+			Return(subscription, nil)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		res, err := uc.UpdateSubscription(context.Background(), input)
+		require.NoError(t, err)
+		require.Equal(t, subscription, res)
+	})
+	t.Run("businessError.short_secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		input := subscription
+		input.Secret = "secret"
+
+		uc, err := New(NewMocksubscriptionRepo(ctrl), NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		_, err = uc.UpdateSubscription(context.Background(), input)
+		require.ErrorIs(t, err, model.ErrWebhookSubscriptionInvalid)
+	})
+	t.Run("businessError.NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+
+		subscriptionRepo.EXPECT().
+			Update(gomock.Any(), subscription).
+			Return(model.WebhookSubscription{}, model.ErrWebhookSubscriptionNotFound)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		_, err = uc.UpdateSubscription(context.Background(), subscription)
+		require.ErrorIs(t, err, model.ErrWebhookSubscriptionNotFound)
+	})
+}
+
+func TestUseCase_DeleteSubscription(t *testing.T) {
+	subscriptionID := model.NewWebhookSubscriptionID()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+		subscriptionRepo.EXPECT().
+			Delete(gomock.Any(), subscriptionID).
+			Return(nil)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		require.NoError(t, uc.DeleteSubscription(context.Background(), subscriptionID))
+	})
+	t.Run("error.Delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+		subscriptionRepo.EXPECT().
+			Delete(gomock.Any(), subscriptionID).
+			Return(assert.AnError)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, uc.DeleteSubscription(context.Background(), subscriptionID), assert.AnError)
+	})
+}
+
+func TestUseCase_GetSubscription(t *testing.T) {
+	subscription := testSubscription()
+	subscription.ID = model.NewWebhookSubscriptionID()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+		subscriptionRepo.EXPECT().
+			GetByID(gomock.Any(), subscription.ID).
+			Return(subscription, nil)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		res, err := uc.GetSubscription(context.Background(), subscription.ID)
+		require.NoError(t, err)
+		require.Equal(t, subscription, res)
+	})
+	t.Run("error.GetByID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+		subscriptionRepo.EXPECT().
+			GetByID(gomock.Any(), subscription.ID).
+			Return(model.WebhookSubscription{}, assert.AnError)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		_, err = uc.GetSubscription(context.Background(), subscription.ID)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestUseCase_ListSubscriptions(t *testing.T) {
+	subscriptions := []model.WebhookSubscription{testSubscription()}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+		subscriptionRepo.EXPECT().
+			List(gomock.Any()).
+			Return(subscriptions, nil)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		res, err := uc.ListSubscriptions(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, subscriptions, res)
+	})
+	t.Run("error.List", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		subscriptionRepo := NewMocksubscriptionRepo(ctrl)
+		subscriptionRepo.EXPECT().
+			List(gomock.Any()).
+			Return(nil, assert.AnError)
+
+		uc, err := New(subscriptionRepo, NewMockdeliveryRepo(ctrl))
+		require.NoError(t, err)
+
+		_, err = uc.ListSubscriptions(context.Background())
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestUseCase_GetDeliveries(t *testing.T) {
+	type mocks struct {
+		subscriptionRepo *MocksubscriptionRepo
+		deliveryRepo     *MockdeliveryRepo
+	}
+
+	subscriptionID := model.NewWebhookSubscriptionID()
+	status := model.WebhookDeliveryStatusDead
+	deliveries := []model.WebhookDelivery{
+		{
+			SubscriptionID: subscriptionID,
+			Status:         status,
+			Attempts:       10,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		prepare func(m *mocks)
+		wantErr error
+		wantRes []model.WebhookDelivery
+	}{
+		{
+			name: "success",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					GetByID(gomock.Any(), subscriptionID).
+					Return(model.WebhookSubscription{ID: subscriptionID}, nil)
+				m.deliveryRepo.EXPECT().
+					ListBySubscription(gomock.Any(), subscriptionID, &status, int64(20), int64(10)).
+					Return(deliveries, nil)
+			},
+			wantErr: nil,
+			wantRes: deliveries,
+		},
+		{
+			name: "businessError.NotFound",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					GetByID(gomock.Any(), subscriptionID).
+					Return(model.WebhookSubscription{}, model.ErrWebhookSubscriptionNotFound)
+			},
+			wantErr: model.ErrWebhookSubscriptionNotFound,
+			wantRes: nil,
+		},
+		{
+			name: "error.ListBySubscription",
+			prepare: func(m *mocks) {
+				m.subscriptionRepo.EXPECT().
+					GetByID(gomock.Any(), subscriptionID).
+					Return(model.WebhookSubscription{ID: subscriptionID}, nil)
+				m.deliveryRepo.EXPECT().
+					ListBySubscription(gomock.Any(), subscriptionID, &status, int64(20), int64(10)).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+			wantRes: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				subscriptionRepo: NewMocksubscriptionRepo(ctrl),
+				deliveryRepo:     NewMockdeliveryRepo(ctrl),
+			}
+
+			tc.prepare(m)
+
+			uc, err := New(m.subscriptionRepo, m.deliveryRepo)
+			require.NoError(t, err)
+
+			res, err := uc.GetDeliveries(context.Background(), subscriptionID, &status, 3, 10)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS receptions__recepted_at ON receptions(recepted_at);
+DROP INDEX IF EXISTS receptions__recepted_at_id;
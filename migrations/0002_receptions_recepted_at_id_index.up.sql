@@ -0,0 +1,3 @@
+-- keyset pagination of receptions goes in (recepted_at, id) order
+CREATE INDEX IF NOT EXISTS receptions__recepted_at_id ON receptions(recepted_at, id);
+DROP INDEX IF EXISTS receptions__recepted_at;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS receptions__pvz_id_recepted_at;
@@ -0,0 +1,2 @@
+-- reception history of a single pvz is paged in recepted_at order
+CREATE INDEX IF NOT EXISTS receptions__pvz_id_recepted_at ON receptions(pvz_id, recepted_at);
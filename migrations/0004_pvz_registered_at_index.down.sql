@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS pvz__city_registered_at_id;
+DROP INDEX IF EXISTS pvz__registered_at_id;
@@ -0,0 +1,3 @@
+-- pvz-centric listing pages over pvz in registered_at order, optionally filtered by city
+CREATE INDEX IF NOT EXISTS pvz__registered_at_id ON pvz(registered_at, id);
+CREATE INDEX IF NOT EXISTS pvz__city_registered_at_id ON pvz(city, registered_at, id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS products__reception_id_barcode;
+
+ALTER TABLE products DROP COLUMN IF EXISTS barcode;
@@ -0,0 +1,5 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS barcode TEXT;
+
+-- the same box can't be scanned twice into one reception
+CREATE UNIQUE INDEX IF NOT EXISTS products__reception_id_barcode
+    ON products(reception_id, barcode) WHERE barcode IS NOT NULL;
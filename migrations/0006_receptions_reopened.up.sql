@@ -0,0 +1,3 @@
+-- who and when reopened a closed reception, NULL if it has never been reopened
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopened_by UUID;
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopened_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,15 @@
+-- no foreign keys: products are deleted and dummy login users don't exist in the users table,
+-- but their audit events must stay
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    action SMALLINT NOT NULL,
+    pvz_id UUID NOT NULL,
+    reception_id UUID,
+    product_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_events__created_at_id ON audit_events(created_at, id);
+CREATE INDEX IF NOT EXISTS audit_events__user_id_created_at ON audit_events(user_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_events__pvz_id_created_at ON audit_events(pvz_id, created_at);
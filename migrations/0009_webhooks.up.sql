@@ -0,0 +1,33 @@
+-- pvz of the event, subscriptions are filtered by it
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS pvz_id UUID;
+UPDATE outbox SET pvz_id = (payload->'reception'->>'pvzId')::UUID WHERE pvz_id IS NULL;
+ALTER TABLE outbox ALTER COLUMN pvz_id SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    url TEXT NOT NULL,
+    event_types SMALLINT[] NOT NULL,
+    -- empty means events of all pvz
+    pvz_ids UUID[] NOT NULL DEFAULT '{}',
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    outbox_id UUID NOT NULL REFERENCES outbox(id),
+    status SMALLINT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_status_code INT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    -- the outbox relay is at-least-once, a redelivered event must not be sent to the subscription twice
+    UNIQUE (subscription_id, outbox_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries__next_attempt_at_pending ON webhook_deliveries(next_attempt_at) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS webhook_deliveries__subscription_id_created_at ON webhook_deliveries(subscription_id, created_at);
@@ -0,0 +1,8 @@
+-- employees may change receptions and products only at the PVZs they are assigned to
+CREATE TABLE IF NOT EXISTS user_pvz_assignments (
+    user_id UUID NOT NULL REFERENCES users(id),
+    pvz_id UUID NOT NULL REFERENCES pvz(id),
+    assigned_by UUID NOT NULL,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, pvz_id)
+);
@@ -0,0 +1,7 @@
+-- failed login attempts by email ("email:<email>") and by client IP ("ip:<ip>"), a row is reset on successful login
+-- or when a moderator unlocks the user
+CREATE TABLE IF NOT EXISTS login_failures (
+    key TEXT PRIMARY KEY,
+    failures INT NOT NULL,
+    last_failure_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS refresh_tokens__user_id;
+
+ALTER TABLE users DROP COLUMN IF EXISTS disabled;
@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFAULT false;
+
+-- refresh tokens of a user are revoked when the user is disabled or the password is changed
+CREATE INDEX IF NOT EXISTS refresh_tokens__user_id ON refresh_tokens(user_id) WHERE revoked_at IS NULL;
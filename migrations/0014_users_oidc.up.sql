@@ -0,0 +1,4 @@
+-- users who logged in with OpenID Connect are linked to the subject of the identity provider,
+-- their password is empty until a moderator resets it
+ALTER TABLE users ADD COLUMN IF NOT EXISTS oidc_issuer TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS oidc_subject TEXT;
@@ -0,0 +1,14 @@
+-- keys of machine clients, only the sha256 hash of a key is stored, the key itself is shown once on creation
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    key_hash BYTEA NOT NULL UNIQUE,
+    user_role SMALLINT NOT NULL,
+    -- empty means the PVZs of the role
+    pvz_ids UUID[] NOT NULL DEFAULT '{}',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
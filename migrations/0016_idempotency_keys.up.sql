@@ -0,0 +1,14 @@
+-- responses of requests sent with an Idempotency-Key header, replayed when the client retries the request;
+-- status_code is NULL while the first request is being handled
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    client TEXT NOT NULL,
+    key TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    status_code SMALLINT,
+    response_body BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (client, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys__expires_at ON idempotency_keys(expires_at);
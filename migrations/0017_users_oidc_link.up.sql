@@ -0,0 +1,2 @@
+-- an existing user is linked to an OpenID Connect identity with the same email only after a moderator allowed it
+ALTER TABLE users ADD COLUMN IF NOT EXISTS oidc_link_allowed BOOLEAN NOT NULL DEFAULT false;
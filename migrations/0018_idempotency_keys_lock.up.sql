@@ -0,0 +1,6 @@
+-- a request being handled holds its key until locked_until, after that a retry takes the key over,
+-- so a key is not blocked until it expires when the instance handling the request dies
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP WITH TIME ZONE;
+
+-- requests in progress before the column was added can be taken over at once
+UPDATE idempotency_keys SET locked_until = created_at WHERE status_code IS NULL AND locked_until IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS outbox__next_attempt_at_pending;
+CREATE INDEX IF NOT EXISTS outbox__next_attempt_at_pending ON outbox(next_attempt_at) WHERE delivered_at IS NULL;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS dead_at;
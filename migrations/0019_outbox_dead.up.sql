@@ -0,0 +1,6 @@
+-- a message is given up after the relay's maximum number of attempts and stays for inspection
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS dead_at TIMESTAMP WITH TIME ZONE;
+
+DROP INDEX IF EXISTS outbox__next_attempt_at_pending;
+CREATE INDEX IF NOT EXISTS outbox__next_attempt_at_pending ON outbox(next_attempt_at)
+    WHERE delivered_at IS NULL AND dead_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS audit_events__api_key_id_created_at;
+
+ALTER TABLE audit_events DROP COLUMN IF EXISTS api_key_id;
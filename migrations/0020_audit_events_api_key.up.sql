@@ -0,0 +1,5 @@
+-- changes made by machine clients belong to no user, the API key identifies them
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS api_key_id UUID;
+
+CREATE INDEX IF NOT EXISTS audit_events__api_key_id_created_at ON audit_events(api_key_id, created_at)
+    WHERE api_key_id IS NOT NULL;
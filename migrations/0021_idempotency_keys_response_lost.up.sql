@@ -0,0 +1,3 @@
+-- a request that is done but whose response could not be stored keeps its key until it expires,
+-- so a retry neither takes the key over nor does the request again
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS response_lost BOOLEAN NOT NULL DEFAULT false;
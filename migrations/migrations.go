@@ -0,0 +1,8 @@
+// Package migrations contains versioned SQL migrations of the service database.
+// File names follow the pattern <version>_<name>.up.sql / <version>_<name>.down.sql.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
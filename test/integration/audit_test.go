@@ -0,0 +1,100 @@
+//go:build integration
+
+package integration
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_Audit(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+
+	email := strconv.Itoa(rand.Int()) + "email@gmail.com"
+	resp := apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	employee := parseJSON[api.User](t, resp)
+	require.NotNil(t, employee.Id)
+
+	resp = apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Казань,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPut(t, "/pvz/"+pvz.Id.String()+"/employees/"+employee.Id.String(), moderatorToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusOK)
+	employeeToken := parseJSON[string](t, resp)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+		PvzId: *pvz.Id,
+		Type:  api.PostProductsJSONBodyTypeОбувь,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	product := parseJSON[api.Product](t, resp)
+	require.NotNil(t, product.Id)
+
+	resp = apiDelete(t, "/products/"+product.Id.String(), employeeToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiGet(t, "/audit?pvzId="+pvz.Id.String(), employeeToken)
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiGet(t, "/audit?pvzId="+pvz.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	events := parseJSON[[]api.AuditEvent](t, resp)
+
+	actions := make([]api.AuditEventAction, 0, len(events))
+	for _, event := range events {
+		actions = append(actions, event.Action)
+	}
+	require.Equal(t, []api.AuditEventAction{
+		api.ReceptionClosed,
+		api.ProductRemoved,
+		api.ProductAdded,
+		api.ReceptionCreated,
+		api.PvzRegistered,
+	}, actions)
+
+	// who deleted that product
+	require.Equal(t, *employee.Id, events[1].UserId)
+	require.Equal(t, product.Id, events[1].ProductId)
+	require.Equal(t, reception.Id, events[1].ReceptionId)
+
+	resp = apiGet(t, "/audit?action=product_removed&userId="+employee.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	events = parseJSON[[]api.AuditEvent](t, resp)
+	require.Len(t, events, 1)
+	require.Equal(t, product.Id, events[0].ProductId)
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+package integration
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+// Test_JWKS verifies a token only with the published keys, as another service would do
+func Test_JWKS(t *testing.T) {
+	setUp()
+
+	resp := apiGet(t, "/.well-known/jwks.json", "")
+	assertStatus(t, resp, http.StatusOK)
+	jwks := parseJSON[api.JWKS](t, resp)
+	require.NotEmpty(t, jwks.Keys)
+
+	token := dummyLogin(t, model.UserRoleEmployee)
+
+	parsed, err := jwt.Parse(token, func(token *jwt.Token) (any, error) {
+		for _, key := range jwks.Keys {
+			if key.Kid != token.Header["kid"] {
+				continue
+			}
+			require.Equal(t, key.Alg, token.Method.Alg())
+			return publicKeyFromJWK(t, key), nil
+		}
+		return nil, jwt.ErrInvalidKey
+	})
+	require.NoError(t, err)
+	require.True(t, parsed.Valid)
+
+	claims, _ := parsed.Claims.(jwt.MapClaims)
+	require.Equal(t, "employee", claims["role"])
+}
+
+func publicKeyFromJWK(t *testing.T, key api.JWK) any {
+	t.Helper()
+
+	decode := func(s *string) *big.Int {
+		require.NotNil(t, s)
+		b, err := base64.RawURLEncoding.DecodeString(*s)
+		require.NoError(t, err)
+		return new(big.Int).SetBytes(b)
+	}
+
+	switch key.Kty {
+	case "EC":
+		require.Equal(t, "P-256", *key.Crv)
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: decode(key.X), Y: decode(key.Y)}
+	case "RSA":
+		return &rsa.PublicKey{N: decode(key.N), E: int(decode(key.E).Int64())}
+	}
+	t.Fatalf("unexpected key type %s", key.Kty)
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:build integration
+
+package integration
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_LoginLockout(t *testing.T) {
+	setUp()
+
+	email := strconv.Itoa(rand.Int()) + "lockout@gmail.com"
+	resp := apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	user := parseJSON[api.User](t, resp)
+	require.NotNil(t, user.Id)
+
+	// wrong passwords lock the account after LOGIN_MAX_FAILURES attempts, attempts made before the delay
+	// after the previous failure has passed are throttled
+	locked := false
+	for range 20 {
+		resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+			Email:    openapi_types.Email(email),
+			Password: "wrong password",
+		})
+		if resp.StatusCode == http.StatusLocked {
+			locked = true
+			break
+		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+			require.NoError(t, err)
+			time.Sleep(time.Duration(retryAfter) * time.Second)
+			continue
+		}
+		assertStatus(t, resp, http.StatusUnauthorized)
+	}
+	require.True(t, locked)
+
+	// the locked account can't log in even with the right password
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusLocked)
+	require.NotEmpty(t, resp.Header.Get("Retry-After"))
+
+	unlockPath := "/users/" + user.Id.String() + "/unlock"
+
+	resp = apiPost(t, unlockPath, dummyLogin(t, model.UserRoleEmployee), struct{}{})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiPost(t, unlockPath, dummyLogin(t, model.UserRoleModerator), struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/users/"+model.DefaultUserID.UUID().String()+"/unlock", dummyLogin(t, model.UserRoleModerator), struct{}{})
+	assertStatus(t, resp, http.StatusNotFound)
+}
@@ -4,18 +4,42 @@ package integration
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"database/sql"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	_ "github.com/jackc/pgx/v5/stdlib" // register "pgx" database/sql driver
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/generated/pvz_v1"
+	"github.com/inna-maikut/avito-pvz/internal/grpc_api/pvz_service"
 	"github.com/inna-maikut/avito-pvz/internal/infrastructure/config"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/jwt"
+	"github.com/inna-maikut/avito-pvz/internal/infrastructure/middleware"
 	"github.com/inna-maikut/avito-pvz/internal/model"
+	"github.com/inna-maikut/avito-pvz/internal/repository"
+	"github.com/inna-maikut/avito-pvz/internal/usecases/pvz_list_getting"
 )
 
 func setUp() {
@@ -79,6 +103,173 @@ func apiPost[In any](t *testing.T, path, token string, in In) *http.Response {
 	return resp
 }
 
+// apiPut path should start with slash
+func apiPut[In any](t *testing.T, path, token string, in In) *http.Response {
+	t.Helper()
+
+	inStr, err := json.Marshal(in)
+	require.NoError(t, err)
+
+	url := "http://localhost:" + os.Getenv("SERVER_PORT") + path
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(inStr))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return resp
+}
+
+// apiPatch path should start with slash
+func apiPatch[In any](t *testing.T, path, token string, in In) *http.Response {
+	t.Helper()
+
+	inStr, err := json.Marshal(in)
+	require.NoError(t, err)
+
+	url := "http://localhost:" + os.Getenv("SERVER_PORT") + path
+	req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(inStr))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return resp
+}
+
+// apiDelete path should start with slash
+func apiDelete(t *testing.T, path, token string) *http.Response {
+	t.Helper()
+
+	url := "http://localhost:" + os.Getenv("SERVER_PORT") + path
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err)
+
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return resp
+}
+
+// grpcPVZClient serves the PVZ service in process over bufconn, with the auth interceptor of the server and
+// repositories over the test database. Tokens are verified with the keys published by the HTTP server, so tokens
+// of dummyLogin are accepted, and are passed with grpcAuthContext.
+func grpcPVZClient(t *testing.T) pvz_v1.PVZServiceClient {
+	t.Helper()
+
+	cfg := config.Load()
+
+	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%d/%s",
+		cfg.DatabaseUser, cfg.DatabasePassword, cfg.DatabaseHost,
+		cfg.DatabasePort, cfg.DatabaseName)
+	sqlDB, err := sql.Open("pgx", databaseURL)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+	db := sqlx.NewDb(sqlDB, "pgx")
+
+	pvzRepo, err := repository.NewPVZRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	receptionRepo, err := repository.NewReceptionRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	productRepo, err := repository.NewProductRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	revokedTokenRepo, err := repository.NewRevokedTokenRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	userRepo, err := repository.NewUserRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+	apiKeyRepo, err := repository.NewAPIKeyRepository(db, trmsqlx.DefaultCtxGetter)
+	require.NoError(t, err)
+
+	pvzListGetting, err := pvz_list_getting.New(pvzRepo, receptionRepo, productRepo)
+	require.NoError(t, err)
+	pvzService, err := pvz_service.New(pvzListGetting, zap.NewNop())
+	require.NoError(t, err)
+
+	authMode, err := model.ParseAuthMode(cfg.AppEnv)
+	require.NoError(t, err)
+
+	interceptor := middleware.CreateGRPCAuthInterceptor(grpcTokenProvider(t), revokedTokenRepo, userRepo, apiKeyRepo,
+		model.DefaultRolePermissions(), authMode.DummyTokenAccess(cfg.DummyTokensReadOnly),
+		map[string]model.Permission{
+			pvz_v1.PVZService_GetPVZList_FullMethodName: model.PermissionPVZRead,
+		})
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	pvz_v1.RegisterPVZServiceServer(server, pvzService)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return pvz_v1.NewPVZServiceClient(conn)
+}
+
+// grpcTokenProvider verifies tokens with the keys of the JWKS of the HTTP server. The provider needs a key to sign
+// with, a generated one is never used.
+func grpcTokenProvider(t *testing.T) *jwt.Provider {
+	t.Helper()
+
+	resp := apiGet(t, "/.well-known/jwks.json", "")
+	assertStatus(t, resp, http.StatusOK)
+	jwks := parseJSON[jwt.JWKS](t, resp)
+
+	keys := make([]jwt.Key, 0, len(jwks.Keys)+1)
+	for _, jwk := range jwks.Keys {
+		key, err := jwt.ParseJWK(jwk)
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(private)
+	require.NoError(t, err)
+	signingKey, err := jwt.ParseKey("grpc-test", pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	keys = append(keys, signingKey)
+
+	keySet, err := jwt.NewKeySet(keys...)
+	require.NoError(t, err)
+	provider, err := jwt.New(keySet, signingKey.ID, time.Hour)
+	require.NoError(t, err)
+
+	return provider
+}
+
+func grpcAuthContext(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", token)
+}
+
 func parseJSON[Out any](t *testing.T, resp *http.Response) Out {
 	var out Out
 
@@ -101,5 +292,5 @@ func assertStatus(t *testing.T, resp *http.Response, expected int) {
 	defer func() { _ = resp.Body.Close() }()
 	require.NoError(t, err)
 
-	require.Equal(t, http.StatusOK, resp.StatusCode, "body: "+string(bodyBytes))
+	require.Equal(t, expected, resp.StatusCode, "body: "+string(bodyBytes))
 }
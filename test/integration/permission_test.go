@@ -0,0 +1,64 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_RolePermissions(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+	auditorToken := dummyLogin(t, model.UserRoleAuditor)
+	regionalManagerToken := dummyLogin(t, model.UserRoleRegionalManager)
+
+	// the auditor only reads
+	resp := apiPost(t, "/pvz", auditorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusForbidden)
+	require.Equal(t, "permission pvz:create is required", parseJSON[api.Error](t, resp).Message)
+
+	resp = apiGet(t, "/pvz", auditorToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiGet(t, "/audit", auditorToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	resp = apiPost(t, "/receptions", regionalManagerToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	// the regional manager closes and reopens receptions at any pvz
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", regionalManagerToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/receptions/"+reception.Id.String()+"/reopen", auditorToken, struct{}{})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiPost(t, "/receptions/"+reception.Id.String()+"/reopen", regionalManagerToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+}
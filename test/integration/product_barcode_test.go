@@ -0,0 +1,71 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_AddProduct_Barcode(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	barcode := strconv.FormatInt(time.Now().UnixNano(), 10)
+
+	resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+		PvzId:   *pvz.Id,
+		Type:    api.PostProductsJSONBodyTypeОбувь,
+		Barcode: &barcode,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	product := parseJSON[api.Product](t, resp)
+	require.Equal(t, &barcode, product.Barcode)
+
+	// the same box scanned twice
+	resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+		PvzId:   *pvz.Id,
+		Type:    api.PostProductsJSONBodyTypeОбувь,
+		Barcode: &barcode,
+	})
+	assertStatus(t, resp, http.StatusConflict)
+
+	// products without barcode are not checked
+	for range 2 {
+		resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+			PvzId: *pvz.Id,
+			Type:  api.PostProductsJSONBodyTypeОдежда,
+		})
+		assertStatus(t, resp, http.StatusCreated)
+	}
+
+	resp = apiGet(t, "/receptions/"+reception.Id.String(), employeeToken)
+	assertStatus(t, resp, http.StatusOK)
+	res := parseJSON[receptionGetResponse](t, resp)
+	require.Len(t, res.Products, 3)
+	require.Equal(t, &barcode, res.Products[0].Barcode)
+	require.Nil(t, res.Products[1].Barcode)
+}
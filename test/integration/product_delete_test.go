@@ -0,0 +1,72 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_DeleteProduct(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Казань,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	productIDs := make([]string, 0, 3)
+	for range 3 {
+		resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+			PvzId: *pvz.Id,
+			Type:  api.PostProductsJSONBodyTypeЭлектроника,
+		})
+		assertStatus(t, resp, http.StatusCreated)
+		product := parseJSON[api.Product](t, resp)
+		require.NotNil(t, product.Id)
+		productIDs = append(productIDs, product.Id.String())
+	}
+
+	resp = apiDelete(t, "/products/"+productIDs[1], moderatorToken)
+	assertStatus(t, resp, http.StatusForbidden)
+
+	// a product from the middle of the reception
+	resp = apiDelete(t, "/products/"+productIDs[1], employeeToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiDelete(t, "/products/"+productIDs[1], employeeToken)
+	assertStatus(t, resp, http.StatusNotFound)
+
+	// LIFO removal still works after deleting by ID
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/delete_last_product", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiGet(t, "/receptions/"+reception.Id.String(), employeeToken)
+	assertStatus(t, resp, http.StatusOK)
+	res := parseJSON[receptionGetResponse](t, resp)
+	require.Len(t, res.Products, 1)
+	require.Equal(t, productIDs[0], res.Products[0].Id.String())
+
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiDelete(t, "/products/"+productIDs[0], employeeToken)
+	assertStatus(t, resp, http.StatusBadRequest)
+}
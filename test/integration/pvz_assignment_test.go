@@ -0,0 +1,87 @@
+//go:build integration
+
+package integration
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_PVZAssignment(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Казань,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	email := strconv.Itoa(rand.Int()) + "assigned@gmail.com"
+	resp = apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	user := parseJSON[api.User](t, resp)
+	require.NotNil(t, user.Id)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusOK)
+	refreshToken := resp.Header.Get("X-Refresh-Token")
+	employeeToken := parseJSON[string](t, resp)
+
+	// the employee is not assigned to the pvz yet
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	assignmentPath := "/pvz/" + pvz.Id.String() + "/employees/" + user.Id.String()
+
+	resp = apiPut(t, assignmentPath, employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiPut(t, assignmentPath, moderatorToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	// the assignment gets into the next issued token
+	resp = apiPost(t, "/token/refresh", "", api.PostTokenRefreshJSONBody{RefreshToken: refreshToken})
+	assertStatus(t, resp, http.StatusOK)
+	employeeToken = parseJSON[string](t, resp)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+		PvzId: *pvz.Id,
+		Type:  api.PostProductsJSONBodyTypeОбувь,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	resp = apiDelete(t, assignmentPath, moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiDelete(t, assignmentPath, moderatorToken)
+	assertStatus(t, resp, http.StatusNotFound)
+
+	// the dummy user is not registered
+	resp = apiPut(t, "/pvz/"+pvz.Id.String()+"/employees/"+model.DefaultUserID.UUID().String(), moderatorToken, struct{}{})
+	assertStatus(t, resp, http.StatusNotFound)
+}
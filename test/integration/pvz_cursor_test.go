@@ -0,0 +1,83 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzCursorPage struct {
+	Items      []api.PVZListItem `json:"items"`
+	NextCursor *string           `json:"nextCursor"`
+}
+
+func Test_GetPVZ_Cursor(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.СанктПетербург,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	receptionIDs := make([]openapi_types.UUID, 0, 3)
+	var from, to time.Time
+	for i := range 3 {
+		resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+			PvzId: *pvz.Id,
+		})
+		assertStatus(t, resp, http.StatusCreated)
+		reception := parseJSON[api.Reception](t, resp)
+		require.NotNil(t, reception.Id)
+		receptionIDs = append(receptionIDs, *reception.Id)
+		if i == 0 {
+			from = reception.DateTime
+		}
+		to = reception.DateTime
+
+		resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+		assertStatus(t, resp, http.StatusOK)
+	}
+
+	query := url.Values{}
+	query.Set("startDate", from.UTC().Format(time.RFC3339Nano))
+	query.Set("endDate", to.UTC().Format(time.RFC3339Nano))
+	query.Set("limit", "1")
+	query.Set("cursor", "")
+
+	var found []openapi_types.UUID
+	for range 100 {
+		resp = apiGet(t, "/pvz?"+query.Encode(), employeeToken)
+		assertStatus(t, resp, http.StatusOK)
+		page := parseJSON[pvzCursorPage](t, resp)
+
+		for _, item := range page.Items {
+			if item.Pvz == nil || *item.Pvz.Id != *pvz.Id || item.Receptions == nil {
+				continue
+			}
+			for _, r := range *item.Receptions {
+				found = append(found, *r.Reception.Id)
+			}
+		}
+
+		if page.NextCursor == nil {
+			break
+		}
+		query.Set("cursor", *page.NextCursor)
+	}
+
+	require.Equal(t, receptionIDs, found)
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type pvzDetailsResponse struct {
+	PVZ                 api.PVZ        `json:"pvz"`
+	InProgressReception *api.Reception `json:"inProgressReception"`
+}
+
+type pvzReceptionItem struct {
+	Reception api.Reception `json:"reception"`
+	Products  []api.Product `json:"products"`
+}
+
+func Test_GetPVZDetailsAndReceptions(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	// a pvz without receptions is available too
+	resp = apiGet(t, "/pvz/"+pvz.Id.String(), employeeToken)
+	assertStatus(t, resp, http.StatusOK)
+	details := parseJSON[pvzDetailsResponse](t, resp)
+	require.Equal(t, *pvz.Id, *details.PVZ.Id)
+	require.Nil(t, details.InProgressReception)
+
+	resp = apiGet(t, "/pvz/"+pvz.Id.String()+"/receptions", employeeToken)
+	assertStatus(t, resp, http.StatusOK)
+	require.Empty(t, parseJSON[[]pvzReceptionItem](t, resp))
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception1 := parseJSON[api.Reception](t, resp)
+
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception2 := parseJSON[api.Reception](t, resp)
+
+	resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+		PvzId: *pvz.Id,
+		Type:  api.PostProductsJSONBodyTypeОдежда,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	resp = apiGet(t, "/pvz/"+pvz.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	details = parseJSON[pvzDetailsResponse](t, resp)
+	require.NotNil(t, details.InProgressReception)
+	require.Equal(t, *reception2.Id, *details.InProgressReception.Id)
+
+	resp = apiGet(t, "/pvz/"+pvz.Id.String()+"/receptions?limit=1", moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	page1 := parseJSON[[]pvzReceptionItem](t, resp)
+	require.Len(t, page1, 1)
+	require.Equal(t, *reception2.Id, *page1[0].Reception.Id)
+	require.Len(t, page1[0].Products, 1)
+
+	resp = apiGet(t, "/pvz/"+pvz.Id.String()+"/receptions?limit=1&page=2", moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	page2 := parseJSON[[]pvzReceptionItem](t, resp)
+	require.Len(t, page2, 1)
+	require.Equal(t, *reception1.Id, *page2[0].Reception.Id)
+	require.Empty(t, page2[0].Products)
+
+	resp = apiGet(t, "/pvz/"+uuid.NewString(), employeeToken)
+	assertStatus(t, resp, http.StatusNotFound)
+
+	resp = apiGet(t, "/pvz/"+uuid.NewString()+"/receptions", employeeToken)
+	assertStatus(t, resp, http.StatusNotFound)
+}
@@ -0,0 +1,107 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/generated/pvz_v1"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_GRPC_GetPVZList_OK(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Казань,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	for range 3 {
+		resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+			PvzId: *pvz.Id,
+			Type:  api.PostProductsJSONBodyTypeЭлектроника,
+		})
+		assertStatus(t, resp, http.StatusCreated)
+	}
+
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	client := grpcPVZClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := client.GetPVZList(grpcAuthContext(ctx, employeeToken), &pvz_v1.GetPVZListRequest{
+		StartDate: timestamppb.New(reception.DateTime),
+		EndDate:   timestamppb.New(reception.DateTime),
+		Limit:     30,
+	})
+	require.NoError(t, err)
+
+	var found *pvz_v1.PVZItem
+	for _, item := range res.GetPvzs() {
+		if item.GetPvz().GetId() == pvz.Id.String() {
+			found = item
+			break
+		}
+	}
+	require.NotNil(t, found, "pvz not found in grpc response")
+	require.Equal(t, "Казань", found.GetPvz().GetCity())
+	require.Len(t, found.GetReceptions(), 1)
+	require.Equal(t, reception.Id.String(), found.GetReceptions()[0].GetReception().GetId())
+	require.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSE, found.GetReceptions()[0].GetReception().GetStatus())
+	require.Len(t, found.GetReceptions()[0].GetProducts(), 3)
+}
+
+func Test_GRPC_GetPVZList_InvalidArgument(t *testing.T) {
+	setUp()
+
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+	client := grpcPVZClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.GetPVZList(grpcAuthContext(ctx, employeeToken), &pvz_v1.GetPVZListRequest{
+		Limit: 31,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_GRPC_GetPVZList_Unauthenticated(t *testing.T) {
+	setUp()
+
+	client := grpcPVZClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetPVZList(grpcAuthContext(ctx, "invalid"), &pvz_v1.GetPVZListRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,53 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_GetPVZ_ModePVZ(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Казань,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	query := url.Values{}
+	query.Set("mode", "pvz")
+	query.Set("city", string(api.Казань))
+	query.Set("limit", "30")
+
+	var found *api.PVZListItem
+	for page := 1; found == nil; page++ {
+		query.Set("page", strconv.Itoa(page))
+		resp = apiGet(t, "/pvz?"+query.Encode(), moderatorToken)
+		assertStatus(t, resp, http.StatusOK)
+		items := parseJSON[[]api.PVZListItem](t, resp)
+		require.NotEmpty(t, items, "registered pvz not found")
+
+		for _, item := range items {
+			require.Equal(t, api.Казань, item.Pvz.City)
+			if *item.Pvz.Id == *pvz.Id {
+				found = &item
+				break
+			}
+		}
+	}
+
+	require.NotNil(t, found.Receptions)
+	require.Empty(t, *found.Receptions)
+}
@@ -0,0 +1,59 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+type receptionGetResponse struct {
+	Reception api.Reception `json:"reception"`
+	Products  []api.Product `json:"products"`
+}
+
+func Test_GetReception(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	for range 2 {
+		resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+			PvzId: *pvz.Id,
+			Type:  api.PostProductsJSONBodyTypeОбувь,
+		})
+		assertStatus(t, resp, http.StatusCreated)
+	}
+
+	resp = apiGet(t, "/receptions/"+reception.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	res := parseJSON[receptionGetResponse](t, resp)
+	require.Equal(t, *reception.Id, *res.Reception.Id)
+	require.Equal(t, *pvz.Id, res.Reception.PvzId)
+	require.Equal(t, api.InProgress, res.Reception.Status)
+	require.Len(t, res.Products, 2)
+
+	resp = apiGet(t, "/receptions/"+uuid.NewString(), employeeToken)
+	assertStatus(t, resp, http.StatusNotFound)
+}
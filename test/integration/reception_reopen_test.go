@@ -0,0 +1,69 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_ReopenReception(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	reception := parseJSON[api.Reception](t, resp)
+	require.NotNil(t, reception.Id)
+
+	reopenPath := "/receptions/" + reception.Id.String() + "/reopen"
+
+	resp = apiPost(t, reopenPath, moderatorToken, struct{}{})
+	assertStatus(t, resp, http.StatusConflict)
+
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, reopenPath, employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiPost(t, reopenPath, moderatorToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+	reopened := parseJSON[api.Reception](t, resp)
+	require.Equal(t, api.InProgress, reopened.Status)
+
+	// products can be added into the reopened reception
+	resp = apiPost(t, "/products", employeeToken, api.PostProductsJSONBody{
+		PvzId: *pvz.Id,
+		Type:  api.PostProductsJSONBodyTypeОдежда,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	// another reception is opened while the first one is closed
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	resp = apiPost(t, reopenPath, moderatorToken, struct{}{})
+	assertStatus(t, resp, http.StatusConflict)
+}
@@ -19,22 +19,23 @@ func Test_RegisterLogin(t *testing.T) {
 
 	email := strconv.Itoa(rand.Int()) + "email@gmail.com"
 
+	// a failed attempt delays the next one for the email, so an unknown user is checked with another one
 	resp := apiPost(t, "/login", "", api.PostLoginJSONBody{
-		Email:    openapi_types.Email(email),
-		Password: "password1",
+		Email:    openapi_types.Email(strconv.Itoa(rand.Int()) + "unknown@gmail.com"),
+		Password: "pvz-password-1",
 	})
 	assertStatus(t, resp, http.StatusUnauthorized)
 
 	resp = apiPost(t, "/register", "", api.PostRegisterJSONBody{
 		Email:    openapi_types.Email(email),
-		Password: "password1",
+		Password: "pvz-password-1",
 		Role:     api.Moderator,
 	})
 	assertStatus(t, resp, http.StatusCreated)
 
 	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
 		Email:    openapi_types.Email(email),
-		Password: "password1",
+		Password: "pvz-password-1",
 	})
 	assertStatus(t, resp, http.StatusOK)
 	moderatorToken := parseJSON[string](t, resp)
@@ -45,3 +46,29 @@ func Test_RegisterLogin(t *testing.T) {
 	})
 	assertStatus(t, resp, http.StatusCreated)
 }
+
+func Test_RegisterPasswordPolicy(t *testing.T) {
+	setUp()
+
+	email := strconv.Itoa(rand.Int()) + "weak@gmail.com"
+
+	resp := apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "Qwerty123",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusBadRequest)
+	policyErr := parseJSON[api.PasswordPolicyError](t, resp)
+	require.NotNil(t, policyErr.Violations)
+	require.Equal(t, []string{"not_breached"}, *policyErr.Violations)
+
+	resp = apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "short",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusBadRequest)
+	policyErr = parseJSON[api.PasswordPolicyError](t, resp)
+	require.NotNil(t, policyErr.Violations)
+	require.Equal(t, []string{"min_length", "digit"}, *policyErr.Violations)
+}
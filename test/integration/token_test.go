@@ -0,0 +1,71 @@
+//go:build integration
+
+package integration
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+)
+
+func Test_RefreshTokenLogout(t *testing.T) {
+	setUp()
+
+	email := strconv.Itoa(rand.Int()) + "refresh@gmail.com"
+
+	resp := apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusOK)
+	refreshToken1 := resp.Header.Get("X-Refresh-Token")
+	require.NotEmpty(t, refreshToken1)
+	require.NotEmpty(t, parseJSON[string](t, resp))
+
+	// refresh rotates the refresh token
+	resp = apiPost(t, "/token/refresh", "", api.PostTokenRefreshJSONBody{RefreshToken: refreshToken1})
+	assertStatus(t, resp, http.StatusOK)
+	refreshToken2 := resp.Header.Get("X-Refresh-Token")
+	require.NotEmpty(t, refreshToken2)
+	require.NotEqual(t, refreshToken1, refreshToken2)
+	accessToken := parseJSON[string](t, resp)
+
+	resp = apiGet(t, "/pvz", accessToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	// reuse of the rotated token revokes the whole family, including the token issued by the refresh
+	resp = apiPost(t, "/token/refresh", "", api.PostTokenRefreshJSONBody{RefreshToken: refreshToken1})
+	assertStatus(t, resp, http.StatusUnauthorized)
+	resp = apiPost(t, "/token/refresh", "", api.PostTokenRefreshJSONBody{RefreshToken: refreshToken2})
+	assertStatus(t, resp, http.StatusUnauthorized)
+
+	// logout revokes the access token and the refresh token
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusOK)
+	refreshToken3 := resp.Header.Get("X-Refresh-Token")
+	accessToken = parseJSON[string](t, resp)
+
+	resp = apiPost(t, "/logout", accessToken, api.PostLogoutJSONBody{RefreshToken: &refreshToken3})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiGet(t, "/pvz", accessToken)
+	assertStatus(t, resp, http.StatusUnauthorized)
+	resp = apiPost(t, "/token/refresh", "", api.PostTokenRefreshJSONBody{RefreshToken: refreshToken3})
+	assertStatus(t, resp, http.StatusUnauthorized)
+}
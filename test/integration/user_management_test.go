@@ -0,0 +1,121 @@
+//go:build integration
+
+package integration
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_UserManagement(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeDummyToken := dummyLogin(t, model.UserRoleEmployee)
+
+	email := strconv.Itoa(rand.Int()) + "managed@gmail.com"
+	resp := apiPost(t, "/register", "", api.PostRegisterJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+		Role:     api.Employee,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	user := parseJSON[api.User](t, resp)
+	require.NotNil(t, user.Id)
+	userPath := "/users/" + user.Id.String()
+
+	resp = apiGet(t, "/users?role=employee&limit=100", employeeDummyToken)
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiGet(t, "/users?role=employee&limit=100", moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	for _, u := range parseJSON[[]api.User](t, resp) {
+		require.Equal(t, api.UserRoleEmployee, u.Role)
+	}
+
+	// the user changes the password, the old one stops working
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-1",
+	})
+	assertStatus(t, resp, http.StatusOK)
+	refreshToken := resp.Header.Get("X-Refresh-Token")
+	employeeToken := parseJSON[string](t, resp)
+
+	resp = apiPost(t, "/users/me/password", employeeToken, api.PostUsersMePasswordJSONBody{
+		CurrentPassword: "wrong_password",
+		NewPassword:     "pvz-password-2",
+	})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiPost(t, "/users/me/password", employeeToken, api.PostUsersMePasswordJSONBody{
+		CurrentPassword: "pvz-password-1",
+		NewPassword:     "pvz-password-2",
+	})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/token/refresh", "", api.PostTokenRefreshJSONBody{RefreshToken: refreshToken})
+	assertStatus(t, resp, http.StatusUnauthorized)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-2",
+	})
+	assertStatus(t, resp, http.StatusOK)
+	employeeToken = parseJSON[string](t, resp)
+
+	// the moderator resets the password
+	resp = apiPost(t, userPath+"/reset_password", moderatorToken, api.PostUsersUserIdResetPasswordJSONBody{
+		Password: "pvz-password-3",
+	})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-3",
+	})
+	assertStatus(t, resp, http.StatusOK)
+
+	// the disabled user can't log in, the access token is rejected
+	disabled := true
+	resp = apiPatch(t, userPath, moderatorToken, api.PatchUsersUserIdJSONBody{Disabled: &disabled})
+	assertStatus(t, resp, http.StatusOK)
+	updated := parseJSON[api.User](t, resp)
+	require.NotNil(t, updated.Disabled)
+	require.True(t, *updated.Disabled)
+
+	resp = apiGet(t, "/pvz", employeeToken)
+	assertStatus(t, resp, http.StatusUnauthorized)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-3",
+	})
+	assertStatus(t, resp, http.StatusForbidden)
+
+	// enabled again with a new role
+	role := model.UserRoleAuditor.String()
+	enabled := false
+	resp = apiPatch(t, userPath, moderatorToken, api.PatchUsersUserIdJSONBody{Role: &role, Disabled: &enabled})
+	assertStatus(t, resp, http.StatusOK)
+	updated = parseJSON[api.User](t, resp)
+	require.Equal(t, api.UserRoleAuditor, updated.Role)
+
+	resp = apiPost(t, "/login", "", api.PostLoginJSONBody{
+		Email:    openapi_types.Email(email),
+		Password: "pvz-password-3",
+	})
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiPatch(t, "/users/"+model.NewUserID().UUID().String(), moderatorToken,
+		api.PatchUsersUserIdJSONBody{Disabled: &disabled})
+	assertStatus(t, resp, http.StatusNotFound)
+}
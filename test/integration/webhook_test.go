@@ -0,0 +1,109 @@
+//go:build integration
+
+package integration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/inna-maikut/avito-pvz/internal/api"
+	"github.com/inna-maikut/avito-pvz/internal/model"
+)
+
+func Test_Webhooks(t *testing.T) {
+	setUp()
+
+	moderatorToken := dummyLogin(t, model.UserRoleModerator)
+	employeeToken := dummyLogin(t, model.UserRoleEmployee)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	resp := apiPost(t, "/pvz", moderatorToken, api.PostPvzJSONRequestBody{
+		City: api.Москва,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+	pvz := parseJSON[api.PVZ](t, resp)
+	require.NotNil(t, pvz.Id)
+
+	secret := "0123456789abcdef"
+	input := api.WebhookSubscriptionInput{
+		Url:        server.URL,
+		EventTypes: []string{"reception.closed"},
+		PvzIds:     &[]openapi_types.UUID{*pvz.Id},
+		Secret:     &secret,
+	}
+
+	resp = apiPost(t, "/webhooks", employeeToken, input)
+	assertStatus(t, resp, http.StatusForbidden)
+
+	resp = apiPost(t, "/webhooks", moderatorToken, api.WebhookSubscriptionInput{
+		Url:        server.URL,
+		EventTypes: []string{"pvz_registered"},
+		Secret:     &secret,
+	})
+	assertStatus(t, resp, http.StatusBadRequest)
+
+	resp = apiPost(t, "/webhooks", moderatorToken, api.WebhookSubscriptionInput{
+		Url:        server.URL,
+		EventTypes: []string{"reception.closed"},
+	})
+	assertStatus(t, resp, http.StatusBadRequest)
+
+	resp = apiPost(t, "/webhooks", moderatorToken, input)
+	assertStatus(t, resp, http.StatusCreated)
+	subscription := parseJSON[api.WebhookSubscription](t, resp)
+	require.Equal(t, server.URL, subscription.Url)
+	require.Equal(t, []string{"reception.closed"}, subscription.EventTypes)
+	require.Equal(t, []openapi_types.UUID{*pvz.Id}, subscription.PvzIds)
+
+	resp = apiGet(t, "/webhooks/"+subscription.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	require.Equal(t, subscription, parseJSON[api.WebhookSubscription](t, resp))
+
+	resp = apiGet(t, "/webhooks", moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+	require.Contains(t, parseJSON[[]api.WebhookSubscription](t, resp), subscription)
+
+	// the secret is kept when it's not passed
+	input.EventTypes = []string{"reception.created", "reception.closed"}
+	input.Secret = nil
+	resp = apiPut(t, "/webhooks/"+subscription.Id.String(), moderatorToken, input)
+	assertStatus(t, resp, http.StatusOK)
+	subscription = parseJSON[api.WebhookSubscription](t, resp)
+	require.Equal(t, []string{"reception.created", "reception.closed"}, subscription.EventTypes)
+
+	resp = apiPost(t, "/receptions", employeeToken, api.PostReceptionsJSONBody{
+		PvzId: *pvz.Id,
+	})
+	assertStatus(t, resp, http.StatusCreated)
+
+	resp = apiPost(t, "/pvz/"+pvz.Id.String()+"/close_last_reception", employeeToken, struct{}{})
+	assertStatus(t, resp, http.StatusOK)
+
+	// the relay schedules a delivery per event, the delivery status depends on whether the service reaches the server
+	require.Eventually(t, func() bool {
+		resp = apiGet(t, "/webhooks/"+subscription.Id.String()+"/deliveries", moderatorToken)
+		assertStatus(t, resp, http.StatusOK)
+		return len(parseJSON[[]api.WebhookDelivery](t, resp)) == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	resp = apiGet(t, "/webhooks/"+subscription.Id.String()+"/deliveries?status=unknown", moderatorToken)
+	assertStatus(t, resp, http.StatusBadRequest)
+
+	resp = apiDelete(t, "/webhooks/"+subscription.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusOK)
+
+	resp = apiDelete(t, "/webhooks/"+subscription.Id.String(), moderatorToken)
+	assertStatus(t, resp, http.StatusNotFound)
+
+	resp = apiGet(t, "/webhooks/"+subscription.Id.String()+"/deliveries", moderatorToken)
+	assertStatus(t, resp, http.StatusNotFound)
+}